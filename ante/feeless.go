@@ -3,7 +3,6 @@ package ante
 import (
 	"errors"
 	"math"
-	"strings"
 
 	"cosmossdk.io/collections"
 
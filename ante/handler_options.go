@@ -31,7 +31,7 @@ type HandlerOptions struct {
 	BankKeeper             anteinterfaces.BankKeeper
 	IBCKeeper              *ibckeeper.Keeper
 	FeeMarketKeeper        anteinterfaces.FeeMarketKeeper
-	EvmKeeper              anteinterfaces.EVMKeeper
+	EvmKeeper              antetypes.EVMKeeper
 	FeeAbstractionKeeper   antetypes.FeeAbstractionKeeper
 	FeegrantKeeper         ante.FeegrantKeeper
 	ExtensionOptionChecker ante.ExtensionOptionChecker
@@ -0,0 +1,29 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	kiievmante "github.com/kiichain/kiichain/v4/x/feeabstraction/ante/evm"
+)
+
+// NewPostHandler returns a post handler that completes the leftover gas refund of the Ethereum transactions
+// The Cosmos SDK transactions have no post handler
+func NewPostHandler(options HandlerOptions) sdk.PostHandler {
+	evmPostHandler := sdk.ChainPostDecorators(
+		kiievmante.NewRefundDecorator(options.EvmKeeper, options.FeeAbstractionKeeper),
+	)
+
+	return func(ctx sdk.Context, tx sdk.Tx, simulate, success bool) (sdk.Context, error) {
+		// Only the Ethereum transactions are refunded
+		txWithExtensions, ok := tx.(authante.HasExtensionOptionsTx)
+		if ok {
+			opts := txWithExtensions.GetExtensionOptions()
+			if len(opts) > 0 && opts[0].GetTypeUrl() == "/cosmos.evm.vm.v1.ExtensionOptionsEthereumTx" {
+				return evmPostHandler(ctx, tx, simulate, success)
+			}
+		}
+
+		return ctx, nil
+	}
+}
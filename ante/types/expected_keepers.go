@@ -1,10 +1,26 @@
 package types
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	anteinterfaces "github.com/cosmos/evm/ante/interfaces"
 )
 
+// EVMKeeper defines the required interface for the EVM module
+// The transient gas used is read after the execution to refund the leftover gas
+type EVMKeeper interface {
+	anteinterfaces.EVMKeeper
+	GetTransientGasUsed(ctx sdk.Context) uint64
+}
+
 // FeeAbstractionKeeper defines the required interface for the Fee Abstraction module
 type FeeAbstractionKeeper interface {
 	ConvertNativeFee(ctx sdk.Context, account sdk.AccAddress, fees sdk.Coins) (sdk.Coins, error)
+	HasPaymaster(ctx context.Context, contract sdk.AccAddress) (bool, error)
+	ChargePaymaster(ctx sdk.Context, contract, user sdk.AccAddress, msgs []sdk.Msg, fees sdk.Coins) error
+	ChargeEVMPaymaster(ctx sdk.Context, contract, user, callee sdk.AccAddress, fees sdk.Coins) error
+	RefundPaymaster(ctx sdk.Context, contract, user sdk.AccAddress, refund sdk.Coins) error
+	RefundFees(ctx sdk.Context, account sdk.AccAddress, refund sdk.Coins) error
 }
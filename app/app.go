@@ -67,6 +67,7 @@ import (
 	"github.com/kiichain/kiichain/v4/app/keepers"
 	"github.com/kiichain/kiichain/v4/app/upgrades"
 	v4_0 "github.com/kiichain/kiichain/v4/app/upgrades/v4_0"
+	v5_0 "github.com/kiichain/kiichain/v4/app/upgrades/v5_0"
 	"github.com/kiichain/kiichain/v4/client/docs"
 )
 
@@ -77,6 +78,7 @@ var (
 	// Upgrades is a list of all the upgrades that are available for the application.
 	Upgrades = []upgrades.Upgrade{
 		v4_0.Upgrade,
+		v5_0.Upgrade,
 	}
 )
 
@@ -311,7 +313,7 @@ func NewKiichainApp(
 	return app
 }
 
-// setAnteHandler sets the antehandler on the app
+// setAnteHandler sets the antehandler and the posthandler on the app
 func (app *KiichainApp) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64, appOpts servertypes.AppOptions) {
 	wasmConfig, err := wasm.ReadWasmConfig(appOpts)
 	if err != nil {
@@ -342,6 +344,7 @@ func (app *KiichainApp) setAnteHandler(txConfig client.TxConfig, maxGasWanted ui
 	}
 
 	app.SetAnteHandler(kiiante.NewAnteHandler(options))
+	app.SetPostHandler(kiiante.NewPostHandler(options))
 }
 
 // Name returns the name of the App
@@ -155,6 +155,15 @@ type AppKeepers struct {
 var tokenFactoryCapabilities = []string{
 	tokenfactorytypes.EnableSetMetadata,
 	tokenfactorytypes.EnableCommunityPoolFeeFunding,
+	tokenfactorytypes.EnableBeforeSendHook,
+}
+
+// tokenFactoryFreezeExemptModules are the modules whose payouts are not blocked by the tokenfactory pauses and freezes
+// They only pay out the coins they already hold, the transfers into them are still blocked
+var tokenFactoryFreezeExemptModules = []string{
+	authtypes.FeeCollectorName,
+	distrtypes.ModuleName,
+	rewardstypes.ModuleName,
 }
 
 func NewAppKeeper(
@@ -431,7 +440,10 @@ func NewAppKeeper(
 	appKeepers.RewardsKeeper = rewardskeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(appKeepers.keys[rewardstypes.StoreKey]),
+		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
+		appKeepers.DistrKeeper,
+		appKeepers.StakingKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		authtypes.FeeCollectorName,
 	)
@@ -501,29 +513,37 @@ func NewAppKeeper(
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
 		appKeepers.DistrKeeper,
+		appKeepers.Erc20Keeper,
 		tokenFactoryCapabilities,
+		tokenFactoryFreezeExemptModules,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
-	wasmOpts = append(
-		wasmOpts,
-		wasmbinding.RegisterCustomPlugins(
-			appKeepers.BankKeeper,
-			&appKeepers.TokenFactoryKeeper,
-			appKeepers.EVMKeeper,
-			appKeepers.OracleKeeper,
-		)...,
-	)
 
 	// FeeAbstractionKeeper must be created after EVMKeeper and Erc20Keeper
+	// and before the wasm bindings are registered
 	appKeepers.FeeAbstractionKeeper = feeabstractionkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(appKeepers.keys[feeabstractiontypes.StoreKey]),
 		appKeepers.Erc20Keeper,
 		appKeepers.BankKeeper,
 		appKeepers.OracleKeeper,
+		appKeepers.TransferKeeper,
+		appKeepers.EVMKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	wasmOpts = append(
+		wasmOpts,
+		wasmbinding.RegisterCustomPlugins(
+			appKeepers.BankKeeper,
+			&appKeepers.TokenFactoryKeeper,
+			appKeepers.EVMKeeper,
+			appKeepers.OracleKeeper,
+			appKeepers.FeeAbstractionKeeper,
+			&appKeepers.RewardsKeeper,
+		)...,
+	)
+
 	// Must be called on PFMRouter AFTER TransferKeeper initialized
 	appKeepers.PFMRouterKeeper.SetTransferKeeper(appKeepers.TransferKeeper)
 
@@ -554,6 +574,19 @@ func NewAppKeeper(
 		wasmOpts...,
 	)
 
+	// The rewards module checks the contracts receiving releases
+	appKeepers.RewardsKeeper.SetWasmKeeper(appKeepers.WasmKeeper)
+
+	// The fee abstraction module checks the contracts managing their own paymaster
+	appKeepers.FeeAbstractionKeeper.SetWasmKeeper(appKeepers.WasmKeeper)
+
+	// The tokenfactory before send hooks are called by the contract keeper on every bank send
+	// The restriction must be appended after the contract keeper is set, since it copies the keeper
+	appKeepers.TokenFactoryKeeper.SetContractKeeper(appKeepers.WasmKeeper)
+	// The paused denoms and frozen accounts are enforced before calling the hooks
+	appKeepers.BankKeeper.AppendSendRestriction(appKeepers.TokenFactoryKeeper.FreezeSendRestriction)
+	appKeepers.BankKeeper.AppendSendRestriction(appKeepers.TokenFactoryKeeper.BeforeSendRestriction)
+
 	// Middleware Stacks
 	appKeepers.ICAModule = ica.NewAppModule(&appKeepers.ICAControllerKeeper, &appKeepers.ICAHostKeeper)
 	appKeepers.TransferModule = transfer.NewAppModule(appKeepers.TransferKeeper)
@@ -619,6 +652,9 @@ func NewAppKeeper(
 		appKeepers.EvidenceKeeper,
 		appKeepers.WasmKeeper,
 		appKeepers.OracleKeeper,
+		appKeepers.FeeAbstractionKeeper,
+		appKeepers.FeeMarketKeeper,
+		appKeepers.RewardsKeeper,
 	)
 	appKeepers.EVMKeeper.WithStaticPrecompiles(
 		corePrecompiles,
@@ -31,14 +31,19 @@ import (
 	slashingprecompile "github.com/cosmos/evm/precompiles/slashing"
 	stakingprecompile "github.com/cosmos/evm/precompiles/staking"
 	erc20Keeper "github.com/cosmos/evm/x/erc20/keeper"
+	feemarketkeeper "github.com/cosmos/evm/x/feemarket/keeper"
 	transferkeeper "github.com/cosmos/evm/x/ibc/transfer/keeper"
 	"github.com/cosmos/evm/x/vm/core/vm"
 	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
 
+	"github.com/kiichain/kiichain/v4/precompiles/feeabstraction"
 	"github.com/kiichain/kiichain/v4/precompiles/ibc"
 	"github.com/kiichain/kiichain/v4/precompiles/oracle"
+	"github.com/kiichain/kiichain/v4/precompiles/rewards"
 	"github.com/kiichain/kiichain/v4/precompiles/wasmd"
+	feeabstractionkeeper "github.com/kiichain/kiichain/v4/x/feeabstraction/keeper"
 	oraclekeeper "github.com/kiichain/kiichain/v4/x/oracle/keeper"
+	rewardskeeper "github.com/kiichain/kiichain/v4/x/rewards/keeper"
 )
 
 const bech32PrecompileBaseGas = 6_000
@@ -62,6 +67,9 @@ func NewAvailableStaticPrecompiles(
 	evidenceKeeper evidencekeeper.Keeper,
 	wasmdKeeper wasmkeeper.Keeper,
 	oracleKeeper oraclekeeper.Keeper,
+	feeAbstractionKeeper feeabstractionkeeper.Keeper,
+	feeMarketKeeper feemarketkeeper.Keeper,
+	rewardsKeeper rewardskeeper.Keeper,
 ) map[common.Address]vm.PrecompiledContract {
 	// Clone the mapping from the latest EVM fork.
 	precompiles := maps.Clone(vm.PrecompiledContractsBerlin)
@@ -146,6 +154,18 @@ func NewAvailableStaticPrecompiles(
 		panic(fmt.Errorf("failed to instantiate oracle precompile: %w", err))
 	}
 
+	// Prepare the fee abstraction precompile
+	feeAbstractionPrecompile, err := feeabstraction.NewPrecompile(feeAbstractionKeeper, feeMarketKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate fee abstraction precompile: %w", err))
+	}
+
+	// Prepare the rewards precompile
+	rewardsPrecompile, err := rewards.NewPrecompile(rewardsKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to instantiate rewards precompile: %w", err))
+	}
+
 	// Stateless precompiles
 	precompiles[bech32Precompile.Address()] = bech32Precompile
 	precompiles[p256Precompile.Address()] = p256Precompile
@@ -161,6 +181,8 @@ func NewAvailableStaticPrecompiles(
 	precompiles[wasmdPrecompile.Address()] = wasmdPrecompile
 	precompiles[ibcPrecompile.Address()] = ibcPrecompile
 	precompiles[oraclePrecompile.Address()] = oraclePrecompile
+	precompiles[feeAbstractionPrecompile.Address()] = feeAbstractionPrecompile
+	precompiles[rewardsPrecompile.Address()] = rewardsPrecompile
 
 	// Return the precompiles
 	return precompiles
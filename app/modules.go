@@ -193,7 +193,8 @@ BeginBlockers, which are run at the beginning of every block.
 func orderBeginBlockers() []string {
 	return []string{
 		capabilitytypes.ModuleName,
-		// Rewards should be added to distribution before it runs
+		// Rewards takes its fee share and adds the releases to the fee collector
+		// before distribution allocates it
 		rewardstypes.ModuleName,
 		distrtypes.ModuleName,
 		slashingtypes.ModuleName,
@@ -0,0 +1,20 @@
+package v500
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/kiichain/kiichain/v4/app/upgrades"
+)
+
+const (
+	// UpgradeName is the name of the upgrade
+	UpgradeName = "v5.0.0"
+)
+
+// Upgrade defines the upgrade
+// This runs the fee abstraction store migrations and installs the fee abstraction and rewards precompiles
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades:        storetypes.StoreUpgrades{},
+}
@@ -0,0 +1,54 @@
+package v500
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/kiichain/kiichain/v4/app/keepers"
+	"github.com/kiichain/kiichain/v4/app/upgrades/utils"
+	"github.com/kiichain/kiichain/v4/precompiles/feeabstraction"
+	"github.com/kiichain/kiichain/v4/precompiles/rewards"
+)
+
+// CreateUpgradeHandler creates the upgrade handler for the v5.0.0 upgrade
+// This runs the module migrations and installs the fee abstraction and rewards precompiles
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(c context.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		// State the context and log
+		ctx := sdk.UnwrapSDKContext(c)
+		ctx.Logger().Info("Starting module migrations...")
+
+		// Run the module migrations
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return vm, err
+		}
+
+		// Install the new precompiles
+		err = utils.InstallNewPrecompiles(
+			ctx,
+			keepers,
+			[]common.Address{
+				common.HexToAddress(feeabstraction.FeeAbstractionPrecompileAddress),
+				common.HexToAddress(rewards.RewardsPrecompileAddress),
+			},
+		)
+		if err != nil {
+			return vm, err
+		}
+
+		// Log the upgrade completion
+		ctx.Logger().Info("Upgrade v5.0.0 complete")
+		return vm, nil
+	}
+}
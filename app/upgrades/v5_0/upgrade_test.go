@@ -0,0 +1,56 @@
+package v500_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	tmtypes "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/kiichain/kiichain/v4/app/helpers"
+	utils "github.com/kiichain/kiichain/v4/app/upgrades/utils"
+	"github.com/kiichain/kiichain/v4/precompiles/feeabstraction"
+	"github.com/kiichain/kiichain/v4/precompiles/rewards"
+)
+
+// TestUpgrade tests the upgrade handler for v5.0.0
+func TestUpgrade(t *testing.T) {
+	// Create the app and the context
+	app := helpers.Setup(t)
+	ctx := app.BaseApp.NewUncachedContext(true, tmtypes.Header{Height: 1, ChainID: "test_1010-1", Time: time.Now().UTC()})
+
+	// Create a pre-populated list of pre-compiles
+	precompiles := []string{
+		"0x0000000000000000000000000000000000000001",
+		"0x0000000000000000000000000000000000000002",
+	}
+
+	// Install the precompiles
+	evmParams := app.EVMKeeper.GetParams(ctx)
+	evmParams.ActiveStaticPrecompiles = precompiles
+	err := app.EVMKeeper.SetParams(ctx, evmParams)
+	require.NoError(t, err)
+
+	// Now install the fee abstraction and rewards precompiles
+	err = utils.InstallNewPrecompiles(
+		ctx,
+		&app.AppKeepers,
+		[]common.Address{
+			common.HexToAddress(feeabstraction.FeeAbstractionPrecompileAddress),
+			common.HexToAddress(rewards.RewardsPrecompileAddress),
+		},
+	)
+	require.NoError(t, err)
+
+	// Get the params again
+	evmParams = app.EVMKeeper.GetParams(ctx)
+
+	// Check that the precompiles was added
+	require.Len(t, evmParams.ActiveStaticPrecompiles, 4)
+	require.Contains(t, evmParams.ActiveStaticPrecompiles, "0x0000000000000000000000000000000000000001")
+	require.Contains(t, evmParams.ActiveStaticPrecompiles, "0x0000000000000000000000000000000000000002")
+	require.Contains(t, evmParams.ActiveStaticPrecompiles, feeabstraction.FeeAbstractionPrecompileAddress)
+	require.Contains(t, evmParams.ActiveStaticPrecompiles, rewards.RewardsPrecompileAddress)
+}
@@ -0,0 +1,51 @@
+/// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev IFeeAbstraction contract address
+address constant FEE_ABSTRACTION_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000001004;
+
+/// @author Kiichain Team
+/// @title Fee Abstraction Precompiles Contract
+/// @dev This contract is a precompiled contract that provides a set of functions for interacting with the Fee Abstraction module
+/// @custom:address 0x0000000000000000000000000000000000001004
+interface IFeeAbstraction {
+    /// @dev Get all the fee tokens that can pay for gas
+    /// @return denoms An array of the fee token denominations
+    /// @return prices An array of the fee token prices with the markup or discount applied
+    /// @return decimals An array of the fee token decimals
+    /// @return enabled An array with the enabled status of each fee token
+    function getFeeTokens()
+        external
+        view
+        returns (
+            string[] memory denoms,
+            string[] memory prices,
+            uint32[] memory decimals,
+            bool[] memory enabled
+        );
+
+    /// @dev Get the price of a single fee token in terms of the native token
+    /// @param denom The fee token denomination
+    /// @return price The fee token price
+    /// @return adjustedPrice The fee token price with the markup or discount applied
+    /// @return decimals The fee token decimals
+    function getFeeTokenPrice(
+        string memory denom
+    )
+        external
+        view
+        returns (
+            string memory price,
+            string memory adjustedPrice,
+            uint32 decimals
+        );
+
+    /// @dev Estimate the fee paid for an amount of gas at the current base fee
+    /// @param gas The amount of gas
+    /// @param denom The denomination used to pay, either the native denom or a fee token
+    /// @return amount The fee amount on the given denomination
+    function estimateFee(
+        uint256 gas,
+        string memory denom
+    ) external view returns (uint256 amount);
+}
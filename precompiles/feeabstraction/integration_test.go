@@ -0,0 +1,48 @@
+package feeabstraction_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	tmtypes "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	app "github.com/kiichain/kiichain/v4/app"
+	"github.com/kiichain/kiichain/v4/app/helpers"
+	feeabstractionprecompile "github.com/kiichain/kiichain/v4/precompiles/feeabstraction"
+)
+
+// FeeAbstractionPrecompileTestSuite is a test suite for the fee abstraction precompile
+type FeeAbstractionPrecompileTestSuite struct {
+	suite.Suite
+
+	// App and context
+	App *app.KiichainApp
+	Ctx sdk.Context
+
+	// Precompile
+	Precompile *feeabstractionprecompile.Precompile
+}
+
+// TestFeeAbstractionPrecompileTestSuite runs all the tests under the fee abstraction pre-compile test suite
+func TestFeeAbstractionPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(FeeAbstractionPrecompileTestSuite))
+}
+
+// SetupTest sets up the test suite
+func (s *FeeAbstractionPrecompileTestSuite) SetupTest() {
+	// Get the test context
+	t := s.T()
+
+	// Create the app and the context
+	s.App = helpers.Setup(t)
+	s.Ctx = s.App.BaseApp.NewUncachedContext(true, tmtypes.Header{Height: 1, ChainID: "test_1010-1", Time: time.Now().UTC()})
+
+	// Start the precompile
+	pc, err := feeabstractionprecompile.NewPrecompile(s.App.FeeAbstractionKeeper, s.App.FeeMarketKeeper, s.App.AuthzKeeper)
+	s.Require().NoError(err)
+	s.Precompile = pc
+}
@@ -0,0 +1,110 @@
+package feeabstraction
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feeabstractionkeeper "github.com/kiichain/kiichain/v4/x/feeabstraction/keeper"
+)
+
+const (
+	// GetFeeTokensMethod is the method name for the fee tokens query
+	GetFeeTokensMethod = "getFeeTokens"
+	// GetFeeTokenPriceMethod is the method name for the fee token price query
+	GetFeeTokenPriceMethod = "getFeeTokenPrice"
+	// EstimateFeeMethod is the method name for the fee estimation query
+	EstimateFeeMethod = "estimateFee"
+)
+
+// GetFeeTokens queries the fee tokens through the IFeeAbstraction precompile
+func (p Precompile) GetFeeTokens(ctx sdk.Context, method *abi.Method, args []any) ([]byte, error) {
+	// Build the request from the arguments
+	req, err := ParseGetFeeTokensArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start a new query service
+	queryService := feeabstractionkeeper.NewQuerier(p.feeAbstractionKeeper)
+
+	// Make the request
+	res, err := queryService.FeeTokens(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pack the response into bytes
+	denoms := make([]string, len(res.FeeTokens.Items))
+	prices := make([]string, len(res.FeeTokens.Items))
+	decimals := make([]uint32, len(res.FeeTokens.Items))
+	enabled := make([]bool, len(res.FeeTokens.Items))
+
+	// Iterate over the fee tokens and fill the slices
+	for i, feeToken := range res.FeeTokens.Items {
+		denoms[i] = feeToken.Denom
+		prices[i] = feeToken.AdjustedPrice().String()
+		decimals[i] = feeToken.Decimals
+		enabled[i] = feeToken.Enabled
+	}
+
+	// Return the packed response
+	return method.Outputs.Pack(
+		denoms,
+		prices,
+		decimals,
+		enabled,
+	)
+}
+
+// GetFeeTokenPrice queries the price of a single fee token through the IFeeAbstraction precompile
+func (p Precompile) GetFeeTokenPrice(ctx sdk.Context, method *abi.Method, args []any) ([]byte, error) {
+	// Parse the denom from the arguments
+	denom, err := ParseGetFeeTokenPriceArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the fee token
+	feeToken, err := p.feeAbstractionKeeper.GetFeeToken(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pack the response into bytes
+	return method.Outputs.Pack(
+		feeToken.Price.String(),
+		feeToken.AdjustedPrice().String(),
+		feeToken.Decimals,
+	)
+}
+
+// EstimateFee estimates the fee for an amount of gas through the IFeeAbstraction precompile
+// The native fee is calculated with the current base fee and then converted to the denom
+func (p Precompile) EstimateFee(ctx sdk.Context, method *abi.Method, args []any) ([]byte, error) {
+	// Parse the gas and the denom from the arguments
+	gas, denom, err := ParseEstimateFeeArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the base fee, a disabled base fee is considered as zero
+	baseFee := p.feeMarketKeeper.GetBaseFee(ctx)
+	if baseFee.IsNil() {
+		baseFee = math.LegacyZeroDec()
+	}
+
+	// Calculate the native fee the same way as the fee checker
+	nativeFee := baseFee.MulInt(math.NewIntFromBigInt(gas)).Ceil().RoundInt()
+
+	// Convert the native fee to the denom
+	fee, err := p.feeAbstractionKeeper.EstimateFee(ctx, nativeFee, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pack the response into bytes
+	return method.Outputs.Pack(fee.Amount.BigInt())
+}
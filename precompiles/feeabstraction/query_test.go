@@ -0,0 +1,196 @@
+package feeabstraction_test
+
+import (
+	"math/big"
+
+	"cosmossdk.io/math"
+
+	feeabstractionprecompile "github.com/kiichain/kiichain/v4/precompiles/feeabstraction"
+	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
+)
+
+// setFeeTokens registers the fee tokens used on the tests
+func (s *FeeAbstractionPrecompileTestSuite) setFeeTokens() {
+	// Register a token with a markup and a disabled token
+	atom := types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyNewDec(2))
+	atom.PriceAdjustment = math.LegacyMustNewDecFromStr("0.5")
+	usdc := types.NewFeeTokenMetadata("uusdc", "usdcoracle", 6, math.LegacyOneDec())
+	usdc.Enabled = false
+
+	err := s.App.FeeAbstractionKeeper.SetFeeTokens(s.Ctx, *types.NewFeeTokenMetadataCollection(atom, usdc))
+	s.Require().NoError(err)
+}
+
+// TestGetFeeTokens tests the GetFeeTokens method of the fee abstraction precompile
+func (s *FeeAbstractionPrecompileTestSuite) TestGetFeeTokens() {
+	// Get the method
+	method := s.Precompile.Methods[feeabstractionprecompile.GetFeeTokensMethod]
+	s.setFeeTokens()
+
+	// Create the test cases
+	tc := []struct {
+		name        string
+		args        []any
+		errContains string
+	}{
+		{
+			name: "valid query - get fee tokens",
+			args: []any{},
+		},
+		{
+			name:        "invalid number of arguments",
+			args:        []any{"extra"},
+			errContains: "invalid number of arguments",
+		},
+	}
+
+	// Loop and execute the test cases
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			res, err := s.Precompile.GetFeeTokens(s.Ctx, &method, tc.args)
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Decode the response
+				resUnpacked, err := s.Precompile.Unpack(feeabstractionprecompile.GetFeeTokensMethod, res)
+				s.Require().NoError(err)
+
+				// Check the response, the tokens are ordered by denom
+				s.Require().Equal([]string{"uatom", "uusdc"}, resUnpacked[0])
+				s.Require().Equal([]string{"3.000000000000000000", "1.000000000000000000"}, resUnpacked[1])
+				s.Require().Equal([]uint32{6, 6}, resUnpacked[2])
+				s.Require().Equal([]bool{true, false}, resUnpacked[3])
+			}
+		})
+	}
+}
+
+// TestGetFeeTokenPrice tests the GetFeeTokenPrice method of the fee abstraction precompile
+func (s *FeeAbstractionPrecompileTestSuite) TestGetFeeTokenPrice() {
+	// Get the method
+	method := s.Precompile.Methods[feeabstractionprecompile.GetFeeTokenPriceMethod]
+	s.setFeeTokens()
+
+	// Create the test cases
+	tc := []struct {
+		name        string
+		args        []any
+		errContains string
+	}{
+		{
+			name: "valid query - get fee token price",
+			args: []any{"uatom"},
+		},
+		{
+			name:        "unknown fee token",
+			args:        []any{"unknown"},
+			errContains: "fee token not found",
+		},
+		{
+			name:        "invalid denom",
+			args:        []any{""},
+			errContains: "invalid denom",
+		},
+		{
+			name:        "invalid number of arguments",
+			args:        []any{},
+			errContains: "invalid number of arguments",
+		},
+	}
+
+	// Loop and execute the test cases
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			res, err := s.Precompile.GetFeeTokenPrice(s.Ctx, &method, tc.args)
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Decode the response
+				resUnpacked, err := s.Precompile.Unpack(feeabstractionprecompile.GetFeeTokenPriceMethod, res)
+				s.Require().NoError(err)
+
+				// Check the response
+				s.Require().Equal("2.000000000000000000", resUnpacked[0])
+				s.Require().Equal("3.000000000000000000", resUnpacked[1])
+				s.Require().Equal(uint32(6), resUnpacked[2])
+			}
+		})
+	}
+}
+
+// TestEstimateFee tests the EstimateFee method of the fee abstraction precompile
+func (s *FeeAbstractionPrecompileTestSuite) TestEstimateFee() {
+	// Get the method
+	method := s.Precompile.Methods[feeabstractionprecompile.EstimateFeeMethod]
+	s.setFeeTokens()
+
+	// Set a known base fee, 1e9 akii per gas
+	feeMarketParams := s.App.FeeMarketKeeper.GetParams(s.Ctx)
+	feeMarketParams.BaseFee = math.LegacyNewDec(1_000_000_000)
+	s.Require().NoError(s.App.FeeMarketKeeper.SetParams(s.Ctx, feeMarketParams))
+
+	// Create the test cases
+	tc := []struct {
+		name        string
+		args        []any
+		expected    *big.Int
+		errContains string
+	}{
+		{
+			// 1e9 gas at 1e9 akii per gas is 1 Kii
+			name:     "valid query - native denom",
+			args:     []any{big.NewInt(1_000_000_000), "akii"},
+			expected: big.NewInt(1_000_000_000_000_000_000),
+		},
+		{
+			// 1 Kii at the adjusted price of 3 is 3 Atom
+			name:     "valid query - fee token",
+			args:     []any{big.NewInt(1_000_000_000), "uatom"},
+			expected: big.NewInt(3_000_000),
+		},
+		{
+			name:        "disabled fee token",
+			args:        []any{big.NewInt(1_000_000_000), "uusdc"},
+			errContains: "fee token uusdc is disabled",
+		},
+		{
+			name:        "unknown fee token",
+			args:        []any{big.NewInt(1_000_000_000), "unknown"},
+			errContains: "fee token not found",
+		},
+		{
+			name:        "invalid gas",
+			args:        []any{"gas", "uatom"},
+			errContains: "invalid gas",
+		},
+		{
+			name:        "invalid number of arguments",
+			args:        []any{big.NewInt(1)},
+			errContains: "invalid number of arguments",
+		},
+	}
+
+	// Loop and execute the test cases
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			res, err := s.Precompile.EstimateFee(s.Ctx, &method, tc.args)
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Decode the response
+				resUnpacked, err := s.Precompile.Unpack(feeabstractionprecompile.EstimateFeeMethod, res)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expected, resUnpacked[0])
+			}
+		})
+	}
+}
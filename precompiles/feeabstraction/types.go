@@ -0,0 +1,59 @@
+package feeabstraction
+
+import (
+	"fmt"
+	"math/big"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	feeabstractiontypes "github.com/kiichain/kiichain/v4/x/feeabstraction/types"
+)
+
+// ParseGetFeeTokensArgs parses the arguments for the GetFeeTokens method
+func ParseGetFeeTokensArgs(args []interface{}) (*feeabstractiontypes.QueryFeeTokensRequest, error) {
+	// Check the number of arguments, should be 0
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	// Create the QueryFeeTokensRequest and return
+	return &feeabstractiontypes.QueryFeeTokensRequest{}, nil
+}
+
+// ParseGetFeeTokenPriceArgs parses the arguments for the GetFeeTokenPrice method
+func ParseGetFeeTokenPriceArgs(args []interface{}) (string, error) {
+	// Check the number of arguments, should be 1
+	if len(args) != 1 {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	// Parse the first arg, the denom
+	denom, ok := args[0].(string)
+	if !ok || denom == "" {
+		return "", fmt.Errorf("invalid denom")
+	}
+
+	return denom, nil
+}
+
+// ParseEstimateFeeArgs parses the arguments for the EstimateFee method
+func ParseEstimateFeeArgs(args []interface{}) (*big.Int, string, error) {
+	// Check the number of arguments, should be 2
+	if len(args) != 2 {
+		return nil, "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	// Parse the first arg, the gas
+	gas, ok := args[0].(*big.Int)
+	if !ok || gas == nil || gas.Sign() < 0 || !gas.IsUint64() {
+		return nil, "", fmt.Errorf("invalid gas")
+	}
+
+	// Parse the second arg, the denom
+	denom, ok := args[1].(string)
+	if !ok || denom == "" {
+		return nil, "", fmt.Errorf("invalid denom")
+	}
+
+	return gas, denom, nil
+}
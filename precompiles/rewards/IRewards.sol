@@ -0,0 +1,65 @@
+/// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev IRewards contract address
+address constant REWARDS_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000001005;
+
+/// @author Kiichain Team
+/// @title Rewards Precompiles Contract
+/// @dev This contract is a precompiled contract that provides a set of functions for interacting with the Rewards module
+/// @custom:address 0x0000000000000000000000000000000000001005
+interface IRewards {
+    /// @dev Emitted when the reward pool is funded
+    /// @param sender The address that funded the pool
+    /// @param denom The denomination of the funds
+    /// @param amount The amount of funds
+    event PoolFunded(address indexed sender, string denom, uint256 amount);
+
+    /// @dev Fund the reward pool with the funds of the caller
+    /// @param denom The denomination of the funds, it must be allowed by the rewards module
+    /// @param amount The amount of funds
+    /// @return success Whether the pool was funded
+    function fundPool(
+        string memory denom,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @dev Get a release schedule by its id
+    /// @param id The id of the schedule
+    /// @return denom The denomination released by the schedule
+    /// @return totalAmount The total amount of the schedule
+    /// @return releasedAmount The amount already released
+    /// @return startTime The start time as a unix timestamp, zero if unset
+    /// @return endTime The end time as a unix timestamp
+    /// @return lastReleaseTime The time of the last release as a unix timestamp, zero if unset
+    /// @return active Whether the schedule is releasing
+    function getReleaseSchedule(
+        uint64 id
+    )
+        external
+        view
+        returns (
+            string memory denom,
+            uint256 totalAmount,
+            uint256 releasedAmount,
+            int64 startTime,
+            int64 endTime,
+            int64 lastReleaseTime,
+            bool active
+        );
+
+    /// @dev Get the funds of the reward pool per denom
+    /// @return denoms An array of the pool denominations
+    /// @return poolAmounts An array of the amounts held by the pool
+    /// @return committedAmounts An array of the amounts committed to the schedules
+    /// @return freeAmounts An array of the amounts not committed to schedules
+    function getRewardPool()
+        external
+        view
+        returns (
+            string[] memory denoms,
+            string[] memory poolAmounts,
+            uint256[] memory committedAmounts,
+            string[] memory freeAmounts
+        );
+}
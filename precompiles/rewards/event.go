@@ -0,0 +1,48 @@
+package rewards
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+)
+
+const (
+	// EventTypePoolFunded define the event when the reward pool is funded
+	EventTypePoolFunded = "PoolFunded"
+)
+
+// EmitEventPoolFunded emits the PoolFunded event
+func (p *Precompile) EmitEventPoolFunded(ctx sdk.Context, stateDB vm.StateDB, sender common.Address, amount sdk.Coin) (err error) {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypePoolFunded]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is the signature of the event
+	topics[0] = event.ID
+
+	// The second topic is the sender address
+	topics[1], err = cmn.MakeTopic(sender)
+	if err != nil {
+		return err
+	}
+
+	// Parse the data
+	dataField, err := event.Inputs.NonIndexed().Pack(amount.Denom, amount.Amount.BigInt())
+	if err != nil {
+		return err
+	}
+
+	// Write to the stateDB
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        dataField,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
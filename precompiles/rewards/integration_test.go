@@ -0,0 +1,79 @@
+package rewards_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+
+	tmtypes "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeyring "github.com/cosmos/evm/testutil/integration/os/keyring"
+	"github.com/cosmos/evm/x/vm/statedb"
+
+	app "github.com/kiichain/kiichain/v4/app"
+	"github.com/kiichain/kiichain/v4/app/helpers"
+	rewardsprecompile "github.com/kiichain/kiichain/v4/precompiles/rewards"
+	tokenfactorytypes "github.com/kiichain/kiichain/v4/x/tokenfactory/types"
+)
+
+// RewardsPrecompileTestSuite is a test suite for the rewards precompile
+type RewardsPrecompileTestSuite struct {
+	suite.Suite
+
+	// App and context
+	App     *app.KiichainApp
+	Ctx     sdk.Context
+	keyring testkeyring.Keyring
+
+	// Precompile
+	Precompile *rewardsprecompile.Precompile
+}
+
+// TestRewardsPrecompileTestSuite runs all the tests under the rewards pre-compile test suite
+func TestRewardsPrecompileTestSuite(t *testing.T) {
+	suite.Run(t, new(RewardsPrecompileTestSuite))
+}
+
+// SetupTest sets up the test suite
+func (s *RewardsPrecompileTestSuite) SetupTest() {
+	// Get the test context
+	t := s.T()
+
+	// Create the app and the context
+	s.App = helpers.Setup(t)
+	s.Ctx = s.App.BaseApp.NewUncachedContext(true, tmtypes.Header{Height: 1, ChainID: "test_1010-1", Time: time.Now().UTC()})
+
+	// Start a new keyring
+	s.keyring = testkeyring.New(2)
+
+	// Start the precompile
+	pc, err := rewardsprecompile.NewPrecompile(s.App.RewardsKeeper, s.App.AuthzKeeper)
+	s.Require().NoError(err)
+	s.Precompile = pc
+}
+
+// GetStateDB returns the state database for the precompile
+func (s *RewardsPrecompileTestSuite) GetStateDB() *statedb.StateDB {
+	// Get the header hash
+	headerHash := s.Ctx.HeaderHash()
+
+	// Return the statedb
+	return statedb.New(
+		s.Ctx,
+		s.App.EVMKeeper,
+		statedb.NewEmptyTxConfig(common.BytesToHash(headerHash)),
+	)
+}
+
+// fundAccount mints coins to an account
+func (s *RewardsPrecompileTestSuite) fundAccount(address sdk.AccAddress, coins sdk.Coins) {
+	err := s.App.BankKeeper.MintCoins(s.Ctx, tokenfactorytypes.ModuleName, coins)
+	s.Require().NoError(err)
+
+	err = s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, tokenfactorytypes.ModuleName, address, coins)
+	s.Require().NoError(err)
+}
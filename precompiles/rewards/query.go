@@ -0,0 +1,103 @@
+package rewards
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rewardskeeper "github.com/kiichain/kiichain/v4/x/rewards/keeper"
+)
+
+const (
+	// GetReleaseScheduleMethod is the method name for the release schedule query
+	GetReleaseScheduleMethod = "getReleaseSchedule"
+	// GetRewardPoolMethod is the method name for the reward pool query
+	GetRewardPoolMethod = "getRewardPool"
+)
+
+// GetReleaseSchedule queries a release schedule by its id through the IRewards precompile
+func (p Precompile) GetReleaseSchedule(ctx sdk.Context, method *abi.Method, args []any) ([]byte, error) {
+	// Build the request from the arguments
+	req, err := ParseGetReleaseScheduleArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start a new query service
+	queryService := rewardskeeper.NewQuerier(p.rewardsKeeper)
+
+	// Make the request
+	res, err := queryService.ReleaseSchedule(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	schedule := res.ReleaseSchedule
+
+	// The released amount is unset until the first release
+	releasedAmount := big.NewInt(0)
+	if !schedule.ReleasedAmount.Amount.IsNil() {
+		releasedAmount = schedule.ReleasedAmount.Amount.BigInt()
+	}
+
+	// Pack the response into bytes
+	return method.Outputs.Pack(
+		schedule.TotalAmount.Denom,
+		schedule.TotalAmount.Amount.BigInt(),
+		releasedAmount,
+		unixOrZero(schedule.StartTime),
+		unixOrZero(schedule.EndTime),
+		unixOrZero(schedule.LastReleaseTime),
+		schedule.Active,
+	)
+}
+
+// GetRewardPool queries the funds of the reward pool per denom through the IRewards precompile
+func (p Precompile) GetRewardPool(ctx sdk.Context, method *abi.Method, args []any) ([]byte, error) {
+	// Build the request from the arguments
+	req, err := ParseGetRewardPoolArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Start a new query service
+	queryService := rewardskeeper.NewQuerier(p.rewardsKeeper)
+
+	// Make the request
+	res, err := queryService.RewardPool(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pack the response into bytes
+	denoms := make([]string, len(res.Balances))
+	poolAmounts := make([]string, len(res.Balances))
+	committedAmounts := make([]*big.Int, len(res.Balances))
+	freeAmounts := make([]string, len(res.Balances))
+
+	// Iterate over the balances and fill the slices
+	for i, balance := range res.Balances {
+		denoms[i] = balance.Denom
+		poolAmounts[i] = balance.PoolAmount.String()
+		committedAmounts[i] = balance.CommittedAmount.BigInt()
+		freeAmounts[i] = balance.FreeAmount.String()
+	}
+
+	// Return the packed response
+	return method.Outputs.Pack(
+		denoms,
+		poolAmounts,
+		committedAmounts,
+		freeAmounts,
+	)
+}
+
+// unixOrZero returns the unix timestamp of a time, or zero if the time is unset
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
@@ -0,0 +1,143 @@
+package rewards_test
+
+import (
+	"math/big"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rewardsprecompile "github.com/kiichain/kiichain/v4/precompiles/rewards"
+	"github.com/kiichain/kiichain/v4/x/rewards/types"
+)
+
+// TestGetReleaseSchedule tests the GetReleaseSchedule method of the rewards precompile
+func (s *RewardsPrecompileTestSuite) TestGetReleaseSchedule() {
+	// Get the method
+	method := s.Precompile.Methods[rewardsprecompile.GetReleaseScheduleMethod]
+
+	// Store a schedule
+	startTime := s.Ctx.BlockTime().Add(time.Hour).Truncate(time.Second)
+	endTime := startTime.Add(time.Hour)
+	err := s.App.RewardsKeeper.ReleaseSchedules.Set(s.Ctx, 1, types.ReleaseSchedule{
+		Id:             1,
+		TotalAmount:    sdk.NewCoin("akii", math.NewInt(1000)),
+		ReleasedAmount: sdk.NewCoin("akii", math.NewInt(100)),
+		StartTime:      startTime,
+		EndTime:        endTime,
+		Active:         true,
+	})
+	s.Require().NoError(err)
+
+	// Create the test cases
+	tc := []struct {
+		name        string
+		args        []any
+		errContains string
+	}{
+		{
+			name: "valid query - get release schedule",
+			args: []any{uint64(1)},
+		},
+		{
+			name:        "invalid number of arguments",
+			args:        []any{},
+			errContains: "invalid number of arguments",
+		},
+		{
+			name:        "invalid schedule id",
+			args:        []any{"1"},
+			errContains: "invalid schedule id",
+		},
+		{
+			name:        "schedule not found",
+			args:        []any{uint64(2)},
+			errContains: "not found",
+		},
+	}
+
+	// Loop and execute the test cases
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			res, err := s.Precompile.GetReleaseSchedule(s.Ctx, &method, tc.args)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Decode the response
+				resUnpacked, err := s.Precompile.Unpack(rewardsprecompile.GetReleaseScheduleMethod, res)
+				s.Require().NoError(err)
+
+				// Check the response, the unset last release time is zero
+				s.Require().Equal("akii", resUnpacked[0])
+				s.Require().Equal(big.NewInt(1000), resUnpacked[1])
+				s.Require().Equal(big.NewInt(100), resUnpacked[2])
+				s.Require().Equal(startTime.Unix(), resUnpacked[3])
+				s.Require().Equal(endTime.Unix(), resUnpacked[4])
+				s.Require().Equal(int64(0), resUnpacked[5])
+				s.Require().Equal(true, resUnpacked[6])
+			}
+		})
+	}
+}
+
+// TestGetRewardPool tests the GetRewardPool method of the rewards precompile
+func (s *RewardsPrecompileTestSuite) TestGetRewardPool() {
+	// Get the method
+	method := s.Precompile.Methods[rewardsprecompile.GetRewardPoolMethod]
+
+	// Fund the pool and commit part of it to a schedule
+	sender := s.keyring.GetAccAddr(0)
+	s.fundAccount(sender, sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000))))
+	err := s.App.RewardsKeeper.FundCommunityPool(s.Ctx, sdk.NewCoin("akii", math.NewInt(1000)), sender)
+	s.Require().NoError(err)
+	err = s.App.RewardsKeeper.ReleaseSchedules.Set(s.Ctx, 1, types.ReleaseSchedule{
+		Id:             1,
+		TotalAmount:    sdk.NewCoin("akii", math.NewInt(600)),
+		ReleasedAmount: sdk.NewCoin("akii", math.ZeroInt()),
+		EndTime:        s.Ctx.BlockTime().Add(time.Hour),
+		Active:         true,
+	})
+	s.Require().NoError(err)
+
+	// Create the test cases
+	tc := []struct {
+		name        string
+		args        []any
+		errContains string
+	}{
+		{
+			name: "valid query - get reward pool",
+			args: []any{},
+		},
+		{
+			name:        "invalid number of arguments",
+			args:        []any{"extra"},
+			errContains: "invalid number of arguments",
+		},
+	}
+
+	// Loop and execute the test cases
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			res, err := s.Precompile.GetRewardPool(s.Ctx, &method, tc.args)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Decode the response
+				resUnpacked, err := s.Precompile.Unpack(rewardsprecompile.GetRewardPoolMethod, res)
+				s.Require().NoError(err)
+
+				// Check the response
+				s.Require().Equal([]string{"akii"}, resUnpacked[0])
+				s.Require().Equal([]string{"1000.000000000000000000"}, resUnpacked[1])
+				s.Require().Equal([]*big.Int{big.NewInt(600)}, resUnpacked[2])
+				s.Require().Equal([]string{"400.000000000000000000"}, resUnpacked[3])
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package rewards
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/x/vm/core/vm"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	rewardskeeper "github.com/kiichain/kiichain/v4/x/rewards/keeper"
+)
+
+const (
+	// FundPoolMethod is the method name for funding the reward pool
+	FundPoolMethod = "fundPool"
+)
+
+// FundPool funds the reward pool with the funds of the caller through the IRewards precompile
+// The caller is the account calling the precompile, either the origin or a contract funding the pool with its balance
+func (p *Precompile) FundPool(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	// Create the fund pool message
+	caller := contract.CallerAddress
+	msg, err := NewMsgFundPool(caller, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// Log the call
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf("{ sender: %s, amount: %s }", msg.Sender, msg.Amount),
+	)
+
+	// Initialize the message server
+	msgSrv := rewardskeeper.NewMsgServerImpl(p.rewardsKeeper)
+
+	// Call the fund pool method
+	if _, err := msgSrv.FundPool(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// When a contract funds the pool with the EVM denom, mirror the bank change on the stateDB
+	// This prevents the stateDB from overwriting the balance in the bank keeper when committing the EVM state
+	if caller != origin && msg.Amount.Denom == evmtypes.GetEVMCoinDenom() {
+		convertedAmount := evmtypes.ConvertAmountTo18DecimalsBigInt(msg.Amount.Amount.BigInt())
+		if convertedAmount.Sign() > 0 {
+			p.SetBalanceChangeEntries(cmn.NewBalanceChangeEntry(caller, convertedAmount, cmn.Sub))
+		}
+	}
+
+	// Emit the event
+	if err := p.EmitEventPoolFunded(ctx, stateDB, caller, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	// Return the response
+	return method.Outputs.Pack(true)
+}
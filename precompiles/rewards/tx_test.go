@@ -0,0 +1,114 @@
+package rewards_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/testutil"
+
+	rewardsprecompile "github.com/kiichain/kiichain/v4/precompiles/rewards"
+)
+
+// TestFundPool is a test for the FundPool precompile method
+func (s *RewardsPrecompileTestSuite) TestFundPool() {
+	// Get the method
+	method := s.Precompile.Methods[rewardsprecompile.FundPoolMethod]
+
+	// Get a funded account from the keyring
+	account := s.keyring.GetKey(0)
+	s.fundAccount(account.AccAddr, sdk.NewCoins(
+		sdk.NewCoin("akii", math.NewInt(1000)),
+		sdk.NewCoin("uatom", math.NewInt(1000)),
+	))
+
+	// Create the test cases
+	tc := []struct {
+		name        string
+		args        []any
+		errContains string
+	}{
+		{
+			name: "valid funding",
+			args: []any{"akii", big.NewInt(500)},
+		},
+		{
+			name:        "invalid args - different than 2",
+			args:        []any{"akii"},
+			errContains: "invalid number of arguments; expected 2; got: 1",
+		},
+		{
+			name:        "invalid args - invalid denom",
+			args:        []any{123, big.NewInt(500)},
+			errContains: "invalid denom",
+		},
+		{
+			name:        "invalid args - zero amount",
+			args:        []any{"akii", big.NewInt(0)},
+			errContains: "invalid amount",
+		},
+		{
+			name:        "denom not allowed by the pool",
+			args:        []any{"uatom", big.NewInt(500)},
+			errContains: "is not allowed by the rewards pool",
+		},
+		{
+			name:        "insufficient funds",
+			args:        []any{"akii", big.NewInt(5000)},
+			errContains: "insufficient funds",
+		},
+	}
+
+	// Loop and execute the test cases
+	for _, tc := range tc {
+		s.Run(tc.name, func() {
+			// Get the state db
+			stateDB := s.GetStateDB()
+
+			// Create the contract from the precompile contract
+			contract, ctx := testutil.NewPrecompileContract(s.T(), s.Ctx, account.Addr, s.Precompile, 200000)
+			cacheCtx, _ := ctx.CacheContext()
+
+			// Execute the contract using the precompile
+			res, err := s.Precompile.FundPool(cacheCtx, account.Addr, contract, stateDB, &method, tc.args)
+
+			// Check if the error contains the expected string
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Unpack the result
+				success, err := s.Precompile.Unpack(rewardsprecompile.FundPoolMethod, res)
+				s.Require().NoError(err)
+				s.Require().Equal(true, success[0])
+
+				// Check if events were emitted
+				log := stateDB.Logs()[0]
+				event := s.Precompile.ABI.Events[rewardsprecompile.EventTypePoolFunded]
+				s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+
+				// Decode the event data and check
+				var fundedEvent rewardsprecompile.PoolFundedEvent
+				err = cmn.UnpackLog(s.Precompile.ABI, &fundedEvent, rewardsprecompile.EventTypePoolFunded, *log)
+				s.Require().NoError(err)
+				s.Require().Equal(account.Addr, fundedEvent.Sender)
+				s.Require().Equal(tc.args[0], fundedEvent.Denom)
+				s.Require().Equal(tc.args[1], fundedEvent.Amount)
+
+				// The funds moved from the caller to the pool
+				pool, err := s.App.RewardsKeeper.RewardPool.Get(cacheCtx)
+				s.Require().NoError(err)
+				s.Require().Equal(math.LegacyNewDec(500), pool.CommunityPool.AmountOf("akii"))
+				balance := s.App.BankKeeper.GetBalance(cacheCtx, account.AccAddr, "akii")
+				s.Require().Equal(int64(500), balance.Amount.Int64())
+			}
+		})
+	}
+}
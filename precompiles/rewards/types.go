@@ -0,0 +1,75 @@
+package rewards
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	rewardstypes "github.com/kiichain/kiichain/v4/x/rewards/types"
+)
+
+// PoolFundedEvent is the event emitted when the reward pool is funded
+type PoolFundedEvent struct {
+	Sender common.Address
+	Denom  string
+	Amount *big.Int
+}
+
+// NewMsgFundPool creates the fund pool message from the caller and the arguments
+func NewMsgFundPool(caller common.Address, args []interface{}) (*rewardstypes.MsgFundPool, error) {
+	// Check the number of arguments, should be 2
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	// Parse the first arg, the denom
+	denom, ok := args[0].(string)
+	if !ok || denom == "" {
+		return nil, fmt.Errorf("invalid denom")
+	}
+
+	// Parse the second arg, the amount
+	amount, ok := args[1].(*big.Int)
+	if !ok || amount == nil || amount.Sign() <= 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidAmount, args[1])
+	}
+
+	// Build the message with the caller as the sender
+	return rewardstypes.NewMsgFundPool(
+		sdk.AccAddress(caller.Bytes()),
+		sdk.NewCoin(denom, math.NewIntFromBigInt(amount)),
+	), nil
+}
+
+// ParseGetReleaseScheduleArgs parses the arguments for the GetReleaseSchedule method
+func ParseGetReleaseScheduleArgs(args []interface{}) (*rewardstypes.QueryReleaseScheduleRequest, error) {
+	// Check the number of arguments, should be 1
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	// Parse the first arg, the schedule id
+	id, ok := args[0].(uint64)
+	if !ok {
+		return nil, fmt.Errorf("invalid schedule id")
+	}
+
+	return &rewardstypes.QueryReleaseScheduleRequest{Id: id}, nil
+}
+
+// ParseGetRewardPoolArgs parses the arguments for the GetRewardPool method
+func ParseGetRewardPoolArgs(args []interface{}) (*rewardstypes.QueryRewardPoolRequest, error) {
+	// Check the number of arguments, should be 0
+	if len(args) != 0 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 0, len(args))
+	}
+
+	return &rewardstypes.QueryRewardPoolRequest{}, nil
+}
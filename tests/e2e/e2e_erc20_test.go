@@ -12,11 +12,16 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	erc20types "github.com/cosmos/evm/x/erc20/types"
 
 	"github.com/kiichain/kiichain/v4/tests/e2e/mock"
+	tokenfactorycli "github.com/kiichain/kiichain/v4/x/tokenfactory/client/cli"
+	tokenfactorytypes "github.com/kiichain/kiichain/v4/x/tokenfactory/types"
 )
 
 // testEVM Tests EVM send and contract usage
@@ -110,6 +115,39 @@ func (s *IntegrationTestSuite) testERC20(jsonRPC string) {
 		// converting to string since one is big int and the other is math int
 		s.Require().Equal(amount.String(), erc20Balance.Amount.String())
 	})
+
+	s.Run("Register tokenfactory denom as ERC20", func() {
+		// Create a denom registered as an ERC20 and mint some tokens
+		newDenom := "usun"
+		fullDenom := builFullDenom(alice.String(), newDenom)
+		mintAmount := math.NewInt(1000000)
+		s.createDenom(c, alice.String(), newDenom, fmt.Sprintf("--%s", tokenfactorycli.FlagRegisterERC20))
+		s.mintDenom(c, alice.String(), sdk.NewCoin(fullDenom, mintAmount))
+
+		// The ERC20 address is queryable
+		var erc20Address string
+		s.Require().Eventually(
+			func() bool {
+				erc20Address, err = queryDenomERC20Address(chainAAPIEndpoint, fullDenom)
+				return err == nil && erc20Address != ""
+			},
+			20*time.Second,
+			5*time.Second,
+		)
+		s.Require().Equal(tokenfactorytypes.GetERC20Address(fullDenom).Hex(), erc20Address)
+
+		// The ERC20 balance follows the bank balance
+		denomERC20, err := mock.NewERC20Mock(common.HexToAddress(erc20Address), client)
+		s.Require().NoError(err)
+		s.Require().Eventually(
+			func() bool {
+				balance, err := denomERC20.BalanceOf(&bind.CallOpts{Context: context.Background()}, aliceEvmAddress)
+				return err == nil && balance.String() == mintAmount.String()
+			},
+			20*time.Second,
+			5*time.Second,
+		)
+	})
 }
 
 // convertERC20 calls the CLI to transfer the given erc20 contract coin to the linked native pair
@@ -0,0 +1,249 @@
+package e2e
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	feeabstractiontypes "github.com/kiichain/kiichain/v4/x/feeabstraction/types"
+)
+
+const (
+	proposalAddIBCFeeTokenFilename = "proposal_add_ibc_fee_token.json"
+	// ibcFeeTokenOracleDenom is the oracle denom mapped to the IBC fee token
+	ibcFeeTokenOracleDenom = "uusdc"
+)
+
+// chainBProposalCounter tracks the proposals submitted on chain B
+var chainBProposalCounter = 0
+
+/*
+testIBCFeeToken tests paying fees on chain B with a token relayed from chain A
+
+Steps:
+1. Relay akii from chain A to an account on chain B
+2. Move all the native balance of the account away, so it can only pay with the IBC token
+3. Feed the oracle price of the oracle denom mapped to the IBC token
+4. Register the IBC token as a fee token by its trace path through governance
+5. Send a transaction from the account and check that the fee was paid with the IBC token
+*/
+func (s *IntegrationTestSuite) testIBCFeeToken() {
+	s.Run("pay_fees_with_ibc_token", func() {
+		c := s.chainB
+		chainBAPIEndpoint := fmt.Sprintf("http://%s", s.valResources[c.id][0].GetHostPort("1317/tcp"))
+
+		// The IBC denom of akii relayed from chain A
+		tracePath := fmt.Sprintf("%s/%s/%s", transferPort, transferChannel, akiiDenom)
+		ibcDenom := transfertypes.ParseDenomTrace(tracePath).IBCDenom()
+
+		senderAddress, _ := s.chainA.validators[0].keyInfo.GetAddress()
+		payerAddress, _ := c.genesisAccounts[4].keyInfo.GetAddress()
+		payer := payerAddress.String()
+
+		// Relay the tokens to the payer
+		s.sendIBC(s.chainA, 0, senderAddress.String(), payer, tokenAmount.String(), standardFees.String(), "", false)
+		pass := s.hermesClearPacket(hermesConfigWithGasPrices, s.chainA.id, transferPort, transferChannel)
+		s.Require().True(pass)
+
+		var ibcBalance sdk.Coin
+		s.Require().Eventually(
+			func() bool {
+				var err error
+				ibcBalance, err = getSpecificBalance(chainBAPIEndpoint, payer, ibcDenom)
+				s.Require().NoError(err)
+				return ibcBalance.Amount.GTE(tokenAmount.Amount)
+			},
+			time.Minute,
+			5*time.Second,
+		)
+
+		// Move the whole native balance away, the fee of the transfer is paid with native tokens
+		nativeBalance, err := getSpecificBalance(chainBAPIEndpoint, payer, akiiDenom)
+		s.Require().NoError(err)
+		s.execBankSend(c, 0, payer, Address(), nativeBalance.Sub(standardFees).String(), standardFees.String(), false)
+		s.Require().Eventually(
+			func() bool {
+				nativeBalance, err = getSpecificBalance(chainBAPIEndpoint, payer, akiiDenom)
+				s.Require().NoError(err)
+				return nativeBalance.IsZero()
+			},
+			time.Minute,
+			5*time.Second,
+		)
+
+		// Feed the oracle price from all the validators
+		for i, val := range c.validators {
+			valAddress, _ := val.keyInfo.GetAddress()
+			s.execAggregateVote(
+				c,
+				i,
+				"1.0"+ibcFeeTokenOracleDenom,
+				sdk.ValAddress(valAddress).String(),
+				valAddress.String(),
+				kiichainHomePath,
+				Fee.String(),
+				nil,
+			)
+		}
+
+		// Register the fee token by its trace path, the denom is resolved to the IBC denom
+		validatorAddress, _ := c.validators[0].keyInfo.GetAddress()
+		s.writeAddIBCFeeTokenProposal(c, tracePath)
+		chainBProposalCounter++
+		submitGovFlags := []string{configFile(proposalAddIBCFeeTokenFilename)}
+		depositGovFlags := []string{strconv.Itoa(chainBProposalCounter), depositAmount.String()}
+		voteGovFlags := []string{strconv.Itoa(chainBProposalCounter), "yes"}
+		s.submitChainGovProposal(c, chainBAPIEndpoint, validatorAddress.String(), chainBProposalCounter, "AddFeeToken", submitGovFlags, depositGovFlags, voteGovFlags)
+
+		// The denom trace is resolved for display
+		s.Require().Eventually(
+			func() bool {
+				res, err := queryFeeTokenDenomTraces(chainBAPIEndpoint)
+				s.Require().NoError(err)
+				for _, trace := range res.DenomTraces {
+					if trace.Denom == ibcDenom {
+						s.Require().Equal(akiiDenom, trace.BaseDenom)
+						s.Require().Equal(fmt.Sprintf("%s/%s", transferPort, transferChannel), trace.Path)
+						return true
+					}
+				}
+				return false
+			},
+			time.Minute,
+			5*time.Second,
+		)
+
+		// The token is enabled once the oracle data covers the guard windows
+		s.Require().Eventually(
+			func() bool {
+				res, err := queryFeeTokens(chainBAPIEndpoint)
+				s.Require().NoError(err)
+				for _, feeToken := range res.FeeTokens.Items {
+					if feeToken.Denom == ibcDenom {
+						return feeToken.Enabled
+					}
+				}
+				return false
+			},
+			3*time.Minute,
+			5*time.Second,
+		)
+
+		// Send a transaction with native fees, they are paid with the IBC token
+		ibcBalance, err = getSpecificBalance(chainBAPIEndpoint, payer, ibcDenom)
+		s.Require().NoError(err)
+		sendAmount := sdk.NewCoin(ibcDenom, ibcBalance.Amount.QuoRaw(2))
+		s.execBankSend(c, 0, payer, Address(), sendAmount.String(), standardFees.String(), false)
+
+		// The IBC balance goes down by the sent amount and the fee
+		s.Require().Eventually(
+			func() bool {
+				afterBalance, err := getSpecificBalance(chainBAPIEndpoint, payer, ibcDenom)
+				s.Require().NoError(err)
+				return afterBalance.Amount.LT(ibcBalance.Amount.Sub(sendAmount.Amount))
+			},
+			time.Minute,
+			5*time.Second,
+		)
+
+		// No native balance was used
+		nativeBalance, err = getSpecificBalance(chainBAPIEndpoint, payer, akiiDenom)
+		s.Require().NoError(err)
+		s.Require().True(nativeBalance.IsZero())
+	})
+}
+
+// submitChainGovProposal submits, deposits and votes a proposal on the given chain
+func (s *IntegrationTestSuite) submitChainGovProposal(c *chain, endpoint, sender string, proposalID int, proposalType string, submitFlags, depositFlags, voteFlags []string) {
+	steps := []struct {
+		command        string
+		flags          []string
+		expectedStatus govtypesv1beta1.ProposalStatus
+	}{
+		{"submit-proposal", submitFlags, govtypesv1beta1.StatusDepositPeriod},
+		{"deposit", depositFlags, govtypesv1beta1.StatusVotingPeriod},
+		{"vote", voteFlags, govtypesv1beta1.StatusPassed},
+	}
+
+	for _, step := range steps {
+		s.T().Logf("Running tx gov %s for %s on chain %s", step.command, proposalType, c.id)
+		s.runGovExec(c, 0, sender, step.command, step.flags, standardFees.String(), nil)
+
+		s.Require().Eventually(
+			func() bool {
+				proposal, err := queryGovProposal(endpoint, proposalID)
+				s.Require().NoError(err)
+				return proposal.GetProposal().Status == step.expectedStatus
+			},
+			15*time.Second,
+			5*time.Second,
+		)
+	}
+}
+
+// writeAddIBCFeeTokenProposal stores a file with the add fee token proposal for an IBC token
+func (s *IntegrationTestSuite) writeAddIBCFeeTokenProposal(c *chain, tracePath string) {
+	body := `{
+		"messages": [
+		 {
+		  "@type": "/kiichain.feeabstraction.v1beta1.MsgAddFeeToken",
+		  "authority": "%s",
+		  "fee_token": {
+		    "denom": "%s",
+		    "oracle_denom": "%s",
+		    "decimals": 18,
+		    "price": "1.000000000000000000",
+		    "enabled": true,
+		    "price_adjustment": "0.000000000000000000",
+		    "min_fee": "0",
+		    "max_fee": "0"
+		  }
+		 }
+		],
+		"metadata": "ipfs://CID",
+		"deposit": "100akii",
+		"title": "Add IBC fee token",
+		"summary": "Add the IBC akii as a fee token"
+	   }`
+
+	propMsgBody := fmt.Sprintf(body, govAuthority, tracePath, ibcFeeTokenOracleDenom)
+
+	err := writeFile(filepath.Join(c.validators[0].configDir(), "config", proposalAddIBCFeeTokenFilename), []byte(propMsgBody))
+	s.Require().NoError(err)
+}
+
+// queryFeeTokens queries the fee tokens of the fee abstraction module
+func queryFeeTokens(endpoint string) (feeabstractiontypes.QueryFeeTokensResponse, error) {
+	var res feeabstractiontypes.QueryFeeTokensResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/kiichain/feeabstraction/v1beta1/fee_tokens", endpoint))
+	if err != nil {
+		return res, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+	if err := cdc.UnmarshalJSON(body, &res); err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
+
+// queryFeeTokenDenomTraces queries the denom traces of the IBC fee tokens
+func queryFeeTokenDenomTraces(endpoint string) (feeabstractiontypes.QueryFeeTokenDenomTracesResponse, error) {
+	var res feeabstractiontypes.QueryFeeTokenDenomTracesResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/kiichain/feeabstraction/v1beta1/fee_token_denom_traces", endpoint))
+	if err != nil {
+		return res, fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+	if err := cdc.UnmarshalJSON(body, &res); err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
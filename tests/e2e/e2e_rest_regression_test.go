@@ -64,7 +64,7 @@ const (
 	// Rewards endpoints
 	rewardsParams   = "/kiichain/rewards/v1beta1/params"
 	rewardsPool     = "/kiichain/rewards/v1beta1/reward-pool"
-	rewardsSchedule = "/kiichain/rewards/v1beta1/release-schedule"
+	rewardsSchedule = "/kiichain/rewards/v1beta1/release-schedules"
 
 	// Oracle Endpoints
 	oracleExchangeRates        = "/kiichain/oracle/v1beta1/denoms/exchange_rates"
@@ -0,0 +1,85 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"cosmossdk.io/math"
+
+	"github.com/kiichain/kiichain/v4/tests/e2e/precompiles"
+)
+
+const (
+	RewardsPrecompileAddress = "0x0000000000000000000000000000000000001005"
+)
+
+// testRewardsPrecompile funds the reward pool and queries it through the rewards precompile
+func (s *IntegrationTestSuite) testRewardsPrecompile() {
+	var (
+		denom      = "akii"
+		evmAccount = s.chainA.evmAccount
+		amount     = standardFees.Amount // 0.33 Kii
+	)
+	chainEndpoint := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("1317/tcp"))
+
+	// Setup evm client
+	jsonRPC := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("8545/tcp"))
+	client, err := ethclient.Dial(jsonRPC)
+	s.Require().NoError(err)
+
+	// Bind abigen precompile contract to address
+	rewardsPrecompile, err := precompiles.NewRewardsPrecompile(common.HexToAddress(RewardsPrecompileAddress), client)
+	s.Require().NoError(err)
+
+	// Get the initial pool amount through the precompile
+	initialPoolAmount := s.rewardPoolAmountViaPrecompile(rewardsPrecompile, denom)
+
+	// 1. Fund the pool via precompile
+	s.Run("fund reward pool via precompile", func() {
+		tx, err := rewardsPrecompile.FundPool(
+			setupDefaultAuth(client, evmAccount.key),
+			denom,
+			amount.BigInt(),
+		)
+		s.Require().NoError(err)
+
+		// Wait and check tx
+		s.waitForTransaction(client, tx, evmAccount.address)
+	})
+
+	// 2. The precompile and the REST query see the funds
+	poolAmount := s.rewardPoolAmountViaPrecompile(rewardsPrecompile, denom)
+	s.Require().True(poolAmount.GTE(initialPoolAmount.Add(math.LegacyNewDecFromInt(amount))))
+
+	rewardResponse, err := queryRewardPool(chainEndpoint)
+	s.Require().NoError(err)
+	s.Require().True(rewardResponse.RewardPool.CommunityPool.AmountOf(denom).GTE(poolAmount))
+}
+
+// rewardPoolAmountViaPrecompile returns the pool amount of a denom through the rewards precompile
+func (s *IntegrationTestSuite) rewardPoolAmountViaPrecompile(
+	rewardsPrecompile *precompiles.RewardsPrecompile,
+	denom string,
+) math.LegacyDec {
+	// Setup call options
+	callOpts := &bind.CallOpts{
+		Pending: false,
+		Context: context.Background(),
+	}
+
+	// Query the pool
+	resp, err := rewardsPrecompile.GetRewardPool(callOpts)
+	s.Require().NoError(err)
+
+	// Look for the denom, the pool may not hold it yet
+	for i, poolDenom := range resp.Denoms {
+		if poolDenom == denom {
+			return math.LegacyMustNewDecFromStr(resp.PoolAmounts[i])
+		}
+	}
+	return math.LegacyZeroDec()
+}
@@ -68,13 +68,14 @@ func (s *IntegrationTestSuite) testRewardUpdate() {
 	pool := rewardResponse.RewardPool.CommunityPool
 	s.Require().False(pool.AmountOf(denom).IsZero())
 
-	// 2. Create and pass proposal to change schedule
+	// 2. Create and pass proposal to create a schedule
 	s.passScheduleProposal(chainEndpoint, amount, senderAddress.String(), endTime)
 
-	// Query changes
-	scheduleResponse, err := queryReleaseSchedule(chainEndpoint)
+	// Query changes, the new schedule is the last one
+	schedulesResponse, err := queryReleaseSchedules(chainEndpoint)
 	s.Require().NoError(err)
-	schedule := scheduleResponse.ReleaseSchedule
+	s.Require().NotEmpty(schedulesResponse.ReleaseSchedules)
+	schedule := schedulesResponse.ReleaseSchedules[len(schedulesResponse.ReleaseSchedules)-1]
 	s.Require().Equal(schedule.TotalAmount, amount)
 	s.Require().True(schedule.Active)
 
@@ -82,7 +83,7 @@ func (s *IntegrationTestSuite) testRewardUpdate() {
 	time.Sleep(time.Second * 10)
 
 	// Check schedule change
-	scheduleResponse, err = queryReleaseSchedule(chainEndpoint)
+	scheduleResponse, err := queryReleaseSchedule(chainEndpoint, schedule.Id)
 	s.Require().NoError(err)
 	finalSchedule := scheduleResponse.ReleaseSchedule
 	s.T().Logf("Scheduled amt before %s vs after %s", schedule.ReleasedAmount.Amount.String(), finalSchedule.ReleasedAmount.Amount.String())
@@ -103,11 +104,32 @@ func (s *IntegrationTestSuite) testRewardUpdate() {
 }
 
 // queryReleaseSchedule returns schedule information from the chain
-func queryReleaseSchedule(endpoint string) (rewardstypes.QueryReleaseScheduleResponse, error) {
+func queryReleaseSchedule(endpoint string, id uint64) (rewardstypes.QueryReleaseScheduleResponse, error) {
 	var res rewardstypes.QueryReleaseScheduleResponse
 
 	// Construct the full URL
-	url := fmt.Sprintf("%s/kiichain/rewards/v1beta1/release-schedule", endpoint)
+	url := fmt.Sprintf("%s/kiichain/rewards/v1beta1/release-schedules/%d", endpoint, id)
+
+	// Make HTTP GET request
+	body, err := httpGet(url)
+	if err != nil {
+		return res, err
+	}
+
+	// Unmarshal JSON response
+	if err := cdc.UnmarshalJSON(body, &res); err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
+
+// queryReleaseSchedules returns all the schedules from the chain
+func queryReleaseSchedules(endpoint string) (rewardstypes.QueryReleaseSchedulesResponse, error) {
+	var res rewardstypes.QueryReleaseSchedulesResponse
+
+	// Construct the full URL
+	url := fmt.Sprintf("%s/kiichain/rewards/v1beta1/release-schedules", endpoint)
 
 	// Make HTTP GET request
 	body, err := httpGet(url)
@@ -200,15 +222,15 @@ func (s *IntegrationTestSuite) passScheduleProposal(chainEndpoint string, amount
 	voteGovFlags := []string{strconv.Itoa(proposalCounter), "yes"}
 
 	// Create and pass proposal
-	s.submitGovProposal(chainEndpoint, sender, proposalCounter, "ChangeSchedule", submitGovFlags, depositGovFlags, voteGovFlags, "vote")
+	s.submitGovProposal(chainEndpoint, sender, proposalCounter, "CreateSchedule", submitGovFlags, depositGovFlags, voteGovFlags, "vote")
 }
 
-// writeScheduleProposal stores a file with the change schedule proposal
+// writeScheduleProposal stores a file with the create schedule proposal
 func (s *IntegrationTestSuite) writeScheduleProposal(c *chain, amount sdk.Coin, endTime time.Time) {
 	body := `{
 		"messages": [
                 {
-			"@type": "/kiichain.rewards.v1beta1.MsgChangeSchedule",
+			"@type": "/kiichain.rewards.v1beta1.MsgCreateSchedule",
             "authority": "kii10d07y265gmmuvt4z0w9aw880jnsr700jrff0qv",
             "schedule": {
                 "total_amount": {
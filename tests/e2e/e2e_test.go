@@ -96,6 +96,9 @@ func (s *IntegrationTestSuite) TestIBC() {
 	// IBC precompile
 	jsonRPC := fmt.Sprintf("http://%s", s.valResources[s.chainA.id][0].GetHostPort("8545/tcp"))
 	s.testIBCPrecompileTransfer(jsonRPC)
+
+	// IBC tokens as fee tokens
+	s.testIBCFeeToken()
 }
 
 // TestSlashing runs the slashing tests. It is skipped if the variable is set
@@ -133,6 +136,7 @@ func (s *IntegrationTestSuite) TestRewards() {
 		s.T().Skip()
 	}
 	s.testRewardUpdate()
+	s.testRewardsPrecompile()
 }
 
 // TestTokenFactory runs the token factory tests. It is skipped if the variable is set
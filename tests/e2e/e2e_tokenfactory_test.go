@@ -96,7 +96,7 @@ func (s *IntegrationTestSuite) testTokenFactory() {
 
 // createDenomAndMint uses tokenfactory module to create a specific denom under a given
 // admin and mint a given amount of that new currency
-func (s *IntegrationTestSuite) createDenom(c *chain, admin, denom string) {
+func (s *IntegrationTestSuite) createDenom(c *chain, admin, denom string, extraFlags ...string) {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
 	defer cancel()
 
@@ -117,6 +117,7 @@ func (s *IntegrationTestSuite) createDenom(c *chain, admin, denom string) {
 		"--output=json",
 		"-y",
 	}
+	createDenomCmd = append(createDenomCmd, extraFlags...)
 	s.T().Logf("Creating denom %s with admin %s on chain %s ", denom, admin, s.chainA.id)
 
 	s.executeKiichainTxCommand(ctx, c, createDenomCmd, 0, s.defaultExecValidation(c, 0))
@@ -189,6 +189,7 @@ func modifyGenesis(path, moniker, amountStr string, addrAll []sdk.AccAddress, de
 		"0x0000000000000000000000000000000000000805",
 		"0x0000000000000000000000000000000000001001",
 		"0x0000000000000000000000000000000000001002",
+		"0x0000000000000000000000000000000000001005",
 	}
 
 	evmGenStateBz, err := cdc.MarshalJSON(evmGenesisState)
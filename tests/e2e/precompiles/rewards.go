@@ -0,0 +1,472 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package precompiles
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// RewardsPrecompileMetaData contains all meta data concerning the RewardsPrecompile contract.
+var RewardsPrecompileMetaData = &bind.MetaData{
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"sender\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"PoolFunded\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"amount\",\"type\":\"uint256\"}],\"name\":\"fundPool\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"success\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint64\",\"name\":\"id\",\"type\":\"uint64\"}],\"name\":\"getReleaseSchedule\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"denom\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"totalAmount\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"releasedAmount\",\"type\":\"uint256\"},{\"internalType\":\"int64\",\"name\":\"startTime\",\"type\":\"int64\"},{\"internalType\":\"int64\",\"name\":\"endTime\",\"type\":\"int64\"},{\"internalType\":\"int64\",\"name\":\"lastReleaseTime\",\"type\":\"int64\"},{\"internalType\":\"bool\",\"name\":\"active\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getRewardPool\",\"outputs\":[{\"internalType\":\"string[]\",\"name\":\"denoms\",\"type\":\"string[]\"},{\"internalType\":\"string[]\",\"name\":\"poolAmounts\",\"type\":\"string[]\"},{\"internalType\":\"uint256[]\",\"name\":\"committedAmounts\",\"type\":\"uint256[]\"},{\"internalType\":\"string[]\",\"name\":\"freeAmounts\",\"type\":\"string[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// RewardsPrecompileABI is the input ABI used to generate the binding from.
+// Deprecated: Use RewardsPrecompileMetaData.ABI instead.
+var RewardsPrecompileABI = RewardsPrecompileMetaData.ABI
+
+// RewardsPrecompile is an auto generated Go binding around an Ethereum contract.
+type RewardsPrecompile struct {
+	RewardsPrecompileCaller     // Read-only binding to the contract
+	RewardsPrecompileTransactor // Write-only binding to the contract
+	RewardsPrecompileFilterer   // Log filterer for contract events
+}
+
+// RewardsPrecompileCaller is an auto generated read-only Go binding around an Ethereum contract.
+type RewardsPrecompileCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RewardsPrecompileTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type RewardsPrecompileTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RewardsPrecompileFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type RewardsPrecompileFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RewardsPrecompileSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type RewardsPrecompileSession struct {
+	Contract     *RewardsPrecompile // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts      // Call options to use throughout this session
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// RewardsPrecompileCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type RewardsPrecompileCallerSession struct {
+	Contract *RewardsPrecompileCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts            // Call options to use throughout this session
+}
+
+// RewardsPrecompileTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type RewardsPrecompileTransactorSession struct {
+	Contract     *RewardsPrecompileTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts            // Transaction auth options to use throughout this session
+}
+
+// RewardsPrecompileRaw is an auto generated low-level Go binding around an Ethereum contract.
+type RewardsPrecompileRaw struct {
+	Contract *RewardsPrecompile // Generic contract binding to access the raw methods on
+}
+
+// RewardsPrecompileCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type RewardsPrecompileCallerRaw struct {
+	Contract *RewardsPrecompileCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// RewardsPrecompileTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type RewardsPrecompileTransactorRaw struct {
+	Contract *RewardsPrecompileTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewRewardsPrecompile creates a new instance of RewardsPrecompile, bound to a specific deployed contract.
+func NewRewardsPrecompile(address common.Address, backend bind.ContractBackend) (*RewardsPrecompile, error) {
+	contract, err := bindRewardsPrecompile(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &RewardsPrecompile{RewardsPrecompileCaller: RewardsPrecompileCaller{contract: contract}, RewardsPrecompileTransactor: RewardsPrecompileTransactor{contract: contract}, RewardsPrecompileFilterer: RewardsPrecompileFilterer{contract: contract}}, nil
+}
+
+// NewRewardsPrecompileCaller creates a new read-only instance of RewardsPrecompile, bound to a specific deployed contract.
+func NewRewardsPrecompileCaller(address common.Address, caller bind.ContractCaller) (*RewardsPrecompileCaller, error) {
+	contract, err := bindRewardsPrecompile(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RewardsPrecompileCaller{contract: contract}, nil
+}
+
+// NewRewardsPrecompileTransactor creates a new write-only instance of RewardsPrecompile, bound to a specific deployed contract.
+func NewRewardsPrecompileTransactor(address common.Address, transactor bind.ContractTransactor) (*RewardsPrecompileTransactor, error) {
+	contract, err := bindRewardsPrecompile(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RewardsPrecompileTransactor{contract: contract}, nil
+}
+
+// NewRewardsPrecompileFilterer creates a new log filterer instance of RewardsPrecompile, bound to a specific deployed contract.
+func NewRewardsPrecompileFilterer(address common.Address, filterer bind.ContractFilterer) (*RewardsPrecompileFilterer, error) {
+	contract, err := bindRewardsPrecompile(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &RewardsPrecompileFilterer{contract: contract}, nil
+}
+
+// bindRewardsPrecompile binds a generic wrapper to an already deployed contract.
+func bindRewardsPrecompile(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(RewardsPrecompileABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RewardsPrecompile *RewardsPrecompileRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _RewardsPrecompile.Contract.RewardsPrecompileCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RewardsPrecompile *RewardsPrecompileRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RewardsPrecompile.Contract.RewardsPrecompileTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RewardsPrecompile *RewardsPrecompileRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RewardsPrecompile.Contract.RewardsPrecompileTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RewardsPrecompile *RewardsPrecompileCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _RewardsPrecompile.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RewardsPrecompile *RewardsPrecompileTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RewardsPrecompile.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RewardsPrecompile *RewardsPrecompileTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RewardsPrecompile.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetReleaseSchedule is a free data retrieval call binding the contract method 0x6e73401f.
+//
+// Solidity: function getReleaseSchedule(uint64 id) view returns(string denom, uint256 totalAmount, uint256 releasedAmount, int64 startTime, int64 endTime, int64 lastReleaseTime, bool active)
+func (_RewardsPrecompile *RewardsPrecompileCaller) GetReleaseSchedule(opts *bind.CallOpts, id uint64) (struct {
+	Denom           string
+	TotalAmount     *big.Int
+	ReleasedAmount  *big.Int
+	StartTime       int64
+	EndTime         int64
+	LastReleaseTime int64
+	Active          bool
+}, error) {
+	var out []interface{}
+	err := _RewardsPrecompile.contract.Call(opts, &out, "getReleaseSchedule", id)
+
+	outstruct := new(struct {
+		Denom           string
+		TotalAmount     *big.Int
+		ReleasedAmount  *big.Int
+		StartTime       int64
+		EndTime         int64
+		LastReleaseTime int64
+		Active          bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Denom = *abi.ConvertType(out[0], new(string)).(*string)
+	outstruct.TotalAmount = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.ReleasedAmount = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.StartTime = *abi.ConvertType(out[3], new(int64)).(*int64)
+	outstruct.EndTime = *abi.ConvertType(out[4], new(int64)).(*int64)
+	outstruct.LastReleaseTime = *abi.ConvertType(out[5], new(int64)).(*int64)
+	outstruct.Active = *abi.ConvertType(out[6], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// GetReleaseSchedule is a free data retrieval call binding the contract method 0x6e73401f.
+//
+// Solidity: function getReleaseSchedule(uint64 id) view returns(string denom, uint256 totalAmount, uint256 releasedAmount, int64 startTime, int64 endTime, int64 lastReleaseTime, bool active)
+func (_RewardsPrecompile *RewardsPrecompileSession) GetReleaseSchedule(id uint64) (struct {
+	Denom           string
+	TotalAmount     *big.Int
+	ReleasedAmount  *big.Int
+	StartTime       int64
+	EndTime         int64
+	LastReleaseTime int64
+	Active          bool
+}, error) {
+	return _RewardsPrecompile.Contract.GetReleaseSchedule(&_RewardsPrecompile.CallOpts, id)
+}
+
+// GetReleaseSchedule is a free data retrieval call binding the contract method 0x6e73401f.
+//
+// Solidity: function getReleaseSchedule(uint64 id) view returns(string denom, uint256 totalAmount, uint256 releasedAmount, int64 startTime, int64 endTime, int64 lastReleaseTime, bool active)
+func (_RewardsPrecompile *RewardsPrecompileCallerSession) GetReleaseSchedule(id uint64) (struct {
+	Denom           string
+	TotalAmount     *big.Int
+	ReleasedAmount  *big.Int
+	StartTime       int64
+	EndTime         int64
+	LastReleaseTime int64
+	Active          bool
+}, error) {
+	return _RewardsPrecompile.Contract.GetReleaseSchedule(&_RewardsPrecompile.CallOpts, id)
+}
+
+// GetRewardPool is a free data retrieval call binding the contract method 0x1b8b13a7.
+//
+// Solidity: function getRewardPool() view returns(string[] denoms, string[] poolAmounts, uint256[] committedAmounts, string[] freeAmounts)
+func (_RewardsPrecompile *RewardsPrecompileCaller) GetRewardPool(opts *bind.CallOpts) (struct {
+	Denoms           []string
+	PoolAmounts      []string
+	CommittedAmounts []*big.Int
+	FreeAmounts      []string
+}, error) {
+	var out []interface{}
+	err := _RewardsPrecompile.contract.Call(opts, &out, "getRewardPool")
+
+	outstruct := new(struct {
+		Denoms           []string
+		PoolAmounts      []string
+		CommittedAmounts []*big.Int
+		FreeAmounts      []string
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Denoms = *abi.ConvertType(out[0], new([]string)).(*[]string)
+	outstruct.PoolAmounts = *abi.ConvertType(out[1], new([]string)).(*[]string)
+	outstruct.CommittedAmounts = *abi.ConvertType(out[2], new([]*big.Int)).(*[]*big.Int)
+	outstruct.FreeAmounts = *abi.ConvertType(out[3], new([]string)).(*[]string)
+
+	return *outstruct, err
+
+}
+
+// GetRewardPool is a free data retrieval call binding the contract method 0x1b8b13a7.
+//
+// Solidity: function getRewardPool() view returns(string[] denoms, string[] poolAmounts, uint256[] committedAmounts, string[] freeAmounts)
+func (_RewardsPrecompile *RewardsPrecompileSession) GetRewardPool() (struct {
+	Denoms           []string
+	PoolAmounts      []string
+	CommittedAmounts []*big.Int
+	FreeAmounts      []string
+}, error) {
+	return _RewardsPrecompile.Contract.GetRewardPool(&_RewardsPrecompile.CallOpts)
+}
+
+// GetRewardPool is a free data retrieval call binding the contract method 0x1b8b13a7.
+//
+// Solidity: function getRewardPool() view returns(string[] denoms, string[] poolAmounts, uint256[] committedAmounts, string[] freeAmounts)
+func (_RewardsPrecompile *RewardsPrecompileCallerSession) GetRewardPool() (struct {
+	Denoms           []string
+	PoolAmounts      []string
+	CommittedAmounts []*big.Int
+	FreeAmounts      []string
+}, error) {
+	return _RewardsPrecompile.Contract.GetRewardPool(&_RewardsPrecompile.CallOpts)
+}
+
+// FundPool is a paid mutator transaction binding the contract method 0xc842ed91.
+//
+// Solidity: function fundPool(string denom, uint256 amount) returns(bool success)
+func (_RewardsPrecompile *RewardsPrecompileTransactor) FundPool(opts *bind.TransactOpts, denom string, amount *big.Int) (*types.Transaction, error) {
+	return _RewardsPrecompile.contract.Transact(opts, "fundPool", denom, amount)
+}
+
+// FundPool is a paid mutator transaction binding the contract method 0xc842ed91.
+//
+// Solidity: function fundPool(string denom, uint256 amount) returns(bool success)
+func (_RewardsPrecompile *RewardsPrecompileSession) FundPool(denom string, amount *big.Int) (*types.Transaction, error) {
+	return _RewardsPrecompile.Contract.FundPool(&_RewardsPrecompile.TransactOpts, denom, amount)
+}
+
+// FundPool is a paid mutator transaction binding the contract method 0xc842ed91.
+//
+// Solidity: function fundPool(string denom, uint256 amount) returns(bool success)
+func (_RewardsPrecompile *RewardsPrecompileTransactorSession) FundPool(denom string, amount *big.Int) (*types.Transaction, error) {
+	return _RewardsPrecompile.Contract.FundPool(&_RewardsPrecompile.TransactOpts, denom, amount)
+}
+
+// RewardsPrecompilePoolFundedIterator is returned from FilterPoolFunded and is used to iterate over the raw logs and unpacked data for PoolFunded events raised by the RewardsPrecompile contract.
+type RewardsPrecompilePoolFundedIterator struct {
+	Event *RewardsPrecompilePoolFunded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *RewardsPrecompilePoolFundedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(RewardsPrecompilePoolFunded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(RewardsPrecompilePoolFunded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *RewardsPrecompilePoolFundedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *RewardsPrecompilePoolFundedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// RewardsPrecompilePoolFunded represents a PoolFunded event raised by the RewardsPrecompile contract.
+type RewardsPrecompilePoolFunded struct {
+	Sender common.Address
+	Denom  string
+	Amount *big.Int
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterPoolFunded is a free log retrieval operation binding the contract event 0x0e4af4d1d2d2a7ce5d0d864b98641b8ed88c54486deaebc8df22082fa9978a97.
+//
+// Solidity: event PoolFunded(address indexed sender, string denom, uint256 amount)
+func (_RewardsPrecompile *RewardsPrecompileFilterer) FilterPoolFunded(opts *bind.FilterOpts, sender []common.Address) (*RewardsPrecompilePoolFundedIterator, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _RewardsPrecompile.contract.FilterLogs(opts, "PoolFunded", senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &RewardsPrecompilePoolFundedIterator{contract: _RewardsPrecompile.contract, event: "PoolFunded", logs: logs, sub: sub}, nil
+}
+
+// WatchPoolFunded is a free log subscription operation binding the contract event 0x0e4af4d1d2d2a7ce5d0d864b98641b8ed88c54486deaebc8df22082fa9978a97.
+//
+// Solidity: event PoolFunded(address indexed sender, string denom, uint256 amount)
+func (_RewardsPrecompile *RewardsPrecompileFilterer) WatchPoolFunded(opts *bind.WatchOpts, sink chan<- *RewardsPrecompilePoolFunded, sender []common.Address) (event.Subscription, error) {
+
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _RewardsPrecompile.contract.WatchLogs(opts, "PoolFunded", senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(RewardsPrecompilePoolFunded)
+				if err := _RewardsPrecompile.contract.UnpackLog(event, "PoolFunded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParsePoolFunded is a log parse operation binding the contract event 0x0e4af4d1d2d2a7ce5d0d864b98641b8ed88c54486deaebc8df22082fa9978a97.
+//
+// Solidity: event PoolFunded(address indexed sender, string denom, uint256 amount)
+func (_RewardsPrecompile *RewardsPrecompileFilterer) ParsePoolFunded(log types.Log) (*RewardsPrecompilePoolFunded, error) {
+	event := new(RewardsPrecompilePoolFunded)
+	if err := _RewardsPrecompile.contract.UnpackLog(event, "PoolFunded", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -20,6 +20,8 @@ import (
 	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govtypesv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	tokenfactorytypes "github.com/kiichain/kiichain/v4/x/tokenfactory/types"
 )
 
 func queryKiichainTx(endpoint, txHash string) error {
@@ -330,3 +332,17 @@ func queryICAAccountAddress(endpoint, owner, connectionID string) (string, error
 
 	return icaAccountResp.Address, nil
 }
+
+func queryDenomERC20Address(endpoint, denom string) (string, error) {
+	var res tokenfactorytypes.QueryDenomERC20AddressResponse
+
+	body, err := httpGet(fmt.Sprintf("%s/kiichain/tokenfactory/v1beta1/erc20_address/by_denom?denom=%s", endpoint, denom))
+	if err != nil {
+		return "", fmt.Errorf("failed to execute HTTP request: %w", err)
+	}
+
+	if err := cdc.UnmarshalJSON(body, &res); err != nil {
+		return "", err
+	}
+	return res.Erc20Address, nil
+}
@@ -0,0 +1,110 @@
+package feeabstraction_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	app "github.com/kiichain/kiichain/v4/app"
+	"github.com/kiichain/kiichain/v4/app/apptesting"
+	"github.com/kiichain/kiichain/v4/wasmbinding"
+	"github.com/kiichain/kiichain/v4/wasmbinding/feeabstraction"
+	feeabstractionbindingtypes "github.com/kiichain/kiichain/v4/wasmbinding/feeabstraction/types"
+	"github.com/kiichain/kiichain/v4/wasmbinding/helpers"
+	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
+)
+
+// TestFeeAbstractionQueries tests the fee abstraction queries through the kiichain custom querier
+func TestFeeAbstractionQueries(t *testing.T) {
+	actor := apptesting.RandomAccountAddress()
+	app, ctx := helpers.SetupCustomApp(t, actor)
+
+	// Register a fee token
+	err := app.FeeAbstractionKeeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
+		types.NewFeeTokenMetadata("uatom", "atom", 6, math.LegacyMustNewDecFromStr("0.5")),
+	))
+	require.NoError(t, err)
+
+	// Query the fee tokens
+	query := feeabstractionbindingtypes.Query{
+		FeeTokens: &feeabstractionbindingtypes.FeeTokensRequest{},
+	}
+	respFeeTokens := feeabstractionbindingtypes.FeeTokensResponse{}
+	err = queryCustom(t, ctx, app, query, &respFeeTokens)
+	require.NoError(t, err)
+	require.Len(t, respFeeTokens.FeeTokens, 1)
+	require.Equal(t, "uatom", respFeeTokens.FeeTokens[0].Denom)
+	require.Equal(t, uint32(6), respFeeTokens.FeeTokens[0].Decimals)
+	require.False(t, respFeeTokens.FeeTokens[0].Paused)
+
+	// Query the params
+	query = feeabstractionbindingtypes.Query{
+		Params: &feeabstractionbindingtypes.ParamsRequest{},
+	}
+	respParams := feeabstractionbindingtypes.ParamsResponse{}
+	err = queryCustom(t, ctx, app, query, &respParams)
+	require.NoError(t, err)
+	require.Equal(t, "akii", respParams.NativeDenom)
+	require.True(t, respParams.Enabled)
+
+	// Dry run a fee conversion
+	query = feeabstractionbindingtypes.Query{
+		ConvertNativeFee: &feeabstractionbindingtypes.ConvertNativeFeeRequest{
+			Amount: "1000000000000000000",
+			Denom:  "uatom",
+		},
+	}
+	respConvert := feeabstractionbindingtypes.ConvertNativeFeeResponse{}
+	err = queryCustom(t, ctx, app, query, &respConvert)
+	require.NoError(t, err)
+	require.Equal(t, "uatom", respConvert.Denom)
+	require.Equal(t, "500000", respConvert.Amount)
+
+	// Unknown fee tokens fail the query
+	query = feeabstractionbindingtypes.Query{
+		ConvertNativeFee: &feeabstractionbindingtypes.ConvertNativeFeeRequest{
+			Amount: "1000",
+			Denom:  "unknown",
+		},
+	}
+	err = queryCustom(t, ctx, app, query, &respConvert)
+	require.Error(t, err)
+}
+
+// queryCustom is a helper function to query the fee abstraction bindings through the kiichain custom querier
+// This is the same JSON path used by the contracts on the wasm keeper
+func queryCustom(t *testing.T, ctx sdk.Context, app *app.KiichainApp, request feeabstractionbindingtypes.Query, response interface{}) error {
+	t.Helper()
+
+	// Make the request a kiichain query
+	kiichainQuery := wasmbinding.KiichainQuery{
+		FeeAbstraction: &request,
+	}
+
+	// Marshal the request to JSON
+	msgBz, err := json.Marshal(kiichainQuery)
+	if err != nil {
+		return err
+	}
+
+	// Build the custom querier with the app keepers
+	queryPlugin := wasmbinding.NewQueryPlugin(
+		nil,
+		nil,
+		nil,
+		nil,
+		feeabstraction.NewQueryPlugin(app.FeeAbstractionKeeper),
+		nil,
+	)
+	resBz, err := wasmbinding.CustomQuerier(queryPlugin)(ctx, msgBz)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(resBz, response)
+}
@@ -0,0 +1,159 @@
+package feeabstraction
+
+import (
+	"encoding/json"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	feeabstractionbindingtypes "github.com/kiichain/kiichain/v4/wasmbinding/feeabstraction/types"
+	feeabstractionkeeper "github.com/kiichain/kiichain/v4/x/feeabstraction/keeper"
+)
+
+// QueryPlugin is the query plugin object for the fee abstraction queries
+type QueryPlugin struct {
+	feeAbstractionKeeper feeabstractionkeeper.Keeper
+}
+
+// NewQueryPlugin returns a new query plugin
+func NewQueryPlugin(feeAbstractionKeeper feeabstractionkeeper.Keeper) *QueryPlugin {
+	return &QueryPlugin{
+		feeAbstractionKeeper: feeAbstractionKeeper,
+	}
+}
+
+// HandleFeeAbstractionQuery is a custom querier for the fee abstraction module
+func (qp *QueryPlugin) HandleFeeAbstractionQuery(ctx sdk.Context, feeAbstractionQuery feeabstractionbindingtypes.Query) ([]byte, error) {
+	// Match the query under the module
+	switch {
+	// The query is a fee tokens query
+	case feeAbstractionQuery.FeeTokens != nil:
+		// Apply the request
+		feeTokens, err := qp.HandleFeeTokens(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// Marshal the response
+		return json.Marshal(feeTokens)
+
+	// The query is a params query
+	case feeAbstractionQuery.Params != nil:
+		// Apply the request
+		params, err := qp.HandleParams(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// Marshal the response
+		return json.Marshal(params)
+
+	// The query is a convert native fee dry run
+	case feeAbstractionQuery.ConvertNativeFee != nil:
+		// Apply the request
+		convertedFee, err := qp.HandleConvertNativeFee(ctx, *feeAbstractionQuery.ConvertNativeFee)
+		if err != nil {
+			return nil, err
+		}
+
+		// Marshal the response
+		return json.Marshal(convertedFee)
+
+	default:
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown fee abstraction query variant"}
+	}
+}
+
+// HandleFeeTokens handles the fee tokens query
+func (qp *QueryPlugin) HandleFeeTokens(ctx sdk.Context) (*feeabstractionbindingtypes.FeeTokensResponse, error) {
+	// Get the fee tokens from the keeper
+	feeTokens, err := qp.feeAbstractionKeeper.GetFeeTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the response with the pause state of each token
+	res := &feeabstractionbindingtypes.FeeTokensResponse{
+		FeeTokens: make([]feeabstractionbindingtypes.FeeToken, 0, len(feeTokens.Items)),
+	}
+	for _, feeToken := range feeTokens.Items {
+		paused, err := qp.feeAbstractionKeeper.IsFeeTokenPaused(ctx, feeToken.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		res.FeeTokens = append(res.FeeTokens, feeabstractionbindingtypes.FeeToken{
+			Denom:         feeToken.Denom,
+			OracleDenom:   feeToken.OracleDenom,
+			Decimals:      feeToken.Decimals,
+			Price:         feeToken.Price.String(),
+			AdjustedPrice: feeToken.AdjustedPrice().String(),
+			Enabled:       feeToken.Enabled,
+			Paused:        paused,
+		})
+	}
+
+	// Return the response
+	return res, nil
+}
+
+// HandleParams handles the params query
+func (qp *QueryPlugin) HandleParams(ctx sdk.Context) (*feeabstractionbindingtypes.ParamsResponse, error) {
+	// Get the params from the keeper
+	params, err := qp.feeAbstractionKeeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the module pause state
+	paused, err := qp.feeAbstractionKeeper.IsPaused(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the response
+	return &feeabstractionbindingtypes.ParamsResponse{
+		NativeDenom:         params.NativeDenom,
+		NativeOracleDenom:   params.NativeOracleDenom,
+		Enabled:             params.Enabled,
+		Paused:              paused,
+		ClampFactor:         params.ClampFactor.String(),
+		TwapLookbackWindow:  params.TwapLookbackWindow,
+		FallbackNativePrice: params.FallbackNativePrice.String(),
+		ConversionCapWindow: params.ConversionCapWindow,
+		MultiTokenFees:      params.MultiTokenFees,
+		MaxPriceAge:         params.MaxPriceAge,
+		MinTwapCoverage:     params.MinTwapCoverage,
+	}, nil
+}
+
+// HandleConvertNativeFee handles the convert native fee query
+// This is a dry run of the fee conversion, no balances are converted
+func (qp *QueryPlugin) HandleConvertNativeFee(
+	ctx sdk.Context,
+	query feeabstractionbindingtypes.ConvertNativeFeeRequest,
+) (*feeabstractionbindingtypes.ConvertNativeFeeResponse, error) {
+	// Validate the query
+	if query.Denom == "" {
+		return nil, wasmvmtypes.InvalidRequest{Err: "empty denom"}
+	}
+	amount, ok := math.NewIntFromString(query.Amount)
+	if !ok || amount.IsNegative() {
+		return nil, wasmvmtypes.InvalidRequest{Err: "invalid amount"}
+	}
+
+	// Convert the native fee
+	fee, err := qp.feeAbstractionKeeper.EstimateFee(ctx, amount, query.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the response
+	return &feeabstractionbindingtypes.ConvertNativeFeeResponse{
+		Denom:  fee.Denom,
+		Amount: fee.Amount.String(),
+	}, nil
+}
@@ -0,0 +1,137 @@
+package feeabstraction_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/kiichain/kiichain/v4/app/apptesting"
+	"github.com/kiichain/kiichain/v4/wasmbinding/feeabstraction"
+	feeabstractionbindingtypes "github.com/kiichain/kiichain/v4/wasmbinding/feeabstraction/types"
+	"github.com/kiichain/kiichain/v4/wasmbinding/helpers"
+	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
+)
+
+// TestHandleFeeAbstractionQuery tests the HandleFeeAbstractionQuery function of the fee abstraction module
+func TestHandleFeeAbstractionQuery(t *testing.T) {
+	// Setup the app
+	actor := apptesting.RandomAccountAddress()
+	app, ctx := helpers.SetupCustomApp(t, actor)
+
+	// Register two fee tokens, one of them paused
+	err := app.FeeAbstractionKeeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
+		types.NewFeeTokenMetadata("uatom", "atom", 6, math.LegacyMustNewDecFromStr("0.5")),
+		types.NewFeeTokenMetadata("uusdc", "usdc", 6, math.LegacyOneDec()),
+	))
+	require.NoError(t, err)
+	err = app.FeeAbstractionKeeper.PausedFeeTokens.Set(ctx, "uusdc")
+	require.NoError(t, err)
+
+	// Set all the test cases
+	testCases := []struct {
+		name        string
+		query       feeabstractionbindingtypes.Query
+		expected    []byte
+		errContains string
+	}{
+		{
+			name: "valid - fee tokens",
+			query: feeabstractionbindingtypes.Query{
+				FeeTokens: &feeabstractionbindingtypes.FeeTokensRequest{},
+			},
+			expected: []byte(`{"fee_tokens":[{"denom":"uatom","oracle_denom":"atom","decimals":6,"price":"0.500000000000000000","adjusted_price":"0.500000000000000000","enabled":true,"paused":false},{"denom":"uusdc","oracle_denom":"usdc","decimals":6,"price":"1.000000000000000000","adjusted_price":"1.000000000000000000","enabled":true,"paused":true}]}`),
+		},
+		{
+			name: "valid - params",
+			query: feeabstractionbindingtypes.Query{
+				Params: &feeabstractionbindingtypes.ParamsRequest{},
+			},
+			expected: []byte(`{"native_denom":"akii","native_oracle_denom":"kii","enabled":true,"paused":false,"clamp_factor":"0.100000000000000000","twap_lookback_window":120,"fallback_native_price":"0.010000000000000000","conversion_cap_window":86400,"multi_token_fees":false,"max_price_age":600,"min_twap_coverage":60}`),
+		},
+		{
+			name: "valid - convert native fee",
+			query: feeabstractionbindingtypes.Query{
+				ConvertNativeFee: &feeabstractionbindingtypes.ConvertNativeFeeRequest{
+					Amount: "1000000000000000000",
+					Denom:  "uatom",
+				},
+			},
+			expected: []byte(`{"denom":"uatom","amount":"500000"}`),
+		},
+		{
+			name: "valid - convert native fee on the native denom",
+			query: feeabstractionbindingtypes.Query{
+				ConvertNativeFee: &feeabstractionbindingtypes.ConvertNativeFeeRequest{
+					Amount: "1000",
+					Denom:  "akii",
+				},
+			},
+			expected: []byte(`{"denom":"akii","amount":"1000"}`),
+		},
+		{
+			name: "invalid - convert native fee empty denom",
+			query: feeabstractionbindingtypes.Query{
+				ConvertNativeFee: &feeabstractionbindingtypes.ConvertNativeFeeRequest{
+					Amount: "1000",
+				},
+			},
+			errContains: "invalid request: empty denom",
+		},
+		{
+			name: "invalid - convert native fee bad amount",
+			query: feeabstractionbindingtypes.Query{
+				ConvertNativeFee: &feeabstractionbindingtypes.ConvertNativeFeeRequest{
+					Amount: "abc",
+					Denom:  "uatom",
+				},
+			},
+			errContains: "invalid request: invalid amount",
+		},
+		{
+			name: "invalid - convert native fee unknown token",
+			query: feeabstractionbindingtypes.Query{
+				ConvertNativeFee: &feeabstractionbindingtypes.ConvertNativeFeeRequest{
+					Amount: "1000",
+					Denom:  "unknown",
+				},
+			},
+			errContains: "fee token not found",
+		},
+		{
+			name: "invalid - convert native fee paused token",
+			query: feeabstractionbindingtypes.Query{
+				ConvertNativeFee: &feeabstractionbindingtypes.ConvertNativeFeeRequest{
+					Amount: "1000",
+					Denom:  "uusdc",
+				},
+			},
+			errContains: "fee token unavailable for fee payment",
+		},
+		{
+			name:        "invalid - unknown query variant",
+			query:       feeabstractionbindingtypes.Query{},
+			errContains: "unknown fee abstraction query variant",
+		},
+	}
+
+	// Iterate over the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Start the query plugin
+			queryPlugin := feeabstraction.NewQueryPlugin(app.FeeAbstractionKeeper)
+
+			// Handle the query
+			bz, err := queryPlugin.HandleFeeAbstractionQuery(ctx, tc.query)
+
+			// Check for errors
+			if tc.errContains != "" {
+				require.ErrorContains(t, err, tc.errContains)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, string(tc.expected), string(bz))
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package types
+
+// Query is the query type for the fee abstraction module on wasmbindings
+type Query struct {
+	FeeTokens        *FeeTokensRequest        `json:"fee_tokens,omitempty"`
+	Params           *ParamsRequest           `json:"params,omitempty"`
+	ConvertNativeFee *ConvertNativeFeeRequest `json:"convert_native_fee,omitempty"`
+}
+
+// FeeTokensRequest is the query type for the FeeTokens query
+type FeeTokensRequest struct{}
+
+// FeeToken is a single fee token on the FeeTokens response
+type FeeToken struct {
+	Denom         string `json:"denom"`
+	OracleDenom   string `json:"oracle_denom"`
+	Decimals      uint32 `json:"decimals"`
+	Price         string `json:"price"`
+	AdjustedPrice string `json:"adjusted_price"`
+	Enabled       bool   `json:"enabled"`
+	Paused        bool   `json:"paused"`
+}
+
+// FeeTokensResponse is the response type for the FeeTokens query
+type FeeTokensResponse struct {
+	FeeTokens []FeeToken `json:"fee_tokens"`
+}
+
+// ParamsRequest is the query type for the Params query
+type ParamsRequest struct{}
+
+// ParamsResponse is the response type for the Params query
+type ParamsResponse struct {
+	NativeDenom         string `json:"native_denom"`
+	NativeOracleDenom   string `json:"native_oracle_denom"`
+	Enabled             bool   `json:"enabled"`
+	Paused              bool   `json:"paused"`
+	ClampFactor         string `json:"clamp_factor"`
+	TwapLookbackWindow  uint64 `json:"twap_lookback_window"`
+	FallbackNativePrice string `json:"fallback_native_price"`
+	ConversionCapWindow uint64 `json:"conversion_cap_window"`
+	MultiTokenFees      bool   `json:"multi_token_fees"`
+	MaxPriceAge         uint64 `json:"max_price_age"`
+	MinTwapCoverage     uint64 `json:"min_twap_coverage"`
+}
+
+// ConvertNativeFeeRequest is the query type for the ConvertNativeFee query
+// This is a dry run, no balances are converted
+type ConvertNativeFeeRequest struct {
+	// Amount is the fee amount on the native denom
+	Amount string `json:"amount"`
+	// Denom is the fee token used to pay the fee
+	Denom string `json:"denom"`
+}
+
+// ConvertNativeFeeResponse is the response type for the ConvertNativeFee query
+type ConvertNativeFeeResponse struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
@@ -13,6 +13,8 @@ import (
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 
+	rewardsbinding "github.com/kiichain/kiichain/v4/wasmbinding/rewards"
+	rewardsbindingtypes "github.com/kiichain/kiichain/v4/wasmbinding/rewards/types"
 	tfbinding "github.com/kiichain/kiichain/v4/wasmbinding/tokenfactory"
 	tfbindingtypes "github.com/kiichain/kiichain/v4/wasmbinding/tokenfactory/types"
 	"github.com/kiichain/kiichain/v4/wasmbinding/utils"
@@ -20,25 +22,28 @@ import (
 
 // KiichainMsg is the msg type for all cosmwasm bindings
 type KiichainMsg struct {
-	TokenFactory *tfbindingtypes.Msg `json:"token_factory,omitempty"`
+	TokenFactory *tfbindingtypes.Msg      `json:"token_factory,omitempty"`
+	Rewards      *rewardsbindingtypes.Msg `json:"rewards,omitempty"`
 }
 
 // CustomMessageDecorator returns decorator for custom CosmWasm bindings messages
-func CustomMessageDecorator(bank bankkeeper.Keeper, tokenFactory *tfbinding.CustomMessenger) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+func CustomMessageDecorator(bank bankkeeper.Keeper, tokenFactory *tfbinding.CustomMessenger, rewards *rewardsbinding.CustomMessenger) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
 	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
 		return &CustomMessenger{
 			wrapped:      old,
 			bank:         bank,
 			tokenFactory: tokenFactory,
+			rewards:      rewards,
 		}
 	}
 }
 
-// CustomMessenger is a wrapper for the token factory message plugin
+// CustomMessenger is a wrapper for the kiichain message plugins
 type CustomMessenger struct {
 	wrapped      wasmkeeper.Messenger
 	bank         bankkeeper.Keeper
 	tokenFactory *tfbinding.CustomMessenger
+	rewards      *rewardsbinding.CustomMessenger
 }
 
 // Ensure CustomMessenger implements the Messenger interface
@@ -59,6 +64,9 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		case contractMsg.TokenFactory != nil:
 			// Call the token factory custom message handler
 			return m.tokenFactory.DispatchMsg(ctx, contractAddr, contractIBCPortID, *contractMsg.TokenFactory)
+		case contractMsg.Rewards != nil:
+			// Call the rewards custom message handler
+			return m.rewards.DispatchMsg(ctx, contractAddr, contractIBCPortID, *contractMsg.Rewards)
 		default:
 			return nil, nil, utils.EmptyMsgResp, wasmvmtypes.UnsupportedRequest{Kind: "unknown kiichain msg variant"}
 		}
@@ -13,26 +13,34 @@ import (
 	bech32bindingtypes "github.com/kiichain/kiichain/v4/wasmbinding/bech32/types"
 	"github.com/kiichain/kiichain/v4/wasmbinding/evm"
 	evmbindingtypes "github.com/kiichain/kiichain/v4/wasmbinding/evm/types"
+	"github.com/kiichain/kiichain/v4/wasmbinding/feeabstraction"
+	feeabstractionbindingtypes "github.com/kiichain/kiichain/v4/wasmbinding/feeabstraction/types"
 	"github.com/kiichain/kiichain/v4/wasmbinding/oracle"
 	oraclebindingtypes "github.com/kiichain/kiichain/v4/wasmbinding/oracle/types"
+	"github.com/kiichain/kiichain/v4/wasmbinding/rewards"
+	rewardsbindingtypes "github.com/kiichain/kiichain/v4/wasmbinding/rewards/types"
 	"github.com/kiichain/kiichain/v4/wasmbinding/tokenfactory"
 	tfbindingtypes "github.com/kiichain/kiichain/v4/wasmbinding/tokenfactory/types"
 )
 
 // KiichainQuery is the query type for all cosmwasm bindings
 type KiichainQuery struct {
-	TokenFactory *tfbindingtypes.Query     `json:"token_factory,omitempty"`
-	EVM          *evmbindingtypes.Query    `json:"evm,omitempty"`
-	Bech32       *bech32bindingtypes.Query `json:"bech32,omitempty"`
-	Oracle       *oraclebindingtypes.Query `json:"oracle,omitempty"`
+	TokenFactory   *tfbindingtypes.Query             `json:"token_factory,omitempty"`
+	EVM            *evmbindingtypes.Query            `json:"evm,omitempty"`
+	Bech32         *bech32bindingtypes.Query         `json:"bech32,omitempty"`
+	Oracle         *oraclebindingtypes.Query         `json:"oracle,omitempty"`
+	FeeAbstraction *feeabstractionbindingtypes.Query `json:"fee_abstraction,omitempty"`
+	Rewards        *rewardsbindingtypes.Query        `json:"rewards,omitempty"`
 }
 
 // QueryPlugin is the query plugin for all cosmwasm bindings
 type QueryPlugin struct {
-	tokenfactoryHandler *tokenfactory.QueryPlugin
-	evmHandler          *evm.QueryPlugin
-	bech32Handler       *bech32.QueryPlugin
-	oracleHandler       *oracle.QueryPlugin
+	tokenfactoryHandler   *tokenfactory.QueryPlugin
+	evmHandler            *evm.QueryPlugin
+	bech32Handler         *bech32.QueryPlugin
+	oracleHandler         *oracle.QueryPlugin
+	feeAbstractionHandler *feeabstraction.QueryPlugin
+	rewardsHandler        *rewards.QueryPlugin
 }
 
 // NewQueryPlugin returns a reference to a new QueryPlugin
@@ -41,12 +49,16 @@ func NewQueryPlugin(
 	evm *evm.QueryPlugin,
 	bech32 *bech32.QueryPlugin,
 	oracle *oracle.QueryPlugin,
+	feeAbstraction *feeabstraction.QueryPlugin,
+	rewards *rewards.QueryPlugin,
 ) *QueryPlugin {
 	return &QueryPlugin{
-		tokenfactoryHandler: th,
-		evmHandler:          evm,
-		bech32Handler:       bech32,
-		oracleHandler:       oracle,
+		tokenfactoryHandler:   th,
+		evmHandler:            evm,
+		bech32Handler:         bech32,
+		oracleHandler:         oracle,
+		feeAbstractionHandler: feeAbstraction,
+		rewardsHandler:        rewards,
 	}
 }
 
@@ -73,6 +85,12 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 		case contractQuery.Oracle != nil:
 			// Call the oracle custom querier
 			return qp.oracleHandler.HandleOracleQuery(ctx, *contractQuery.Oracle)
+		case contractQuery.FeeAbstraction != nil:
+			// Call the fee abstraction custom querier
+			return qp.feeAbstractionHandler.HandleFeeAbstractionQuery(ctx, *contractQuery.FeeAbstraction)
+		case contractQuery.Rewards != nil:
+			// Call the rewards custom querier
+			return qp.rewardsHandler.HandleRewardsQuery(ctx, *contractQuery.Rewards)
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown query variant"}
 		}
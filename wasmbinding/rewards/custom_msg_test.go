@@ -0,0 +1,129 @@
+package rewards_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	app "github.com/kiichain/kiichain/v4/app"
+	"github.com/kiichain/kiichain/v4/app/apptesting"
+	"github.com/kiichain/kiichain/v4/wasmbinding"
+	"github.com/kiichain/kiichain/v4/wasmbinding/helpers"
+	"github.com/kiichain/kiichain/v4/wasmbinding/rewards"
+	rewardsbindingtypes "github.com/kiichain/kiichain/v4/wasmbinding/rewards/types"
+)
+
+// TestFundPoolMsg tests funding the reward pool through the kiichain custom messenger
+func TestFundPoolMsg(t *testing.T) {
+	actor := apptesting.RandomAccountAddress()
+	app, ctx := helpers.SetupCustomApp(t, actor)
+
+	// Fund the contract
+	contract := apptesting.RandomAccountAddress()
+	helpers.FundAccount(t, ctx, app, contract, sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000))))
+
+	specs := map[string]struct {
+		fundPool  *rewardsbindingtypes.FundPool
+		expErrMsg string
+	}{
+		"valid fund pool": {
+			fundPool: &rewardsbindingtypes.FundPool{Denom: "akii", Amount: math.NewInt(400)},
+		},
+		"denom not allowed": {
+			fundPool:  &rewardsbindingtypes.FundPool{Denom: "uatom", Amount: math.NewInt(400)},
+			expErrMsg: "denom uatom is not allowed by the rewards pool",
+		},
+		"zero amount": {
+			fundPool:  &rewardsbindingtypes.FundPool{Denom: "akii", Amount: math.ZeroInt()},
+			expErrMsg: "fund pool amount must be positive",
+		},
+		"insufficient funds": {
+			fundPool:  &rewardsbindingtypes.FundPool{Denom: "akii", Amount: math.NewInt(5000)},
+			expErrMsg: "insufficient funds",
+		},
+		"null fund pool": {
+			expErrMsg: "unknown rewards msg variant",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+
+			// when
+			msg := rewardsbindingtypes.Msg{FundPool: spec.fundPool}
+			err := executeCustom(t, cacheCtx, app, contract, msg)
+
+			// then
+			if spec.expErrMsg != "" {
+				require.ErrorContains(t, err, spec.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			// The funds left the contract and reached the pool
+			require.Equal(t, math.NewInt(600), app.BankKeeper.GetBalance(cacheCtx, contract, "akii").Amount)
+			resp := rewardsbindingtypes.RewardPoolResponse{}
+			err = queryCustom(t, cacheCtx, app, rewardsbindingtypes.Query{RewardPool: &rewardsbindingtypes.RewardPoolRequest{}}, &resp)
+			require.NoError(t, err)
+			require.Len(t, resp.Balances, 1)
+			require.Equal(t, "akii", resp.Balances[0].Denom)
+			require.Equal(t, "400.000000000000000000", resp.Balances[0].PoolAmount)
+		})
+	}
+}
+
+// executeCustom dispatches a rewards message through the kiichain custom messenger
+// This is the same JSON path used by the contracts on the wasm keeper
+func executeCustom(t *testing.T, ctx sdk.Context, app *app.KiichainApp, contract sdk.AccAddress, msg rewardsbindingtypes.Msg) error {
+	t.Helper()
+
+	// Make the request a kiichain msg
+	customBz, err := json.Marshal(wasmbinding.KiichainMsg{
+		Rewards: &msg,
+	})
+	require.NoError(t, err)
+
+	// Build the custom messenger with the app keepers, no message is forwarded to the wrapped messenger
+	messenger := wasmbinding.CustomMessageDecorator(
+		app.BankKeeper,
+		nil,
+		rewards.NewCustomMessenger(&app.RewardsKeeper),
+	)(nil)
+	_, _, _, err = messenger.DispatchMsg(ctx, contract, "", wasmvmtypes.CosmosMsg{Custom: customBz})
+	return err
+}
+
+// queryCustom is a helper function to query the rewards bindings through the kiichain custom querier
+func queryCustom(t *testing.T, ctx sdk.Context, app *app.KiichainApp, request rewardsbindingtypes.Query, response interface{}) error {
+	t.Helper()
+
+	// Make the request a kiichain query
+	msgBz, err := json.Marshal(wasmbinding.KiichainQuery{
+		Rewards: &request,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Build the custom querier with the app keepers
+	queryPlugin := wasmbinding.NewQueryPlugin(
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		rewards.NewQueryPlugin(&app.RewardsKeeper),
+	)
+	resBz, err := wasmbinding.CustomQuerier(queryPlugin)(ctx, msgBz)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(resBz, response)
+}
@@ -0,0 +1,119 @@
+package rewards
+
+import (
+	"encoding/json"
+	"time"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rewardsbindingtypes "github.com/kiichain/kiichain/v4/wasmbinding/rewards/types"
+	rewardskeeper "github.com/kiichain/kiichain/v4/x/rewards/keeper"
+)
+
+// QueryPlugin is the query plugin object for the rewards queries
+type QueryPlugin struct {
+	rewardsKeeper *rewardskeeper.Keeper
+}
+
+// NewQueryPlugin returns a new query plugin
+func NewQueryPlugin(rewardsKeeper *rewardskeeper.Keeper) *QueryPlugin {
+	return &QueryPlugin{
+		rewardsKeeper: rewardsKeeper,
+	}
+}
+
+// HandleRewardsQuery is a custom querier for the rewards module
+func (qp *QueryPlugin) HandleRewardsQuery(ctx sdk.Context, rewardsQuery rewardsbindingtypes.Query) ([]byte, error) {
+	// Match the query under the module
+	switch {
+	// The query is a reward pool query
+	case rewardsQuery.RewardPool != nil:
+		// Apply the request
+		rewardPool, err := qp.HandleRewardPool(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// Marshal the response
+		return json.Marshal(rewardPool)
+
+	// The query is a release schedule query
+	case rewardsQuery.ReleaseSchedule != nil:
+		// Apply the request
+		schedule, err := qp.HandleReleaseSchedule(ctx, *rewardsQuery.ReleaseSchedule)
+		if err != nil {
+			return nil, err
+		}
+
+		// Marshal the response
+		return json.Marshal(schedule)
+
+	default:
+		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown rewards query variant"}
+	}
+}
+
+// HandleRewardPool handles the reward pool query
+func (qp *QueryPlugin) HandleRewardPool(ctx sdk.Context) (*rewardsbindingtypes.RewardPoolResponse, error) {
+	// Get the pool balances from the keeper
+	balances, err := qp.rewardsKeeper.GetPoolBalances(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Build the response
+	res := &rewardsbindingtypes.RewardPoolResponse{
+		Balances: make([]rewardsbindingtypes.PoolBalance, 0, len(balances)),
+	}
+	for _, balance := range balances {
+		res.Balances = append(res.Balances, rewardsbindingtypes.PoolBalance{
+			Denom:           balance.Denom,
+			PoolAmount:      balance.PoolAmount.String(),
+			CommittedAmount: balance.CommittedAmount.String(),
+			FreeAmount:      balance.FreeAmount.String(),
+		})
+	}
+
+	// Return the response
+	return res, nil
+}
+
+// HandleReleaseSchedule handles the release schedule query
+func (qp *QueryPlugin) HandleReleaseSchedule(
+	ctx sdk.Context,
+	query rewardsbindingtypes.ReleaseScheduleRequest,
+) (*rewardsbindingtypes.ReleaseScheduleResponse, error) {
+	// Get the schedule from the keeper
+	schedule, err := qp.rewardsKeeper.GetReleaseSchedule(ctx, query.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The released amount is unset until the first release
+	releasedAmount := "0"
+	if !schedule.ReleasedAmount.Amount.IsNil() {
+		releasedAmount = schedule.ReleasedAmount.Amount.String()
+	}
+
+	// Return the response
+	return &rewardsbindingtypes.ReleaseScheduleResponse{
+		ID:              schedule.Id,
+		Denom:           schedule.TotalAmount.Denom,
+		TotalAmount:     schedule.TotalAmount.Amount.String(),
+		ReleasedAmount:  releasedAmount,
+		StartTime:       unixOrZero(schedule.StartTime),
+		EndTime:         unixOrZero(schedule.EndTime),
+		LastReleaseTime: unixOrZero(schedule.LastReleaseTime),
+		Active:          schedule.Active,
+	}, nil
+}
+
+// unixOrZero returns the unix timestamp of a time, or zero if the time is unset
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
@@ -0,0 +1,100 @@
+package rewards_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v4/app/apptesting"
+	"github.com/kiichain/kiichain/v4/wasmbinding/helpers"
+	"github.com/kiichain/kiichain/v4/wasmbinding/rewards"
+	rewardsbindingtypes "github.com/kiichain/kiichain/v4/wasmbinding/rewards/types"
+	"github.com/kiichain/kiichain/v4/x/rewards/types"
+)
+
+// TestHandleRewardsQuery tests the HandleRewardsQuery function of the rewards module
+func TestHandleRewardsQuery(t *testing.T) {
+	// Setup the app
+	actor := apptesting.RandomAccountAddress()
+	app, ctx := helpers.SetupCustomApp(t, actor)
+
+	// Fund the pool and store a schedule committing part of it
+	err := app.RewardsKeeper.RewardPool.Set(ctx, types.RewardPool{
+		CommunityPool: sdk.NewDecCoins(sdk.NewDecCoin("akii", math.NewInt(1500))),
+	})
+	require.NoError(t, err)
+	startTime := time.Unix(1_700_000_000, 0).UTC()
+	endTime := startTime.Add(time.Hour)
+	err = app.RewardsKeeper.ReleaseSchedules.Set(ctx, 1, types.ReleaseSchedule{
+		Id:             1,
+		TotalAmount:    sdk.NewCoin("akii", math.NewInt(1000)),
+		ReleasedAmount: sdk.NewCoin("akii", math.NewInt(100)),
+		StartTime:      startTime,
+		EndTime:        endTime,
+		Active:         true,
+	})
+	require.NoError(t, err)
+
+	// Set all the test cases
+	testCases := []struct {
+		name        string
+		query       rewardsbindingtypes.Query
+		expected    []byte
+		errContains string
+	}{
+		{
+			name: "valid - reward pool",
+			query: rewardsbindingtypes.Query{
+				RewardPool: &rewardsbindingtypes.RewardPoolRequest{},
+			},
+			expected: []byte(`{"balances":[{"denom":"akii","pool_amount":"1500.000000000000000000","committed_amount":"900","free_amount":"600.000000000000000000"}]}`),
+		},
+		{
+			name: "valid - release schedule",
+			query: rewardsbindingtypes.Query{
+				ReleaseSchedule: &rewardsbindingtypes.ReleaseScheduleRequest{ID: 1},
+			},
+			expected: []byte(fmt.Sprintf(
+				`{"id":1,"denom":"akii","total_amount":"1000","released_amount":"100","start_time":%d,"end_time":%d,"last_release_time":0,"active":true}`,
+				startTime.Unix(), endTime.Unix(),
+			)),
+		},
+		{
+			name: "invalid - release schedule not found",
+			query: rewardsbindingtypes.Query{
+				ReleaseSchedule: &rewardsbindingtypes.ReleaseScheduleRequest{ID: 2},
+			},
+			errContains: "not found",
+		},
+		{
+			name:        "invalid - unknown query variant",
+			query:       rewardsbindingtypes.Query{},
+			errContains: "unknown rewards query variant",
+		},
+	}
+
+	// Iterate over the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Start the query plugin
+			queryPlugin := rewards.NewQueryPlugin(&app.RewardsKeeper)
+
+			// Handle the query
+			bz, err := queryPlugin.HandleRewardsQuery(ctx, tc.query)
+
+			// Check for errors
+			if tc.errContains != "" {
+				require.ErrorContains(t, err, tc.errContains)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, string(tc.expected), string(bz))
+			}
+		})
+	}
+}
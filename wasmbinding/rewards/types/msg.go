@@ -0,0 +1,16 @@
+package types
+
+import "cosmossdk.io/math"
+
+// Msg is the msg type for the rewards module on wasmbindings
+type Msg struct {
+	/// Contracts can fund the reward pool with their own balance
+	FundPool *FundPool `json:"fund_pool,omitempty"`
+}
+
+// FundPool sends funds from the contract to the reward pool
+// The denom must be allowed by the rewards module params
+type FundPool struct {
+	Denom  string   `json:"denom"`
+	Amount math.Int `json:"amount"`
+}
@@ -0,0 +1,42 @@
+package types
+
+// Query is the query type for the rewards module on wasmbindings
+type Query struct {
+	RewardPool      *RewardPoolRequest      `json:"reward_pool,omitempty"`
+	ReleaseSchedule *ReleaseScheduleRequest `json:"release_schedule,omitempty"`
+}
+
+// RewardPoolRequest is the query type for the RewardPool query
+type RewardPoolRequest struct{}
+
+// PoolBalance is the balance of a single denom on the RewardPool response
+type PoolBalance struct {
+	Denom           string `json:"denom"`
+	PoolAmount      string `json:"pool_amount"`
+	CommittedAmount string `json:"committed_amount"`
+	FreeAmount      string `json:"free_amount"`
+}
+
+// RewardPoolResponse is the response type for the RewardPool query
+type RewardPoolResponse struct {
+	Balances []PoolBalance `json:"balances"`
+}
+
+// ReleaseScheduleRequest is the query type for the ReleaseSchedule query
+type ReleaseScheduleRequest struct {
+	// ID is the id of the release schedule
+	ID uint64 `json:"id"`
+}
+
+// ReleaseScheduleResponse is the response type for the ReleaseSchedule query
+// The times are unix timestamps in seconds, zero if unset
+type ReleaseScheduleResponse struct {
+	ID              uint64 `json:"id"`
+	Denom           string `json:"denom"`
+	TotalAmount     string `json:"total_amount"`
+	ReleasedAmount  string `json:"released_amount"`
+	StartTime       int64  `json:"start_time"`
+	EndTime         int64  `json:"end_time"`
+	LastReleaseTime int64  `json:"last_release_time"`
+	Active          bool   `json:"active"`
+}
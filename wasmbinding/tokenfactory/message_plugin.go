@@ -38,6 +38,14 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		return m.SetMetadata(ctx, contractAddr, msg.SetMetadata)
 	case msg.ForceTransfer != nil:
 		return m.ForceTransfer(ctx, contractAddr, msg.ForceTransfer)
+	case msg.SetBeforeSendHook != nil:
+		return m.SetBeforeSendHook(ctx, contractAddr, msg.SetBeforeSendHook)
+	case msg.SetMaxSupply != nil:
+		return m.SetMaxSupply(ctx, contractAddr, msg.SetMaxSupply)
+	case msg.SetDenomPaused != nil:
+		return m.SetDenomPaused(ctx, contractAddr, msg.SetDenomPaused)
+	case msg.SetAccountFrozen != nil:
+		return m.SetAccountFrozen(ctx, contractAddr, msg.SetAccountFrozen)
 	default:
 		return nil, nil, utils.EmptyMsgResp, wasmvmtypes.UnsupportedRequest{Kind: "unknown token factory msg variant"}
 	}
@@ -69,6 +77,8 @@ func PerformCreateDenom(f *tokenfactorykeeper.Keeper, b bankkeeper.Keeper, ctx s
 	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
 
 	msgCreateDenom := tokenfactorytypes.NewMsgCreateDenom(contractAddr.String(), createDenom.Subdenom)
+	msgCreateDenom.MaxSupply = createDenom.MaxSupply
+	msgCreateDenom.RegisterErc20 = createDenom.RegisterErc20
 
 	if err := msgCreateDenom.ValidateBasic(); err != nil {
 		return nil, errorsmod.Wrap(err, "failed validating MsgCreateDenom")
@@ -113,8 +123,13 @@ func PerformMint(f *tokenfactorykeeper.Keeper, b bankkeeper.Keeper, ctx sdk.Cont
 		return err
 	}
 
+	if b.BlockedAddr(rcpt) {
+		return wasmvmtypes.InvalidRequest{Err: "minting coins to blocked address " + rcpt.String()}
+	}
+
+	// The coins are minted straight to the recipient, so the mint is not blocked by a paused denom
 	coin := sdk.Coin{Denom: mint.Denom, Amount: mint.Amount}
-	sdkMsg := tokenfactorytypes.NewMsgMint(contractAddr.String(), coin)
+	sdkMsg := tokenfactorytypes.NewMsgMintTo(contractAddr.String(), coin, rcpt.String())
 
 	if err = sdkMsg.ValidateBasic(); err != nil {
 		return err
@@ -126,15 +141,6 @@ func PerformMint(f *tokenfactorykeeper.Keeper, b bankkeeper.Keeper, ctx sdk.Cont
 	if err != nil {
 		return errorsmod.Wrap(err, "minting coins from message")
 	}
-
-	if b.BlockedAddr(rcpt) {
-		return errorsmod.Wrapf(err, "minting coins to blocked address %s", rcpt.String())
-	}
-
-	err = b.SendCoins(ctx, contractAddr, rcpt, sdk.NewCoins(coin))
-	if err != nil {
-		return errorsmod.Wrap(err, "sending newly minted coins from message")
-	}
 	return nil
 }
 
@@ -257,13 +263,13 @@ func (m *CustomMessenger) SetMetadata(ctx sdk.Context, contractAddr sdk.AccAddre
 // PerformSetMetadata is used with setMetadata to add new metadata
 // It also is called inside CreateDenom if optional metadata field is set
 func PerformSetMetadata(f *tokenfactorykeeper.Keeper, b bankkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, denom string, metadata tfbindingtypes.Metadata) error {
-	// ensure contract address is admin of denom
+	// ensure contract address is admin of denom or has the metadata role
 	auth, err := f.GetAuthorityMetadata(ctx, denom)
 	if err != nil {
 		return err
 	}
-	if auth.Admin != contractAddr.String() {
-		return wasmvmtypes.InvalidRequest{Err: "only admin can set metadata"}
+	if !auth.HasRole(tokenfactorytypes.RoleMetadata, contractAddr.String()) {
+		return wasmvmtypes.InvalidRequest{Err: "only admin or metadata role can set metadata"}
 	}
 
 	// ensure we are setting proper denom metadata (bank uses Base field, fill it if missing)
@@ -284,6 +290,118 @@ func PerformSetMetadata(f *tokenfactorykeeper.Keeper, b bankkeeper.Keeper, ctx s
 	return nil
 }
 
+// SetBeforeSendHook sets the before send hook of a denom.
+func (m *CustomMessenger) SetBeforeSendHook(ctx sdk.Context, contractAddr sdk.AccAddress, setBeforeSendHook *tfbindingtypes.SetBeforeSendHook) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	err := PerformSetBeforeSendHook(m.tokenFactory, ctx, contractAddr, setBeforeSendHook)
+	if err != nil {
+		return nil, nil, utils.EmptyMsgResp, errorsmod.Wrap(err, "perform set before send hook")
+	}
+	return nil, nil, utils.EmptyMsgResp, nil
+}
+
+// PerformSetBeforeSendHook is used with setBeforeSendHook to validate the message and set the hook through token factory.
+func PerformSetBeforeSendHook(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, setBeforeSendHook *tfbindingtypes.SetBeforeSendHook) error {
+	if setBeforeSendHook == nil {
+		return wasmvmtypes.InvalidRequest{Err: "set before send hook null set before send hook"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgSetBeforeSendHook(contractAddr.String(), setBeforeSendHook.Denom, setBeforeSendHook.ContractAddr)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.SetBeforeSendHook(ctx, sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "setting before send hook from message")
+	}
+	return nil
+}
+
+// SetMaxSupply sets the max supply of a denom.
+func (m *CustomMessenger) SetMaxSupply(ctx sdk.Context, contractAddr sdk.AccAddress, setMaxSupply *tfbindingtypes.SetMaxSupply) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	err := PerformSetMaxSupply(m.tokenFactory, ctx, contractAddr, setMaxSupply)
+	if err != nil {
+		return nil, nil, utils.EmptyMsgResp, errorsmod.Wrap(err, "perform set max supply")
+	}
+	return nil, nil, utils.EmptyMsgResp, nil
+}
+
+// PerformSetMaxSupply is used with setMaxSupply to validate the message and set the max supply through token factory.
+func PerformSetMaxSupply(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, setMaxSupply *tfbindingtypes.SetMaxSupply) error {
+	if setMaxSupply == nil {
+		return wasmvmtypes.InvalidRequest{Err: "set max supply null set max supply"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgSetMaxSupply(contractAddr.String(), setMaxSupply.Denom, setMaxSupply.MaxSupply)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.SetMaxSupply(ctx, sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "setting max supply from message")
+	}
+	return nil
+}
+
+// SetDenomPaused pauses or resumes the transfers of a denom.
+func (m *CustomMessenger) SetDenomPaused(ctx sdk.Context, contractAddr sdk.AccAddress, setDenomPaused *tfbindingtypes.SetDenomPaused) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	err := PerformSetDenomPaused(m.tokenFactory, ctx, contractAddr, setDenomPaused)
+	if err != nil {
+		return nil, nil, utils.EmptyMsgResp, errorsmod.Wrap(err, "perform set denom paused")
+	}
+	return nil, nil, utils.EmptyMsgResp, nil
+}
+
+// PerformSetDenomPaused is used with setDenomPaused to validate the message and pause the denom through token factory.
+func PerformSetDenomPaused(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, setDenomPaused *tfbindingtypes.SetDenomPaused) error {
+	if setDenomPaused == nil {
+		return wasmvmtypes.InvalidRequest{Err: "set denom paused null set denom paused"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgSetDenomPaused(contractAddr.String(), setDenomPaused.Denom, setDenomPaused.Paused)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.SetDenomPaused(ctx, sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "setting denom paused from message")
+	}
+	return nil
+}
+
+// SetAccountFrozen freezes or unfreezes an address for a denom.
+func (m *CustomMessenger) SetAccountFrozen(ctx sdk.Context, contractAddr sdk.AccAddress, setAccountFrozen *tfbindingtypes.SetAccountFrozen) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
+	err := PerformSetAccountFrozen(m.tokenFactory, ctx, contractAddr, setAccountFrozen)
+	if err != nil {
+		return nil, nil, utils.EmptyMsgResp, errorsmod.Wrap(err, "perform set account frozen")
+	}
+	return nil, nil, utils.EmptyMsgResp, nil
+}
+
+// PerformSetAccountFrozen is used with setAccountFrozen to validate the message and freeze the address through token factory.
+func PerformSetAccountFrozen(f *tokenfactorykeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, setAccountFrozen *tfbindingtypes.SetAccountFrozen) error {
+	if setAccountFrozen == nil {
+		return wasmvmtypes.InvalidRequest{Err: "set account frozen null set account frozen"}
+	}
+
+	sdkMsg := tokenfactorytypes.NewMsgSetAccountFrozen(contractAddr.String(), setAccountFrozen.Denom, setAccountFrozen.Address, setAccountFrozen.Frozen)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := tokenfactorykeeper.NewMsgServerImpl(*f)
+	_, err := msgServer.SetAccountFrozen(ctx, sdkMsg)
+	if err != nil {
+		return errorsmod.Wrap(err, "setting account frozen from message")
+	}
+	return nil
+}
+
 // GetFullDenom is a function, not method, so the message_plugin can use it
 func GetFullDenom(contract string, subDenom string) (string, error) {
 	// Address validation
@@ -14,6 +14,7 @@ import (
 	"github.com/kiichain/kiichain/v4/wasmbinding/helpers"
 	wasmbinding "github.com/kiichain/kiichain/v4/wasmbinding/tokenfactory"
 	bindingtypes "github.com/kiichain/kiichain/v4/wasmbinding/tokenfactory/types"
+	tfkeeper "github.com/kiichain/kiichain/v4/x/tokenfactory/keeper"
 	"github.com/kiichain/kiichain/v4/x/tokenfactory/types"
 )
 
@@ -419,3 +420,322 @@ func TestBurn(t *testing.T) {
 		})
 	}
 }
+
+// TestSetBeforeSendHook tests the SetBeforeSendHook function with a misbehaving hook contract
+func TestSetBeforeSendHook(t *testing.T) {
+	actor := apptesting.RandomAccountAddress()
+	app, ctx := helpers.SetupCustomApp(t, actor)
+
+	// Fund actor with 100 base denom creation fees
+	actorAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	helpers.FundAccount(t, ctx, app, actor, actorAmount)
+
+	// The reflect contract has no sudo entry point, so it fails every hook call
+	hook := helpers.InstantiateReflectContract(t, ctx, app, actor)
+	require.NotEmpty(t, hook)
+
+	// Create a denom and mint some tokens
+	_, err := wasmbinding.PerformCreateDenom(&app.TokenFactoryKeeper, app.BankKeeper, ctx, actor, &bindingtypes.CreateDenom{
+		Subdenom: "SUN",
+	})
+	require.NoError(t, err)
+	sunDenom := fmt.Sprintf("factory/%s/SUN", actor.String())
+	err = wasmbinding.PerformMint(&app.TokenFactoryKeeper, app.BankKeeper, ctx, actor, &bindingtypes.MintTokens{
+		Denom:         sunDenom,
+		Amount:        sdkmath.NewInt(100),
+		MintToAddress: actor.String(),
+	})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		actor             sdk.AccAddress
+		setBeforeSendHook *bindingtypes.SetBeforeSendHook
+		expErrMsg         string
+	}{
+		"not a contract": {
+			actor: actor,
+			setBeforeSendHook: &bindingtypes.SetBeforeSendHook{
+				Denom:        sunDenom,
+				ContractAddr: actor.String(),
+			},
+			expErrMsg: fmt.Sprintf("setting before send hook from message: %s is not a contract: invalid before send hook", actor.String()),
+		},
+		"not the admin": {
+			actor: apptesting.RandomAccountAddress(),
+			setBeforeSendHook: &bindingtypes.SetBeforeSendHook{
+				Denom:        sunDenom,
+				ContractAddr: hook.String(),
+			},
+			expErrMsg: "setting before send hook from message: unauthorized account",
+		},
+		"nil binding": {
+			actor:     actor,
+			expErrMsg: "invalid request: set before send hook null set before send hook - original request: ",
+		},
+		"valid": {
+			actor: actor,
+			setBeforeSendHook: &bindingtypes.SetBeforeSendHook{
+				Denom:        sunDenom,
+				ContractAddr: hook.String(),
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := wasmbinding.PerformSetBeforeSendHook(&app.TokenFactoryKeeper, ctx, spec.actor, spec.setBeforeSendHook)
+			if len(spec.expErrMsg) > 0 {
+				require.Error(t, err)
+				require.Equal(t, spec.expErrMsg, err.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// The hook is returned by the query plugin
+	queryPlugin := wasmbinding.NewQueryPlugin(app.BankKeeper, &app.TokenFactoryKeeper)
+	resp := queryPlugin.GetTokenfactoryBeforeSendHook(ctx, sunDenom)
+	require.Equal(t, hook.String(), resp.ContractAddr)
+
+	// The misbehaving hook blocks the user transfers of the denom
+	// The send runs on a cached context, as a failed transaction would be reverted
+	receiver := apptesting.RandomAccountAddress()
+	cacheCtx, _ := ctx.CacheContext()
+	err = app.BankKeeper.SendCoins(cacheCtx, actor, receiver, sdk.NewCoins(sdk.NewInt64Coin(sunDenom, 10)))
+	require.Error(t, err)
+
+	// The transfers of other denoms are not affected
+	err = app.BankKeeper.SendCoins(ctx, actor, receiver, sdk.NewCoins(types.DefaultParams().DenomCreationFee[0]))
+	require.NoError(t, err)
+
+	// Module transfers are not blocked, so the admin can still mint
+	msgServer := tfkeeper.NewMsgServerImpl(app.TokenFactoryKeeper)
+	_, err = msgServer.Mint(ctx, types.NewMsgMint(actor.String(), sdk.NewInt64Coin(sunDenom, 10)))
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(110), app.BankKeeper.GetBalance(ctx, actor, sunDenom).Amount)
+
+	// Removing the hook allows the transfers again
+	err = wasmbinding.PerformSetBeforeSendHook(&app.TokenFactoryKeeper, ctx, actor, &bindingtypes.SetBeforeSendHook{
+		Denom: sunDenom,
+	})
+	require.NoError(t, err)
+	err = app.BankKeeper.SendCoins(ctx, actor, receiver, sdk.NewCoins(sdk.NewInt64Coin(sunDenom, 10)))
+	require.NoError(t, err)
+}
+
+// TestSetMaxSupply tests the max supply set on CreateDenom and with the SetMaxSupply function
+func TestSetMaxSupply(t *testing.T) {
+	actor := apptesting.RandomAccountAddress()
+	app, ctx := helpers.SetupCustomApp(t, actor)
+
+	// Fund actor with 100 base denom creation fees
+	actorAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	helpers.FundAccount(t, ctx, app, actor, actorAmount)
+
+	// Create a denom with a max supply
+	maxSupply := sdkmath.NewInt(100)
+	_, err := wasmbinding.PerformCreateDenom(&app.TokenFactoryKeeper, app.BankKeeper, ctx, actor, &bindingtypes.CreateDenom{
+		Subdenom:  "SUN",
+		MaxSupply: &maxSupply,
+	})
+	require.NoError(t, err)
+	sunDenom := fmt.Sprintf("factory/%s/SUN", actor.String())
+
+	// The max supply is returned by the metadata query
+	queryPlugin := wasmbinding.NewQueryPlugin(app.BankKeeper, &app.TokenFactoryKeeper)
+	resp, err := queryPlugin.GetTokenfactoryMetadata(ctx, sunDenom)
+	require.NoError(t, err)
+	require.NotNil(t, resp.MaxSupply)
+	require.Equal(t, "100", resp.MaxSupply.String())
+
+	// Native denoms have no max supply
+	resp, err = queryPlugin.GetTokenfactoryMetadata(ctx, types.DefaultParams().DenomCreationFee[0].Denom)
+	require.NoError(t, err)
+	require.Nil(t, resp.MaxSupply)
+
+	// Mints can't exceed the max supply
+	mint := &bindingtypes.MintTokens{
+		Denom:         sunDenom,
+		Amount:        sdkmath.NewInt(101),
+		MintToAddress: actor.String(),
+	}
+	err = wasmbinding.PerformMint(&app.TokenFactoryKeeper, app.BankKeeper, ctx, actor, mint)
+	require.ErrorContains(t, err, "max supply exceeded")
+	mint.Amount = sdkmath.NewInt(50)
+	err = wasmbinding.PerformMint(&app.TokenFactoryKeeper, app.BankKeeper, ctx, actor, mint)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		actor        sdk.AccAddress
+		setMaxSupply *bindingtypes.SetMaxSupply
+		expErrMsg    string
+	}{
+		"raise the max supply": {
+			actor: actor,
+			setMaxSupply: &bindingtypes.SetMaxSupply{
+				Denom:     sunDenom,
+				MaxSupply: sdkmath.NewInt(200),
+			},
+			expErrMsg: "setting max supply from message: max supply can only be lowered",
+		},
+		"below the supply": {
+			actor: actor,
+			setMaxSupply: &bindingtypes.SetMaxSupply{
+				Denom:     sunDenom,
+				MaxSupply: sdkmath.NewInt(49),
+			},
+			expErrMsg: "setting max supply from message: max supply can't be lower than the current supply 50",
+		},
+		"not the admin": {
+			actor: apptesting.RandomAccountAddress(),
+			setMaxSupply: &bindingtypes.SetMaxSupply{
+				Denom:     sunDenom,
+				MaxSupply: sdkmath.NewInt(80),
+			},
+			expErrMsg: "setting max supply from message: unauthorized account",
+		},
+		"nil binding": {
+			actor:     actor,
+			expErrMsg: "invalid request: set max supply null set max supply - original request: ",
+		},
+		"valid": {
+			actor: actor,
+			setMaxSupply: &bindingtypes.SetMaxSupply{
+				Denom:     sunDenom,
+				MaxSupply: sdkmath.NewInt(80),
+			},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// The specs run in any order, so the current max supply is not part of the expected errors
+			err := wasmbinding.PerformSetMaxSupply(&app.TokenFactoryKeeper, ctx, spec.actor, spec.setMaxSupply)
+			if len(spec.expErrMsg) > 0 {
+				require.ErrorContains(t, err, spec.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// The lowered max supply is enforced
+	resp, err = queryPlugin.GetTokenfactoryMetadata(ctx, sunDenom)
+	require.NoError(t, err)
+	require.Equal(t, "80", resp.MaxSupply.String())
+	mint.Amount = sdkmath.NewInt(31)
+	err = wasmbinding.PerformMint(&app.TokenFactoryKeeper, app.BankKeeper, ctx, actor, mint)
+	require.ErrorContains(t, err, "max supply exceeded")
+}
+
+// TestSetMetadataRole tests that the metadata role can set the metadata of a denom
+func TestSetMetadataRole(t *testing.T) {
+	actor := apptesting.RandomAccountAddress()
+	app, ctx := helpers.SetupCustomApp(t, actor)
+
+	// Fund actor with 100 base denom creation fees
+	actorAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	helpers.FundAccount(t, ctx, app, actor, actorAmount)
+
+	_, err := wasmbinding.PerformCreateDenom(&app.TokenFactoryKeeper, app.BankKeeper, ctx, actor, &bindingtypes.CreateDenom{
+		Subdenom: "SUN",
+	})
+	require.NoError(t, err)
+	sunDenom := fmt.Sprintf("factory/%s/SUN", actor.String())
+
+	metadata := bindingtypes.Metadata{
+		Description: "Set by the metadata role",
+		DenomUnits:  []bindingtypes.DenomUnit{{Denom: sunDenom, Exponent: 0}},
+		Display:     sunDenom,
+		Name:        "Sun",
+		Symbol:      "SUN",
+	}
+
+	// The operator can't set the metadata without the role
+	operator := apptesting.RandomAccountAddress()
+	err = wasmbinding.PerformSetMetadata(&app.TokenFactoryKeeper, app.BankKeeper, ctx, operator, sunDenom, metadata)
+	require.ErrorContains(t, err, "only admin or metadata role can set metadata")
+
+	// Grant the metadata role to the operator
+	msgServer := tfkeeper.NewMsgServerImpl(app.TokenFactoryKeeper)
+	_, err = msgServer.GrantRole(ctx, types.NewMsgGrantRole(actor.String(), sunDenom, types.RoleMetadata, operator.String(), nil))
+	require.NoError(t, err)
+
+	err = wasmbinding.PerformSetMetadata(&app.TokenFactoryKeeper, app.BankKeeper, ctx, operator, sunDenom, metadata)
+	require.NoError(t, err)
+
+	bankMetadata, found := app.BankKeeper.GetDenomMetaData(ctx, sunDenom)
+	require.True(t, found)
+	require.Equal(t, "Set by the metadata role", bankMetadata.Description)
+}
+
+func TestSetDenomPausedAndAccountFrozen(t *testing.T) {
+	actor := apptesting.RandomAccountAddress()
+	app, ctx := helpers.SetupCustomApp(t, actor)
+
+	// Fund actor with 100 base denom creation fees
+	actorAmount := sdk.NewCoins(sdk.NewCoin(types.DefaultParams().DenomCreationFee[0].Denom, types.DefaultParams().DenomCreationFee[0].Amount.MulRaw(100)))
+	helpers.FundAccount(t, ctx, app, actor, actorAmount)
+
+	// Create a denom and mint to a holder
+	_, err := wasmbinding.PerformCreateDenom(&app.TokenFactoryKeeper, app.BankKeeper, ctx, actor, &bindingtypes.CreateDenom{
+		Subdenom: "SUN",
+	})
+	require.NoError(t, err)
+	sunDenom := fmt.Sprintf("factory/%s/SUN", actor.String())
+	holder := apptesting.RandomAccountAddress()
+	err = wasmbinding.PerformMint(&app.TokenFactoryKeeper, app.BankKeeper, ctx, actor, &bindingtypes.MintTokens{
+		Denom:         sunDenom,
+		Amount:        sdkmath.NewInt(100),
+		MintToAddress: holder.String(),
+	})
+	require.NoError(t, err)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sunDenom, 10))
+
+	// Only the admin can pause the denom or freeze an address
+	err = wasmbinding.PerformSetDenomPaused(&app.TokenFactoryKeeper, ctx, holder, &bindingtypes.SetDenomPaused{Denom: sunDenom, Paused: true})
+	require.ErrorContains(t, err, "setting denom paused from message: unauthorized account")
+	err = wasmbinding.PerformSetAccountFrozen(&app.TokenFactoryKeeper, ctx, holder, &bindingtypes.SetAccountFrozen{Denom: sunDenom, Address: holder.String(), Frozen: true})
+	require.ErrorContains(t, err, "setting account frozen from message: unauthorized account")
+	err = wasmbinding.PerformSetDenomPaused(&app.TokenFactoryKeeper, ctx, actor, nil)
+	require.ErrorContains(t, err, "set denom paused null set denom paused")
+	err = wasmbinding.PerformSetAccountFrozen(&app.TokenFactoryKeeper, ctx, actor, nil)
+	require.ErrorContains(t, err, "set account frozen null set account frozen")
+
+	// Freeze the holder
+	queryPlugin := wasmbinding.NewQueryPlugin(app.BankKeeper, &app.TokenFactoryKeeper)
+	err = wasmbinding.PerformSetAccountFrozen(&app.TokenFactoryKeeper, ctx, actor, &bindingtypes.SetAccountFrozen{Denom: sunDenom, Address: holder.String(), Frozen: true})
+	require.NoError(t, err)
+	frozenResp, err := queryPlugin.GetTokenfactoryAccountFrozen(ctx, sunDenom, holder.String())
+	require.NoError(t, err)
+	require.True(t, frozenResp.Frozen)
+
+	cacheCtx, _ := ctx.CacheContext()
+	err = app.BankKeeper.SendCoins(cacheCtx, holder, actor, coins)
+	require.ErrorIs(t, err, types.ErrAccountFrozen)
+
+	// Unfreeze the holder and pause the denom
+	err = wasmbinding.PerformSetAccountFrozen(&app.TokenFactoryKeeper, ctx, actor, &bindingtypes.SetAccountFrozen{Denom: sunDenom, Address: holder.String(), Frozen: false})
+	require.NoError(t, err)
+	err = wasmbinding.PerformSetDenomPaused(&app.TokenFactoryKeeper, ctx, actor, &bindingtypes.SetDenomPaused{Denom: sunDenom, Paused: true})
+	require.NoError(t, err)
+	require.True(t, queryPlugin.GetTokenfactoryDenomPaused(ctx, sunDenom).Paused)
+
+	cacheCtx, _ = ctx.CacheContext()
+	err = app.BankKeeper.SendCoins(cacheCtx, holder, actor, coins)
+	require.ErrorIs(t, err, types.ErrDenomPaused)
+
+	// The contract can still mint while the denom is paused
+	err = wasmbinding.PerformMint(&app.TokenFactoryKeeper, app.BankKeeper, ctx, actor, &bindingtypes.MintTokens{
+		Denom:         sunDenom,
+		Amount:        sdkmath.NewInt(10),
+		MintToAddress: holder.String(),
+	})
+	require.NoError(t, err)
+
+	// Resume the transfers
+	err = wasmbinding.PerformSetDenomPaused(&app.TokenFactoryKeeper, ctx, actor, &bindingtypes.SetDenomPaused{Denom: sunDenom, Paused: false})
+	require.NoError(t, err)
+	require.False(t, queryPlugin.GetTokenfactoryDenomPaused(ctx, sunDenom).Paused)
+	err = app.BankKeeper.SendCoins(ctx, holder, actor, coins)
+	require.NoError(t, err)
+}
@@ -8,6 +8,7 @@ import (
 	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
@@ -15,6 +16,7 @@ import (
 	tfbindingtypes "github.com/kiichain/kiichain/v4/wasmbinding/tokenfactory/types"
 	"github.com/kiichain/kiichain/v4/wasmbinding/utils"
 	tokenfactorykeeper "github.com/kiichain/kiichain/v4/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/kiichain/kiichain/v4/x/tokenfactory/types"
 )
 
 // QueryPlugin is a custom query plugin for the wasm module for the token factory
@@ -112,6 +114,42 @@ func (qp *QueryPlugin) HandleTokenFactoryQuery(ctx sdk.Context, tokenfactoryQuer
 
 		return bz, nil
 
+		// The query is a before send hook query
+	case tokenfactoryQuery.BeforeSendHook != nil:
+		res := qp.GetTokenfactoryBeforeSendHook(ctx, tokenfactoryQuery.BeforeSendHook.Denom)
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to JSON marshal BeforeSendHookResponse: %w", err)
+		}
+
+		return bz, nil
+
+		// The query is a denom paused query
+	case tokenfactoryQuery.DenomPaused != nil:
+		res := qp.GetTokenfactoryDenomPaused(ctx, tokenfactoryQuery.DenomPaused.Denom)
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to JSON marshal DenomPausedResponse: %w", err)
+		}
+
+		return bz, nil
+
+		// The query is an account frozen query
+	case tokenfactoryQuery.AccountFrozen != nil:
+		res, err := qp.GetTokenfactoryAccountFrozen(ctx, tokenfactoryQuery.AccountFrozen.Denom, tokenfactoryQuery.AccountFrozen.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to JSON marshal AccountFrozenResponse: %w", err)
+		}
+
+		return bz, nil
+
 	default:
 		return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown token factory query variant"}
 	}
@@ -140,7 +178,17 @@ func (qp QueryPlugin) GetTokenfactoryMetadata(ctx context.Context, denom string)
 	if found {
 		parsed = SdkMetadataToWasm(metadata)
 	}
-	return &tfbindingtypes.MetadataResponse{Metadata: parsed}, nil
+
+	// Only the token factory denoms have a max supply
+	var maxSupply *math.Int
+	if _, _, err := tokenfactorytypes.DeconstructDenom(denom); err == nil {
+		authorityMetadata, err := qp.tokenFactoryKeeper.GetAuthorityMetadata(ctx, denom)
+		if err != nil {
+			return nil, err
+		}
+		maxSupply = authorityMetadata.MaxSupply
+	}
+	return &tfbindingtypes.MetadataResponse{Metadata: parsed, MaxSupply: maxSupply}, nil
 }
 
 // GetTokenfactoryParams is a query to get token factory params
@@ -152,3 +200,23 @@ func (qp QueryPlugin) GetTokenfactoryParams(ctx context.Context) (*tfbindingtype
 		},
 	}, nil
 }
+
+// GetTokenfactoryBeforeSendHook is a query to get the before send hook of a denom
+func (qp QueryPlugin) GetTokenfactoryBeforeSendHook(ctx context.Context, denom string) *tfbindingtypes.BeforeSendHookResponse {
+	contractAddr := qp.tokenFactoryKeeper.GetBeforeSendHook(ctx, denom)
+	return &tfbindingtypes.BeforeSendHookResponse{ContractAddr: contractAddr}
+}
+
+// GetTokenfactoryDenomPaused is a query to check if the transfers of a denom are paused
+func (qp QueryPlugin) GetTokenfactoryDenomPaused(ctx context.Context, denom string) *tfbindingtypes.DenomPausedResponse {
+	return &tfbindingtypes.DenomPausedResponse{Paused: qp.tokenFactoryKeeper.IsDenomPaused(ctx, denom)}
+}
+
+// GetTokenfactoryAccountFrozen is a query to check if an address is frozen for a denom
+func (qp QueryPlugin) GetTokenfactoryAccountFrozen(ctx context.Context, denom, address string) (*tfbindingtypes.AccountFrozenResponse, error) {
+	addr, err := parseAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	return &tfbindingtypes.AccountFrozenResponse{Frozen: qp.tokenFactoryKeeper.IsAccountFrozen(ctx, denom, addr)}, nil
+}
@@ -19,6 +19,14 @@ type Msg struct {
 	SetMetadata *SetMetadata `json:"set_metadata,omitempty"`
 	/// Forces a transfer of tokens from one address to another.
 	ForceTransfer *ForceTransfer `json:"force_transfer,omitempty"`
+	/// Sets the contract called before the transfers of a denom which the contract controls.
+	SetBeforeSendHook *SetBeforeSendHook `json:"set_before_send_hook,omitempty"`
+	/// Lowers the max supply of a denom which the contract controls.
+	SetMaxSupply *SetMaxSupply `json:"set_max_supply,omitempty"`
+	/// Pauses or resumes the transfers of a denom which the contract controls.
+	SetDenomPaused *SetDenomPaused `json:"set_denom_paused,omitempty"`
+	/// Freezes or unfreezes an address for a denom which the contract controls.
+	SetAccountFrozen *SetAccountFrozen `json:"set_account_frozen,omitempty"`
 }
 
 // CreateDenom creates a new factory denom, of denomination:
@@ -27,9 +35,13 @@ type Msg struct {
 // The (creating contract address, subdenom) pair must be unique.
 // The created denom's admin is the creating contract address,
 // but this admin can be changed using the ChangeAdmin binding.
+// The optional MaxSupply caps the mints of the denom.
+// RegisterErc20 registers the denom as a native coin ERC20.
 type CreateDenom struct {
-	Subdenom string    `json:"subdenom"`
-	Metadata *Metadata `json:"metadata,omitempty"`
+	Subdenom      string    `json:"subdenom"`
+	Metadata      *Metadata `json:"metadata,omitempty"`
+	MaxSupply     *math.Int `json:"max_supply,omitempty"`
+	RegisterErc20 bool      `json:"register_erc20,omitempty"`
 }
 
 // ChangeAdmin changes the admin for a factory denom.
@@ -62,3 +74,31 @@ type ForceTransfer struct {
 	FromAddress string   `json:"from_address"`
 	ToAddress   string   `json:"to_address"`
 }
+
+// SetBeforeSendHook sets the before send hook of a factory denom.
+// If the ContractAddr is empty, the hook is removed.
+type SetBeforeSendHook struct {
+	Denom        string `json:"denom"`
+	ContractAddr string `json:"contract_addr"`
+}
+
+// SetMaxSupply sets the max supply of a factory denom.
+// Once set, the max supply can only be lowered.
+type SetMaxSupply struct {
+	Denom     string   `json:"denom"`
+	MaxSupply math.Int `json:"max_supply"`
+}
+
+// SetDenomPaused pauses or resumes every transfer of a factory denom.
+type SetDenomPaused struct {
+	Denom  string `json:"denom"`
+	Paused bool   `json:"paused"`
+}
+
+// SetAccountFrozen freezes or unfreezes the transfers of a factory denom
+// from and to an address.
+type SetAccountFrozen struct {
+	Denom   string `json:"denom"`
+	Address string `json:"address"`
+	Frozen  bool   `json:"frozen"`
+}
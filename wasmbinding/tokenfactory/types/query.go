@@ -1,5 +1,7 @@
 package types
 
+import "cosmossdk.io/math"
+
 // See https://github.com/CosmWasm/token-bindings/blob/main/packages/bindings/src/query.rs
 type Query struct {
 	/// Given a subdenom minted by a contract via `KiiMsg::MintTokens`,
@@ -9,6 +11,9 @@ type Query struct {
 	Metadata        *GetMetadata     `json:"metadata,omitempty"`
 	DenomsByCreator *DenomsByCreator `json:"denoms_by_creator,omitempty"`
 	Params          *GetParams       `json:"params,omitempty"`
+	BeforeSendHook  *BeforeSendHook  `json:"before_send_hook,omitempty"`
+	DenomPaused     *DenomPaused     `json:"denom_paused,omitempty"`
+	AccountFrozen   *AccountFrozen   `json:"account_frozen,omitempty"`
 }
 
 // query types
@@ -32,6 +37,19 @@ type DenomsByCreator struct {
 
 type GetParams struct{}
 
+type BeforeSendHook struct {
+	Denom string `json:"denom"`
+}
+
+type DenomPaused struct {
+	Denom string `json:"denom"`
+}
+
+type AccountFrozen struct {
+	Denom   string `json:"denom"`
+	Address string `json:"address"`
+}
+
 // responses
 
 type FullDenomResponse struct {
@@ -43,7 +61,8 @@ type AdminResponse struct {
 }
 
 type MetadataResponse struct {
-	Metadata *Metadata `json:"metadata,omitempty"`
+	Metadata  *Metadata `json:"metadata,omitempty"`
+	MaxSupply *math.Int `json:"max_supply,omitempty"`
 }
 
 type DenomsByCreatorResponse struct {
@@ -53,3 +72,15 @@ type DenomsByCreatorResponse struct {
 type ParamsResponse struct {
 	Params Params `json:"params"`
 }
+
+type BeforeSendHookResponse struct {
+	ContractAddr string `json:"contract_addr"`
+}
+
+type DenomPausedResponse struct {
+	Paused bool `json:"paused"`
+}
+
+type AccountFrozenResponse struct {
+	Frozen bool `json:"frozen"`
+}
@@ -9,9 +9,13 @@ import (
 
 	"github.com/kiichain/kiichain/v4/wasmbinding/bech32"
 	evmwasmbinding "github.com/kiichain/kiichain/v4/wasmbinding/evm"
+	"github.com/kiichain/kiichain/v4/wasmbinding/feeabstraction"
 	"github.com/kiichain/kiichain/v4/wasmbinding/oracle"
+	rewardsbinding "github.com/kiichain/kiichain/v4/wasmbinding/rewards"
 	tfbinding "github.com/kiichain/kiichain/v4/wasmbinding/tokenfactory"
+	feeabstractionkeeper "github.com/kiichain/kiichain/v4/x/feeabstraction/keeper"
 	oraclekeeper "github.com/kiichain/kiichain/v4/x/oracle/keeper"
+	rewardskeeper "github.com/kiichain/kiichain/v4/x/rewards/keeper"
 	tokenfactorykeeper "github.com/kiichain/kiichain/v4/x/tokenfactory/keeper"
 )
 
@@ -21,12 +25,16 @@ func RegisterCustomPlugins(
 	tokenFactory *tokenfactorykeeper.Keeper,
 	evmKeeper *evmkeeper.Keeper,
 	oracleKeeper oraclekeeper.Keeper,
+	feeAbstractionKeeper feeabstractionkeeper.Keeper,
+	rewardsKeeper *rewardskeeper.Keeper,
 ) []wasmkeeper.Option {
 	// Register custom query plugins
 	tokenFactoryQueryPlugin := tfbinding.NewQueryPlugin(bank, tokenFactory)
 	evmQueryPlugin := evmwasmbinding.NewQueryPlugin(evmKeeper)
 	bech32QueryPlugin := bech32.NewQueryPlugin()
 	oracleQueryPlugin := oracle.NewQueryPlugin(oracleKeeper)
+	feeAbstractionQueryPlugin := feeabstraction.NewQueryPlugin(feeAbstractionKeeper)
+	rewardsQueryPlugin := rewardsbinding.NewQueryPlugin(rewardsKeeper)
 
 	// Create the central query plugin
 	queryPlugin := NewQueryPlugin(
@@ -34,6 +42,8 @@ func RegisterCustomPlugins(
 		evmQueryPlugin,
 		bech32QueryPlugin,
 		oracleQueryPlugin,
+		feeAbstractionQueryPlugin,
+		rewardsQueryPlugin,
 	)
 
 	// Register custom message handler decorators
@@ -44,9 +54,12 @@ func RegisterCustomPlugins(
 	// Create the custom messenger to the token factory
 	tokenFactoryMessenger := tfbinding.NewCustomMessenger(bank, tokenFactory)
 
+	// Create the custom messenger to the rewards module
+	rewardsMessenger := rewardsbinding.NewCustomMessenger(rewardsKeeper)
+
 	// Initialize the decorator for the custom messenger
 	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
-		CustomMessageDecorator(bank, tokenFactoryMessenger),
+		CustomMessageDecorator(bank, tokenFactoryMessenger, rewardsMessenger),
 	)
 
 	// Register custom message handlers
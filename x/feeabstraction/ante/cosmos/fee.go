@@ -2,6 +2,8 @@
 // The original implementation can be found at: `x/auth/ante/fee.go`
 // These are the main changes to the original implementation:
 // - The fee abstraction module is used to convert the fees from the native coin to a available coin
+// - Fee grants are spent on the converted fees, the coins actually paid by the fee granter
+// - A registered paymaster set as the fee granter pays the fees from its balance
 package cosmos
 
 import (
@@ -103,21 +105,28 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 	feePayer := feeTx.FeePayer()
 	feeGranter := feeTx.FeeGranter()
 	deductFeesFrom := feePayer
+	useGrantedFees := false
 
 	// if feegranter set deduct fee from feegranter account.
 	// this works with only when feegrant enabled.
 	if feeGranter != nil {
 		feeGranterAddr := sdk.AccAddress(feeGranter)
 
+		// A registered paymaster as the fee granter opts the transaction into sponsored fees
+		// This is different from the original implementation
+		isPaymaster, err := dfd.feeAbstractionKeeper.HasPaymaster(ctx, feeGranterAddr)
+		if err != nil {
+			return err
+		}
+		if isPaymaster {
+			return dfd.chargePaymaster(ctx, sdkTx, feeGranterAddr, feePayer, fee)
+		}
+
 		// If feegranter is set, we need to check if the feegrant module is enabled
 		if dfd.feegrantKeeper == nil {
 			return sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
-		} else if !bytes.Equal(feeGranterAddr, feePayer) {
-			err := dfd.feegrantKeeper.UseGrantedFees(ctx, feeGranterAddr, feePayer, fee, sdkTx.GetMsgs())
-			if err != nil {
-				return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
-			}
 		}
+		useGrantedFees = !bytes.Equal(feeGranterAddr, feePayer)
 
 		// If feegranter is set, we deduct the fees from the feegranter account
 		deductFeesFrom = feeGranterAddr
@@ -129,19 +138,28 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 		return sdkerrors.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
 	}
 
-	// Deduct the fees
-	var convertedFee sdk.Coins
+	// Apply the fee conversion from the fee abstraction module
+	convertedFee := fee
 	if !fee.IsZero() {
-		// Apply the fee conversion from the fee abstraction module
-		// This is the only change from the original implementation
 		var err error
 		convertedFee, err = dfd.feeAbstractionKeeper.ConvertNativeFee(ctx, deductFeesFromAcc.GetAddress(), fee)
 		if err != nil {
 			return err
 		}
+	}
+
+	// Spend the fee grant on the native fee, the allowances are denominated in the native denom
+	// whatever coins the fee was converted to
+	if useGrantedFees {
+		err := dfd.feegrantKeeper.UseGrantedFees(ctx, deductFeesFrom, feePayer, fee, sdkTx.GetMsgs())
+		if err != nil {
+			return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, feePayer)
+		}
+	}
 
-		// Deduct the fees from the fee payer account
-		err = ante.DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, convertedFee)
+	// Deduct the fees from the fee payer account
+	if !convertedFee.IsZero() {
+		err := ante.DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, convertedFee)
 		if err != nil {
 			return err
 		}
@@ -159,3 +177,24 @@ func (dfd DeductFeeDecorator) checkDeductFee(ctx sdk.Context, sdkTx sdk.Tx, fee
 
 	return nil
 }
+
+// chargePaymaster charges the paymaster for the fees of a sponsored transaction
+// The fee payer balance is untouched, all the messages must be sponsored by the paymaster
+func (dfd DeductFeeDecorator) chargePaymaster(ctx sdk.Context, sdkTx sdk.Tx, paymaster, feePayer sdk.AccAddress, fee sdk.Coins) error {
+	// Charge the paymaster
+	if err := dfd.feeAbstractionKeeper.ChargePaymaster(ctx, paymaster, feePayer, sdkTx.GetMsgs(), fee); err != nil {
+		return err
+	}
+
+	// Emit the events
+	events := sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyFeePayer, paymaster.String()),
+		),
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	return nil
+}
@@ -162,7 +162,7 @@ func TestDeductFeeDecorator(t *testing.T) {
 				})
 
 				// Set the pair on the fee abstraction keeper
-				err := app.FeeAbstractionKeeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+				err := app.FeeAbstractionKeeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
 					types.NewFeeTokenMetadata(
 						MockErc20Denom,
 						MockErc20Denom,
@@ -208,7 +208,7 @@ func TestDeductFeeDecorator(t *testing.T) {
 
 				// Set the pair on the fee abstraction keeper
 				erc20NativeAddress := "erc20/" + erc20Address.Hex()
-				err = app.FeeAbstractionKeeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+				err = app.FeeAbstractionKeeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
 					types.NewFeeTokenMetadata(
 						erc20NativeAddress,
 						erc20NativeAddress,
@@ -239,7 +239,100 @@ func TestDeductFeeDecorator(t *testing.T) {
 			},
 		},
 		{
-			name:        "fail - unauthorized fee grant",
+			name: "fee abstraction - paused fee token is not used",
+			malleate: func(ctx sdk.Context) {
+				// Set the token pair on the erc20 keeper
+				app.Erc20Keeper.SetToken(ctx, erc20types.TokenPair{
+					Erc20Address:  MockErc20Address,
+					Denom:         MockErc20Denom,
+					Enabled:       true,
+					ContractOwner: erc20types.OWNER_UNSPECIFIED,
+				})
+
+				// Set the pair on the fee abstraction keeper and pause it
+				err := app.FeeAbstractionKeeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata(
+						MockErc20Denom,
+						MockErc20Denom,
+						18,
+						MockErc20Price,
+					),
+				))
+				require.NoError(t, err)
+				err = app.FeeAbstractionKeeper.PausedFeeTokens.Set(ctx, MockErc20Denom)
+				require.NoError(t, err)
+
+				// Now we mint tokens for the fee payer
+				err = app.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(MockErc20Denom, DefaultMinFeeValue*10)))
+				require.NoError(t, err)
+				err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, founder, sdk.NewCoins(sdk.NewInt64Coin(MockErc20Denom, DefaultMinFeeValue*10)))
+				require.NoError(t, err)
+			},
+			fee:         sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue)),
+			expected:    sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue)),
+			errContains: "insufficient funds",
+		},
+		{
+			name: "fee abstraction - multi token fee with fee granter",
+			malleate: func(ctx sdk.Context) {
+				// Enable the multi token mode
+				params, err := app.FeeAbstractionKeeper.Params.Get(ctx)
+				require.NoError(t, err)
+				params.MultiTokenFees = true
+				require.NoError(t, app.FeeAbstractionKeeper.Params.Set(ctx, params))
+
+				// Set the token pair on the erc20 keeper
+				app.Erc20Keeper.SetToken(ctx, erc20types.TokenPair{
+					Erc20Address:  MockErc20Address,
+					Denom:         MockErc20Denom,
+					Enabled:       true,
+					ContractOwner: erc20types.OWNER_UNSPECIFIED,
+				})
+
+				// Set the pair on the fee abstraction keeper
+				err = app.FeeAbstractionKeeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata(
+						MockErc20Denom,
+						MockErc20Denom,
+						18,
+						MockErc20Price,
+					),
+				))
+				require.NoError(t, err)
+
+				// Fund the fee granter with half of the fee in native and half in the fee token
+				granterFunds := sdk.NewCoins(
+					sdk.NewInt64Coin("akii", DefaultMinFeeValue/2),
+					sdk.NewInt64Coin(MockErc20Denom, DefaultMinFeeValue*5),
+				)
+				err = app.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, granterFunds)
+				require.NoError(t, err)
+				err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, feeGranter, granterFunds)
+				require.NoError(t, err)
+
+				// The grant is spent on the native fee
+				err = app.FeeGrantKeeper.GrantAllowance(ctx, feeGranter, founder, &feegrant.BasicAllowance{
+					SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue)),
+					Expiration: nil,
+				})
+				require.NoError(t, err)
+			},
+			feeGranter: feeGranter,
+			fee:        sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue)),
+			expected: sdk.NewCoins(
+				sdk.NewInt64Coin("akii", DefaultMinFeeValue/2),
+				sdk.NewInt64Coin(MockErc20Denom, DefaultMinFeeValue*5),
+			),
+		},
+		{
+			name: "fail - unauthorized fee grant",
+			malleate: func(ctx sdk.Context) {
+				// Fund the fee granter account, the grant is checked after the conversion
+				err := app.BankKeeper.MintCoins(ctx, evmtypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue)))
+				require.NoError(t, err)
+				err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, feeGranter, sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue)))
+				require.NoError(t, err)
+			},
 			feeGranter:  feeGranter,
 			fee:         sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue)),
 			expected:    sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue)),
@@ -405,3 +498,228 @@ func TestDeductFeeDecoratorFeeGranterNoFeeKeeper(t *testing.T) {
 	_, err = anteHandler(ctx, tx, false)
 	require.ErrorContains(t, err, "fee grants are not enabled")
 }
+
+// TestDeductFeeDecoratorPaymaster tests the DeductFeeDecorator with a paymaster as the fee granter
+func TestDeductFeeDecoratorPaymaster(t *testing.T) {
+	// Start the app and the context
+	app, ctx := helpers.SetupWithContext(t)
+
+	// Create the fee payer and the paymaster contract
+	founder := apptesting.RandomAccountAddress()
+	paymaster := apptesting.RandomAccountAddress()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, founder))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue))
+
+	// Set the different test cases
+	testCases := []struct {
+		name        string
+		msgTypes    []string
+		malleate    func(ctx sdk.Context)
+		errContains string
+	}{
+		{
+			name:     "success - fee paid by the paymaster",
+			msgTypes: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+		},
+		{
+			name:        "fail - message not sponsored",
+			errContains: "is not sponsored by",
+		},
+		{
+			name:     "fail - paymaster paused with the module",
+			msgTypes: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+			malleate: func(ctx sdk.Context) {
+				require.NoError(t, app.FeeAbstractionKeeper.Paused.Set(ctx, true))
+			},
+			errContains: "fee abstraction is paused",
+		},
+	}
+
+	// Iterate and run the tests
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Start a cached context
+			cachedCtx, _ := ctx.CacheContext()
+
+			// Register and fund the paymaster
+			err := app.FeeAbstractionKeeper.Paymasters.Set(cachedCtx, paymaster, types.Paymaster{
+				Contract:      paymaster.String(),
+				MsgTypes:      tc.msgTypes,
+				PerUserLimit:  math.NewInt(DefaultMinFeeValue),
+				PerBlockLimit: math.NewInt(DefaultMinFeeValue),
+				UserWindow:    3600,
+				Balance:       math.NewInt(DefaultMinFeeValue),
+			})
+			require.NoError(t, err)
+			err = app.BankKeeper.MintCoins(cachedCtx, evmtypes.ModuleName, fee)
+			require.NoError(t, err)
+			err = app.BankKeeper.SendCoinsFromModuleToModule(cachedCtx, evmtypes.ModuleName, types.ModuleName, fee)
+			require.NoError(t, err)
+
+			// Malleate the context
+			if tc.malleate != nil {
+				tc.malleate(cachedCtx)
+			}
+
+			// Start up the DeductFeeDecorator
+			deductFeeDecorator := cosmos.NewDeductFeeDecorator(
+				app.AccountKeeper,
+				app.BankKeeper,
+				app.FeeGrantKeeper,
+				app.FeeAbstractionKeeper,
+				cosmosevmante.NewDynamicFeeChecker(app.FeeMarketKeeper),
+			)
+
+			// Wrap into a ante decorator
+			anteHandler := sdk.ChainAnteDecorators(deductFeeDecorator)
+
+			// Build a TX with the paymaster as the fee granter
+			tx, err := helpers.BuildTxFromMsgs(
+				founder,
+				paymaster,
+				fee,
+				1000000,
+				banktypes.NewMsgSend(founder, paymaster, sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000)))),
+			)
+			require.NoError(t, err)
+
+			// Call the ante handler
+			_, err = anteHandler(cachedCtx, tx, false)
+			if tc.errContains != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errContains)
+			} else {
+				require.NoError(t, err)
+
+				// The paymaster balance paid the whole fee
+				paymasterState, err := app.FeeAbstractionKeeper.GetPaymaster(cachedCtx, paymaster)
+				require.NoError(t, err)
+				require.True(t, paymasterState.Balance.IsZero())
+				require.True(t, app.BankKeeper.GetAllBalances(cachedCtx, founder).IsZero())
+			}
+		})
+	}
+}
+
+// TestDeductFeeDecoratorFeeGrantMultiToken tests that converted fees spend native fee grants on the native fee
+func TestDeductFeeDecoratorFeeGrantMultiToken(t *testing.T) {
+	// Start the app and the context
+	app, ctx := helpers.SetupWithContext(t)
+
+	// Create the fee payer and the fee granter
+	founder := apptesting.RandomAccountAddress()
+	feeGranter := apptesting.RandomAccountAddress()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccountWithAddress(ctx, founder))
+	fee := sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue))
+
+	// Set the different test cases
+	testCases := []struct {
+		name          string
+		granterFunds  sdk.Coins
+		spendLimit    sdk.Coins
+		expectedLimit sdk.Coins
+		errContains   string
+	}{
+		{
+			name:          "success - native grant with the fee converted to the fee token",
+			granterFunds:  sdk.NewCoins(sdk.NewInt64Coin(MockErc20Denom, DefaultMinFeeValue*10)),
+			spendLimit:    sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue*2)),
+			expectedLimit: fee,
+		},
+		{
+			name: "success - native grant with mixed fees",
+			granterFunds: sdk.NewCoins(
+				sdk.NewInt64Coin("akii", DefaultMinFeeValue/2),
+				sdk.NewInt64Coin(MockErc20Denom, DefaultMinFeeValue*5),
+			),
+			spendLimit:    sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue*2)),
+			expectedLimit: fee,
+		},
+		{
+			name:         "fail - native grant does not cover the fee",
+			granterFunds: sdk.NewCoins(sdk.NewInt64Coin(MockErc20Denom, DefaultMinFeeValue*10)),
+			spendLimit:   sdk.NewCoins(sdk.NewInt64Coin("akii", DefaultMinFeeValue/2)),
+			errContains:  "fee limit exceeded",
+		},
+	}
+
+	// Iterate and run the tests
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Start a cached context
+			cachedCtx, _ := ctx.CacheContext()
+
+			// Enable the multi token mode
+			params, err := app.FeeAbstractionKeeper.Params.Get(cachedCtx)
+			require.NoError(t, err)
+			params.MultiTokenFees = true
+			require.NoError(t, app.FeeAbstractionKeeper.Params.Set(cachedCtx, params))
+
+			// Set the token pair on the erc20 keeper and the fee abstraction keeper
+			app.Erc20Keeper.SetToken(cachedCtx, erc20types.TokenPair{
+				Erc20Address:  MockErc20Address,
+				Denom:         MockErc20Denom,
+				Enabled:       true,
+				ContractOwner: erc20types.OWNER_UNSPECIFIED,
+			})
+			err = app.FeeAbstractionKeeper.SetFeeTokens(cachedCtx, *types.NewFeeTokenMetadataCollection(
+				types.NewFeeTokenMetadata(
+					MockErc20Denom,
+					MockErc20Denom,
+					18,
+					MockErc20Price,
+				),
+			))
+			require.NoError(t, err)
+
+			// Fund the fee granter and create the fee grant
+			err = app.BankKeeper.MintCoins(cachedCtx, evmtypes.ModuleName, tc.granterFunds)
+			require.NoError(t, err)
+			err = app.BankKeeper.SendCoinsFromModuleToAccount(cachedCtx, evmtypes.ModuleName, feeGranter, tc.granterFunds)
+			require.NoError(t, err)
+			err = app.FeeGrantKeeper.GrantAllowance(cachedCtx, feeGranter, founder, &feegrant.BasicAllowance{
+				SpendLimit: tc.spendLimit,
+			})
+			require.NoError(t, err)
+
+			// Start up the DeductFeeDecorator
+			deductFeeDecorator := cosmos.NewDeductFeeDecorator(
+				app.AccountKeeper,
+				app.BankKeeper,
+				app.FeeGrantKeeper,
+				app.FeeAbstractionKeeper,
+				cosmosevmante.NewDynamicFeeChecker(app.FeeMarketKeeper),
+			)
+
+			// Wrap into a ante decorator
+			anteHandler := sdk.ChainAnteDecorators(deductFeeDecorator)
+
+			// Build a TX with the fee granter
+			tx, err := helpers.BuildTxFromMsgs(
+				founder,
+				feeGranter,
+				fee,
+				1000000,
+				banktypes.NewMsgSend(founder, apptesting.RandomAccountAddress(), sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000)))),
+			)
+			require.NoError(t, err)
+
+			// Call the ante handler
+			_, err = anteHandler(cachedCtx, tx, false)
+			if tc.errContains != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errContains)
+			} else {
+				require.NoError(t, err)
+
+				// The fee granter paid the converted fees
+				require.True(t, app.BankKeeper.GetAllBalances(cachedCtx, feeGranter).IsZero())
+
+				// The grant was spent on the native fee
+				grant, err := app.FeeGrantKeeper.GetAllowance(cachedCtx, feeGranter, founder)
+				require.NoError(t, err)
+				require.Equal(t, tc.spendLimit.Sub(tc.expectedLimit...), grant.(*feegrant.BasicAllowance).SpendLimit)
+			}
+		})
+	}
+}
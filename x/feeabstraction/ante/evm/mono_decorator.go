@@ -7,6 +7,9 @@
 // - VerifyAccountBalance will check if the user has enough balance to pay for the transaction value (before was fee + value)
 // - The key ContextPaidFeesKey is defined on the context to store the paid fees, this is used to refund the gas under the evm module
 //   - EVM module counterpart is defined under `x/vm/keeper/gas.go`
+//   - Mixed fees from the multi token mode are reduced to a single refundable coin
+//   - The fee payment is also stored under ContextFeePaymentKey, the RefundDecorator completes the refund with it
+// - A registered paymaster on the first access list entry pays the fees, the leftover gas is refunded to it
 
 package evm
 
@@ -198,23 +201,48 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			return ctx, err
 		}
 
-		// Here the fee abstraction module does it work
-		// We check if the user has enough balance to pay for the fees using the
-		// native token (evmDenom), if not we iterate the fee abstraction module tokens
-		convertedMsgFees, err := md.feeAbstractionKeeper.ConvertNativeFee(ctx, from, msgFees)
+		// Check if a paymaster sponsors the transaction
+		paymaster, err := md.getPaymaster(ctx, txData)
 		if err != nil {
 			return ctx, err
 		}
 
-		// Here the gas is deducted from the user
-		err = evmante.ConsumeFeesAndEmitEvent(
-			ctx,
-			md.evmKeeper,
-			convertedMsgFees,
-			from,
-		)
-		if err != nil {
-			return ctx, err
+		var paidFees sdk.Coins
+		payment := FeePayment{
+			Sender:    from,
+			Paymaster: paymaster,
+			GasLimit:  gas,
+		}
+		if paymaster != nil {
+			// The paymaster pays the fees, the user balance is untouched
+			if err := md.chargePaymaster(ctx, paymaster, from, txData, msgFees); err != nil {
+				return ctx, err
+			}
+			payment.PaidFees = msgFees
+			paidFees = msgFees
+		} else {
+			// Here the fee abstraction module does it work
+			// We check if the user has enough balance to pay for the fees using the
+			// native token (evmDenom), if not we iterate the fee abstraction module tokens
+			convertedMsgFees, err := md.feeAbstractionKeeper.ConvertNativeFee(ctx, from, msgFees)
+			if err != nil {
+				return ctx, err
+			}
+
+			// Here the gas is deducted from the user
+			err = evmante.ConsumeFeesAndEmitEvent(
+				ctx,
+				md.evmKeeper,
+				convertedMsgFees,
+				from,
+			)
+			if err != nil {
+				return ctx, err
+			}
+
+			// The EVM refund only supports a single coin, mixed fees are reduced to the refundable portion
+			payment.PaidFees = convertedMsgFees
+			paidFees = RefundableFees(convertedMsgFees, evmDenom)
 		}
 
 		// This checks if the user has enough balance
@@ -288,11 +316,12 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			"min_priority", decUtils.MinPriority,
 			"base_fee", decUtils.BaseFee,
 			"tx_type", txData.TxType(),
-			"paid_fees", convertedMsgFees,
+			"paid_fees", paidFees,
 		)
 
 		// Define the fee on the context for gas refunding
-		ctx = ctx.WithValue(evmkeeper.ContextPaidFeesKey{}, convertedMsgFees)
+		ctx = ctx.WithValue(evmkeeper.ContextPaidFeesKey{}, paidFees)
+		ctx = ctx.WithValue(ContextFeePaymentKey{}, payment)
 	}
 
 	if err := evmante.CheckTxFee(txFeeInfo, decUtils.TxFee, decUtils.TxGasLimit); err != nil {
@@ -306,3 +335,53 @@ func (md MonoDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 
 	return next(ctx, tx, simulate)
 }
+
+// getPaymaster returns the paymaster opted in by the transaction
+// The opt in is done by setting a registered paymaster as the first access list entry
+// Only the first entry is checked, this keeps the lookup cost constant
+func (md MonoDecorator) getPaymaster(ctx sdk.Context, txData evmtypes.TxData) (sdk.AccAddress, error) {
+	// Get the first access list entry
+	accessList := txData.GetAccessList()
+	if len(accessList) == 0 {
+		return nil, nil
+	}
+	candidate := sdk.AccAddress(accessList[0].Address.Bytes())
+
+	// Check if the entry is a registered paymaster
+	isPaymaster, err := md.feeAbstractionKeeper.HasPaymaster(ctx, candidate)
+	if err != nil || !isPaymaster {
+		return nil, err
+	}
+
+	return candidate, nil
+}
+
+// chargePaymaster charges the paymaster for the fees of a sponsored transaction
+// The EVM module refunds the leftover gas to the sender, the RefundDecorator then moves it back to the paymaster
+func (md MonoDecorator) chargePaymaster(
+	ctx sdk.Context,
+	paymaster, from sdk.AccAddress,
+	txData evmtypes.TxData,
+	msgFees sdk.Coins,
+) error {
+	// Get the callee, contract creations have no callee
+	var callee sdk.AccAddress
+	if to := txData.GetTo(); to != nil {
+		callee = to.Bytes()
+	}
+
+	// Charge the paymaster
+	if err := md.feeAbstractionKeeper.ChargeEVMPaymaster(ctx, paymaster, from, callee, msgFees); err != nil {
+		return err
+	}
+
+	// Emit the same event as the fee consumption
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeTx,
+			sdk.NewAttribute(sdk.AttributeKeyFee, msgFees.String()),
+		),
+	)
+
+	return nil
+}
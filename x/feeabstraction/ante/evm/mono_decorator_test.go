@@ -20,6 +20,7 @@ import (
 	"github.com/cosmos/evm/testutil/integration/os/keyring"
 	"github.com/cosmos/evm/testutil/tx"
 	erc20types "github.com/cosmos/evm/x/erc20/types"
+	evmkeeper "github.com/cosmos/evm/x/vm/keeper"
 	"github.com/cosmos/evm/x/vm/statedb"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 
@@ -196,7 +197,7 @@ func TestMonoDecorator(t *testing.T) {
 				})
 
 				// Set the pair on the fee abstraction keeper
-				err := app.FeeAbstractionKeeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+				err := app.FeeAbstractionKeeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
 					types.NewFeeTokenMetadata(
 						MockErc20Denom,
 						MockErc20Denom,
@@ -220,6 +221,51 @@ func TestMonoDecorator(t *testing.T) {
 				require.True(t, balance.IsZero())
 			},
 		},
+		{
+			name: "success - multi token fee combines native and fee token",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Enable the multi token mode
+				params, err := app.FeeAbstractionKeeper.Params.Get(ctx)
+				require.NoError(t, err)
+				params.MultiTokenFees = true
+				require.NoError(t, app.FeeAbstractionKeeper.Params.Set(ctx, params))
+
+				// Set up the token pair on the erc20 module
+				app.Erc20Keeper.SetToken(ctx, erc20types.TokenPair{
+					Erc20Address:  MockErc20Address,
+					Denom:         MockErc20Denom,
+					Enabled:       true,
+					ContractOwner: erc20types.OWNER_UNSPECIFIED,
+				})
+
+				// Set the pair on the fee abstraction keeper
+				err = app.FeeAbstractionKeeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata(
+						MockErc20Denom,
+						MockErc20Denom,
+						18,
+						MockErc20Price,
+					),
+				))
+				require.NoError(t, err)
+
+				// Mint half of the fee in native and half in the fee token
+				amount := sdk.NewCoins(
+					sdk.NewInt64Coin("akii", 10000000*1000000),
+					sdk.NewInt64Coin(MockErc20Denom, 10000000*1000000*10),
+				)
+				err = mintCoins(app, ctx, keys.GetKey(0).AccAddr, amount)
+				require.NoError(t, err)
+				return ctx
+			},
+			gasLimit: 20000000,
+			gasPrice: big.NewInt(1000000),
+			postCheck: func(ctx sdk.Context) {
+				// Both balances were used for fees
+				balances := app.BankKeeper.GetAllBalances(ctx, keys.GetKey(0).AccAddr)
+				require.True(t, balances.IsZero())
+			},
+		},
 		{
 			name: "success - fee with fee abstraction and transaction value native token",
 			malleate: func(ctx sdk.Context) sdk.Context {
@@ -232,7 +278,7 @@ func TestMonoDecorator(t *testing.T) {
 				})
 
 				// Set the pair on the fee abstraction keeper
-				err := app.FeeAbstractionKeeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+				err := app.FeeAbstractionKeeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
 					types.NewFeeTokenMetadata(
 						MockErc20Denom,
 						MockErc20Denom,
@@ -288,7 +334,7 @@ func TestMonoDecorator(t *testing.T) {
 
 				// Set the pair on the fee abstraction keeper
 				erc20NativeAddress := "erc20/" + erc20Address.Hex()
-				err = app.FeeAbstractionKeeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+				err = app.FeeAbstractionKeeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
 					types.NewFeeTokenMetadata(
 						erc20NativeAddress,
 						erc20NativeAddress,
@@ -329,6 +375,40 @@ func TestMonoDecorator(t *testing.T) {
 			gasPrice:    big.NewInt(1000000),
 			errContains: "insufficient funds for fee",
 		},
+		{
+			name: "fail - fee token paused by the guardian",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Set up the token pair on the erc20 module
+				app.Erc20Keeper.SetToken(ctx, erc20types.TokenPair{
+					Erc20Address:  MockErc20Address,
+					Denom:         MockErc20Denom,
+					Enabled:       true,
+					ContractOwner: erc20types.OWNER_UNSPECIFIED,
+				})
+
+				// Set the pair on the fee abstraction keeper and pause it
+				err := app.FeeAbstractionKeeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata(
+						MockErc20Denom,
+						MockErc20Denom,
+						18,
+						MockErc20Price,
+					),
+				))
+				require.NoError(t, err)
+				err = app.FeeAbstractionKeeper.PausedFeeTokens.Set(ctx, MockErc20Denom)
+				require.NoError(t, err)
+
+				// Mint the tokens for the fee payer
+				amount := sdk.NewCoins(sdk.NewInt64Coin(MockErc20Denom, 20000000*1000000*10))
+				err = mintCoins(app, ctx, keys.GetKey(0).AccAddr, amount)
+				require.NoError(t, err)
+				return ctx
+			},
+			gasLimit:    20000000,
+			gasPrice:    big.NewInt(1000000),
+			errContains: "insufficient funds for fee",
+		},
 		{
 			name: "fail - not enough gas",
 			malleate: func(ctx sdk.Context) sdk.Context {
@@ -444,6 +524,131 @@ func TestMonoDecorator(t *testing.T) {
 	}
 }
 
+// TestMonoDecoratorPaymaster tests the MonoDecorator with a paymaster on the access list
+func TestMonoDecoratorPaymaster(t *testing.T) {
+	// Start the app and the context
+	app, ctx := helpers.SetupWithContext(t)
+
+	// Create a keyring and separate a single key
+	keys := keyring.New(1)
+
+	// Set the fee market fees to a good value for calculations
+	feeMarketParams := app.FeeMarketKeeper.GetParams(ctx)
+	feeMarketParams.MinGasPrice = math.LegacyMustNewDecFromStr("1000000")
+	feeMarketParams.BaseFee = math.LegacyMustNewDecFromStr("1000000")
+	err := app.FeeMarketKeeper.SetParams(ctx, feeMarketParams)
+	require.NoError(t, err)
+
+	// Define the paymaster contract and the fee paid by the transactions
+	paymaster := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	fee := sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(100000*1000000)))
+
+	// Define the test cases
+	testCases := []struct {
+		name        string
+		to          *common.Address
+		accessList  gethtypes.AccessList
+		errContains string
+	}{
+		{
+			name:       "success - fee paid by the paymaster",
+			to:         &paymaster,
+			accessList: gethtypes.AccessList{{Address: paymaster}},
+		},
+		{
+			name:        "fail - paymaster does not sponsor contract creations",
+			accessList:  gethtypes.AccessList{{Address: paymaster}},
+			errContains: "is not sponsored by",
+		},
+		{
+			name:        "fail - paymaster does not sponsor the callee",
+			to:          &common.Address{0x2},
+			accessList:  gethtypes.AccessList{{Address: paymaster}},
+			errContains: "is not sponsored by",
+		},
+		{
+			name:        "fail - no paymaster opt in, the user pays",
+			to:          &paymaster,
+			errContains: "insufficient funds",
+		},
+	}
+
+	// Run the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create a cached context
+			cacheCtx, _ := ctx.CacheContext()
+			cacheCtx = cacheCtx.WithBlockGasMeter(storetypes.NewGasMeter(20000000))
+
+			// Register and fund the paymaster
+			err := app.FeeAbstractionKeeper.Paymasters.Set(cacheCtx, paymaster.Bytes(), types.Paymaster{
+				Contract:      sdk.AccAddress(paymaster.Bytes()).String(),
+				PerUserLimit:  fee[0].Amount,
+				PerBlockLimit: fee[0].Amount,
+				UserWindow:    3600,
+				Balance:       fee[0].Amount,
+			})
+			require.NoError(t, err)
+			err = app.BankKeeper.MintCoins(cacheCtx, evmtypes.ModuleName, fee)
+			require.NoError(t, err)
+			err = app.BankKeeper.SendCoinsFromModuleToModule(cacheCtx, evmtypes.ModuleName, types.ModuleName, fee)
+			require.NoError(t, err)
+
+			// Start up the wrapped ante decorator
+			monoDecorator := kiievmante.NewEVMMonoDecorator(
+				app.AccountKeeper,
+				app.FeeMarketKeeper,
+				app.EVMKeeper,
+				app.FeeAbstractionKeeper,
+				20000000,
+			)
+			anteHandler := sdk.ChainAnteDecorators(monoDecorator)
+
+			// Build the tx
+			args := getDefaultEVMTxArgs(big.NewInt(1010), 100000, big.NewInt(1000000), 0)
+			args.To = tc.to
+			if tc.accessList != nil {
+				args.Accesses = &tc.accessList
+			}
+			tx, err := signEVMTx(keys.GetKey(0), args)
+			require.NoError(t, err)
+
+			// Execute the ante handler
+			newCtx, err := anteHandler(cacheCtx, tx, false)
+			if tc.errContains != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errContains)
+				return
+			}
+			require.NoError(t, err)
+
+			// The paymaster paid the fee
+			paymasterState, err := app.FeeAbstractionKeeper.GetPaymaster(cacheCtx, paymaster.Bytes())
+			require.NoError(t, err)
+			require.True(t, paymasterState.Balance.IsZero())
+			require.Equal(t, fee, newCtx.Value(evmkeeper.ContextPaidFeesKey{}))
+
+			// Run the EVM refund for a transaction using 40% of the gas limit
+			gasUsed := uint64(40000)
+			app.EVMKeeper.SetTransientGasUsed(newCtx, gasUsed)
+			coreMsg, err := tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx).AsMessage(gethtypes.LatestSignerForChainID(big.NewInt(1010)), nil)
+			require.NoError(t, err)
+			err = app.EVMKeeper.RefundGas(newCtx, coreMsg, args.GasLimit-gasUsed, "akii")
+			require.NoError(t, err)
+
+			// The post handler moves the leftover gas refund back to the paymaster
+			postHandler := sdk.ChainPostDecorators(kiievmante.NewRefundDecorator(app.EVMKeeper, app.FeeAbstractionKeeper))
+			_, err = postHandler(newCtx, tx, false, true)
+			require.NoError(t, err)
+
+			paymasterState, err = app.FeeAbstractionKeeper.GetPaymaster(newCtx, paymaster.Bytes())
+			require.NoError(t, err)
+			require.Equal(t, fee[0].Amount.MulRaw(60).QuoRaw(100), paymasterState.Balance)
+			require.True(t, app.BankKeeper.GetBalance(newCtx, keys.GetKey(0).AccAddr, "akii").IsZero())
+		})
+	}
+}
+
 // mintCoins mints coins to the given account
 func mintCoins(app *kiichain.KiichainApp, ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) error {
 	// Mint the coins to the module account
@@ -458,10 +663,14 @@ func mintCoins(app *kiichain.KiichainApp, ctx sdk.Context, addr sdk.AccAddress,
 
 // createAndSignTx creates and signs a transaction with the given key
 func createAndSignTx(key keyring.Key, gasLimit uint64, gasPrice *big.Int, amount int64) (signing.Tx, error) {
-	ethChainID := big.NewInt(1010)
-	msgEthereumTx := evmtypes.NewTx(getDefaultEVMTxArgs(ethChainID, gasLimit, gasPrice, amount))
+	return signEVMTx(key, getDefaultEVMTxArgs(big.NewInt(1010), gasLimit, gasPrice, amount))
+}
+
+// signEVMTx creates and signs a transaction with the given key and arguments
+func signEVMTx(key keyring.Key, args *evmtypes.EvmTxArgs) (signing.Tx, error) {
+	msgEthereumTx := evmtypes.NewTx(args)
 
-	signer := gethtypes.LatestSignerForChainID(ethChainID)
+	signer := gethtypes.LatestSignerForChainID(args.ChainID)
 
 	msgEthereumTx.From = common.BytesToAddress(key.Priv.PubKey().Address().Bytes()).String()
 
@@ -0,0 +1,118 @@
+package evm
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	antetypes "github.com/kiichain/kiichain/v4/ante/types"
+)
+
+// ContextFeePaymentKey is the context key for the fee payment of an EVM transaction
+// It's set by the mono decorator and read by the refund decorator after the execution
+type ContextFeePaymentKey struct{}
+
+// FeePayment is the fee paid for the gas limit of an EVM transaction
+type FeePayment struct {
+	// Sender is the transaction sender
+	Sender sdk.AccAddress
+	// Paymaster is the paymaster that paid the fees, empty if the sender paid them
+	Paymaster sdk.AccAddress
+	// PaidFees are the coins paid for the gas limit
+	PaidFees sdk.Coins
+	// GasLimit is the transaction gas limit
+	GasLimit uint64
+}
+
+// RefundableFees returns the paid fees used by the EVM module to refund the leftover gas
+// The EVM refund is calculated over a single coin, so mixed fees from the multi token mode
+// are refunded over the native portion, or over the first coin if no native was paid
+// The remaining portions are refunded by the RefundDecorator
+func RefundableFees(paidFees sdk.Coins, nativeDenom string) sdk.Coins {
+	// Single coin fees are refunded as they are
+	if len(paidFees) <= 1 {
+		return paidFees
+	}
+
+	// Refund over the native portion if it exists
+	if found, nativeCoin := paidFees.Find(nativeDenom); found {
+		return sdk.Coins{nativeCoin}
+	}
+
+	return sdk.Coins{paidFees[0]}
+}
+
+// ProRataRefund returns the share of each coin matching the leftover gas
+// It follows the EVM module refund, calculated as amount * leftoverGas / gasLimit
+func ProRataRefund(paidFees sdk.Coins, leftoverGas, gasLimit uint64) sdk.Coins {
+	// Nothing is refunded without a gas limit
+	if gasLimit == 0 {
+		return sdk.Coins{}
+	}
+
+	// Calculate the share of each coin
+	refund := sdk.Coins{}
+	for _, coin := range paidFees {
+		amount := new(big.Int).Div(
+			new(big.Int).Mul(coin.Amount.BigInt(), new(big.Int).SetUint64(leftoverGas)),
+			new(big.Int).SetUint64(gasLimit),
+		)
+		refund = refund.Add(sdk.NewCoin(coin.Denom, sdkmath.NewIntFromBigInt(amount)))
+	}
+
+	return refund
+}
+
+// RefundDecorator completes the EVM module refund of the leftover gas
+// The EVM module refunds the sender over a single coin, so this decorator refunds the remaining
+// portions of mixed fees and moves the refund of sponsored transactions back to the paymaster
+type RefundDecorator struct {
+	evmKeeper            antetypes.EVMKeeper
+	feeAbstractionKeeper antetypes.FeeAbstractionKeeper
+}
+
+// NewRefundDecorator creates a new RefundDecorator instance
+func NewRefundDecorator(evmKeeper antetypes.EVMKeeper, feeAbstractionKeeper antetypes.FeeAbstractionKeeper) RefundDecorator {
+	return RefundDecorator{
+		evmKeeper:            evmKeeper,
+		feeAbstractionKeeper: feeAbstractionKeeper,
+	}
+}
+
+// PostHandle refunds the leftover gas not covered by the EVM module
+func (rd RefundDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	// Failed transactions are reverted, and so is the EVM refund
+	payment, ok := ctx.Value(ContextFeePaymentKey{}).(FeePayment)
+	if !success || !ok {
+		return next(ctx, tx, simulate, success)
+	}
+
+	// Get the leftover gas the same way as the EVM module
+	gasUsed := rd.evmKeeper.GetTransientGasUsed(ctx)
+	if gasUsed >= payment.GasLimit {
+		return next(ctx, tx, simulate, success)
+	}
+	leftoverGas := payment.GasLimit - gasUsed
+
+	// The EVM module refunded the sender over the refundable coin
+	refundableFees := RefundableFees(payment.PaidFees, evmtypes.GetEVMCoinDenom())
+	if !payment.Paymaster.Empty() {
+		// Move the refund back to the paymaster
+		refund := ProRataRefund(refundableFees, leftoverGas, payment.GasLimit)
+		if err := rd.feeAbstractionKeeper.RefundPaymaster(ctx, payment.Paymaster, payment.Sender, refund); err != nil {
+			return ctx, err
+		}
+	} else {
+		// Refund the remaining portions of mixed fees
+		refund := ProRataRefund(payment.PaidFees.Sub(refundableFees...), leftoverGas, payment.GasLimit)
+		if err := rd.feeAbstractionKeeper.RefundFees(ctx, payment.Sender, refund); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate, success)
+}
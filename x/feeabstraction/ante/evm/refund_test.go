@@ -0,0 +1,211 @@
+package evm_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/evm/testutil/integration/os/keyring"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	"github.com/kiichain/kiichain/v4/app/helpers"
+	kiievmante "github.com/kiichain/kiichain/v4/x/feeabstraction/ante/evm"
+)
+
+// TestRefundableFees tests the RefundableFees function
+func TestRefundableFees(t *testing.T) {
+	// Prepare the test cases
+	testCases := []struct {
+		name     string
+		paidFees sdk.Coins
+		expected sdk.Coins
+	}{
+		{
+			name:     "empty fees",
+			paidFees: sdk.Coins{},
+			expected: sdk.Coins{},
+		},
+		{
+			name:     "single coin is kept",
+			paidFees: sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(100))),
+			expected: sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(100))),
+		},
+		{
+			name: "mixed fees are refunded over the native portion",
+			paidFees: sdk.NewCoins(
+				sdk.NewCoin("akii", math.NewInt(40)),
+				sdk.NewCoin("uatom", math.NewInt(60)),
+			),
+			expected: sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(40))),
+		},
+		{
+			name: "mixed fees without native are refunded over the first coin",
+			paidFees: sdk.NewCoins(
+				sdk.NewCoin("uusdc", math.NewInt(50)),
+				sdk.NewCoin("uatom", math.NewInt(60)),
+			),
+			expected: sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(60))),
+		},
+	}
+
+	// Iterate over the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, kiievmante.RefundableFees(tc.paidFees, "akii"))
+		})
+	}
+}
+
+// TestProRataRefund tests the ProRataRefund function
+func TestProRataRefund(t *testing.T) {
+	// Prepare the test cases
+	testCases := []struct {
+		name        string
+		paidFees    sdk.Coins
+		leftoverGas uint64
+		gasLimit    uint64
+		expected    sdk.Coins
+	}{
+		{
+			name:        "no gas limit refunds nothing",
+			paidFees:    sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(100))),
+			leftoverGas: 10,
+			expected:    sdk.Coins{},
+		},
+		{
+			name:     "no leftover gas refunds nothing",
+			paidFees: sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(100))),
+			gasLimit: 100,
+			expected: sdk.Coins{},
+		},
+		{
+			name: "every coin is refunded pro rata",
+			paidFees: sdk.NewCoins(
+				sdk.NewCoin("akii", math.NewInt(400)),
+				sdk.NewCoin("uatom", math.NewInt(600)),
+			),
+			leftoverGas: 75,
+			gasLimit:    100,
+			expected: sdk.NewCoins(
+				sdk.NewCoin("akii", math.NewInt(300)),
+				sdk.NewCoin("uatom", math.NewInt(450)),
+			),
+		},
+		{
+			name:        "refund is truncated",
+			paidFees:    sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(50))),
+			leftoverGas: 75,
+			gasLimit:    100,
+			expected:    sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(37))),
+		},
+	}
+
+	// Iterate over the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, kiievmante.ProRataRefund(tc.paidFees, tc.leftoverGas, tc.gasLimit))
+		})
+	}
+}
+
+// TestRefundDecorator tests the refund of the mixed fees portions not refunded by the EVM module
+func TestRefundDecorator(t *testing.T) {
+	// Start the app and the context
+	app, ctx := helpers.SetupWithContext(t)
+
+	// Create a keyring and separate a single key
+	keys := keyring.New(1)
+	sender := keys.GetKey(0).AccAddr
+
+	// Prepare the test cases
+	testCases := []struct {
+		name     string
+		paidFees sdk.Coins
+		gasUsed  uint64
+		success  bool
+		expected sdk.Coins
+	}{
+		{
+			name: "mixed fees are refunded besides the native portion",
+			paidFees: sdk.NewCoins(
+				sdk.NewCoin("akii", math.NewInt(400)),
+				sdk.NewCoin("uatom", math.NewInt(600)),
+			),
+			gasUsed:  25,
+			success:  true,
+			expected: sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(450))),
+		},
+		{
+			name: "mixed fees without native are refunded besides the first coin",
+			paidFees: sdk.NewCoins(
+				sdk.NewCoin("uatom", math.NewInt(600)),
+				sdk.NewCoin("uusdc", math.NewInt(50)),
+			),
+			gasUsed:  25,
+			success:  true,
+			expected: sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(37))),
+		},
+		{
+			name:     "single coin fees are fully refunded by the EVM module",
+			paidFees: sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(600))),
+			gasUsed:  25,
+			success:  true,
+			expected: sdk.Coins{},
+		},
+		{
+			name: "no leftover gas refunds nothing",
+			paidFees: sdk.NewCoins(
+				sdk.NewCoin("akii", math.NewInt(400)),
+				sdk.NewCoin("uatom", math.NewInt(600)),
+			),
+			gasUsed:  100,
+			success:  true,
+			expected: sdk.Coins{},
+		},
+		{
+			name: "failed transactions are not refunded",
+			paidFees: sdk.NewCoins(
+				sdk.NewCoin("akii", math.NewInt(400)),
+				sdk.NewCoin("uatom", math.NewInt(600)),
+			),
+			gasUsed:  25,
+			expected: sdk.Coins{},
+		},
+	}
+
+	// Iterate over the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+
+			// Fund the fee collector with the paid fees
+			err := app.BankKeeper.MintCoins(cacheCtx, evmtypes.ModuleName, tc.paidFees)
+			require.NoError(t, err)
+			err = app.BankKeeper.SendCoinsFromModuleToModule(cacheCtx, evmtypes.ModuleName, authtypes.FeeCollectorName, tc.paidFees)
+			require.NoError(t, err)
+
+			// Set the fee payment and the gas used by the execution
+			cacheCtx = cacheCtx.WithValue(kiievmante.ContextFeePaymentKey{}, kiievmante.FeePayment{
+				Sender:   sender,
+				PaidFees: tc.paidFees,
+				GasLimit: 100,
+			})
+			app.EVMKeeper.SetTransientGasUsed(cacheCtx, tc.gasUsed)
+
+			// Run the post handler
+			postHandler := sdk.ChainPostDecorators(kiievmante.NewRefundDecorator(app.EVMKeeper, app.FeeAbstractionKeeper))
+			_, err = postHandler(cacheCtx, nil, false, tc.success)
+			require.NoError(t, err)
+
+			// Check the sender balance
+			for _, coin := range tc.paidFees {
+				require.Equal(t, tc.expected.AmountOf(coin.Denom), app.BankKeeper.GetBalance(cacheCtx, sender, coin.Denom).Amount)
+			}
+		})
+	}
+}
@@ -26,6 +26,12 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdQueryParams(),
 		GetCmdQueryFeeTokens(),
+		GetCmdQueryFeeTokenDenomTraces(),
+		GetCmdQueryPauseState(),
+		GetCmdQueryConversionCapacity(),
+		GetCmdQueryPaymaster(),
+		GetCmdQueryPaymasters(),
+		GetCmdQueryPaymasterUsage(),
 	)
 	return cmd
 }
@@ -91,3 +97,196 @@ func GetCmdQueryFeeTokens() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryFeeTokenDenomTraces implements the fee token denom traces query command.
+func GetCmdQueryFeeTokenDenomTraces() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-token-denom-traces",
+		Short: "Query the IBC denom traces of the IBC fee tokens",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Initialize the client
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Create a new query client
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Call the FeeTokenDenomTraces query
+			res, err := queryClient.FeeTokenDenomTraces(cmd.Context(), &types.QueryFeeTokenDenomTracesRequest{})
+			if err != nil {
+				return err
+			}
+
+			// Print the response
+			return clientCtx.PrintProto(res)
+		},
+	}
+	// Add query flags to the command
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryPauseState implements the pause state query command.
+func GetCmdQueryPauseState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-state",
+		Short: "Query the pause state of the module and the fee tokens",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Initialize the client
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Create a new query client
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Call the PauseState query
+			res, err := queryClient.PauseState(cmd.Context(), &types.QueryPauseStateRequest{})
+			if err != nil {
+				return err
+			}
+
+			// Print the response
+			return clientCtx.PrintProto(res)
+		},
+	}
+	// Add query flags to the command
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryConversionCapacity implements the conversion capacity query command.
+func GetCmdQueryConversionCapacity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conversion-capacity [denom]",
+		Short: "Query the remaining conversion capacity of a fee token on the rolling window",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Initialize the client
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Create a new query client
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Call the ConversionCapacity query
+			res, err := queryClient.ConversionCapacity(cmd.Context(), &types.QueryConversionCapacityRequest{Denom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			// Print the response
+			return clientCtx.PrintProto(res)
+		},
+	}
+	// Add query flags to the command
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryPaymaster implements the paymaster query command.
+func GetCmdQueryPaymaster() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "paymaster [contract]",
+		Short: "Query a single paymaster",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Initialize the client
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Create a new query client
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Call the Paymaster query
+			res, err := queryClient.Paymaster(cmd.Context(), &types.QueryPaymasterRequest{Contract: args[0]})
+			if err != nil {
+				return err
+			}
+
+			// Print the response
+			return clientCtx.PrintProto(&res.Paymaster)
+		},
+	}
+	// Add query flags to the command
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryPaymasters implements the paymasters query command.
+func GetCmdQueryPaymasters() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "paymasters",
+		Short: "Query all the paymasters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Initialize the client
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Read the pagination flags
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			// Create a new query client
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Call the Paymasters query
+			res, err := queryClient.Paymasters(cmd.Context(), &types.QueryPaymastersRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			// Print the response
+			return clientCtx.PrintProto(res)
+		},
+	}
+	// Add query and pagination flags to the command
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "paymasters")
+	return cmd
+}
+
+// GetCmdQueryPaymasterUsage implements the paymaster usage query command.
+func GetCmdQueryPaymasterUsage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "paymaster-usage [contract] [user]",
+		Short: "Query the fees sponsored by a paymaster for a user and on the current block",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Initialize the client
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Create a new query client
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Call the PaymasterUsage query
+			res, err := queryClient.PaymasterUsage(cmd.Context(), &types.QueryPaymasterUsageRequest{Contract: args[0], User: args[1]})
+			if err != nil {
+				return err
+			}
+
+			// Print the response
+			return clientCtx.PrintProto(res)
+		},
+	}
+	// Add query flags to the command
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -2,10 +2,16 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+
+	"cosmossdk.io/math"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
 )
@@ -22,6 +28,272 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	// Add all the commands and return the CMD
-	cmd.AddCommand()
+	cmd.AddCommand(
+		NewAddFeeTokenCmd(),
+		NewRemoveFeeTokenCmd(),
+		NewSetFeeTokenEnabledCmd(),
+		NewPauseCmd(),
+		NewUnpauseCmd(),
+		NewSetPaymasterCmd(),
+		NewFundPaymasterCmd(),
+		NewRemovePaymasterCmd(),
+	)
+	return cmd
+}
+
+// NewAddFeeTokenCmd implements the add-fee-token tx command
+func NewAddFeeTokenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-fee-token [fee-token-json]",
+		Short: "Add a single fee token (gov proposal)",
+		Long: `Add a single fee token through a governance proposal. Example:
+$ %s tx gov submit-proposal add-fee-token '{"denom":"coin","oracle_denom":"oraclecoin","decimals":6,"price":"0.01","enabled":true}' --from mykey
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Parse the fee token
+			var feeToken types.FeeTokenMetadata
+			if err := clientCtx.Codec.UnmarshalJSON([]byte(args[0]), &feeToken); err != nil {
+				return fmt.Errorf("failed to parse fee token: %w", err)
+			}
+
+			msg := types.NewMessageAddFeeToken(clientCtx.GetFromAddress().String(), feeToken)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRemoveFeeTokenCmd implements the remove-fee-token tx command
+func NewRemoveFeeTokenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-fee-token [denom]",
+		Short: "Remove a single fee token (gov proposal)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMessageRemoveFeeToken(clientCtx.GetFromAddress().String(), args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetFeeTokenEnabledCmd implements the set-fee-token-enabled tx command
+func NewSetFeeTokenEnabledCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-fee-token-enabled [denom] [enabled]",
+		Short: "Enable or disable a single fee token (gov proposal)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Parse the enabled flag
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid enabled flag: %w", err)
+			}
+
+			msg := types.NewMessageSetFeeTokenEnabled(clientCtx.GetFromAddress().String(), args[0], enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewPauseCmd implements the pause tx command
+func NewPauseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause [denom]",
+		Short: "Pause a single fee token or the whole module (guardian)",
+		Long: `Pause a single fee token, or the whole module when no denom is given.
+Only the guardian set on the params or the governance account can pause. Example:
+$ %s tx feeabstraction pause uusdc --from guardian
+`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// An empty denom pauses the whole module
+			denom := ""
+			if len(args) == 1 {
+				denom = args[0]
+			}
+
+			msg := types.NewMessagePause(clientCtx.GetFromAddress().String(), denom)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUnpauseCmd implements the unpause tx command
+func NewUnpauseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unpause [denom]",
+		Short: "Unpause a single fee token or the whole module (gov proposal)",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// An empty denom unpauses the whole module
+			denom := ""
+			if len(args) == 1 {
+				denom = args[0]
+			}
+
+			msg := types.NewMessageUnpause(clientCtx.GetFromAddress().String(), denom)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// Flags for the set-paymaster command
+const (
+	FlagMsgTypes = "msg-types"
+	FlagCallees  = "callees"
+)
+
+// NewSetPaymasterCmd implements the set-paymaster tx command
+func NewSetPaymasterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-paymaster [contract] [per-user-limit] [per-block-limit] [user-window]",
+		Short: "Register or update a paymaster (contract or gov proposal)",
+		Long: `Register or update a paymaster, the limits are native amounts and the user window is in seconds.
+Only the contract itself or the governance account can set a paymaster. Example:
+$ %s tx feeabstraction set-paymaster kii1... 1000000 100000000 86400 --callees kii1... --msg-types /cosmos.bank.v1beta1.MsgSend --from mykey
+`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Parse the limits and the window
+			perUserLimit, ok := math.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid per user limit: %s", args[1])
+			}
+			perBlockLimit, ok := math.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid per block limit: %s", args[2])
+			}
+			userWindow, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid user window: %w", err)
+			}
+
+			// Parse the sponsored message types and callees
+			msgTypes, err := cmd.Flags().GetStringSlice(FlagMsgTypes)
+			if err != nil {
+				return err
+			}
+			callees, err := cmd.Flags().GetStringSlice(FlagCallees)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMessageSetPaymaster(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				msgTypes,
+				callees,
+				perUserLimit,
+				perBlockLimit,
+				userWindow,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagMsgTypes, []string{}, "Cosmos message type URLs sponsored by the paymaster")
+	cmd.Flags().StringSlice(FlagCallees, []string{}, "Contracts whose calls are sponsored by the paymaster")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewFundPaymasterCmd implements the fund-paymaster tx command
+func NewFundPaymasterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-paymaster [contract] [amount]",
+		Short: "Deposit native coins on a paymaster balance",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Parse the amount
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+
+			msg := types.NewMessageFundPaymaster(clientCtx.GetFromAddress().String(), args[0], amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRemovePaymasterCmd implements the remove-paymaster tx command
+func NewRemovePaymasterCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-paymaster [contract]",
+		Short: "Remove a paymaster and return its balance to the contract (contract or gov proposal)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMessageRemovePaymaster(clientCtx.GetFromAddress().String(), args[0])
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
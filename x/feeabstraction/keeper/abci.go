@@ -38,7 +38,7 @@ func (k Keeper) BeginBlocker(ctx context.Context) error {
 // WriteFeeTokenPricesMetrics writes the fee token prices to telemetry metrics
 func (k Keeper) WriteFeeTokenPricesMetrics(ctx context.Context) error {
 	// Get the fee token prices
-	feeTokenPrices, err := k.FeeTokens.Get(ctx)
+	feeTokenPrices, err := k.GetFeeTokens(ctx)
 	if err != nil {
 		return err
 	}
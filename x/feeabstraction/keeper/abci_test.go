@@ -9,7 +9,7 @@ import (
 // TestBeginBlocker tests the BeginBlocker of the fee abstraction module
 func (s *KeeperTestSuite) TestBeginBlocker() {
 	// Set the fee token prices in the keeper
-	err := s.app.FeeAbstractionKeeper.FeeTokens.Set(s.ctx, *types.NewFeeTokenMetadataCollection(
+	err := s.app.FeeAbstractionKeeper.SetFeeTokens(s.ctx, *types.NewFeeTokenMetadataCollection(
 		types.NewFeeTokenMetadata("uatom", "atom", 6, math.LegacyMustNewDecFromStr("50")),
 	))
 	s.Require().NoError(err)
@@ -24,7 +24,7 @@ func (s *KeeperTestSuite) TestBeginBlocker() {
 	s.Require().NoError(s.keeper.BeginBlocker(s.ctx))
 
 	// No change is taken to the fee token (the token is still enabled)
-	feeTokens, err := s.app.FeeAbstractionKeeper.FeeTokens.Get(s.ctx)
+	feeTokens, err := s.app.FeeAbstractionKeeper.GetFeeTokens(s.ctx)
 	s.Require().NoError(err)
 	s.Require().Len(feeTokens.Items, 1)
 	s.Require().True(feeTokens.Items[0].Enabled)
@@ -37,7 +37,7 @@ func (s *KeeperTestSuite) TestBeginBlocker() {
 	s.Require().NoError(s.app.FeeAbstractionKeeper.BeginBlocker(s.ctx))
 
 	// Now the token should be disable due to missing twap
-	feeTokens, err = s.app.FeeAbstractionKeeper.FeeTokens.Get(s.ctx)
+	feeTokens, err = s.app.FeeAbstractionKeeper.GetFeeTokens(s.ctx)
 	s.Require().NoError(err)
 	s.Require().Len(feeTokens.Items, 1)
 	s.Require().False(feeTokens.Items[0].Enabled)
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
+)
+
+// GetConversionUsage returns the usage of the fee token on the rolling conversion cap window
+// The usage recorded before the window is pruned, and an empty usage is returned if the token has none
+func (k Keeper) GetConversionUsage(ctx context.Context, denom string, window uint64) (types.ConversionUsage, error) {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+	// Get the stored usage
+	usage, err := k.ConversionUsages.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.NewConversionUsage(denom), nil
+		}
+		return types.ConversionUsage{}, err
+	}
+
+	// Drop the buckets out of the window
+	return usage.Prune(now, window), nil
+}
+
+// hasConversionCapacity checks if the native-equivalent amount fits under the fee token cap
+func (k Keeper) hasConversionCapacity(ctx sdk.Context, feeToken types.FeeTokenMetadata, amount math.Int, window uint64) (bool, error) {
+	// Tokens without a cap have no limit
+	if !feeToken.HasConversionCap() {
+		return true, nil
+	}
+
+	// Get the current usage and check against the cap
+	usage, err := k.GetConversionUsage(ctx, feeToken.Denom, window)
+	if err != nil {
+		return false, err
+	}
+
+	return amount.LTE(usage.Remaining(feeToken.ConversionCap)), nil
+}
+
+// recordConversion adds the native-equivalent amount to the fee token usage on the rolling window
+func (k Keeper) recordConversion(ctx sdk.Context, feeToken types.FeeTokenMetadata, amount math.Int, window uint64) error {
+	// Only capped tokens are tracked
+	if !feeToken.HasConversionCap() {
+		return nil
+	}
+
+	// Get the current usage and add the amount
+	usage, err := k.GetConversionUsage(ctx, feeToken.Denom, window)
+	if err != nil {
+		return err
+	}
+	usage.Add(ctx.BlockTime().Unix(), window, amount)
+
+	return k.ConversionUsages.Set(ctx, feeToken.Denom, usage)
+}
+
+// GetConversionUsages returns the usage of all the fee tokens
+func (k Keeper) GetConversionUsages(ctx context.Context) ([]types.ConversionUsage, error) {
+	// Iterate all the usages
+	var usages []types.ConversionUsage
+	err := k.ConversionUsages.Walk(ctx, nil, func(_ string, usage types.ConversionUsage) (bool, error) {
+		usages = append(usages, usage)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return usages, nil
+}
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"errors"
+	"strings"
+
 	"github.com/ethereum/go-ethereum/common"
 
 	errorsmod "cosmossdk.io/errors"
@@ -30,8 +33,17 @@ func (k Keeper) ConvertNativeFee(ctx sdk.Context, account sdk.AccAddress, fees s
 		return fees, nil // If the module is disabled, we return the fees as is
 	}
 
+	// Check if the module is paused by the guardian
+	paused, err := k.IsPaused(ctx)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	if paused {
+		return fees, nil // If the module is paused, we return the fees as is
+	}
+
 	// Validate the input fees
-	// We only support a single asset coin for now
+	// We only support a single asset coin as input, the multi token mode may return mixed coins
 	// This is ensured on both Cosmos and EVM sides:
 	// - On Cosmos, when the TX goes though the fee market fee ante handler, it returns the only supported asset as the staking coin
 	// - On EVM we always use the staking coin as the fee coin
@@ -56,8 +68,12 @@ func (k Keeper) ConvertNativeFee(ctx sdk.Context, account sdk.AccAddress, fees s
 	}
 
 	// Convert ERC20 tokens to fees
-	newFee, price, err := k.convertERC20ForFees(ctx, account, fee)
+	newFee, price, err := k.convertERC20ForFees(ctx, account, fee, params.ConversionCapWindow)
 	if err != nil {
+		// If no single token covers the fee, the balances can be combined on the multi token mode
+		if params.MultiTokenFees && (errors.Is(err, errortypes.ErrInsufficientFunds) || errors.Is(err, types.ErrConversionCapReached)) {
+			return k.convertMultiTokenFees(ctx, account, fee, params.ConversionCapWindow)
+		}
 		return sdk.Coins{}, err
 	}
 
@@ -75,6 +91,61 @@ func (k Keeper) ConvertNativeFee(ctx sdk.Context, account sdk.AccAddress, fees s
 	return newFee, nil
 }
 
+// EstimateFee converts a native fee amount to the amount paid with the given denom
+// The native denom returns the fee as is, fee tokens must be enabled, not paused and payable under their fee bounds
+// The user balances and the conversion caps are not checked
+func (k Keeper) EstimateFee(ctx sdk.Context, nativeAmount math.Int, denom string) (sdk.Coin, error) {
+	// Get the module params
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// The native denom is paid as is
+	if denom == params.NativeDenom {
+		return sdk.NewCoin(denom, nativeAmount), nil
+	}
+
+	// Fee tokens are only used while the module is enabled and not paused
+	if !params.Enabled {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrFeeTokenUnavailable, "fee abstraction is disabled")
+	}
+	paused, err := k.IsPaused(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if paused {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrFeeTokenUnavailable, "fee abstraction is paused")
+	}
+
+	// Get the fee token and check if it can be used
+	feeToken, err := k.GetFeeToken(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !feeToken.Enabled {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrFeeTokenUnavailable, "fee token %s is disabled", denom)
+	}
+	tokenPaused, err := k.IsFeeTokenPaused(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if tokenPaused {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrFeeTokenUnavailable, "fee token %s is paused", denom)
+	}
+
+	// Convert the amount
+	amount, payable, err := calculateFeeTokenAmount(feeToken, nativeAmount)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if !payable {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrFeeTokenUnavailable, "fee token %s can't pay the fee %s", denom, nativeAmount)
+	}
+
+	return sdk.NewCoin(denom, amount), nil
+}
+
 // hasSufficientNativeBalance checks if the user has enough balance to pay using the native coin
 func (k Keeper) hasSufficientNativeBalance(ctx sdk.Context, account sdk.AccAddress, fee sdk.Coin) bool {
 	// Then we check if the user has enough balance for the fee
@@ -85,13 +156,17 @@ func (k Keeper) hasSufficientNativeBalance(ctx sdk.Context, account sdk.AccAddre
 // convertERC20ForFees prepares the user balance for fees by converting the native coin to the fee token
 // It checks if the user has enough balance in the native token, if not it tries to
 // convert the ERC20 token to the native token
-func (k Keeper) convertERC20ForFees(ctx sdk.Context, account sdk.AccAddress, fee sdk.Coin) (sdk.Coins, math.LegacyDec, error) {
+// Tokens that reached their conversion cap on the rolling window are skipped
+func (k Keeper) convertERC20ForFees(ctx sdk.Context, account sdk.AccAddress, fee sdk.Coin, capWindow uint64) (sdk.Coins, math.LegacyDec, error) {
 	// Get the fee prices
-	feePrices, err := k.FeeTokens.Get(ctx)
+	feePrices, err := k.GetFeeTokens(ctx)
 	if err != nil {
 		return sdk.Coins{}, math.LegacyDec{}, err
 	}
 
+	// Track the tokens skipped due to the conversion cap
+	var capReached []string
+
 	// Iterate over the fee prices and try to convert the native fee
 	for _, feePrice := range feePrices.Items {
 		// Check if the token is enabled
@@ -99,20 +174,32 @@ func (k Keeper) convertERC20ForFees(ctx sdk.Context, account sdk.AccAddress, fee
 			continue
 		}
 
-		// Convert the amount using the price
-		amountEquivalent, err := types.CalculateTokenAmountWithDecimals(
-			feePrice.Price,
-			fee.Amount,
-			params.BaseDenomUnit,
-			uint64(feePrice.Decimals),
-		)
+		// Check if the token is paused by the guardian
+		paused, err := k.IsFeeTokenPaused(ctx, feePrice.Denom)
 		if err != nil {
 			return sdk.Coins{}, math.LegacyDec{}, err
 		}
-		// Truncate the decimals
-		amountEquivalentInt := amountEquivalent.RoundInt()
-		// If the amount is zero, we skip this fee token
-		if amountEquivalentInt.IsZero() {
+		if paused {
+			continue
+		}
+
+		// Convert the amount using the price with the token markup or discount
+		// The token is skipped if it can't pay the fee
+		amountEquivalentInt, payable, err := calculateFeeTokenAmount(feePrice, fee.Amount)
+		if err != nil {
+			return sdk.Coins{}, math.LegacyDec{}, err
+		}
+		if !payable {
+			continue
+		}
+
+		// Check the token conversion cap, the cap is tracked in the native-equivalent value
+		withinCap, err := k.hasConversionCapacity(ctx, feePrice, fee.Amount, capWindow)
+		if err != nil {
+			return sdk.Coins{}, math.LegacyDec{}, err
+		}
+		if !withinCap {
+			capReached = append(capReached, feePrice.Denom)
 			continue
 		}
 
@@ -122,12 +209,30 @@ func (k Keeper) convertERC20ForFees(ctx sdk.Context, account sdk.AccAddress, fee
 			return sdk.Coins{}, math.LegacyDec{}, err
 		}
 
-		// If all went well we return the selected fee
+		// If all went well we record the conversion and return the selected fee
+		// The price returned is the effective one, as the fee bounds may have changed the amount charged
 		if ok {
-			return sdk.Coins{sdk.NewCoin(feePrice.Denom, amountEquivalentInt)}, feePrice.Price, nil
+			if err := k.recordConversion(ctx, feePrice, fee.Amount, capWindow); err != nil {
+				return sdk.Coins{}, math.LegacyDec{}, err
+			}
+			price, err := types.CalculateEffectivePrice(fee.Amount, amountEquivalentInt, params.BaseDenomUnit, uint64(feePrice.Decimals))
+			if err != nil {
+				return sdk.Coins{}, math.LegacyDec{}, err
+			}
+			return sdk.Coins{sdk.NewCoin(feePrice.Denom, amountEquivalentInt)}, price, nil
 		}
 	}
 
+	// If tokens were skipped due to their cap we return a specific error
+	if len(capReached) > 0 {
+		return sdk.Coins{}, math.LegacyDec{}, errorsmod.Wrapf(
+			types.ErrConversionCapReached,
+			"fee tokens %s reached their cap and no other suitable pair was found for amount %s",
+			strings.Join(capReached, ", "),
+			fee.String(),
+		)
+	}
+
 	// If no suitable pair was found we return an error
 	return sdk.Coins{}, math.LegacyDec{}, errorsmod.Wrapf(
 		errortypes.ErrInsufficientFunds,
@@ -136,6 +241,34 @@ func (k Keeper) convertERC20ForFees(ctx sdk.Context, account sdk.AccAddress, fee
 	)
 }
 
+// calculateFeeTokenAmount converts a native fee amount to the fee token amount
+// The token adjusted price and fee bounds are applied
+// It returns false if the price is zero or the amount is above the token max fee
+func calculateFeeTokenAmount(feeToken types.FeeTokenMetadata, nativeAmount math.Int) (math.Int, bool, error) {
+	// Convert the amount using the price with the token markup or discount
+	amountEquivalent, err := types.CalculateTokenAmountWithDecimals(
+		feeToken.AdjustedPrice(),
+		nativeAmount,
+		params.BaseDenomUnit,
+		uint64(feeToken.Decimals),
+	)
+	if err != nil {
+		return math.Int{}, false, err
+	}
+	// If the price is zero, the token can't pay the fee
+	if amountEquivalent.IsZero() {
+		return math.Int{}, false, nil
+	}
+
+	// Truncate the decimals and apply the token fee bounds
+	amountEquivalentInt, withinBounds := feeToken.BoundFeeAmount(amountEquivalent.RoundInt())
+	if !withinBounds || amountEquivalentInt.IsZero() {
+		return math.Int{}, false, nil
+	}
+
+	return amountEquivalentInt, true, nil
+}
+
 // convertERC20ToNative converts the ERC20 token to the native token
 // It checks if the user has enough balance in the native token, if not it tries to
 // convert the ERC20 token to the native token
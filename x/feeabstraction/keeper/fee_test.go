@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/cosmos/evm/contracts"
 	erc20types "github.com/cosmos/evm/x/erc20/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
 	"github.com/kiichain/kiichain/v4/app/apptesting"
 	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
@@ -28,6 +30,9 @@ func (s *KeeperTestSuite) TestConvertNativeFee() {
 	// Default erc20 address to use in tests
 	DefaultFirstERC20 := "0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd"
 
+	// IBC denom without an erc20 token pair
+	ibcDenom := transfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uatom"}.IBCDenom()
+
 	// Build the test cases
 	testCases := []struct {
 		name        string
@@ -50,6 +55,40 @@ func (s *KeeperTestSuite) TestConvertNativeFee() {
 			fees:     sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000))),
 			expected: sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000))),
 		},
+		{
+			name: "success - nothing happens, module paused",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register a fee token and fund the user with it
+				err := s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec()),
+				))
+				s.Require().NoError(err)
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(1, 6))))
+
+				// Pause the whole module
+				s.Require().NoError(s.keeper.Paused.Set(ctx, true))
+				return ctx
+			},
+			fees:     sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000))),
+			expected: sdk.NewCoins(sdk.NewCoin("akii", math.NewInt(1000))),
+		},
+		{
+			name: "fail - user has insufficient native balance, the only fee token is paused",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register a fee token and fund the user with it
+				err := s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec()),
+				))
+				s.Require().NoError(err)
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(1, 6))))
+
+				// Pause the fee token
+				s.Require().NoError(s.keeper.PausedFeeTokens.Set(ctx, "uatom"))
+				return ctx
+			},
+			fees:        sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 17))),
+			errContains: "insufficient funds for fee",
+		},
 		{
 			name:     "success - no fee tokens, no conversion",
 			fees:     sdk.NewCoins(),
@@ -85,7 +124,7 @@ func (s *KeeperTestSuite) TestConvertNativeFee() {
 			name: "fail - user has insufficient native balance, all tokens are disabled",
 			malleate: func(ctx sdk.Context) sdk.Context {
 				// Register a fee token but do not fund the user with it
-				err := s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+				err := s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
 					types.FeeTokenMetadata{
 						Denom:       "coin",
 						OracleDenom: "oraclecoin",
@@ -105,7 +144,7 @@ func (s *KeeperTestSuite) TestConvertNativeFee() {
 			name: "success - user has sufficient balance, no erc20 unwrap needed",
 			malleate: func(ctx sdk.Context) sdk.Context {
 				// Register a fee token and fund the user with it
-				err := s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+				err := s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
 					// 0.1 atom per kii
 					types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec()),
 				))
@@ -123,7 +162,7 @@ func (s *KeeperTestSuite) TestConvertNativeFee() {
 			name: "success - user has insufficient balance, multiple fee tokens, pay with the middle token",
 			malleate: func(ctx sdk.Context) sdk.Context {
 				// Register multiple fee tokens
-				err := s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+				err := s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
 					types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyMustNewDecFromStr("0.123")),
 					types.NewFeeTokenMetadata("usol", "usoloracle", 9, math.LegacyMustNewDecFromStr("0.125")),
 					types.NewFeeTokenMetadata("mbtc", "btcoracle", 8, math.LegacyMustNewDecFromStr("2")),
@@ -137,30 +176,129 @@ func (s *KeeperTestSuite) TestConvertNativeFee() {
 			fees:     sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 17))),  // 0.1 Kii
 			expected: sdk.NewCoins(sdk.NewCoin("usol", convertToMinimalDenomination(125, 5))), // 0.125 USOL
 		},
+		{
+			name: "success - pay with an ibc token without an erc20 token pair",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register the ibc fee token and fund the user with it
+				err := s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata(ibcDenom, "atomoracle", 6, math.LegacyOneDec()),
+				))
+				s.Require().NoError(err)
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin(ibcDenom, convertToMinimalDenomination(1, 6))))
+				return ctx
+			},
+			fees:     sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 18))),  // 1 Kii
+			expected: sdk.NewCoins(sdk.NewCoin(ibcDenom, convertToMinimalDenomination(1, 6))), // 1 Atom
+		},
+		{
+			name: "fail - ibc token without an erc20 token pair and insufficient balance",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register the ibc fee token without funding the user
+				err := s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata(ibcDenom, "atomoracle", 6, math.LegacyOneDec()),
+				))
+				s.Require().NoError(err)
+				return ctx
+			},
+			fees:        sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 18))), // 1 Kii
+			errContains: "insufficient funds for fee",
+		},
 		{
 			name: "success - user has insufficient balance, multiple fee tokens, pay with the first available token",
 			malleate: func(ctx sdk.Context) sdk.Context {
-				// Register multiple fee tokens
-				err := s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+				// Register multiple fee tokens, they are iterated in denom order
+				err := s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
 					types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyMustNewDecFromStr("0.123")),
 					types.NewFeeTokenMetadata("usol", "usoloracle", 9, math.LegacyMustNewDecFromStr("0.125")),
-					types.NewFeeTokenMetadata("mbtc", "btcoracle", 8, math.LegacyMustNewDecFromStr("2")),
+					types.NewFeeTokenMetadata("wbtc", "btcoracle", 8, math.LegacyMustNewDecFromStr("2")),
 				))
 				s.Require().NoError(err)
 
 				// Fund the user with insufficient native balance
 				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("usol", convertToMinimalDenomination(1, 18))))
-				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("mbtc", convertToMinimalDenomination(1, 18))))
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("wbtc", convertToMinimalDenomination(1, 18))))
 				return ctx
 			},
 			fees:     sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 17))),  // 0.1 Kii
 			expected: sdk.NewCoins(sdk.NewCoin("usol", convertToMinimalDenomination(125, 5))), // 0.00125 usol
 		},
+		{
+			name: "success - markup applied over the token price",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register a fee token with a 10% markup
+				feeToken := types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec())
+				feeToken.PriceAdjustment = math.LegacyMustNewDecFromStr("0.1")
+				err := s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(feeToken))
+				s.Require().NoError(err)
+
+				// Fund the user with the fee token
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(2, 6))))
+				return ctx
+			},
+			fees:     sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 18))), // 1 Kii
+			expected: sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(1_100_000))),             // 1.1 Atom
+		},
+		{
+			name: "success - discount applied over the token price",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register a fee token with a 20% discount
+				feeToken := types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec())
+				feeToken.PriceAdjustment = math.LegacyMustNewDecFromStr("-0.2")
+				err := s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(feeToken))
+				s.Require().NoError(err)
+
+				// Fund the user with the fee token
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(2, 6))))
+				return ctx
+			},
+			fees:     sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 18))), // 1 Kii
+			expected: sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(800_000))),               // 0.8 Atom
+		},
+		{
+			name: "success - fee raised to the token min fee",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register a fee token with a min fee of 2 Atom
+				feeToken := types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec())
+				feeToken.MinFee = math.NewInt(2_000_000)
+				err := s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(feeToken))
+				s.Require().NoError(err)
+
+				// Fund the user with the fee token
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(2, 6))))
+				return ctx
+			},
+			fees:     sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 18))), // 1 Kii
+			expected: sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(2_000_000))),             // 2 Atom
+			postCheck: func(ctx sdk.Context, _ sdk.Coins) {
+				// The event has the effective price of the charged amount
+				s.Require().True(hasEventAttribute(ctx, types.TypeEventConvertFees, types.TypeAttributePrice, math.LegacyNewDec(2).String()))
+			},
+		},
+		{
+			name: "success - token above max fee is skipped",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register two fee tokens, the first one with a max fee of 0.5 Atom
+				firstToken := types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec())
+				firstToken.MaxFee = math.NewInt(500_000)
+				err := s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
+					firstToken,
+					types.NewFeeTokenMetadata("usol", "usoloracle", 9, math.LegacyOneDec()),
+				))
+				s.Require().NoError(err)
+
+				// Fund the user with both fee tokens
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(2, 6))))
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("usol", convertToMinimalDenomination(2, 9))))
+				return ctx
+			},
+			fees:     sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 18))), // 1 Kii
+			expected: sdk.NewCoins(sdk.NewCoin("usol", convertToMinimalDenomination(1, 9))),  // 1 Sol
+		},
 		{
 			name: "fail - token with price as zero",
 			malleate: func(ctx sdk.Context) sdk.Context {
 				// Register a fee token with zero price
-				err := s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+				err := s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
 					types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyZeroDec()),
 				))
 				s.Require().NoError(err)
@@ -195,7 +333,7 @@ func (s *KeeperTestSuite) TestConvertNativeFee() {
 
 				// Register the fee token
 				erc20NativeAddress := "erc20/" + erc20Address.Hex()
-				err = s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+				err = s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
 					types.NewFeeTokenMetadata(
 						erc20NativeAddress,
 						"oracleerc20",
@@ -251,7 +389,7 @@ func (s *KeeperTestSuite) TestConvertNativeFee() {
 
 				// Register the fee token
 				erc20NativeAddress := "erc20/" + erc20Address.Hex()
-				err = s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+				err = s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
 					types.NewFeeTokenMetadata(
 						erc20NativeAddress,
 						"oracleerc20",
@@ -289,7 +427,7 @@ func (s *KeeperTestSuite) TestConvertNativeFee() {
 
 				// Register the fee token
 				erc20NativeAddress := "erc20/" + erc20Address.Hex()
-				err = s.keeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+				err = s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
 					types.NewFeeTokenMetadata(
 						erc20NativeAddress,
 						"oracleerc20",
@@ -321,6 +459,79 @@ func (s *KeeperTestSuite) TestConvertNativeFee() {
 				s.Require().EqualValues(30000, erc20Balance.Int64())
 			},
 		},
+		{
+			name: "success - conversion recorded under the token cap",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register a fee token with a cap of 2 Kii per window
+				feeToken := types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec())
+				feeToken.ConversionCap = convertToMinimalDenomination(2, 18)
+				err := s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(feeToken))
+				s.Require().NoError(err)
+
+				// Fund the user with the fee token
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(2, 6))))
+				return ctx
+			},
+			fees:     sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 18))), // 1 Kii
+			expected: sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(1_000_000))),             // 1 Atom
+			postCheck: func(ctx sdk.Context, _ sdk.Coins) {
+				// The native-equivalent amount is recorded on the window
+				usage, err := s.keeper.ConversionUsages.Get(ctx, "uatom")
+				s.Require().NoError(err)
+				s.Require().Equal(convertToMinimalDenomination(1, 18), usage.Used())
+				s.Require().Len(usage.Buckets, 1)
+			},
+		},
+		{
+			name: "fail - token conversion cap reached",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register a fee token with a cap of 1 Kii per window
+				feeToken := types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec())
+				feeToken.ConversionCap = convertToMinimalDenomination(1, 18)
+				err := s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(feeToken))
+				s.Require().NoError(err)
+
+				// The cap was almost fully used on the rolling window
+				usage := types.NewConversionUsage("uatom")
+				usage.Add(ctx.BlockTime().Unix(), types.DefaultConversionCapWindow, convertToMinimalDenomination(95, 16))
+				s.Require().NoError(s.keeper.ConversionUsages.Set(ctx, "uatom", usage))
+
+				// Fund the user with the fee token
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(2, 6))))
+				return ctx
+			},
+			fees:        sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 17))), // 0.1 Kii
+			errContains: "fee tokens uatom reached their cap and no other suitable pair was found",
+		},
+		{
+			name: "success - token conversion cap usage left the rolling window",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register a fee token with a cap of 1 Kii per window
+				feeToken := types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec())
+				feeToken.ConversionCap = convertToMinimalDenomination(1, 18)
+				err := s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(feeToken))
+				s.Require().NoError(err)
+
+				// The cap was fully used before the rolling window
+				window := types.DefaultConversionCapWindow
+				usage := types.NewConversionUsage("uatom")
+				usage.Add(ctx.BlockTime().Unix()-int64(window)-types.BucketDuration(window), window, convertToMinimalDenomination(1, 18))
+				s.Require().NoError(s.keeper.ConversionUsages.Set(ctx, "uatom", usage))
+
+				// Fund the user with the fee token
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(2, 6))))
+				return ctx
+			},
+			fees:     sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 17))), // 0.1 Kii
+			expected: sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(100_000))),               // 0.1 Atom
+			postCheck: func(ctx sdk.Context, _ sdk.Coins) {
+				// Only the new conversion is left on the window
+				usage, err := s.keeper.ConversionUsages.Get(ctx, "uatom")
+				s.Require().NoError(err)
+				s.Require().Equal(convertToMinimalDenomination(1, 17), usage.Used())
+				s.Require().Len(usage.Buckets, 1)
+			},
+		},
 	}
 
 	// Iterate over the test cases
@@ -356,6 +567,400 @@ func (s *KeeperTestSuite) TestConvertNativeFee() {
 	}
 }
 
+// TestConvertNativeFeeRollingCap tests the conversion cap on both sides of a window boundary
+func (s *KeeperTestSuite) TestConvertNativeFeeRollingCap() {
+	ctx, _ := s.ctx.CacheContext()
+	feePayer := apptesting.RandomAccountAddress()
+	s.app.AccountKeeper.SetAccount(ctx, s.app.AccountKeeper.NewAccountWithAddress(ctx, feePayer))
+
+	// Register a fee token with a cap of 1 Kii per window
+	feeToken := types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec())
+	feeToken.ConversionCap = convertToMinimalDenomination(1, 18)
+	s.Require().NoError(s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(feeToken)))
+	s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("uatom", convertToMinimalDenomination(5, 6))))
+
+	// convert pays a native fee with the fee token at the given time
+	start := ctx.BlockTime()
+	window := time.Duration(types.DefaultConversionCapWindow) * time.Second
+	bucket := time.Duration(types.BucketDuration(types.DefaultConversionCapWindow)) * time.Second
+	convert := func(elapsed time.Duration, kii int) error {
+		_, err := s.keeper.ConvertNativeFee(ctx.WithBlockTime(start.Add(elapsed)), feePayer, sdk.NewCoins(
+			sdk.NewCoin("akii", convertToMinimalDenomination(kii, 17)),
+		))
+		return err
+	}
+
+	// Fill the cap on the two halves of the first window
+	s.Require().NoError(convert(0, 4))
+	s.Require().NoError(convert(window/2, 6))
+	s.Require().Error(convert(window/2, 1))
+
+	// Past the first window only the oldest conversion is released, the cap doesn't reset
+	s.Require().ErrorContains(convert(window+bucket, 5), "reached their cap")
+	s.Require().NoError(convert(window+bucket, 4))
+
+	// The second conversion is released once it leaves the window
+	s.Require().Error(convert(window+window/2, 6))
+	s.Require().NoError(convert(window+window/2+bucket, 6))
+}
+
+// TestConvertNativeFeeMultiToken tests the ConvertNativeFee function on the multi token mode
+func (s *KeeperTestSuite) TestConvertNativeFeeMultiToken() {
+	// Fee payer
+	feePayer := apptesting.RandomAccountAddress()
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, feePayer))
+
+	// Default erc20 address to use in tests
+	DefaultFirstERC20 := "0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd"
+
+	// Build the test cases
+	testCases := []struct {
+		name        string
+		multiToken  bool
+		malleate    func(sdk.Context) sdk.Context
+		fees        sdk.Coins
+		expected    sdk.Coins
+		postCheck   func(sdk.Context, sdk.Coins)
+		errContains string
+	}{
+		{
+			name:       "fail - multi token mode disabled",
+			multiToken: false,
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register a fee token
+				err := s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec()),
+				))
+				s.Require().NoError(err)
+
+				// Fund the user with 40% native and 60% fee token
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(
+					sdk.NewCoin("akii", convertToMinimalDenomination(4, 17)),
+					sdk.NewCoin("uatom", math.NewInt(600_000)),
+				))
+				return ctx
+			},
+			fees:        sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 18))), // 1 Kii
+			errContains: "insufficient funds for fee",
+		},
+		{
+			name:       "success - native and fee token combined",
+			multiToken: true,
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register a fee token
+				err := s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec()),
+				))
+				s.Require().NoError(err)
+
+				// Fund the user with 40% native and 60% fee token
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(
+					sdk.NewCoin("akii", convertToMinimalDenomination(4, 17)),
+					sdk.NewCoin("uatom", math.NewInt(600_000)),
+				))
+				return ctx
+			},
+			fees: sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 18))), // 1 Kii
+			expected: sdk.NewCoins(
+				sdk.NewCoin("akii", convertToMinimalDenomination(4, 17)),
+				sdk.NewCoin("uatom", math.NewInt(600_000)),
+			),
+			postCheck: func(ctx sdk.Context, _ sdk.Coins) {
+				// The breakdown is emitted with a portion for each coin
+				s.Require().True(hasEvent(ctx, types.TypeEventConvertFeesMulti))
+				s.Require().Equal(2, countEvents(ctx, types.TypeEventFeePortion))
+			},
+		},
+		{
+			name:       "success - multiple fee tokens combined in denom order",
+			multiToken: true,
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register two fee tokens
+				err := s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata("uusdc", "usdcoracle", 6, math.LegacyOneDec()),
+					types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec()),
+				))
+				s.Require().NoError(err)
+
+				// Fund the user, no single coin covers the fee and the last token has more than needed
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(
+					sdk.NewCoin("akii", convertToMinimalDenomination(2, 17)),
+					sdk.NewCoin("uatom", math.NewInt(300_000)),
+					sdk.NewCoin("uusdc", math.NewInt(700_000)),
+				))
+				return ctx
+			},
+			fees: sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 18))), // 1 Kii
+			expected: sdk.NewCoins(
+				sdk.NewCoin("akii", convertToMinimalDenomination(2, 17)),
+				sdk.NewCoin("uatom", math.NewInt(300_000)),
+				sdk.NewCoin("uusdc", math.NewInt(500_000)),
+			),
+			postCheck: func(ctx sdk.Context, _ sdk.Coins) {
+				s.Require().Equal(3, countEvents(ctx, types.TypeEventFeePortion))
+			},
+		},
+		{
+			name:       "success - fee token cap limits the portion",
+			multiToken: true,
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register a capped fee token and a second token
+				capped := types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec())
+				capped.ConversionCap = convertToMinimalDenomination(5, 17)
+				err := s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
+					capped,
+					types.NewFeeTokenMetadata("uusdc", "usdcoracle", 6, math.LegacyOneDec()),
+				))
+				s.Require().NoError(err)
+
+				// Fund the user with fee tokens only
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(
+					sdk.NewCoin("uatom", math.NewInt(1_000_000)),
+					sdk.NewCoin("uusdc", math.NewInt(600_000)),
+				))
+				return ctx
+			},
+			fees: sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 18))), // 1 Kii
+			expected: sdk.NewCoins(
+				sdk.NewCoin("uatom", math.NewInt(500_000)),
+				sdk.NewCoin("uusdc", math.NewInt(500_000)),
+			),
+			postCheck: func(ctx sdk.Context, _ sdk.Coins) {
+				// The capped portion is recorded on the window
+				usage, err := s.keeper.ConversionUsages.Get(ctx, "uatom")
+				s.Require().NoError(err)
+				s.Require().Equal(convertToMinimalDenomination(5, 17), usage.Used())
+			},
+		},
+		{
+			name:       "success - portion below the token min fee is skipped",
+			multiToken: true,
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register a fee token with a min fee above the user balance
+				bounded := types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec())
+				bounded.MinFee = math.NewInt(400_000)
+				err := s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
+					bounded,
+					types.NewFeeTokenMetadata("uusdc", "usdcoracle", 6, math.LegacyOneDec()),
+				))
+				s.Require().NoError(err)
+
+				// Fund the user
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(
+					sdk.NewCoin("akii", convertToMinimalDenomination(2, 17)),
+					sdk.NewCoin("uatom", math.NewInt(300_000)),
+					sdk.NewCoin("uusdc", math.NewInt(900_000)),
+				))
+				return ctx
+			},
+			fees: sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 18))), // 1 Kii
+			expected: sdk.NewCoins(
+				sdk.NewCoin("akii", convertToMinimalDenomination(2, 17)),
+				sdk.NewCoin("uusdc", math.NewInt(800_000)),
+			),
+		},
+		{
+			name:       "success - erc20 shortfall converted",
+			multiToken: true,
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Deploy the erc20 token and mint to the fee payer
+				erc20Address, err := apptesting.DeployERC20(ctx, s.app)
+				s.Require().NoError(err)
+				err = apptesting.MintERC20(ctx, s.app, erc20Address, common.BytesToAddress(feePayer.Bytes()), big.NewInt(10000))
+				s.Require().NoError(err)
+
+				// Set the token pair on the erc20 keeper
+				_, err = s.app.Erc20Keeper.RegisterERC20(ctx, &erc20types.MsgRegisterERC20{
+					Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					Erc20Addresses: []string{
+						erc20Address.Hex(),
+					},
+				})
+				s.Require().NoError(err)
+
+				// Register the fee token
+				err = s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata("erc20/"+erc20Address.Hex(), "oracleerc20", 6, math.LegacyOneDec()),
+				))
+				s.Require().NoError(err)
+
+				// Fund the user with half of the fee in native
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 16))))
+				return ctx
+			},
+			fees: sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(2, 16))), // 0.02 Kii
+			expected: sdk.NewCoins(
+				sdk.NewCoin("akii", convertToMinimalDenomination(1, 16)),
+				sdk.NewCoin("erc20/"+DefaultFirstERC20, math.NewInt(10000)),
+			),
+			postCheck: func(ctx sdk.Context, _ sdk.Coins) {
+				// The whole erc20 balance was converted
+				balance := s.app.BankKeeper.GetBalance(ctx, feePayer, "erc20/"+DefaultFirstERC20)
+				s.Require().Equal(math.NewInt(10000), balance.Amount)
+			},
+		},
+		{
+			name:       "fail - combined balances can't cover the fee",
+			multiToken: true,
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Register a fee token
+				err := s.keeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec()),
+				))
+				s.Require().NoError(err)
+
+				// Fund the user with half of the fee
+				s.fundAccount(ctx, feePayer, sdk.NewCoins(
+					sdk.NewCoin("akii", convertToMinimalDenomination(2, 17)),
+					sdk.NewCoin("uatom", math.NewInt(300_000)),
+				))
+				return ctx
+			},
+			fees:        sdk.NewCoins(sdk.NewCoin("akii", convertToMinimalDenomination(1, 18))), // 1 Kii
+			errContains: "insufficient funds to cover fee",
+			postCheck: func(ctx sdk.Context, _ sdk.Coins) {
+				// No breakdown is emitted
+				s.Require().False(hasEvent(ctx, types.TypeEventConvertFeesMulti))
+			},
+		},
+	}
+
+	// Iterate over the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Create a cached context
+			cachedCtx, _ := s.ctx.CacheContext()
+
+			// Set the multi token mode
+			params, err := s.keeper.Params.Get(cachedCtx)
+			s.Require().NoError(err)
+			params.MultiTokenFees = tc.multiToken
+			s.Require().NoError(s.keeper.Params.Set(cachedCtx, params))
+
+			// Malleate the system
+			if tc.malleate != nil {
+				cachedCtx = tc.malleate(cachedCtx)
+			}
+
+			// Call the ConvertNativeFee function
+			convertedFees, err := s.keeper.ConvertNativeFee(cachedCtx, feePayer, tc.fees)
+
+			// Check for expected error
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expected, convertedFees)
+			}
+
+			// Run any post-checks if provided
+			if tc.postCheck != nil {
+				tc.postCheck(cachedCtx, convertedFees)
+			}
+		})
+	}
+}
+
+// TestEstimateFee tests the EstimateFee function
+func (s *KeeperTestSuite) TestEstimateFee() {
+	// Build the test cases
+	testCases := []struct {
+		name        string
+		malleate    func(sdk.Context)
+		nativeFee   math.Int
+		denom       string
+		expected    sdk.Coin
+		errContains string
+	}{
+		{
+			name:      "success - native denom is returned as is",
+			nativeFee: math.NewInt(1000),
+			denom:     "akii",
+			expected:  sdk.NewCoin("akii", math.NewInt(1000)),
+		},
+		{
+			name:      "success - fee token with adjusted price",
+			nativeFee: convertToMinimalDenomination(1, 18), // 1 Kii
+			denom:     "uatom",
+			malleate: func(ctx sdk.Context) {
+				feeToken := types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyNewDec(2))
+				feeToken.PriceAdjustment = math.LegacyMustNewDecFromStr("0.5")
+				s.Require().NoError(s.keeper.FeeTokens.Set(ctx, "uatom", feeToken))
+			},
+			expected: sdk.NewCoin("uatom", math.NewInt(3_000_000)),
+		},
+		{
+			name:        "fail - unknown fee token",
+			nativeFee:   math.NewInt(1000),
+			denom:       "uatom",
+			errContains: "fee token not found",
+		},
+		{
+			name:      "fail - disabled fee token",
+			nativeFee: math.NewInt(1000),
+			denom:     "uatom",
+			malleate: func(ctx sdk.Context) {
+				feeToken := types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec())
+				feeToken.Enabled = false
+				s.Require().NoError(s.keeper.FeeTokens.Set(ctx, "uatom", feeToken))
+			},
+			errContains: "fee token uatom is disabled",
+		},
+		{
+			name:      "fail - paused fee token",
+			nativeFee: math.NewInt(1000),
+			denom:     "uatom",
+			malleate: func(ctx sdk.Context) {
+				s.Require().NoError(s.keeper.FeeTokens.Set(ctx, "uatom", types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec())))
+				s.Require().NoError(s.keeper.PausedFeeTokens.Set(ctx, "uatom"))
+			},
+			errContains: "fee token uatom is paused",
+		},
+		{
+			name:      "fail - fee above the token max fee",
+			nativeFee: convertToMinimalDenomination(1, 18), // 1 Kii
+			denom:     "uatom",
+			malleate: func(ctx sdk.Context) {
+				feeToken := types.NewFeeTokenMetadata("uatom", "atomoracle", 6, math.LegacyOneDec())
+				feeToken.MaxFee = math.NewInt(100)
+				s.Require().NoError(s.keeper.FeeTokens.Set(ctx, "uatom", feeToken))
+			},
+			errContains: "can't pay the fee",
+		},
+		{
+			name:      "fail - module paused",
+			nativeFee: math.NewInt(1000),
+			denom:     "uatom",
+			malleate: func(ctx sdk.Context) {
+				s.Require().NoError(s.keeper.Paused.Set(ctx, true))
+			},
+			errContains: "fee abstraction is paused",
+		},
+	}
+
+	// Iterate over the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Create a cached context
+			cachedCtx, _ := s.ctx.CacheContext()
+
+			// Malleate the system
+			if tc.malleate != nil {
+				tc.malleate(cachedCtx)
+			}
+
+			// Estimate the fee
+			fee, err := s.keeper.EstimateFee(cachedCtx, tc.nativeFee, tc.denom)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expected, fee)
+			}
+		})
+	}
+}
+
 // convertToMinimalDenomination converts a int to a base denom given a decimals
 func convertToMinimalDenomination(amount int, decimals int) math.Int {
 	// Convert it to LegacyDec
@@ -373,3 +978,14 @@ func (s *KeeperTestSuite) fundAccount(ctx sdk.Context, account sdk.AccAddress, a
 	err = s.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, account, amount)
 	s.Require().NoError(err)
 }
+
+// countEvents counts the events of a type emitted on the context
+func countEvents(ctx sdk.Context, eventType string) int {
+	count := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+
+	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
+)
+
+// GetFeeTokens returns all the fee tokens as a collection
+// The tokens are ordered by denom
+func (k Keeper) GetFeeTokens(ctx context.Context) (types.FeeTokenMetadataCollection, error) {
+	// Iterate all the fee tokens
+	var feeTokens types.FeeTokenMetadataCollection
+	err := k.FeeTokens.Walk(ctx, nil, func(_ string, feeToken types.FeeTokenMetadata) (bool, error) {
+		feeTokens.Items = append(feeTokens.Items, feeToken)
+		return false, nil
+	})
+	if err != nil {
+		return types.FeeTokenMetadataCollection{}, err
+	}
+
+	return feeTokens, nil
+}
+
+// SetFeeTokens replaces all the fee tokens with the given collection
+func (k Keeper) SetFeeTokens(ctx context.Context, feeTokens types.FeeTokenMetadataCollection) error {
+	// Remove all the current fee tokens, the new tokens are not tracked by the oracle data guard
+	if err := k.FeeTokens.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.StaleFeeTokens.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	// Store each of the new fee tokens under its denom
+	for _, feeToken := range feeTokens.Items {
+		if err := k.FeeTokens.Set(ctx, feeToken.Denom, feeToken); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetFeeToken returns the fee token registered under the denom
+func (k Keeper) GetFeeToken(ctx context.Context, denom string) (types.FeeTokenMetadata, error) {
+	feeToken, err := k.FeeTokens.Get(ctx, denom)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return types.FeeTokenMetadata{}, errors.Wrapf(types.ErrFeeTokenNotFound, "denom %s", denom)
+		}
+		return types.FeeTokenMetadata{}, err
+	}
+
+	return feeToken, nil
+}
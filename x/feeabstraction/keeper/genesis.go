@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
@@ -19,7 +21,48 @@ func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
 	}
 
 	// Set the fee tokens
-	return k.FeeTokens.Set(ctx, *gs.FeeTokens)
+	if err := k.SetFeeTokens(ctx, *gs.FeeTokens); err != nil {
+		return err
+	}
+
+	// Set the pause state
+	if err := k.Paused.Set(ctx, gs.Paused); err != nil {
+		return err
+	}
+	for _, denom := range gs.PausedFeeTokens {
+		if err := k.PausedFeeTokens.Set(ctx, denom); err != nil {
+			return err
+		}
+	}
+
+	// Set the fee tokens disabled by the oracle data guard
+	for _, denom := range gs.StaleFeeTokens {
+		if err := k.StaleFeeTokens.Set(ctx, denom); err != nil {
+			return err
+		}
+	}
+
+	// Set the conversion usages
+	for _, usage := range gs.ConversionUsages {
+		if err := k.ConversionUsages.Set(ctx, usage.Denom, usage); err != nil {
+			return err
+		}
+	}
+
+	// Set the paymasters and their usages
+	for _, paymaster := range gs.Paymasters {
+		if err := k.Paymasters.Set(ctx, sdk.MustAccAddressFromBech32(paymaster.Contract), paymaster); err != nil {
+			return err
+		}
+	}
+	for _, usage := range gs.PaymasterUsages {
+		key := collections.Join(sdk.MustAccAddressFromBech32(usage.Contract), sdk.MustAccAddressFromBech32(usage.User))
+		if err := k.PaymasterUsages.Set(ctx, key, usage); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // ExportGenesis reads the module collections and return the genesis state
@@ -31,11 +74,50 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (*types.GenesisState, error) {
 	}
 
 	// Get the fee tokens
-	feeTokens, err := k.FeeTokens.Get(ctx)
+	feeTokens, err := k.GetFeeTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the pause state
+	paused, err := k.IsPaused(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pausedFeeTokens, err := k.GetPausedFeeTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the fee tokens disabled by the oracle data guard
+	staleFeeTokens, err := k.GetStaleFeeTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the conversion usages
+	conversionUsages, err := k.GetConversionUsages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the paymasters and their usages
+	paymasters, err := k.GetPaymasters(ctx)
+	if err != nil {
+		return nil, err
+	}
+	paymasterUsages, err := k.GetPaymasterUsages(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	// Return the genesis state
-	return types.NewGenesisState(params, &feeTokens), nil
+	genesis := types.NewGenesisState(params, &feeTokens)
+	genesis.Paused = paused
+	genesis.PausedFeeTokens = pausedFeeTokens
+	genesis.StaleFeeTokens = staleFeeTokens
+	genesis.ConversionUsages = conversionUsages
+	genesis.Paymasters = paymasters
+	genesis.PaymasterUsages = paymasterUsages
+	return genesis, nil
 }
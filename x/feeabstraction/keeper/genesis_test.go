@@ -1,6 +1,13 @@
 package keeper_test
 
-import "github.com/kiichain/kiichain/v4/x/feeabstraction/types"
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v4/app/apptesting"
+	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
+)
 
 // TestGenesisInitExport tests the InitGenesis and ExportGenesis
 func (s *KeeperTestSuite) TestGenesisInitExport() {
@@ -20,6 +27,23 @@ func (s *KeeperTestSuite) TestGenesisInitExport() {
 		types.DefaultTwapLookbackWindow,
 		true,
 	)
+	genesisState.Paused = true
+	genesisState.PausedFeeTokens = []string{"coin"}
+	staleToken := types.NewFeeTokenMetadata("stale", "oraclestale", 6, math.LegacyOneDec())
+	staleToken.Enabled = false
+	genesisState.FeeTokens = types.NewFeeTokenMetadataCollection(staleToken)
+	genesisState.StaleFeeTokens = []string{"stale"}
+	contract := apptesting.RandomAccountAddress().String()
+	genesisState.Paymasters = []types.Paymaster{{
+		Contract:      contract,
+		PerUserLimit:  math.NewInt(100),
+		PerBlockLimit: math.NewInt(1000),
+		UserWindow:    3600,
+		Balance:       math.NewInt(500),
+	}}
+	usage := types.NewPaymasterUsage(contract, apptesting.RandomAccountAddress().String(), s.ctx.BlockTime().Unix())
+	usage.Used = math.NewInt(40)
+	genesisState.PaymasterUsages = []types.PaymasterUsage{usage}
 
 	// Apply the init genesis
 	err = s.keeper.InitGenesis(s.ctx, *genesisState)
@@ -30,6 +54,24 @@ func (s *KeeperTestSuite) TestGenesisInitExport() {
 	s.Require().NoError(err)
 	s.Require().Equal(genesisState.Params, params)
 
+	// Check the pause state after init genesis
+	paused, err := s.keeper.IsPaused(s.ctx)
+	s.Require().NoError(err)
+	s.Require().True(paused)
+	paused, err = s.keeper.IsFeeTokenPaused(s.ctx, "coin")
+	s.Require().NoError(err)
+	s.Require().True(paused)
+
+	// Check the stale fee tokens after init genesis
+	stale, err := s.keeper.StaleFeeTokens.Has(s.ctx, "stale")
+	s.Require().NoError(err)
+	s.Require().True(stale)
+
+	// Check the paymasters after init genesis
+	paymaster, err := s.keeper.GetPaymaster(s.ctx, sdk.MustAccAddressFromBech32(contract))
+	s.Require().NoError(err)
+	s.Require().Equal(genesisState.Paymasters[0], paymaster)
+
 	// Export the genesis state again
 	exportedGenesisState, err := s.keeper.ExportGenesis(s.ctx)
 	s.Require().NoError(err)
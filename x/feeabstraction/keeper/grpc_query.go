@@ -2,6 +2,16 @@ package keeper
 
 import (
 	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
 )
@@ -34,7 +44,7 @@ func (q Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*type
 // FeeTokens queries the fee tokens of the module
 func (q Querier) FeeTokens(ctx context.Context, _ *types.QueryFeeTokensRequest) (*types.QueryFeeTokensResponse, error) {
 	// Get the fee tokens from the keeper
-	feeTokens, err := q.Keeper.FeeTokens.Get(ctx)
+	feeTokens, err := q.Keeper.GetFeeTokens(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -42,3 +52,189 @@ func (q Querier) FeeTokens(ctx context.Context, _ *types.QueryFeeTokensRequest)
 	// Return the response with the fee tokens
 	return &types.QueryFeeTokensResponse{FeeTokens: &feeTokens}, nil
 }
+
+// FeeTokenDenomTraces queries the IBC denom traces of the IBC fee tokens
+func (q Querier) FeeTokenDenomTraces(goCtx context.Context, _ *types.QueryFeeTokenDenomTracesRequest) (*types.QueryFeeTokenDenomTracesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Get the fee tokens from the keeper
+	feeTokens, err := q.Keeper.GetFeeTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve the trace of each IBC fee token
+	denomTraces := make([]types.FeeTokenDenomTrace, 0)
+	for _, feeToken := range feeTokens.Items {
+		if !types.IsIBCDenom(feeToken.Denom) {
+			continue
+		}
+		trace, err := q.Keeper.GetDenomTrace(ctx, feeToken.Denom)
+		if err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		denomTraces = append(denomTraces, types.FeeTokenDenomTrace{
+			Denom:       feeToken.Denom,
+			OracleDenom: feeToken.OracleDenom,
+			Path:        trace.Path,
+			BaseDenom:   trace.BaseDenom,
+		})
+	}
+
+	// Return the response with the denom traces
+	return &types.QueryFeeTokenDenomTracesResponse{DenomTraces: denomTraces}, nil
+}
+
+// PauseState queries the pause state of the module and the fee tokens
+func (q Querier) PauseState(ctx context.Context, _ *types.QueryPauseStateRequest) (*types.QueryPauseStateResponse, error) {
+	// Get the module pause flag
+	paused, err := q.Keeper.IsPaused(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the paused fee tokens
+	pausedFeeTokens, err := q.Keeper.GetPausedFeeTokens(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the response with the pause state
+	return &types.QueryPauseStateResponse{Paused: paused, PausedFeeTokens: pausedFeeTokens}, nil
+}
+
+// ConversionCapacity queries the remaining conversion capacity of a fee token on the rolling window
+func (q Querier) ConversionCapacity(ctx context.Context, req *types.QueryConversionCapacityRequest) (*types.QueryConversionCapacityResponse, error) {
+	// Validate the request
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// Get the fee token
+	feeToken, err := q.Keeper.FeeTokens.Get(ctx, req.Denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "fee token %s not found", req.Denom)
+		}
+		return nil, err
+	}
+
+	// Get the params for the window length
+	params, err := q.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the usage on the rolling window
+	usage, err := q.Keeper.GetConversionUsage(ctx, req.Denom, params.ConversionCapWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tokens without a cap have no remaining capacity to report
+	conversionCap, remaining := math.ZeroInt(), math.ZeroInt()
+	if feeToken.HasConversionCap() {
+		conversionCap = feeToken.ConversionCap
+		remaining = usage.Remaining(conversionCap)
+	}
+
+	// Return the response with the capacity
+	return &types.QueryConversionCapacityResponse{
+		Cap:       conversionCap,
+		Used:      usage.Used(),
+		Remaining: remaining,
+		WindowEnd: usage.WindowEnd(params.ConversionCapWindow),
+	}, nil
+}
+
+// Paymaster queries a single paymaster
+func (q Querier) Paymaster(ctx context.Context, req *types.QueryPaymasterRequest) (*types.QueryPaymasterResponse, error) {
+	// Validate the request
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contract, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid contract address: %s", err)
+	}
+
+	// Get the paymaster
+	paymaster, err := q.Keeper.Paymasters.Get(ctx, contract)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "paymaster %s not found", req.Contract)
+		}
+		return nil, err
+	}
+
+	// Return the response with the paymaster
+	return &types.QueryPaymasterResponse{Paymaster: paymaster}, nil
+}
+
+// Paymasters queries all the paymasters
+func (q Querier) Paymasters(ctx context.Context, req *types.QueryPaymastersRequest) (*types.QueryPaymastersResponse, error) {
+	// Validate the request
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// Paginate the paymasters
+	paymasters, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.Keeper.Paymasters,
+		req.Pagination,
+		func(_ sdk.AccAddress, paymaster types.Paymaster) (types.Paymaster, error) {
+			return paymaster, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// Return the response with the paymasters
+	return &types.QueryPaymastersResponse{Paymasters: paymasters, Pagination: pageRes}, nil
+}
+
+// PaymasterUsage queries the fees sponsored by a paymaster for a user and on the current block
+func (q Querier) PaymasterUsage(ctx context.Context, req *types.QueryPaymasterUsageRequest) (*types.QueryPaymasterUsageResponse, error) {
+	// Validate the request
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	contract, err := sdk.AccAddressFromBech32(req.Contract)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid contract address: %s", err)
+	}
+	user, err := sdk.AccAddressFromBech32(req.User)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user address: %s", err)
+	}
+
+	// Get the paymaster
+	paymaster, err := q.Keeper.Paymasters.Get(ctx, contract)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "paymaster %s not found", req.Contract)
+		}
+		return nil, err
+	}
+
+	// Get the user usage on the current window and the block usage
+	userUsage, err := q.Keeper.GetPaymasterUsage(ctx, contract, user, paymaster.UserWindow)
+	if err != nil {
+		return nil, err
+	}
+	blockUsage, err := q.Keeper.GetPaymasterBlockUsage(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the response with the usage
+	return &types.QueryPaymasterUsageResponse{
+		UserUsed:       userUsage.Used,
+		UserRemaining:  userUsage.Remaining(paymaster.PerUserLimit),
+		WindowEnd:      userUsage.WindowEnd(paymaster.UserWindow),
+		BlockUsed:      blockUsage.Used,
+		BlockRemaining: blockUsage.Remaining(paymaster.PerBlockLimit),
+	}, nil
+}
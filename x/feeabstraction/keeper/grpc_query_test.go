@@ -3,6 +3,13 @@ package keeper_test
 import (
 	"cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/kiichain/kiichain/v4/app/apptesting"
 	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
 )
 
@@ -38,7 +45,7 @@ func (s *KeeperTestSuite) TestQuerierFeeTokens() {
 	)
 
 	// Set the fee tokens in the keeper
-	err := s.keeper.FeeTokens.Set(s.ctx, *newFeeTokens)
+	err := s.keeper.SetFeeTokens(s.ctx, *newFeeTokens)
 	s.Require().NoError(err)
 
 	// Query the fee tokens
@@ -48,3 +55,149 @@ func (s *KeeperTestSuite) TestQuerierFeeTokens() {
 	// Check the response
 	s.Require().Equal(newFeeTokens, res.FeeTokens)
 }
+
+// TestQuerierFeeTokenDenomTraces tests the FeeTokenDenomTraces querier
+func (s *KeeperTestSuite) TestQuerierFeeTokenDenomTraces() {
+	// No IBC fee tokens by default
+	res, err := s.querier.FeeTokenDenomTraces(s.ctx, &types.QueryFeeTokenDenomTracesRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(res.DenomTraces)
+
+	// Register an IBC fee token and a native one
+	ibcTrace := transfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uusdc"}
+	s.app.TransferKeeper.SetDenomTrace(s.ctx, ibcTrace)
+	err = s.keeper.SetFeeTokens(s.ctx, *types.NewFeeTokenMetadataCollection(
+		types.NewFeeTokenMetadata(ibcTrace.IBCDenom(), "usdc", 6, math.LegacyOneDec()),
+		types.NewFeeTokenMetadata("testcoin", "oraclecoin", 6, math.LegacyOneDec()),
+	))
+	s.Require().NoError(err)
+
+	// Only the IBC fee token is returned with its trace
+	res, err = s.querier.FeeTokenDenomTraces(s.ctx, &types.QueryFeeTokenDenomTracesRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.FeeTokenDenomTrace{{
+		Denom:       ibcTrace.IBCDenom(),
+		OracleDenom: "usdc",
+		Path:        "transfer/channel-0",
+		BaseDenom:   "uusdc",
+	}}, res.DenomTraces)
+}
+
+// TestQuerierPauseState tests the PauseState querier
+func (s *KeeperTestSuite) TestQuerierPauseState() {
+	// Nothing is paused by default
+	res, err := s.querier.PauseState(s.ctx, &types.QueryPauseStateRequest{})
+	s.Require().NoError(err)
+	s.Require().False(res.Paused)
+	s.Require().Empty(res.PausedFeeTokens)
+
+	// Pause the module and two fee tokens
+	s.Require().NoError(s.keeper.Paused.Set(s.ctx, true))
+	s.Require().NoError(s.keeper.PausedFeeTokens.Set(s.ctx, "two"))
+	s.Require().NoError(s.keeper.PausedFeeTokens.Set(s.ctx, "one"))
+
+	// Query the pause state
+	res, err = s.querier.PauseState(s.ctx, &types.QueryPauseStateRequest{})
+	s.Require().NoError(err)
+
+	// Check the response
+	s.Require().True(res.Paused)
+	s.Require().Equal([]string{"one", "two"}, res.PausedFeeTokens)
+}
+
+// TestQuerierConversionCapacity tests the ConversionCapacity querier
+func (s *KeeperTestSuite) TestQuerierConversionCapacity() {
+	// Register a capped and an uncapped fee token
+	cappedToken := types.NewFeeTokenMetadata("capped", "oraclecapped", 6, math.LegacyOneDec())
+	cappedToken.ConversionCap = math.NewInt(1000)
+	err := s.keeper.SetFeeTokens(s.ctx, *types.NewFeeTokenMetadataCollection(
+		cappedToken,
+		types.NewFeeTokenMetadata("uncapped", "oracleuncapped", 6, math.LegacyOneDec()),
+	))
+	s.Require().NoError(err)
+
+	// Set some usage on the capped token
+	usedAt := s.ctx.BlockTime().Unix() - 100
+	window := types.DefaultConversionCapWindow
+	usage := types.NewConversionUsage("capped")
+	usage.Add(usedAt, window, math.NewInt(400))
+	s.Require().NoError(s.keeper.ConversionUsages.Set(s.ctx, "capped", usage))
+
+	// Query the capped token
+	res, err := s.querier.ConversionCapacity(s.ctx, &types.QueryConversionCapacityRequest{Denom: "capped"})
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(1000), res.Cap)
+	s.Require().Equal(math.NewInt(400), res.Used)
+	s.Require().Equal(math.NewInt(600), res.Remaining)
+	s.Require().Equal(usage.Buckets[0].Start+types.BucketDuration(window)+int64(window), res.WindowEnd)
+
+	// Query the uncapped token
+	res, err = s.querier.ConversionCapacity(s.ctx, &types.QueryConversionCapacityRequest{Denom: "uncapped"})
+	s.Require().NoError(err)
+	s.Require().True(res.Cap.IsZero())
+	s.Require().True(res.Remaining.IsZero())
+
+	// Query an unknown token
+	_, err = s.querier.ConversionCapacity(s.ctx, &types.QueryConversionCapacityRequest{Denom: "unknown"})
+	s.Require().ErrorContains(err, "fee token unknown not found")
+}
+
+// TestQuerierPaymasters tests the Paymaster and Paymasters queriers
+func (s *KeeperTestSuite) TestQuerierPaymasters() {
+	// Register two paymasters
+	contractA := apptesting.RandomAccountAddress()
+	contractB := apptesting.RandomAccountAddress()
+	s.setupPaymaster(s.ctx, contractA, math.NewInt(500))
+	s.setupPaymaster(s.ctx, contractB, math.NewInt(100))
+
+	// Query a single paymaster
+	res, err := s.querier.Paymaster(s.ctx, &types.QueryPaymasterRequest{Contract: contractA.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(contractA.String(), res.Paymaster.Contract)
+	s.Require().Equal(math.NewInt(500), res.Paymaster.Balance)
+
+	// Query an unknown paymaster
+	_, err = s.querier.Paymaster(s.ctx, &types.QueryPaymasterRequest{Contract: apptesting.RandomAccountAddress().String()})
+	s.Require().ErrorContains(err, "not found")
+
+	// Query an invalid address
+	_, err = s.querier.Paymaster(s.ctx, &types.QueryPaymasterRequest{Contract: "invalid"})
+	s.Require().ErrorContains(err, "invalid contract address")
+
+	// Query all the paymasters with pagination
+	allRes, err := s.querier.Paymasters(s.ctx, &types.QueryPaymastersRequest{Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	s.Require().NoError(err)
+	s.Require().Len(allRes.Paymasters, 1)
+	s.Require().Equal(uint64(2), allRes.Pagination.Total)
+}
+
+// TestQuerierPaymasterUsage tests the PaymasterUsage querier
+func (s *KeeperTestSuite) TestQuerierPaymasterUsage() {
+	contract := apptesting.RandomAccountAddress()
+	user := apptesting.RandomAccountAddress()
+	s.setupPaymaster(s.ctx, contract, math.NewInt(500))
+
+	// Charge the paymaster for the user
+	fees := sdk.NewCoins(sdk.NewInt64Coin(s.nativeDenom(), 30))
+	err := s.keeper.ChargePaymaster(s.ctx, contract, user, []sdk.Msg{&banktypes.MsgSend{ToAddress: contract.String()}}, fees)
+	s.Require().NoError(err)
+
+	// Query the usage
+	res, err := s.querier.PaymasterUsage(s.ctx, &types.QueryPaymasterUsageRequest{Contract: contract.String(), User: user.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(30), res.UserUsed)
+	s.Require().Equal(math.NewInt(70), res.UserRemaining)
+	s.Require().Equal(s.ctx.BlockTime().Unix()+3600, res.WindowEnd)
+	s.Require().Equal(math.NewInt(30), res.BlockUsed)
+	s.Require().Equal(math.NewInt(970), res.BlockRemaining)
+
+	// Query the usage of another user
+	res, err = s.querier.PaymasterUsage(s.ctx, &types.QueryPaymasterUsageRequest{Contract: contract.String(), User: apptesting.RandomAccountAddress().String()})
+	s.Require().NoError(err)
+	s.Require().True(res.UserUsed.IsZero())
+	s.Require().Equal(math.NewInt(100), res.UserRemaining)
+
+	// Query an unknown paymaster
+	_, err = s.querier.PaymasterUsage(s.ctx, &types.QueryPaymasterUsageRequest{Contract: apptesting.RandomAccountAddress().String(), User: user.String()})
+	s.Require().ErrorContains(err, "not found")
+}
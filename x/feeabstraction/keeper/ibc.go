@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
+)
+
+// GetDenomTrace returns the denom trace of an IBC voucher denom (ibc/{hash})
+// The trace must be known by the IBC transfer module
+func (k Keeper) GetDenomTrace(ctx sdk.Context, denom string) (transfertypes.DenomTrace, error) {
+	// Parse the hash from the denom
+	if !types.IsIBCDenom(denom) {
+		return transfertypes.DenomTrace{}, errorsmod.Wrapf(types.ErrUnknownDenomTrace, "denom %s is not an ibc denom", denom)
+	}
+	hash, err := transfertypes.ParseHexHash(strings.TrimPrefix(denom, transfertypes.DenomPrefix+"/"))
+	if err != nil {
+		return transfertypes.DenomTrace{}, errorsmod.Wrapf(types.ErrUnknownDenomTrace, "invalid ibc denom %s: %s", denom, err)
+	}
+
+	// Get the trace from the transfer module
+	trace, found := k.transferKeeper.GetDenomTrace(ctx, hash)
+	if !found {
+		return transfertypes.DenomTrace{}, errorsmod.Wrapf(types.ErrUnknownDenomTrace, "denom %s", denom)
+	}
+
+	return trace, nil
+}
+
+// ResolveFeeTokenDenom returns the denom used to hold a fee token on the bank module
+// Denom trace paths ({port}/{channel}/{base_denom}) are resolved into their IBC voucher denom,
+// which lets governance map the oracle denom of an IBC token by its trace path
+func (k Keeper) ResolveFeeTokenDenom(ctx sdk.Context, denom string) (string, error) {
+	// Other denoms are used as is
+	if !types.IsDenomTracePath(denom) {
+		return denom, nil
+	}
+
+	// Build the voucher denom and check that the trace is known
+	trace := transfertypes.ParseDenomTrace(denom)
+	if err := trace.Validate(); err != nil {
+		return "", errorsmod.Wrapf(types.ErrUnknownDenomTrace, "invalid denom trace path %s: %s", denom, err)
+	}
+	ibcDenom := trace.IBCDenom()
+	if _, err := k.GetDenomTrace(ctx, ibcDenom); err != nil {
+		return "", err
+	}
+
+	return ibcDenom, nil
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TestGetDenomTrace tests the GetDenomTrace function
+func (s *KeeperTestSuite) TestGetDenomTrace() {
+	ibcTrace := transfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uusdc"}
+
+	// Prepare the test cases
+	testCases := []struct {
+		name        string
+		denom       string
+		malleate    func(ctx sdk.Context)
+		errContains string
+	}{
+		{
+			name:  "valid - known denom trace",
+			denom: ibcTrace.IBCDenom(),
+			malleate: func(ctx sdk.Context) {
+				s.app.TransferKeeper.SetDenomTrace(ctx, ibcTrace)
+			},
+		},
+		{
+			name:        "invalid - unknown denom trace",
+			denom:       ibcTrace.IBCDenom(),
+			errContains: "unknown ibc denom trace",
+		},
+		{
+			name:        "invalid - not an ibc denom",
+			denom:       "uusdc",
+			errContains: "denom uusdc is not an ibc denom",
+		},
+		{
+			name:        "invalid - bad ibc hash",
+			denom:       "ibc/hash",
+			errContains: "invalid ibc denom ibc/hash",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Set a cached context
+			cachedCtx, _ := s.ctx.CacheContext()
+
+			// Malleate the context
+			if tc.malleate != nil {
+				tc.malleate(cachedCtx)
+			}
+
+			// Call the function under test
+			trace, err := s.keeper.GetDenomTrace(cachedCtx, tc.denom)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(ibcTrace, trace)
+			}
+		})
+	}
+}
+
+// TestResolveFeeTokenDenom tests the ResolveFeeTokenDenom function
+func (s *KeeperTestSuite) TestResolveFeeTokenDenom() {
+	ibcTrace := transfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uusdc"}
+
+	// Prepare the test cases
+	testCases := []struct {
+		name        string
+		denom       string
+		malleate    func(ctx sdk.Context)
+		expDenom    string
+		errContains string
+	}{
+		{
+			name:     "valid - native denom is used as is",
+			denom:    "uatom",
+			expDenom: "uatom",
+		},
+		{
+			name:     "valid - ibc denom is used as is",
+			denom:    ibcTrace.IBCDenom(),
+			expDenom: ibcTrace.IBCDenom(),
+		},
+		{
+			name:     "valid - tokenfactory denom is used as is",
+			denom:    "factory/kii1qqqsyqcyq5rqwzqfpg9scrgwpugpzysn0nxmwt/coin",
+			expDenom: "factory/kii1qqqsyqcyq5rqwzqfpg9scrgwpugpzysn0nxmwt/coin",
+		},
+		{
+			name:  "valid - trace path is resolved into the ibc denom",
+			denom: ibcTrace.GetFullDenomPath(),
+			malleate: func(ctx sdk.Context) {
+				s.app.TransferKeeper.SetDenomTrace(ctx, ibcTrace)
+			},
+			expDenom: ibcTrace.IBCDenom(),
+		},
+		{
+			name:        "invalid - trace path with an unknown denom trace",
+			denom:       ibcTrace.GetFullDenomPath(),
+			errContains: "unknown ibc denom trace",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Set a cached context
+			cachedCtx, _ := s.ctx.CacheContext()
+
+			// Malleate the context
+			if tc.malleate != nil {
+				tc.malleate(cachedCtx)
+			}
+
+			// Call the function under test
+			denom, err := s.keeper.ResolveFeeTokenDenom(cachedCtx, tc.denom)
+			if tc.errContains != "" {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expDenom, denom)
+			}
+		})
+	}
+}
@@ -15,21 +15,32 @@ import (
 
 // Keeper defines the fee abstraction module keeper
 type Keeper struct {
-	// The chain codecs
-	cdc codec.BinaryCodec
+	// The chain codecs and store
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
 
 	// Modules used on the keeper
-	bankKeeper   types.BankKeeper
-	erc20Keeper  types.Erc20Keeper
-	oracleKeeper types.OracleKeeper
+	bankKeeper     types.BankKeeper
+	erc20Keeper    types.Erc20Keeper
+	evmKeeper      types.EVMKeeper
+	oracleKeeper   types.OracleKeeper
+	transferKeeper types.TransferKeeper
+	wasmKeeper     types.WasmKeeper
 
 	// The governance authority
 	authority string
 
 	// The schema and the different entries on collections
-	Schema    collections.Schema
-	Params    collections.Item[types.Params]
-	FeeTokens collections.Item[types.FeeTokenMetadataCollection]
+	Schema               collections.Schema
+	Params               collections.Item[types.Params]
+	FeeTokens            collections.Map[string, types.FeeTokenMetadata]
+	Paused               collections.Item[bool]
+	PausedFeeTokens      collections.KeySet[string]
+	ConversionUsages     collections.Map[string, types.ConversionUsage]
+	Paymasters           collections.Map[sdk.AccAddress, types.Paymaster]
+	PaymasterUsages      collections.Map[collections.Pair[sdk.AccAddress, sdk.AccAddress], types.PaymasterUsage]
+	PaymasterBlockUsages collections.Map[sdk.AccAddress, types.PaymasterBlockUsage]
+	StaleFeeTokens       collections.KeySet[string]
 }
 
 // NewKeeper creates a new instance of the Keeper
@@ -37,6 +48,7 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService store.KVStoreService,
 	erc20Keeper types.Erc20Keeper, bankKeeper types.BankKeeper, oracleKeeper types.OracleKeeper,
+	transferKeeper types.TransferKeeper, evmKeeper types.EVMKeeper,
 	authority string,
 ) Keeper {
 	// Start a new schema builder
@@ -44,13 +56,27 @@ func NewKeeper(
 
 	// Initialize the keeper
 	k := Keeper{
-		cdc:          cdc,
-		erc20Keeper:  erc20Keeper,
-		bankKeeper:   bankKeeper,
-		oracleKeeper: oracleKeeper,
-		authority:    authority,
-		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		FeeTokens:    collections.NewItem(sb, types.FeeTokensKey, "fee_tokens", codec.CollValue[types.FeeTokenMetadataCollection](cdc)),
+		cdc:              cdc,
+		storeService:     storeService,
+		erc20Keeper:      erc20Keeper,
+		bankKeeper:       bankKeeper,
+		oracleKeeper:     oracleKeeper,
+		transferKeeper:   transferKeeper,
+		evmKeeper:        evmKeeper,
+		authority:        authority,
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		FeeTokens:        collections.NewMap(sb, types.FeeTokensKey, "fee_tokens", collections.StringKey, codec.CollValue[types.FeeTokenMetadata](cdc)),
+		Paused:           collections.NewItem(sb, types.PausedKey, "paused", collections.BoolValue),
+		PausedFeeTokens:  collections.NewKeySet(sb, types.PausedFeeTokensKey, "paused_fee_tokens", collections.StringKey),
+		ConversionUsages: collections.NewMap(sb, types.ConversionUsagesKey, "conversion_usages", collections.StringKey, codec.CollValue[types.ConversionUsage](cdc)),
+		Paymasters:       collections.NewMap(sb, types.PaymastersKey, "paymasters", sdk.AccAddressKey, codec.CollValue[types.Paymaster](cdc)),
+		PaymasterUsages: collections.NewMap(
+			sb, types.PaymasterUsagesKey, "paymaster_usages",
+			collections.PairKeyCodec(sdk.AccAddressKey, sdk.AccAddressKey),
+			codec.CollValue[types.PaymasterUsage](cdc),
+		),
+		PaymasterBlockUsages: collections.NewMap(sb, types.PaymasterBlockUsageKey, "paymaster_block_usages", sdk.AccAddressKey, codec.CollValue[types.PaymasterBlockUsage](cdc)),
+		StaleFeeTokens:       collections.NewKeySet(sb, types.StaleFeeTokensKey, "stale_fee_tokens", collections.StringKey),
 	}
 
 	// Build the schema
@@ -64,6 +90,12 @@ func NewKeeper(
 	return k
 }
 
+// SetWasmKeeper sets the wasm keeper used to check the paymaster contracts
+// The wasm keeper is created after this keeper, so it can't be given on the constructor
+func (k *Keeper) SetWasmKeeper(wasmKeeper types.WasmKeeper) {
+	k.wasmKeeper = wasmKeeper
+}
+
 // GetAuthority returns the module authority
 func (k Keeper) GetAuthority() string {
 	return k.authority
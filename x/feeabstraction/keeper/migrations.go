@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/kiichain/kiichain/v4/x/feeabstraction/migrations/v2"
+	v3 "github.com/kiichain/kiichain/v4/x/feeabstraction/migrations/v3"
+	v4 "github.com/kiichain/kiichain/v4/x/feeabstraction/migrations/v4"
+)
+
+// Migrator is a struct for handling in-place store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the fee tokens from a single collection item into a map keyed by denom
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate2to3 sets the default conversion cap window param
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate3to4 sets the default max price age and min twap coverage params
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
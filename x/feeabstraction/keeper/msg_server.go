@@ -2,8 +2,11 @@ package keeper
 
 import (
 	"context"
+	"slices"
+	"strconv"
 
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -54,7 +57,7 @@ func (ms MsgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 }
 
 // UpdateFeeTokens updates the fee tokens through a proposal
-func (ms MsgServer) UpdateFeeTokens(ctx context.Context, msg *types.MsgUpdateFeeTokens) (*types.MsgUpdateFeeTokensResponse, error) {
+func (ms MsgServer) UpdateFeeTokens(goCtx context.Context, msg *types.MsgUpdateFeeTokens) (*types.MsgUpdateFeeTokensResponse, error) {
 	// Check the authority
 	if err := ms.validateAuthority(msg.Authority); err != nil {
 		return nil, err
@@ -67,9 +70,16 @@ func (ms MsgServer) UpdateFeeTokens(ctx context.Context, msg *types.MsgUpdateFee
 	if err := msg.Validate(); err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
 	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Resolve the denom trace paths into their IBC denoms
+	feeTokens, err := ms.resolveFeeTokenDenoms(ctx, msg.FeeTokens)
+	if err != nil {
+		return nil, err
+	}
 
 	// Check if all the oracle denoms are registered on the oracle module
-	voteTargets, err := ms.oracleKeeper.GetVoteTargets(sdk.UnwrapSDKContext(ctx))
+	voteTargets, err := ms.oracleKeeper.GetVoteTargets(ctx)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to get oracle vote targets: %s", err)
 	}
@@ -77,14 +87,14 @@ func (ms MsgServer) UpdateFeeTokens(ctx context.Context, msg *types.MsgUpdateFee
 	for _, denom := range voteTargets {
 		voteTargetMap[denom] = struct{}{}
 	}
-	for _, feeToken := range msg.FeeTokens.Items {
+	for _, feeToken := range feeTokens.Items {
 		if _, ok := voteTargetMap[feeToken.OracleDenom]; !ok {
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf("fee token denom %s is not registered on the oracle module", feeToken.OracleDenom)
 		}
 	}
 
 	// Update the fee tokens
-	if err := ms.FeeTokens.Set(ctx, msg.FeeTokens); err != nil {
+	if err := ms.SetFeeTokens(ctx, feeTokens); err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to update fee tokens: %s", err)
 	}
 
@@ -92,6 +102,559 @@ func (ms MsgServer) UpdateFeeTokens(ctx context.Context, msg *types.MsgUpdateFee
 	return &types.MsgUpdateFeeTokensResponse{}, nil
 }
 
+// UpdateFeeTokenPricing updates the pricing adjustment and fee bounds of a single fee token through a proposal
+func (ms MsgServer) UpdateFeeTokenPricing(goCtx context.Context, msg *types.MsgUpdateFeeTokenPricing) (*types.MsgUpdateFeeTokenPricingResponse, error) {
+	// Check the authority
+	if err := ms.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	// Validate the message
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg cannot be nil")
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Get the fee token
+	feeToken, err := ms.GetFeeToken(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update only the pricing values
+	feeToken.PriceAdjustment = msg.PriceAdjustment
+	feeToken.MinFee = msg.MinFee
+	feeToken.MaxFee = msg.MaxFee
+
+	// Save the fee token
+	if err := ms.FeeTokens.Set(ctx, feeToken.Denom, feeToken); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to update fee token: %s", err)
+	}
+
+	// Emit the event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventUpdateFeeTokenPricing,
+			sdk.NewAttribute(types.TypeAttributeDenom, feeToken.Denom),
+			sdk.NewAttribute(types.TypeAttributePriceAdjustment, feeToken.PriceAdjustment.String()),
+			sdk.NewAttribute(types.TypeAttributeMinFee, feeToken.MinFee.String()),
+			sdk.NewAttribute(types.TypeAttributeMaxFee, feeToken.MaxFee.String()),
+		),
+	)
+
+	// Return the response
+	return &types.MsgUpdateFeeTokenPricingResponse{}, nil
+}
+
+// AddFeeToken adds a single fee token through a proposal
+func (ms MsgServer) AddFeeToken(goCtx context.Context, msg *types.MsgAddFeeToken) (*types.MsgAddFeeTokenResponse, error) {
+	// Check the authority
+	if err := ms.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	// Validate the message
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg cannot be nil")
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Resolve the denom trace path into its IBC denom
+	feeToken := msg.FeeToken
+	denom, err := ms.ResolveFeeTokenDenom(ctx, feeToken.Denom)
+	if err != nil {
+		return nil, err
+	}
+	feeToken.Denom = denom
+
+	// The fee token must not be registered yet
+	exists, err := ms.FeeTokens.Has(ctx, feeToken.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.Wrapf(types.ErrFeeTokenAlreadyExists, "denom %s", feeToken.Denom)
+	}
+
+	// Check the token against the erc20 token pairs or ibc denom traces and the oracle vote targets
+	if err := ms.validateFeeTokenRegistration(ctx, feeToken); err != nil {
+		return nil, err
+	}
+
+	// Save the new fee token
+	if err := ms.FeeTokens.Set(ctx, feeToken.Denom, feeToken); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to add fee token: %s", err)
+	}
+
+	// Emit the event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventAddFeeToken,
+			sdk.NewAttribute(types.TypeAttributeDenom, feeToken.Denom),
+			sdk.NewAttribute(types.TypeAttributeOracleDenom, feeToken.OracleDenom),
+			sdk.NewAttribute(types.TypeAttributeEnabled, strconv.FormatBool(feeToken.Enabled)),
+		),
+	)
+
+	// Return the response
+	return &types.MsgAddFeeTokenResponse{}, nil
+}
+
+// RemoveFeeToken removes a single fee token through a proposal
+func (ms MsgServer) RemoveFeeToken(goCtx context.Context, msg *types.MsgRemoveFeeToken) (*types.MsgRemoveFeeTokenResponse, error) {
+	// Check the authority
+	if err := ms.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	// Validate the message
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg cannot be nil")
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check that the fee token exists
+	feeToken, err := ms.GetFeeToken(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove the fee token, its pause and stale flags and its conversion usage
+	if err := ms.FeeTokens.Remove(ctx, feeToken.Denom); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to remove fee token: %s", err)
+	}
+	if err := ms.PausedFeeTokens.Remove(ctx, feeToken.Denom); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to remove fee token pause flag: %s", err)
+	}
+	if err := ms.StaleFeeTokens.Remove(ctx, feeToken.Denom); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to remove fee token stale flag: %s", err)
+	}
+	if err := ms.ConversionUsages.Remove(ctx, feeToken.Denom); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to remove fee token conversion usage: %s", err)
+	}
+
+	// Emit the event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventRemoveFeeToken,
+			sdk.NewAttribute(types.TypeAttributeDenom, feeToken.Denom),
+			sdk.NewAttribute(types.TypeAttributeOracleDenom, feeToken.OracleDenom),
+		),
+	)
+
+	// Return the response
+	return &types.MsgRemoveFeeTokenResponse{}, nil
+}
+
+// SetFeeTokenEnabled enables or disables a single fee token through a proposal
+func (ms MsgServer) SetFeeTokenEnabled(goCtx context.Context, msg *types.MsgSetFeeTokenEnabled) (*types.MsgSetFeeTokenEnabledResponse, error) {
+	// Check the authority
+	if err := ms.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	// Validate the message
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg cannot be nil")
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Get the fee token
+	feeToken, err := ms.GetFeeToken(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// A token can only be enabled if it's still registered as an erc20 token pair and on the oracle
+	if msg.Enabled {
+		if err := ms.validateFeeTokenRegistration(ctx, feeToken); err != nil {
+			return nil, err
+		}
+	}
+
+	// Update the enabled flag
+	feeToken.Enabled = msg.Enabled
+	if err := ms.FeeTokens.Set(ctx, feeToken.Denom, feeToken); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to update fee token: %s", err)
+	}
+
+	// The governance decision overrides the oracle data guard
+	if err := ms.StaleFeeTokens.Remove(ctx, feeToken.Denom); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to remove fee token stale flag: %s", err)
+	}
+
+	// Emit the event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventSetFeeTokenEnabled,
+			sdk.NewAttribute(types.TypeAttributeDenom, feeToken.Denom),
+			sdk.NewAttribute(types.TypeAttributeEnabled, strconv.FormatBool(feeToken.Enabled)),
+		),
+	)
+
+	// Return the response
+	return &types.MsgSetFeeTokenEnabledResponse{}, nil
+}
+
+// Pause pauses a single fee token or the whole module
+// It can be executed by the guardian or the governance account
+func (ms MsgServer) Pause(goCtx context.Context, msg *types.MsgPause) (*types.MsgPauseResponse, error) {
+	// Validate the message
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg cannot be nil")
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the sender is the guardian or the governance account
+	params, err := ms.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Sender != ms.authority && (params.Guardian == "" || msg.Sender != params.Guardian) {
+		return nil, errors.Wrapf(types.ErrUnauthorizedPauser, "sender %s", msg.Sender)
+	}
+
+	// An empty denom pauses the whole module
+	if msg.Denom == "" {
+		paused, err := ms.IsPaused(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if paused {
+			return nil, errors.Wrap(types.ErrAlreadyPaused, "module")
+		}
+		if err := ms.Paused.Set(ctx, true); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to pause the module: %s", err)
+		}
+	} else {
+		// Check that the fee token exists and is not paused
+		if _, err := ms.GetFeeToken(ctx, msg.Denom); err != nil {
+			return nil, err
+		}
+		paused, err := ms.IsFeeTokenPaused(ctx, msg.Denom)
+		if err != nil {
+			return nil, err
+		}
+		if paused {
+			return nil, errors.Wrapf(types.ErrAlreadyPaused, "denom %s", msg.Denom)
+		}
+		if err := ms.PausedFeeTokens.Set(ctx, msg.Denom); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to pause fee token: %s", err)
+		}
+	}
+
+	// Emit the event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventPause,
+			sdk.NewAttribute(types.TypeAttributeSender, msg.Sender),
+			sdk.NewAttribute(types.TypeAttributeDenom, msg.Denom),
+		),
+	)
+
+	// Return the response
+	return &types.MsgPauseResponse{}, nil
+}
+
+// Unpause unpauses a single fee token or the whole module through a proposal
+func (ms MsgServer) Unpause(goCtx context.Context, msg *types.MsgUnpause) (*types.MsgUnpauseResponse, error) {
+	// Check the authority
+	if err := ms.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	// Validate the message
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg cannot be nil")
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// An empty denom unpauses the whole module
+	if msg.Denom == "" {
+		paused, err := ms.IsPaused(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !paused {
+			return nil, errors.Wrap(types.ErrNotPaused, "module")
+		}
+		if err := ms.Paused.Set(ctx, false); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to unpause the module: %s", err)
+		}
+	} else {
+		// Check that the fee token is paused
+		paused, err := ms.IsFeeTokenPaused(ctx, msg.Denom)
+		if err != nil {
+			return nil, err
+		}
+		if !paused {
+			return nil, errors.Wrapf(types.ErrNotPaused, "denom %s", msg.Denom)
+		}
+		if err := ms.PausedFeeTokens.Remove(ctx, msg.Denom); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to unpause fee token: %s", err)
+		}
+	}
+
+	// Emit the event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventUnpause,
+			sdk.NewAttribute(types.TypeAttributeSender, msg.Authority),
+			sdk.NewAttribute(types.TypeAttributeDenom, msg.Denom),
+		),
+	)
+
+	// Return the response
+	return &types.MsgUnpauseResponse{}, nil
+}
+
+// SetPaymaster registers or updates a paymaster, the balance of an existing paymaster is kept
+// It can be executed by the paymaster contract or the governance account
+func (ms MsgServer) SetPaymaster(goCtx context.Context, msg *types.MsgSetPaymaster) (*types.MsgSetPaymasterResponse, error) {
+	// Validate the message
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg cannot be nil")
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the sender is the contract or the governance account
+	if err := ms.validatePaymasterSender(ctx, msg.Sender, msg.Contract); err != nil {
+		return nil, err
+	}
+
+	// Keep the balance of an existing paymaster
+	contract := sdk.MustAccAddressFromBech32(msg.Contract)
+	balance := math.ZeroInt()
+	found, err := ms.HasPaymaster(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		existing, err := ms.GetPaymaster(ctx, contract)
+		if err != nil {
+			return nil, err
+		}
+		balance = existing.Balance
+	}
+
+	// Set the paymaster
+	paymaster := msg.Paymaster(balance)
+	if err := ms.Paymasters.Set(ctx, contract, paymaster); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to set paymaster: %s", err)
+	}
+
+	// Emit the event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventSetPaymaster,
+			sdk.NewAttribute(types.TypeAttributeSender, msg.Sender),
+			sdk.NewAttribute(types.TypeAttributeContract, msg.Contract),
+		),
+	)
+
+	// Return the response
+	return &types.MsgSetPaymasterResponse{}, nil
+}
+
+// FundPaymaster deposits native coins on a paymaster balance
+// It can be executed by any account
+func (ms MsgServer) FundPaymaster(goCtx context.Context, msg *types.MsgFundPaymaster) (*types.MsgFundPaymasterResponse, error) {
+	// Validate the message
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg cannot be nil")
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Get the paymaster
+	contract := sdk.MustAccAddressFromBech32(msg.Contract)
+	paymaster, err := ms.GetPaymaster(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	// The balance is held on the native denom
+	params, err := ms.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Amount.Denom != params.NativeDenom {
+		return nil, errors.Wrapf(types.ErrInvalidPaymaster, "paymasters are funded in %s, got %s", params.NativeDenom, msg.Amount.Denom)
+	}
+
+	// Move the coins to the module account
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	if err := ms.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	// Update the balance
+	paymaster.Balance = paymaster.Balance.Add(msg.Amount.Amount)
+	if err := ms.Paymasters.Set(ctx, contract, paymaster); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to fund paymaster: %s", err)
+	}
+
+	// Emit the event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventFundPaymaster,
+			sdk.NewAttribute(types.TypeAttributeSender, msg.Sender),
+			sdk.NewAttribute(types.TypeAttributeContract, msg.Contract),
+			sdk.NewAttribute(types.TypeAttributeAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.TypeAttributeBalance, paymaster.Balance.String()),
+		),
+	)
+
+	// Return the response
+	return &types.MsgFundPaymasterResponse{}, nil
+}
+
+// RemovePaymaster removes a paymaster and returns its balance to the contract
+// It can be executed by the paymaster contract or the governance account
+func (ms MsgServer) RemovePaymaster(goCtx context.Context, msg *types.MsgRemovePaymaster) (*types.MsgRemovePaymasterResponse, error) {
+	// Validate the message
+	if msg == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("msg cannot be nil")
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid message: %s", err)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Check if the sender is the contract or the governance account
+	if err := ms.validatePaymasterSender(ctx, msg.Sender, msg.Contract); err != nil {
+		return nil, err
+	}
+
+	// Get the paymaster
+	contract := sdk.MustAccAddressFromBech32(msg.Contract)
+	paymaster, err := ms.GetPaymaster(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the balance to the contract
+	if paymaster.Balance.IsPositive() {
+		params, err := ms.Params.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		refund := sdk.NewCoins(sdk.NewCoin(params.NativeDenom, paymaster.Balance))
+		if err := ms.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, contract, refund); err != nil {
+			return nil, err
+		}
+	}
+
+	// Remove the paymaster
+	if err := ms.Keeper.RemovePaymaster(ctx, contract); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("failed to remove paymaster: %s", err)
+	}
+
+	// Emit the event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventRemovePaymaster,
+			sdk.NewAttribute(types.TypeAttributeSender, msg.Sender),
+			sdk.NewAttribute(types.TypeAttributeContract, msg.Contract),
+			sdk.NewAttribute(types.TypeAttributeBalance, paymaster.Balance.String()),
+		),
+	)
+
+	// Return the response
+	return &types.MsgRemovePaymasterResponse{}, nil
+}
+
+// validatePaymasterSender checks if the sender is the paymaster contract or the governance account
+func (ms MsgServer) validatePaymasterSender(ctx sdk.Context, sender, contract string) error {
+	// The governance account manages any paymaster
+	if sender == ms.authority {
+		return nil
+	}
+	if sender != contract {
+		return errors.Wrapf(types.ErrUnauthorizedPaymaster, "sender %s", sender)
+	}
+
+	// A plain account can't act as its own paymaster contract
+	if !ms.isContract(ctx, sdk.MustAccAddressFromBech32(contract)) {
+		return errors.Wrapf(types.ErrUnauthorizedPaymaster, "sender %s is not a contract", sender)
+	}
+
+	return nil
+}
+
+// resolveFeeTokenDenoms resolves the denom trace paths of the fee tokens into their IBC denoms
+func (ms MsgServer) resolveFeeTokenDenoms(ctx sdk.Context, feeTokens types.FeeTokenMetadataCollection) (types.FeeTokenMetadataCollection, error) {
+	// Resolve each denom
+	resolved := make([]types.FeeTokenMetadata, 0, len(feeTokens.Items))
+	for _, feeToken := range feeTokens.Items {
+		denom, err := ms.ResolveFeeTokenDenom(ctx, feeToken.Denom)
+		if err != nil {
+			return types.FeeTokenMetadataCollection{}, err
+		}
+		feeToken.Denom = denom
+		resolved = append(resolved, feeToken)
+	}
+
+	// A path and its IBC denom may both be on the list
+	collection := types.NewFeeTokenMetadataCollection(resolved...)
+	if err := collection.Validate(); err != nil {
+		return types.FeeTokenMetadataCollection{}, sdkerrors.ErrInvalidRequest.Wrapf("invalid fee tokens: %s", err)
+	}
+
+	return *collection, nil
+}
+
+// validateFeeTokenRegistration checks if the fee token denom is registered as an erc20 token pair
+// and if its oracle denom is registered as a vote target on the oracle module
+// IBC denoms are accepted without a token pair as long as their denom trace is known
+func (ms MsgServer) validateFeeTokenRegistration(ctx sdk.Context, feeToken types.FeeTokenMetadata) error {
+	// Check the ibc denom trace or the erc20 token pair
+	if types.IsIBCDenom(feeToken.Denom) {
+		if _, err := ms.GetDenomTrace(ctx, feeToken.Denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("fee token denom %s has no known ibc denom trace", feeToken.Denom)
+		}
+	} else {
+		pairID := ms.erc20Keeper.GetTokenPairID(ctx, feeToken.Denom)
+		if _, found := ms.erc20Keeper.GetTokenPair(ctx, pairID); !found {
+			return sdkerrors.ErrInvalidRequest.Wrapf("fee token denom %s is not registered as an erc20 token pair", feeToken.Denom)
+		}
+	}
+
+	// Check the oracle vote targets
+	voteTargets, err := ms.oracleKeeper.GetVoteTargets(ctx)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("failed to get oracle vote targets: %s", err)
+	}
+	if !slices.Contains(voteTargets, feeToken.OracleDenom) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("fee token denom %s is not registered on the oracle module", feeToken.OracleDenom)
+	}
+
+	return nil
+}
+
 // validateAuthority checks if address authority is valid and same as expected
 func (ms MsgServer) validateAuthority(authority string) error {
 	// Parse the authority as a acc address
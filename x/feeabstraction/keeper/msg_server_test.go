@@ -1,12 +1,21 @@
 package keeper_test
 
 import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/kiichain/kiichain/v4/app/apptesting"
 	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
 	oracletypes "github.com/kiichain/kiichain/v4/x/oracle/types"
 )
@@ -89,12 +98,19 @@ func (s *KeeperTestSuite) TestUpdateFeeTokens() {
 		types.NewFeeTokenMetadata("two", "oracletwo", 6, math.LegacyMustNewDecFromStr("0.01")),
 		types.NewFeeTokenMetadata("three", "oraclethree", 6, math.LegacyMustNewDecFromStr("0.01")))
 
+	// Prepare an IBC fee token referenced by its trace path
+	ibcTrace := transfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uusdc"}
+	pathFeeToken := types.NewFeeTokenMetadata(ibcTrace.GetFullDenomPath(), "usdc", 6, math.LegacyMustNewDecFromStr("0.01"))
+	ibcFeeToken := pathFeeToken
+	ibcFeeToken.Denom = ibcTrace.IBCDenom()
+
 	// Prepare all the test cases
 	testCases := []struct {
-		name        string
-		msg         *types.MsgUpdateFeeTokens
-		malleate    func(ctx sdk.Context)
-		errContains string
+		name         string
+		msg          *types.MsgUpdateFeeTokens
+		malleate     func(ctx sdk.Context)
+		expFeeTokens []types.FeeTokenMetadata
+		errContains  string
 	}{
 		{
 			name: "valid - valid fee tokens update",
@@ -152,6 +168,30 @@ func (s *KeeperTestSuite) TestUpdateFeeTokens() {
 			),
 			errContains: "denom is invalid: invalid fee token metadata: invalid request",
 		},
+		{
+			name: "valid - ibc fee token by its trace path",
+			msg: types.NewMessageUpdateFeeTokens(
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				*types.NewFeeTokenMetadataCollection(pathFeeToken),
+			),
+			malleate: func(ctx sdk.Context) {
+				s.app.TransferKeeper.SetDenomTrace(ctx, ibcTrace)
+				err := s.app.OracleKeeper.VoteTarget.Set(ctx, pathFeeToken.OracleDenom, oracletypes.Denom{Name: pathFeeToken.OracleDenom})
+				s.Require().NoError(err)
+			},
+			expFeeTokens: []types.FeeTokenMetadata{ibcFeeToken},
+		},
+		{
+			name: "invalid - trace path and ibc denom of the same token",
+			msg: types.NewMessageUpdateFeeTokens(
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				*types.NewFeeTokenMetadataCollection(pathFeeToken, ibcFeeToken),
+			),
+			malleate: func(ctx sdk.Context) {
+				s.app.TransferKeeper.SetDenomTrace(ctx, ibcTrace)
+			},
+			errContains: "duplicate",
+		},
 	}
 
 	// Iterate through the test cases
@@ -175,11 +215,845 @@ func (s *KeeperTestSuite) TestUpdateFeeTokens() {
 			} else {
 				s.Require().NoError(err)
 
-				// Verify the fee tokens were updated
-				tokens, err := s.keeper.FeeTokens.Get(cachedCtx)
+				// Verify the fee tokens were updated, they are stored by denom
+				expFeeTokens := tc.msg.FeeTokens.Items
+				if tc.expFeeTokens != nil {
+					expFeeTokens = tc.expFeeTokens
+				}
+				tokens, err := s.keeper.GetFeeTokens(cachedCtx)
+				s.Require().NoError(err)
+				s.Require().ElementsMatch(expFeeTokens, tokens.Items)
+			}
+		})
+	}
+}
+
+// TestUpdateFeeTokenPricing tests the UpdateFeeTokenPricing method
+func (s *KeeperTestSuite) TestUpdateFeeTokenPricing() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	defaultFeeTokens := types.NewFeeTokenMetadataCollection(
+		types.NewFeeTokenMetadata("one", "oracleone", 6, math.LegacyMustNewDecFromStr("0.01")),
+		types.NewFeeTokenMetadata("two", "oracletwo", 6, math.LegacyMustNewDecFromStr("0.02")),
+	)
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgUpdateFeeTokenPricing
+		errContains string
+	}{
+		{
+			name: "valid - update the pricing of a single token",
+			msg: types.NewMessageUpdateFeeTokenPricing(
+				authority, "two", math.LegacyMustNewDecFromStr("0.05"), math.NewInt(100), math.NewInt(1000),
+			),
+		},
+		{
+			name: "invalid - token not registered",
+			msg: types.NewMessageUpdateFeeTokenPricing(
+				authority, "three", math.LegacyZeroDec(), math.ZeroInt(), math.ZeroInt(),
+			),
+			errContains: "denom three: fee token not found",
+		},
+		{
+			name: "invalid - bad price adjustment",
+			msg: types.NewMessageUpdateFeeTokenPricing(
+				authority, "two", math.LegacyMustNewDecFromStr("2"), math.ZeroInt(), math.ZeroInt(),
+			),
+			errContains: "price adjustment must be greater than -1 and less than or equal to 1",
+		},
+		{
+			name: "invalid - wrong authority",
+			msg: types.NewMessageUpdateFeeTokenPricing(
+				authtypes.NewModuleAddress(types.ModuleName).String(), "two", math.LegacyZeroDec(), math.ZeroInt(), math.ZeroInt(),
+			),
+			errContains: "expected gov account as only signer for proposal message",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Set a cached context with the default fee tokens
+			cachedCtx, _ := s.ctx.CacheContext()
+			s.Require().NoError(s.keeper.SetFeeTokens(cachedCtx, *defaultFeeTokens))
+
+			// Call the UpdateFeeTokenPricing method
+			_, err := s.msgServer.UpdateFeeTokenPricing(cachedCtx, tc.msg)
+
+			// Check for errors
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Only the selected token should be updated
+				tokens, err := s.keeper.GetFeeTokens(cachedCtx)
+				s.Require().NoError(err)
+				s.Require().Len(tokens.Items, 2)
+				s.Require().Equal(defaultFeeTokens.Items[0].Price, tokens.Items[0].Price)
+				s.Require().True(tokens.Items[0].PriceAdjustment.IsZero())
+				s.Require().True(tc.msg.PriceAdjustment.Equal(tokens.Items[1].PriceAdjustment))
+				s.Require().Equal(tc.msg.MinFee, tokens.Items[1].MinFee)
+				s.Require().Equal(tc.msg.MaxFee, tokens.Items[1].MaxFee)
+				s.Require().Equal(defaultFeeTokens.Items[1].Price, tokens.Items[1].Price)
+
+				// The new pricing is emitted
+				s.Require().True(hasEventAttribute(cachedCtx, types.TypeEventUpdateFeeTokenPricing, types.TypeAttributeDenom, tc.msg.Denom))
+				s.Require().True(hasEventAttribute(cachedCtx, types.TypeEventUpdateFeeTokenPricing, types.TypeAttributeMinFee, tc.msg.MinFee.String()))
+				s.Require().True(hasEventAttribute(cachedCtx, types.TypeEventUpdateFeeTokenPricing, types.TypeAttributeMaxFee, tc.msg.MaxFee.String()))
+			}
+		})
+	}
+}
+
+// TestAddFeeToken tests the AddFeeToken method
+func (s *KeeperTestSuite) TestAddFeeToken() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	feeToken := types.NewFeeTokenMetadata("coin", "oraclecoin", 6, math.LegacyMustNewDecFromStr("0.01"))
+
+	// Prepare an IBC fee token, it can be referenced by its IBC denom or its trace path
+	ibcTrace := transfertypes.DenomTrace{Path: "transfer/channel-0", BaseDenom: "uusdc"}
+	ibcFeeToken := types.NewFeeTokenMetadata(ibcTrace.IBCDenom(), "usdc", 6, math.LegacyMustNewDecFromStr("0.01"))
+	pathFeeToken := ibcFeeToken
+	pathFeeToken.Denom = ibcTrace.GetFullDenomPath()
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgAddFeeToken
+		malleate    func(ctx sdk.Context)
+		expDenom    string
+		errContains string
+	}{
+		{
+			name: "valid - add a new fee token",
+			msg:  types.NewMessageAddFeeToken(authority, feeToken),
+			malleate: func(ctx sdk.Context) {
+				// Register the token pair and the oracle vote target
+				s.registerTokenPair(ctx, feeToken.Denom)
+				err := s.app.OracleKeeper.VoteTarget.Set(ctx, feeToken.OracleDenom, oracletypes.Denom{Name: feeToken.OracleDenom})
+				s.Require().NoError(err)
+			},
+		},
+		{
+			name: "invalid - fee token already exists",
+			msg:  types.NewMessageAddFeeToken(authority, feeToken),
+			malleate: func(ctx sdk.Context) {
+				err := s.keeper.FeeTokens.Set(ctx, feeToken.Denom, feeToken)
+				s.Require().NoError(err)
+			},
+			errContains: "denom coin: fee token already exists",
+		},
+		{
+			name: "invalid - not registered as erc20 token pair",
+			msg:  types.NewMessageAddFeeToken(authority, feeToken),
+			malleate: func(ctx sdk.Context) {
+				err := s.app.OracleKeeper.VoteTarget.Set(ctx, feeToken.OracleDenom, oracletypes.Denom{Name: feeToken.OracleDenom})
+				s.Require().NoError(err)
+			},
+			errContains: "fee token denom coin is not registered as an erc20 token pair",
+		},
+		{
+			name: "invalid - not registered on the oracle",
+			msg:  types.NewMessageAddFeeToken(authority, feeToken),
+			malleate: func(ctx sdk.Context) {
+				s.registerTokenPair(ctx, feeToken.Denom)
+			},
+			errContains: "fee token denom oraclecoin is not registered on the oracle module",
+		},
+		{
+			name:        "invalid - wrong authority",
+			msg:         types.NewMessageAddFeeToken(authtypes.NewModuleAddress(types.ModuleName).String(), feeToken),
+			errContains: "expected gov account as only signer for proposal message",
+		},
+		{
+			name: "valid - add an ibc fee token without an erc20 token pair",
+			msg:  types.NewMessageAddFeeToken(authority, ibcFeeToken),
+			malleate: func(ctx sdk.Context) {
+				// Register the denom trace and the oracle vote target
+				s.app.TransferKeeper.SetDenomTrace(ctx, ibcTrace)
+				err := s.app.OracleKeeper.VoteTarget.Set(ctx, ibcFeeToken.OracleDenom, oracletypes.Denom{Name: ibcFeeToken.OracleDenom})
+				s.Require().NoError(err)
+			},
+		},
+		{
+			name: "valid - add an ibc fee token by its trace path",
+			msg:  types.NewMessageAddFeeToken(authority, pathFeeToken),
+			malleate: func(ctx sdk.Context) {
+				// Register the denom trace and the oracle vote target
+				s.app.TransferKeeper.SetDenomTrace(ctx, ibcTrace)
+				err := s.app.OracleKeeper.VoteTarget.Set(ctx, ibcFeeToken.OracleDenom, oracletypes.Denom{Name: ibcFeeToken.OracleDenom})
+				s.Require().NoError(err)
+			},
+			expDenom: ibcFeeToken.Denom,
+		},
+		{
+			name: "invalid - ibc fee token with an unknown denom trace",
+			msg:  types.NewMessageAddFeeToken(authority, ibcFeeToken),
+			malleate: func(ctx sdk.Context) {
+				err := s.app.OracleKeeper.VoteTarget.Set(ctx, ibcFeeToken.OracleDenom, oracletypes.Denom{Name: ibcFeeToken.OracleDenom})
+				s.Require().NoError(err)
+			},
+			errContains: fmt.Sprintf("fee token denom %s has no known ibc denom trace", ibcFeeToken.Denom),
+		},
+		{
+			name:        "invalid - unknown trace path",
+			msg:         types.NewMessageAddFeeToken(authority, pathFeeToken),
+			errContains: "unknown ibc denom trace",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Set a cached context with a fresh event manager
+			cachedCtx, _ := s.ctx.CacheContext()
+			cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+
+			// Malleate if exists
+			if tc.malleate != nil {
+				tc.malleate(cachedCtx)
+			}
+
+			// Call the AddFeeToken method
+			_, err := s.msgServer.AddFeeToken(cachedCtx, tc.msg)
+
+			// Check for errors
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Verify the fee token was added under the resolved denom
+				expToken := tc.msg.FeeToken
+				if tc.expDenom != "" {
+					expToken.Denom = tc.expDenom
+				}
+				token, err := s.keeper.FeeTokens.Get(cachedCtx, expToken.Denom)
+				s.Require().NoError(err)
+				s.Require().Equal(expToken, token)
+
+				// Verify the event was emitted
+				s.Require().True(hasEventAttribute(cachedCtx, types.TypeEventAddFeeToken, types.TypeAttributeDenom, expToken.Denom))
+			}
+		})
+	}
+}
+
+// TestRemoveFeeToken tests the RemoveFeeToken method
+func (s *KeeperTestSuite) TestRemoveFeeToken() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	defaultFeeTokens := types.NewFeeTokenMetadataCollection(
+		types.NewFeeTokenMetadata("one", "oracleone", 6, math.LegacyMustNewDecFromStr("0.01")),
+		types.NewFeeTokenMetadata("two", "oracletwo", 6, math.LegacyMustNewDecFromStr("0.02")),
+	)
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgRemoveFeeToken
+		errContains string
+	}{
+		{
+			name: "valid - remove a single fee token",
+			msg:  types.NewMessageRemoveFeeToken(authority, "one"),
+		},
+		{
+			name:        "invalid - token not registered",
+			msg:         types.NewMessageRemoveFeeToken(authority, "three"),
+			errContains: "denom three: fee token not found",
+		},
+		{
+			name:        "invalid - wrong authority",
+			msg:         types.NewMessageRemoveFeeToken(authtypes.NewModuleAddress(types.ModuleName).String(), "one"),
+			errContains: "expected gov account as only signer for proposal message",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Set a cached context with the default fee tokens
+			cachedCtx, _ := s.ctx.CacheContext()
+			cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+			s.Require().NoError(s.keeper.SetFeeTokens(cachedCtx, *defaultFeeTokens))
+
+			// Call the RemoveFeeToken method
+			_, err := s.msgServer.RemoveFeeToken(cachedCtx, tc.msg)
+
+			// Check for errors
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Only the selected token should be removed
+				tokens, err := s.keeper.GetFeeTokens(cachedCtx)
+				s.Require().NoError(err)
+				s.Require().Equal([]types.FeeTokenMetadata{defaultFeeTokens.Items[1]}, tokens.Items)
+
+				// Verify the event was emitted
+				s.Require().True(hasEvent(cachedCtx, types.TypeEventRemoveFeeToken))
+			}
+		})
+	}
+}
+
+// TestSetFeeTokenEnabled tests the SetFeeTokenEnabled method
+func (s *KeeperTestSuite) TestSetFeeTokenEnabled() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	feeToken := types.NewFeeTokenMetadata("coin", "oraclecoin", 6, math.LegacyMustNewDecFromStr("0.01"))
+	feeToken.Enabled = false
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgSetFeeTokenEnabled
+		malleate    func(ctx sdk.Context)
+		errContains string
+	}{
+		{
+			name: "valid - enable a registered fee token",
+			msg:  types.NewMessageSetFeeTokenEnabled(authority, feeToken.Denom, true),
+			malleate: func(ctx sdk.Context) {
+				// Register the token pair and the oracle vote target
+				s.registerTokenPair(ctx, feeToken.Denom)
+				err := s.app.OracleKeeper.VoteTarget.Set(ctx, feeToken.OracleDenom, oracletypes.Denom{Name: feeToken.OracleDenom})
+				s.Require().NoError(err)
+			},
+		},
+		{
+			name: "valid - disable without registration checks",
+			msg:  types.NewMessageSetFeeTokenEnabled(authority, feeToken.Denom, false),
+		},
+		{
+			name: "valid - governance decision clears the stale flag",
+			msg:  types.NewMessageSetFeeTokenEnabled(authority, feeToken.Denom, false),
+			malleate: func(ctx sdk.Context) {
+				// Flag the token as disabled by the oracle data guard
+				err := s.keeper.StaleFeeTokens.Set(ctx, feeToken.Denom)
+				s.Require().NoError(err)
+			},
+		},
+		{
+			name:        "invalid - enable without the erc20 token pair",
+			msg:         types.NewMessageSetFeeTokenEnabled(authority, feeToken.Denom, true),
+			errContains: "fee token denom coin is not registered as an erc20 token pair",
+		},
+		{
+			name:        "invalid - token not registered",
+			msg:         types.NewMessageSetFeeTokenEnabled(authority, "other", true),
+			errContains: "denom other: fee token not found",
+		},
+		{
+			name:        "invalid - wrong authority",
+			msg:         types.NewMessageSetFeeTokenEnabled(authtypes.NewModuleAddress(types.ModuleName).String(), feeToken.Denom, true),
+			errContains: "expected gov account as only signer for proposal message",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Set a cached context with the fee token
+			cachedCtx, _ := s.ctx.CacheContext()
+			cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+			s.Require().NoError(s.keeper.FeeTokens.Set(cachedCtx, feeToken.Denom, feeToken))
+
+			// Malleate if exists
+			if tc.malleate != nil {
+				tc.malleate(cachedCtx)
+			}
+
+			// Call the SetFeeTokenEnabled method
+			_, err := s.msgServer.SetFeeTokenEnabled(cachedCtx, tc.msg)
+
+			// Check for errors
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Verify the flag was updated
+				token, err := s.keeper.FeeTokens.Get(cachedCtx, tc.msg.Denom)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.msg.Enabled, token.Enabled)
+
+				// Verify the token is not flagged as stale
+				stale, err := s.keeper.StaleFeeTokens.Has(cachedCtx, tc.msg.Denom)
+				s.Require().NoError(err)
+				s.Require().False(stale)
+
+				// Verify the event was emitted
+				s.Require().True(hasEvent(cachedCtx, types.TypeEventSetFeeTokenEnabled))
+			}
+		})
+	}
+}
+
+// TestPause tests the Pause method
+func (s *KeeperTestSuite) TestPause() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	guardian := apptesting.RandomAccountAddress().String()
+	feeToken := types.NewFeeTokenMetadata("coin", "oraclecoin", 6, math.LegacyMustNewDecFromStr("0.01"))
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgPause
+		malleate    func(ctx sdk.Context)
+		errContains string
+	}{
+		{
+			name: "valid - guardian pauses a fee token",
+			msg:  types.NewMessagePause(guardian, feeToken.Denom),
+		},
+		{
+			name: "valid - guardian pauses the whole module",
+			msg:  types.NewMessagePause(guardian, ""),
+		},
+		{
+			name: "valid - governance pauses a fee token",
+			msg:  types.NewMessagePause(authority, feeToken.Denom),
+		},
+		{
+			name:        "invalid - sender is not the guardian",
+			msg:         types.NewMessagePause(apptesting.RandomAccountAddress().String(), feeToken.Denom),
+			errContains: "sender is not the guardian or the governance account",
+		},
+		{
+			name: "invalid - no guardian set",
+			msg:  types.NewMessagePause(guardian, feeToken.Denom),
+			malleate: func(ctx sdk.Context) {
+				params, err := s.keeper.Params.Get(ctx)
+				s.Require().NoError(err)
+				params.Guardian = ""
+				s.Require().NoError(s.keeper.Params.Set(ctx, params))
+			},
+			errContains: "sender is not the guardian or the governance account",
+		},
+		{
+			name:        "invalid - fee token not registered",
+			msg:         types.NewMessagePause(guardian, "other"),
+			errContains: "denom other: fee token not found",
+		},
+		{
+			name: "invalid - fee token already paused",
+			msg:  types.NewMessagePause(guardian, feeToken.Denom),
+			malleate: func(ctx sdk.Context) {
+				s.Require().NoError(s.keeper.PausedFeeTokens.Set(ctx, feeToken.Denom))
+			},
+			errContains: "denom coin: already paused",
+		},
+		{
+			name: "invalid - module already paused",
+			msg:  types.NewMessagePause(guardian, ""),
+			malleate: func(ctx sdk.Context) {
+				s.Require().NoError(s.keeper.Paused.Set(ctx, true))
+			},
+			errContains: "module: already paused",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Set a cached context with the guardian and the fee token
+			cachedCtx, _ := s.ctx.CacheContext()
+			cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+			params, err := s.keeper.Params.Get(cachedCtx)
+			s.Require().NoError(err)
+			params.Guardian = guardian
+			s.Require().NoError(s.keeper.Params.Set(cachedCtx, params))
+			s.Require().NoError(s.keeper.FeeTokens.Set(cachedCtx, feeToken.Denom, feeToken))
+
+			// Malleate if exists
+			if tc.malleate != nil {
+				tc.malleate(cachedCtx)
+			}
+
+			// Call the Pause method
+			_, err = s.msgServer.Pause(cachedCtx, tc.msg)
+
+			// Check for errors
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Verify the pause state
+				if tc.msg.Denom == "" {
+					paused, err := s.keeper.IsPaused(cachedCtx)
+					s.Require().NoError(err)
+					s.Require().True(paused)
+				} else {
+					paused, err := s.keeper.IsFeeTokenPaused(cachedCtx, tc.msg.Denom)
+					s.Require().NoError(err)
+					s.Require().True(paused)
+				}
+
+				// Verify the event was emitted
+				s.Require().True(hasEvent(cachedCtx, types.TypeEventPause))
+			}
+		})
+	}
+}
+
+// TestUnpause tests the Unpause method
+func (s *KeeperTestSuite) TestUnpause() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgUnpause
+		malleate    func(ctx sdk.Context)
+		errContains string
+	}{
+		{
+			name: "valid - unpause a fee token",
+			msg:  types.NewMessageUnpause(authority, "coin"),
+			malleate: func(ctx sdk.Context) {
+				s.Require().NoError(s.keeper.PausedFeeTokens.Set(ctx, "coin"))
+			},
+		},
+		{
+			name: "valid - unpause the whole module",
+			msg:  types.NewMessageUnpause(authority, ""),
+			malleate: func(ctx sdk.Context) {
+				s.Require().NoError(s.keeper.Paused.Set(ctx, true))
+			},
+		},
+		{
+			name:        "invalid - fee token not paused",
+			msg:         types.NewMessageUnpause(authority, "coin"),
+			errContains: "denom coin: not paused",
+		},
+		{
+			name:        "invalid - module not paused",
+			msg:         types.NewMessageUnpause(authority, ""),
+			errContains: "module: not paused",
+		},
+		{
+			name: "invalid - guardian can't unpause",
+			msg:  types.NewMessageUnpause(apptesting.RandomAccountAddress().String(), "coin"),
+			malleate: func(ctx sdk.Context) {
+				s.Require().NoError(s.keeper.PausedFeeTokens.Set(ctx, "coin"))
+			},
+			errContains: "expected gov account as only signer for proposal message",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Set a cached context
+			cachedCtx, _ := s.ctx.CacheContext()
+			cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+
+			// Malleate if exists
+			if tc.malleate != nil {
+				tc.malleate(cachedCtx)
+			}
+
+			// Call the Unpause method
+			_, err := s.msgServer.Unpause(cachedCtx, tc.msg)
+
+			// Check for errors
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Verify the pause state
+				paused, err := s.keeper.IsPaused(cachedCtx)
 				s.Require().NoError(err)
-				s.Require().Equal(tc.msg.FeeTokens, tokens)
+				s.Require().False(paused)
+				pausedFeeTokens, err := s.keeper.GetPausedFeeTokens(cachedCtx)
+				s.Require().NoError(err)
+				s.Require().Empty(pausedFeeTokens)
+
+				// Verify the event was emitted
+				s.Require().True(hasEvent(cachedCtx, types.TypeEventUnpause))
 			}
 		})
 	}
 }
+
+// TestSetPaymaster tests the SetPaymaster method
+func (s *KeeperTestSuite) TestSetPaymaster() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	contract := apptesting.RandomAccountAddress()
+	account := apptesting.RandomAccountAddress()
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name            string
+		msg             *types.MsgSetPaymaster
+		malleate        func(ctx sdk.Context)
+		expectedBalance math.Int
+		errContains     string
+	}{
+		{
+			name:            "valid - contract registers itself",
+			msg:             types.NewMessageSetPaymaster(contract.String(), contract.String(), nil, nil, math.NewInt(100), math.NewInt(1000), 3600),
+			expectedBalance: math.ZeroInt(),
+		},
+		{
+			name:            "valid - governance registers the contract",
+			msg:             types.NewMessageSetPaymaster(authority, contract.String(), nil, nil, math.NewInt(100), math.NewInt(1000), 3600),
+			expectedBalance: math.ZeroInt(),
+		},
+		{
+			name: "valid - update keeps the balance",
+			msg:  types.NewMessageSetPaymaster(contract.String(), contract.String(), nil, nil, math.NewInt(200), math.NewInt(2000), 60),
+			malleate: func(ctx sdk.Context) {
+				s.setupPaymaster(ctx, contract, math.NewInt(500))
+			},
+			expectedBalance: math.NewInt(500),
+		},
+		{
+			name:        "invalid - sender is not the contract",
+			msg:         types.NewMessageSetPaymaster(apptesting.RandomAccountAddress().String(), contract.String(), nil, nil, math.NewInt(100), math.NewInt(1000), 3600),
+			errContains: "sender is not the paymaster contract or the governance account",
+		},
+		{
+			name:        "invalid - plain account registers itself",
+			msg:         types.NewMessageSetPaymaster(account.String(), account.String(), nil, nil, math.NewInt(100), math.NewInt(1000), 3600),
+			errContains: "is not a contract",
+		},
+		{
+			name:        "invalid - missing limits",
+			msg:         types.NewMessageSetPaymaster(contract.String(), contract.String(), nil, nil, math.NewInt(100), math.ZeroInt(), 3600),
+			errContains: "per block limit must be positive",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Set a cached context with the contract deployed
+			cachedCtx, _ := s.ctx.CacheContext()
+			cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+			s.setContractCode(cachedCtx, contract)
+
+			// Malleate if exists
+			if tc.malleate != nil {
+				tc.malleate(cachedCtx)
+			}
+
+			// Call the SetPaymaster method
+			_, err := s.msgServer.SetPaymaster(cachedCtx, tc.msg)
+
+			// Check for errors
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Verify the paymaster
+				paymaster, err := s.keeper.GetPaymaster(cachedCtx, contract)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.msg.PerUserLimit, paymaster.PerUserLimit)
+				s.Require().Equal(tc.msg.PerBlockLimit, paymaster.PerBlockLimit)
+				s.Require().Equal(tc.msg.UserWindow, paymaster.UserWindow)
+				s.Require().Equal(tc.expectedBalance, paymaster.Balance)
+
+				// Verify the event was emitted
+				s.Require().True(hasEvent(cachedCtx, types.TypeEventSetPaymaster))
+			}
+		})
+	}
+}
+
+// TestFundPaymaster tests the FundPaymaster method
+func (s *KeeperTestSuite) TestFundPaymaster() {
+	contract := apptesting.RandomAccountAddress()
+	funder := apptesting.RandomAccountAddress()
+	nativeDenom := s.nativeDenom()
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgFundPaymaster
+		errContains string
+	}{
+		{
+			name: "valid - fund the paymaster",
+			msg:  types.NewMessageFundPaymaster(funder.String(), contract.String(), sdk.NewInt64Coin(nativeDenom, 300)),
+		},
+		{
+			name:        "invalid - paymaster not found",
+			msg:         types.NewMessageFundPaymaster(funder.String(), apptesting.RandomAccountAddress().String(), sdk.NewInt64Coin(nativeDenom, 300)),
+			errContains: "paymaster not found",
+		},
+		{
+			name:        "invalid - not the native denom",
+			msg:         types.NewMessageFundPaymaster(funder.String(), contract.String(), sdk.NewInt64Coin("coin", 300)),
+			errContains: "paymasters are funded in",
+		},
+		{
+			name:        "invalid - insufficient funds",
+			msg:         types.NewMessageFundPaymaster(funder.String(), contract.String(), sdk.NewInt64Coin(nativeDenom, 5000)),
+			errContains: "insufficient funds",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Set a cached context with a paymaster and a funded account
+			cachedCtx, _ := s.ctx.CacheContext()
+			cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+			s.setupPaymaster(cachedCtx, contract, math.NewInt(100))
+			s.fundAccount(cachedCtx, funder, sdk.NewCoins(sdk.NewInt64Coin(nativeDenom, 1000)))
+
+			// Call the FundPaymaster method
+			_, err := s.msgServer.FundPaymaster(cachedCtx, tc.msg)
+
+			// Check for errors
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Verify the balance
+				paymaster, err := s.keeper.GetPaymaster(cachedCtx, contract)
+				s.Require().NoError(err)
+				s.Require().Equal(math.NewInt(400), paymaster.Balance)
+				s.Require().Equal(math.NewInt(700), s.app.BankKeeper.GetBalance(cachedCtx, funder, nativeDenom).Amount)
+
+				// Verify the event was emitted
+				s.Require().True(hasEvent(cachedCtx, types.TypeEventFundPaymaster))
+			}
+		})
+	}
+}
+
+// TestRemovePaymaster tests the RemovePaymaster method
+func (s *KeeperTestSuite) TestRemovePaymaster() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	contract := apptesting.RandomAccountAddress()
+	user := apptesting.RandomAccountAddress()
+	nativeDenom := s.nativeDenom()
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgRemovePaymaster
+		malleate    func(ctx sdk.Context)
+		errContains string
+	}{
+		{
+			name: "valid - contract removes itself",
+			msg:  types.NewMessageRemovePaymaster(contract.String(), contract.String()),
+		},
+		{
+			name: "valid - governance removes the contract",
+			msg:  types.NewMessageRemovePaymaster(authority, contract.String()),
+		},
+		{
+			name:        "invalid - sender is not the contract",
+			msg:         types.NewMessageRemovePaymaster(user.String(), contract.String()),
+			errContains: "sender is not the paymaster contract or the governance account",
+		},
+		{
+			name:        "invalid - contract without code",
+			msg:         types.NewMessageRemovePaymaster(contract.String(), contract.String()),
+			malleate:    func(ctx sdk.Context) { s.app.EVMKeeper.DeleteCodeHash(ctx, common.BytesToAddress(contract)) },
+			errContains: "is not a contract",
+		},
+		{
+			name:        "invalid - paymaster not found",
+			msg:         types.NewMessageRemovePaymaster(authority, user.String()),
+			errContains: "paymaster not found",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Set a cached context with a used paymaster
+			cachedCtx, _ := s.ctx.CacheContext()
+			cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+			s.setContractCode(cachedCtx, contract)
+			s.setupPaymaster(cachedCtx, contract, math.NewInt(500))
+			err := s.keeper.ChargePaymaster(cachedCtx, contract, user, []sdk.Msg{&banktypes.MsgSend{ToAddress: contract.String()}}, sdk.NewCoins(sdk.NewInt64Coin(nativeDenom, 50)))
+			s.Require().NoError(err)
+
+			// Malleate if exists
+			if tc.malleate != nil {
+				tc.malleate(cachedCtx)
+			}
+
+			// Call the RemovePaymaster method
+			_, err = s.msgServer.RemovePaymaster(cachedCtx, tc.msg)
+
+			// Check for errors
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// Verify the paymaster and its usages were removed
+				found, err := s.keeper.HasPaymaster(cachedCtx, contract)
+				s.Require().NoError(err)
+				s.Require().False(found)
+				usages, err := s.keeper.GetPaymasterUsages(cachedCtx)
+				s.Require().NoError(err)
+				s.Require().Empty(usages)
+
+				// Verify the balance was returned to the contract
+				s.Require().Equal(math.NewInt(450), s.app.BankKeeper.GetBalance(cachedCtx, contract, nativeDenom).Amount)
+
+				// Verify the event was emitted
+				s.Require().True(hasEvent(cachedCtx, types.TypeEventRemovePaymaster))
+			}
+		})
+	}
+}
+
+// registerTokenPair registers the denom as an erc20 token pair
+func (s *KeeperTestSuite) registerTokenPair(ctx sdk.Context, denom string) {
+	s.app.Erc20Keeper.SetToken(ctx, erc20types.TokenPair{
+		Erc20Address:  "0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd",
+		Denom:         denom,
+		Enabled:       true,
+		ContractOwner: erc20types.OWNER_MODULE,
+	})
+}
+
+// hasEvent checks if an event of the given type was emitted
+func hasEvent(ctx sdk.Context, eventType string) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// hasEventAttribute checks if an event of the given type was emitted with the attribute
+func hasEventAttribute(ctx sdk.Context, eventType, key, value string) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != eventType {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == key && attr.Value == value {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,252 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/evm/contracts"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+
+	"github.com/kiichain/kiichain/v4/app/params"
+	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
+)
+
+// feePortion is a fee token coin used to cover part of a multi token fee
+type feePortion struct {
+	feeToken     types.FeeTokenMetadata
+	amount       math.Int
+	nativeAmount math.Int
+	price        math.LegacyDec
+}
+
+// convertMultiTokenFees covers a single native fee combining the native balance and the fee token balances
+// The native balance is used first, then the fee tokens in their denom order
+// The portions are planned before any conversion, so nothing is converted if the balances can't cover the fee
+func (k Keeper) convertMultiTokenFees(ctx sdk.Context, account sdk.AccAddress, fee sdk.Coin, capWindow uint64) (sdk.Coins, error) {
+	// Use the native balance first
+	nativeBalance := k.bankKeeper.GetBalance(ctx, account, fee.Denom)
+	nativeAmount := math.MinInt(nativeBalance.Amount, fee.Amount)
+
+	// Plan the fee token portions for the remaining amount
+	portions, remaining, err := k.planFeePortions(ctx, account, fee.Amount.Sub(nativeAmount), capWindow)
+	if err != nil {
+		return sdk.Coins{}, err
+	}
+	if remaining.IsPositive() {
+		return sdk.Coins{}, errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"insufficient funds to cover fee %s combining the native and fee token balances",
+			fee.String(),
+		)
+	}
+
+	// Prepare the user balance for each portion and record the conversions
+	newFee := sdk.NewCoins(sdk.NewCoin(fee.Denom, nativeAmount))
+	for _, portion := range portions {
+		if err := k.convertERC20Shortfall(ctx, account, portion.feeToken.Denom, portion.amount); err != nil {
+			return sdk.Coins{}, err
+		}
+		if err := k.recordConversion(ctx, portion.feeToken, portion.nativeAmount, capWindow); err != nil {
+			return sdk.Coins{}, err
+		}
+		newFee = newFee.Add(sdk.NewCoin(portion.feeToken.Denom, portion.amount))
+	}
+
+	// Emit the events with the fee breakdown
+	k.emitMultiTokenFeeEvents(ctx, account, fee, newFee, nativeAmount, portions)
+
+	return newFee, nil
+}
+
+// planFeePortions selects the fee token portions that cover the remaining native amount
+// Each portion respects the token pause, fee bounds and conversion cap
+// It returns the native amount that is still not covered
+func (k Keeper) planFeePortions(ctx sdk.Context, account sdk.AccAddress, remaining math.Int, capWindow uint64) ([]feePortion, math.Int, error) {
+	// Get the fee tokens, they are iterated in their denom order
+	feeTokens, err := k.GetFeeTokens(ctx)
+	if err != nil {
+		return nil, math.Int{}, err
+	}
+
+	var portions []feePortion
+	for _, feeToken := range feeTokens.Items {
+		// Stop once the fee is covered
+		if !remaining.IsPositive() {
+			break
+		}
+
+		// Check if the token is enabled and not paused
+		if !feeToken.Enabled {
+			continue
+		}
+		paused, err := k.IsFeeTokenPaused(ctx, feeToken.Denom)
+		if err != nil {
+			return nil, math.Int{}, err
+		}
+		if paused {
+			continue
+		}
+
+		// Limit the covered amount to the token conversion cap
+		target := remaining
+		if feeToken.HasConversionCap() {
+			usage, err := k.GetConversionUsage(ctx, feeToken.Denom, capWindow)
+			if err != nil {
+				return nil, math.Int{}, err
+			}
+			target = math.MinInt(target, usage.Remaining(feeToken.ConversionCap))
+		}
+		if !target.IsPositive() {
+			continue
+		}
+
+		// Calculate the token amount needed for the target, rounding up so the fee is never underpaid
+		price := feeToken.AdjustedPrice()
+		needed, err := types.CalculateTokenAmountWithDecimals(price, target, params.BaseDenomUnit, uint64(feeToken.Decimals))
+		if err != nil {
+			return nil, math.Int{}, err
+		}
+		// If the price is zero, we skip this fee token
+		if needed.IsZero() {
+			continue
+		}
+		neededInt := needed.Ceil().TruncateInt()
+
+		// Limit the amount to the user balance and the token fee bounds
+		amount := math.MinInt(neededInt, k.getFeeTokenBalance(ctx, account, feeToken.Denom))
+		amount, withinBounds := feeToken.BoundPortionAmount(amount)
+		if !withinBounds || !amount.IsPositive() {
+			continue
+		}
+
+		// Calculate the native amount covered by partial portions
+		covered := target
+		if amount.LT(neededInt) {
+			coveredDec, err := types.CalculateNativeAmountWithDecimals(price, amount, params.BaseDenomUnit, uint64(feeToken.Decimals))
+			if err != nil {
+				return nil, math.Int{}, err
+			}
+			covered = math.MinInt(coveredDec.TruncateInt(), target)
+		}
+		if !covered.IsPositive() {
+			continue
+		}
+
+		// Add the portion and reduce the remaining amount
+		portions = append(portions, feePortion{
+			feeToken:     feeToken,
+			amount:       amount,
+			nativeAmount: covered,
+			price:        price,
+		})
+		remaining = remaining.Sub(covered)
+	}
+
+	return portions, remaining, nil
+}
+
+// getFeeTokenBalance returns the user balance of a fee token, adding the bank and the ERC20 balances
+func (k Keeper) getFeeTokenBalance(ctx sdk.Context, account sdk.AccAddress, denom string) math.Int {
+	// Get the bank balance
+	balance := k.bankKeeper.GetBalance(ctx, account, denom).Amount
+
+	// Get the pair and add the ERC20 balance if it exists
+	pairID := k.erc20Keeper.GetTokenPairID(ctx, denom)
+	pair, found := k.erc20Keeper.GetTokenPair(ctx, pairID)
+	if !found {
+		return balance
+	}
+	erc20Balance := k.erc20Keeper.BalanceOf(ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, pair.GetERC20Contract(), common.BytesToAddress(account.Bytes()))
+	if erc20Balance == nil {
+		return balance
+	}
+
+	return balance.Add(math.NewIntFromBigInt(erc20Balance))
+}
+
+// convertERC20Shortfall converts the part of the amount not held on the bank balance from the ERC20 token
+func (k Keeper) convertERC20Shortfall(ctx sdk.Context, account sdk.AccAddress, denom string, amount math.Int) error {
+	// Check if the bank balance already covers the amount
+	balance := k.bankKeeper.GetBalance(ctx, account, denom)
+	if balance.Amount.GTE(amount) {
+		return nil
+	}
+
+	// Get the pair, it must exist as the balance was planned with it
+	pairID := k.erc20Keeper.GetTokenPairID(ctx, denom)
+	pair, found := k.erc20Keeper.GetTokenPair(ctx, pairID)
+	if !found {
+		return errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "no token pair found for fee token %s", denom)
+	}
+
+	// Convert the shortfall
+	msg := erc20types.NewMsgConvertERC20(
+		amount.Sub(balance.Amount),
+		account,
+		pair.GetERC20Contract(),
+		common.BytesToAddress(account.Bytes()),
+	)
+	_, err := k.erc20Keeper.ConvertERC20(ctx, msg)
+	return err
+}
+
+// emitMultiTokenFeeEvents emits the multi token fee event and a portion event for each coin used
+func (k Keeper) emitMultiTokenFeeEvents(
+	ctx sdk.Context,
+	account sdk.AccAddress,
+	fee sdk.Coin,
+	newFee sdk.Coins,
+	nativeAmount math.Int,
+	portions []feePortion,
+) {
+	events := sdk.Events{
+		sdk.NewEvent(
+			types.TypeEventConvertFeesMulti,
+			sdk.NewAttribute(types.TypeAttributeFeePayer, account.String()),
+			sdk.NewAttribute(types.TypeAttributeOriginalFeeAmount, fee.String()),
+			sdk.NewAttribute(types.TypeAttributeConvertedFee, newFee.String()),
+		),
+	}
+
+	// The native portion is listed first, it has a unit price
+	if nativeAmount.IsPositive() {
+		nativeCoin := sdk.NewCoin(fee.Denom, nativeAmount)
+		events = append(events, sdk.NewEvent(
+			types.TypeEventFeePortion,
+			sdk.NewAttribute(types.TypeAttributeFeePayer, account.String()),
+			sdk.NewAttribute(types.TypeAttributeAmount, nativeCoin.String()),
+			sdk.NewAttribute(types.TypeAttributeNativeAmount, nativeCoin.String()),
+			sdk.NewAttribute(types.TypeAttributePrice, math.LegacyOneDec().String()),
+		))
+	}
+
+	// Then the fee token portions in their conversion order
+	for _, portion := range portions {
+		events = append(events, sdk.NewEvent(
+			types.TypeEventFeePortion,
+			sdk.NewAttribute(types.TypeAttributeFeePayer, account.String()),
+			sdk.NewAttribute(types.TypeAttributeAmount, sdk.NewCoin(portion.feeToken.Denom, portion.amount).String()),
+			sdk.NewAttribute(types.TypeAttributeNativeAmount, sdk.NewCoin(fee.Denom, portion.nativeAmount).String()),
+			sdk.NewAttribute(types.TypeAttributePrice, portion.price.String()),
+		))
+	}
+
+	ctx.EventManager().EmitEvents(events)
+}
+
+// RefundFees returns the leftover gas refund of a multi token fee from the fee collector
+// The EVM module only refunds a single coin, the remaining fee portions are refunded through here
+func (k Keeper) RefundFees(ctx sdk.Context, account sdk.AccAddress, refund sdk.Coins) error {
+	// Nothing to refund on zero amounts
+	if refund.IsZero() {
+		return nil
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, account, refund)
+}
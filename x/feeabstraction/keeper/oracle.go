@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"context"
+	"errors"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
@@ -29,41 +32,52 @@ func (k Keeper) CalculateFeeTokenPrices(ctx sdk.Context) error {
 	}
 
 	// Parse the twaps into a map for easier access
-	twapPriceMap := make(map[string]math.LegacyDec)
+	twapMap := make(map[string]oracletypes.OracleTwap)
 	for _, twap := range twaps {
-		twapPriceMap[twap.Denom] = twap.Twap
+		twapMap[twap.Denom] = twap
 	}
 
-	// Find the price for the base token
-	baseTokenPrice, ok := twapPriceMap[params.NativeOracleDenom]
-	if !ok {
-		baseTokenPrice = params.FallbackNativePrice
+	// Find the price for the base token, the fallback is used if the oracle data is missing or bad
+	baseTokenPrice := params.FallbackNativePrice
+	reason, err := k.checkOracleData(ctx, params, twapMap, params.NativeOracleDenom)
+	if err != nil {
+		return err
+	}
+	if reason == "" {
+		baseTokenPrice = twapMap[params.NativeOracleDenom].Twap
 	}
 
 	// Iterate all the tokens
 	updateTokens, err := k.calculatePriceTokens(
 		ctx,
-		twapPriceMap,
+		params,
+		twapMap,
 		baseTokenPrice,
-		params.ClampFactor,
 	)
 	if err != nil {
 		return err
 	}
 
 	// Save the updated tokens
-	return k.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(updateTokens...))
+	for _, token := range updateTokens {
+		if err := k.FeeTokens.Set(ctx, token.Denom, token); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // calculatePriceTokens calculates the price of each fee token in terms of the base token
+// Tokens with missing or bad oracle data are disabled, and enabled again once the data is back
 func (k Keeper) calculatePriceTokens(
 	ctx sdk.Context,
-	twapPriceMap map[string]math.LegacyDec,
+	params types.Params,
+	twapMap map[string]oracletypes.OracleTwap,
 	baseTokenPrice math.LegacyDec,
-	clampFactor math.LegacyDec,
 ) ([]types.FeeTokenMetadata, error) {
 	// Get all the fee tokens
-	feeTokens, err := k.FeeTokens.Get(ctx)
+	feeTokens, err := k.GetFeeTokens(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -71,37 +85,50 @@ func (k Keeper) calculatePriceTokens(
 	// Iterate through the fee tokens and calculate their prices
 	updateTokens := make([]types.FeeTokenMetadata, 0, len(feeTokens.Items))
 	for _, token := range feeTokens.Items {
+		// Tokens disabled by the oracle data guard are still checked, so they can be enabled again
+		stale, err := k.StaleFeeTokens.Has(ctx, token.Denom)
+		if err != nil {
+			return nil, err
+		}
+
 		// Check if the token is enabled
-		if !token.Enabled {
+		if !token.Enabled && !stale {
 			updateTokens = append(updateTokens, token)
 			continue
 		}
 
-		// Missing TWAP, fallback to zero
-		tokenPrice, ok := twapPriceMap[token.OracleDenom]
-		if !ok {
-			tokenPrice = math.LegacyZeroDec()
+		// Check the oracle data of the token
+		reason, err := k.checkOracleData(ctx, params, twapMap, token.OracleDenom)
+		if err != nil {
+			return nil, err
 		}
 
-		// If the token price is zero, we disable the token for safety
-		if tokenPrice.IsZero() {
-			// Log or emit telemetry for monitoring
-			k.Logger(ctx).Warn("token price is zero, disabling token", "denom", token.Denom)
-			// Disable the token
-			token.Enabled = false
-			token.Price = math.LegacyZeroDec()
+		// If the data is missing or bad, we disable the token for safety
+		if reason != "" {
+			if token.Enabled {
+				if err := k.disableStaleFeeToken(ctx, &token, reason); err != nil {
+					return nil, err
+				}
+			}
 			updateTokens = append(updateTokens, token)
 			continue
 		}
 
 		// Calculate the price of the token in terms of the base token
-		price, err := types.CalculateTokenPrice(baseTokenPrice, tokenPrice)
+		price, err := types.CalculateTokenPrice(baseTokenPrice, twapMap[token.OracleDenom].Twap)
 		if err != nil {
 			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "error calculating token price for denom %s: %v", token.Denom, err)
 		}
 
-		// Apply clamping
-		price = types.ClampPrice(token.Price, price, clampFactor)
+		// Tokens enabled again take the new price as is, their last price is outdated
+		// Otherwise clamping is applied
+		if stale {
+			if err := k.enableStaleFeeToken(ctx, &token); err != nil {
+				return nil, err
+			}
+		} else {
+			price = types.ClampPrice(token.Price, price, params.ClampFactor)
+		}
 
 		// Update the token price
 		token.Price = price
@@ -111,3 +138,106 @@ func (k Keeper) calculatePriceTokens(
 	// Return the updated tokens
 	return updateTokens, nil
 }
+
+// checkOracleData checks if the oracle data of the denom can be used for pricing
+// It returns the reason why the data can't be used, or an empty string if it can
+func (k Keeper) checkOracleData(
+	ctx sdk.Context,
+	params types.Params,
+	twapMap map[string]oracletypes.OracleTwap,
+	oracleDenom string,
+) (string, error) {
+	// The twap must exist and have a price
+	twap, ok := twapMap[oracleDenom]
+	if !ok || !twap.Twap.IsPositive() {
+		return types.ReasonMissingPrice, nil
+	}
+
+	// The twap must cover the minimum time
+	if params.MinTwapCoverage > 0 && twap.LookbackSeconds < int64(params.MinTwapCoverage) {
+		return types.ReasonLowTwapCoverage, nil
+	}
+
+	// The last price update must not be too old
+	if params.MaxPriceAge > 0 {
+		rate, err := k.oracleKeeper.GetBaseExchangeRate(ctx, oracleDenom)
+		if err != nil {
+			if errors.Is(err, oracletypes.ErrUnknownDenom) {
+				return types.ReasonMissingPrice, nil
+			}
+			return "", err
+		}
+
+		// The oracle stores the update timestamp in milliseconds
+		age := ctx.BlockTime().UnixMilli() - rate.LastUpdateTimestamp
+		if age > int64(params.MaxPriceAge)*1000 {
+			return types.ReasonStalePrice, nil
+		}
+	}
+
+	return "", nil
+}
+
+// disableStaleFeeToken disables a fee token because of bad oracle data
+// The token is flagged as stale so it's enabled again once the data is back
+func (k Keeper) disableStaleFeeToken(ctx sdk.Context, token *types.FeeTokenMetadata, reason string) error {
+	// Log or emit telemetry for monitoring
+	k.Logger(ctx).Warn("oracle data is not usable, disabling token", "denom", token.Denom, "reason", reason)
+
+	// Disable the token
+	token.Enabled = false
+	if err := k.StaleFeeTokens.Set(ctx, token.Denom); err != nil {
+		return err
+	}
+
+	// Emit the event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventFeeTokenAutoDisabled,
+			sdk.NewAttribute(types.TypeAttributeDenom, token.Denom),
+			sdk.NewAttribute(types.TypeAttributeOracleDenom, token.OracleDenom),
+			sdk.NewAttribute(types.TypeAttributeReason, reason),
+		),
+	)
+
+	return nil
+}
+
+// enableStaleFeeToken enables a fee token disabled by the oracle data guard
+func (k Keeper) enableStaleFeeToken(ctx sdk.Context, token *types.FeeTokenMetadata) error {
+	// Log or emit telemetry for monitoring
+	k.Logger(ctx).Info("oracle data is back, enabling token", "denom", token.Denom)
+
+	// Enable the token and remove the stale flag
+	token.Enabled = true
+	if err := k.StaleFeeTokens.Remove(ctx, token.Denom); err != nil {
+		return err
+	}
+
+	// Emit the event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventFeeTokenAutoEnabled,
+			sdk.NewAttribute(types.TypeAttributeDenom, token.Denom),
+			sdk.NewAttribute(types.TypeAttributeOracleDenom, token.OracleDenom),
+		),
+	)
+
+	return nil
+}
+
+// GetStaleFeeTokens returns the denoms of the fee tokens disabled by the oracle data guard
+// The denoms are ordered
+func (k Keeper) GetStaleFeeTokens(ctx context.Context) ([]string, error) {
+	// Iterate all the stale fee tokens
+	var denoms []string
+	err := k.StaleFeeTokens.Walk(ctx, nil, func(denom string) (bool, error) {
+		denoms = append(denoms, denom)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return denoms, nil
+}
@@ -30,7 +30,7 @@ func (s *KeeperTestSuite) TestCalculateFeeTokenPrices() {
 				ctx = s.createTwaps(ctx, math.LegacyMustNewDecFromStr("0.5"), 100, "atom")
 
 				// Set the fee token prices in the keeper
-				err := s.app.FeeAbstractionKeeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+				err := s.app.FeeAbstractionKeeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
 					types.NewFeeTokenMetadata("uatom", "atom", 6, math.LegacyMustNewDecFromStr("50")),
 				))
 				s.Require().NoError(err)
@@ -39,7 +39,7 @@ func (s *KeeperTestSuite) TestCalculateFeeTokenPrices() {
 			},
 			postCheck: func(ctx sdk.Context) {
 				// All tokens are still enabled
-				feeTokens, err := s.app.FeeAbstractionKeeper.FeeTokens.Get(ctx)
+				feeTokens, err := s.app.FeeAbstractionKeeper.GetFeeTokens(ctx)
 				s.Require().NoError(err)
 				for _, token := range feeTokens.Items {
 					s.Require().True(token.Enabled, "Expected token to be enabled: %s", token.Denom)
@@ -65,7 +65,7 @@ func (s *KeeperTestSuite) TestCalculateFeeTokenPrices() {
 			name: "all tokens are disabled due to no twap",
 			malleate: func(ctx sdk.Context) sdk.Context {
 				// Set the fee token prices in the keeper without twaps
-				err := s.app.FeeAbstractionKeeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+				err := s.app.FeeAbstractionKeeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
 					types.NewFeeTokenMetadata("usol", "sol", 18, math.LegacyOneDec()),
 					types.NewFeeTokenMetadata("uatom", "atom", 6, math.LegacyOneDec()),
 				))
@@ -75,7 +75,7 @@ func (s *KeeperTestSuite) TestCalculateFeeTokenPrices() {
 			},
 			postCheck: func(ctx sdk.Context) {
 				// Check that the fee tokens are all disabled
-				feeTokens, err := s.app.FeeAbstractionKeeper.FeeTokens.Get(ctx)
+				feeTokens, err := s.app.FeeAbstractionKeeper.GetFeeTokens(ctx)
 				s.Require().NoError(err)
 				for _, token := range feeTokens.Items {
 					s.Require().False(token.Enabled, "Expected token to be disabled: %s", token.Denom)
@@ -89,7 +89,7 @@ func (s *KeeperTestSuite) TestCalculateFeeTokenPrices() {
 				ctx = s.createTwaps(ctx, math.LegacyMustNewDecFromStr("0.5"), 100, "atom")
 
 				// Set the fee token prices in the keeper
-				err := s.app.FeeAbstractionKeeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+				err := s.app.FeeAbstractionKeeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
 					types.NewFeeTokenMetadata("usol", "sol", 18, math.LegacyOneDec()),
 					types.NewFeeTokenMetadata("uatom", "atom", 6, math.LegacyOneDec()),
 				))
@@ -99,7 +99,7 @@ func (s *KeeperTestSuite) TestCalculateFeeTokenPrices() {
 			},
 			postCheck: func(ctx sdk.Context) {
 				// Check that the fee tokens are partially disabled
-				feeTokens, err := s.app.FeeAbstractionKeeper.FeeTokens.Get(ctx)
+				feeTokens, err := s.app.FeeAbstractionKeeper.GetFeeTokens(ctx)
 				s.Require().NoError(err)
 				for _, token := range feeTokens.Items {
 					if token.Denom == "uatom" {
@@ -117,7 +117,7 @@ func (s *KeeperTestSuite) TestCalculateFeeTokenPrices() {
 				ctx = s.createTwaps(ctx, math.LegacyMustNewDecFromStr("0.5"), 100, "atom")
 
 				// Set the fee token prices in the keeper with zero price
-				err := s.app.FeeAbstractionKeeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+				err := s.app.FeeAbstractionKeeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
 					types.NewFeeTokenMetadata("uatom", "atom", 6, math.LegacyZeroDec()),
 				))
 				s.Require().NoError(err)
@@ -126,7 +126,7 @@ func (s *KeeperTestSuite) TestCalculateFeeTokenPrices() {
 			},
 			postCheck: func(ctx sdk.Context) {
 				// Price is set as normal
-				feeTokens, err := s.app.FeeAbstractionKeeper.FeeTokens.Get(ctx)
+				feeTokens, err := s.app.FeeAbstractionKeeper.GetFeeTokens(ctx)
 				s.Require().NoError(err)
 				s.Require().Len(feeTokens.Items, 1)
 				s.Require().NotEqualValues(math.LegacyZeroDec(), feeTokens.Items[0].Price)
@@ -141,7 +141,7 @@ func (s *KeeperTestSuite) TestCalculateFeeTokenPrices() {
 				ctx = s.createTwaps(ctx, math.LegacyMustNewDecFromStr("0.5"), 100, "sol")
 
 				// Set the fee token prices in the keeper
-				err := s.app.FeeAbstractionKeeper.FeeTokens.Set(ctx, *types.NewFeeTokenMetadataCollection(
+				err := s.app.FeeAbstractionKeeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
 					types.FeeTokenMetadata{
 						Denom:       "uatom",
 						OracleDenom: "atom",
@@ -157,7 +157,7 @@ func (s *KeeperTestSuite) TestCalculateFeeTokenPrices() {
 			},
 			postCheck: func(ctx sdk.Context) {
 				// Check that the fee tokens are partially disabled
-				feeTokens, err := s.app.FeeAbstractionKeeper.FeeTokens.Get(ctx)
+				feeTokens, err := s.app.FeeAbstractionKeeper.GetFeeTokens(ctx)
 				s.Require().NoError(err)
 				s.Require().Len(feeTokens.Items, 2)
 
@@ -170,6 +170,133 @@ func (s *KeeperTestSuite) TestCalculateFeeTokenPrices() {
 				s.Require().NotEqual(math.LegacyOneDec(), feeTokens.Items[1].Price)
 			},
 		},
+		{
+			name: "token disabled due to stale price",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Mock oracle twaps
+				ctx = s.createTwaps(ctx, math.LegacyMustNewDecFromStr("0.5"), 100, "atom")
+
+				// Set the fee token prices in the keeper
+				err := s.app.FeeAbstractionKeeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata("uatom", "atom", 6, math.LegacyMustNewDecFromStr("50")),
+				))
+				s.Require().NoError(err)
+
+				// Move the time past the max price age
+				params, err := s.app.FeeAbstractionKeeper.Params.Get(ctx)
+				s.Require().NoError(err)
+				return ctx.WithBlockTime(ctx.BlockTime().Add(time.Second * time.Duration(params.MaxPriceAge+1)))
+			},
+			postCheck: func(ctx sdk.Context) {
+				// The token is disabled and the price is untouched
+				feeToken, err := s.app.FeeAbstractionKeeper.FeeTokens.Get(ctx, "uatom")
+				s.Require().NoError(err)
+				s.Require().False(feeToken.Enabled)
+				s.Require().Equal(math.LegacyMustNewDecFromStr("50"), feeToken.Price)
+
+				// The token is flagged as stale
+				stale, err := s.app.FeeAbstractionKeeper.StaleFeeTokens.Has(ctx, "uatom")
+				s.Require().NoError(err)
+				s.Require().True(stale)
+
+				// Check the event
+				s.Require().True(hasEventAttribute(ctx, types.TypeEventFeeTokenAutoDisabled, types.TypeAttributeReason, types.ReasonStalePrice))
+			},
+		},
+		{
+			name: "token disabled due to low twap coverage",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Mock oracle twaps covering only a few seconds
+				ctx = s.createTwaps(ctx, math.LegacyMustNewDecFromStr("0.5"), 3, "atom")
+
+				// Set the fee token prices in the keeper
+				err := s.app.FeeAbstractionKeeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
+					types.NewFeeTokenMetadata("uatom", "atom", 6, math.LegacyMustNewDecFromStr("50")),
+				))
+				s.Require().NoError(err)
+
+				return ctx
+			},
+			postCheck: func(ctx sdk.Context) {
+				// The token is disabled
+				feeToken, err := s.app.FeeAbstractionKeeper.FeeTokens.Get(ctx, "uatom")
+				s.Require().NoError(err)
+				s.Require().False(feeToken.Enabled)
+
+				// Check the event
+				s.Require().True(hasEventAttribute(ctx, types.TypeEventFeeTokenAutoDisabled, types.TypeAttributeReason, types.ReasonLowTwapCoverage))
+			},
+		},
+		{
+			name: "stale token enabled once the data is back",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Mock oracle twaps
+				ctx = s.createTwaps(ctx, math.LegacyMustNewDecFromStr("0.5"), 100, "atom")
+
+				// Set the token as disabled by the oracle data guard, with an outdated price
+				err := s.app.FeeAbstractionKeeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
+					types.FeeTokenMetadata{
+						Denom:       "uatom",
+						OracleDenom: "atom",
+						Decimals:    6,
+						Price:       math.LegacyMustNewDecFromStr("5000"),
+						Enabled:     false,
+					},
+				))
+				s.Require().NoError(err)
+				err = s.app.FeeAbstractionKeeper.StaleFeeTokens.Set(ctx, "uatom")
+				s.Require().NoError(err)
+
+				return ctx
+			},
+			postCheck: func(ctx sdk.Context) {
+				// The token is enabled and the price is not clamped to the outdated one
+				feeToken, err := s.app.FeeAbstractionKeeper.FeeTokens.Get(ctx, "uatom")
+				s.Require().NoError(err)
+				s.Require().True(feeToken.Enabled)
+				s.Require().True(feeToken.Price.LT(math.LegacyOneDec()))
+
+				// The stale flag is removed
+				stale, err := s.app.FeeAbstractionKeeper.StaleFeeTokens.Has(ctx, "uatom")
+				s.Require().NoError(err)
+				s.Require().False(stale)
+
+				// Check the event
+				s.Require().True(hasEventAttribute(ctx, types.TypeEventFeeTokenAutoEnabled, types.TypeAttributeDenom, "uatom"))
+			},
+		},
+		{
+			name: "stale token stays disabled while the data is bad",
+			malleate: func(ctx sdk.Context) sdk.Context {
+				// Set the token as disabled by the oracle data guard without twaps
+				err := s.app.FeeAbstractionKeeper.SetFeeTokens(ctx, *types.NewFeeTokenMetadataCollection(
+					types.FeeTokenMetadata{
+						Denom:       "uatom",
+						OracleDenom: "atom",
+						Decimals:    6,
+						Price:       math.LegacyOneDec(),
+						Enabled:     false,
+					},
+				))
+				s.Require().NoError(err)
+				err = s.app.FeeAbstractionKeeper.StaleFeeTokens.Set(ctx, "uatom")
+				s.Require().NoError(err)
+
+				return ctx
+			},
+			postCheck: func(ctx sdk.Context) {
+				// The token is still disabled and flagged
+				feeToken, err := s.app.FeeAbstractionKeeper.FeeTokens.Get(ctx, "uatom")
+				s.Require().NoError(err)
+				s.Require().False(feeToken.Enabled)
+				stale, err := s.app.FeeAbstractionKeeper.StaleFeeTokens.Has(ctx, "uatom")
+				s.Require().NoError(err)
+				s.Require().True(stale)
+
+				// No new transition is emitted
+				s.Require().Zero(countEvents(ctx, types.TypeEventFeeTokenAutoDisabled))
+			},
+		},
 	}
 
 	// Iterate through the test cases
@@ -177,6 +304,7 @@ func (s *KeeperTestSuite) TestCalculateFeeTokenPrices() {
 		s.Run(tc.name, func() {
 			// Set a cached context
 			cachedCtx, _ := s.ctx.CacheContext()
+			cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
 
 			// Malleate the context
 			if tc.malleate != nil {
@@ -244,5 +372,9 @@ func (s *KeeperTestSuite) createTwaps(
 	err := s.app.OracleKeeper.VoteTarget.Set(ctx, denom, oracletypes.Denom{Name: denom})
 	s.Require().NoError(err)
 
+	// Set the latest exchange rate, updated at the last snapshot
+	err = s.app.OracleKeeper.SetBaseExchangeRateWithDefault(ctx, denom, startRate)
+	s.Require().NoError(err)
+
 	return ctx
 }
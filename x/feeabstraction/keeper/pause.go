@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+)
+
+// IsPaused returns true if the whole module is paused
+func (k Keeper) IsPaused(ctx context.Context) (bool, error) {
+	// The module is not paused if the flag was never set
+	paused, err := k.Paused.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return paused, nil
+}
+
+// IsFeeTokenPaused returns true if the fee token is paused
+func (k Keeper) IsFeeTokenPaused(ctx context.Context, denom string) (bool, error) {
+	return k.PausedFeeTokens.Has(ctx, denom)
+}
+
+// GetPausedFeeTokens returns the denoms of all the paused fee tokens
+// The denoms are ordered
+func (k Keeper) GetPausedFeeTokens(ctx context.Context) ([]string, error) {
+	// Iterate all the paused fee tokens
+	var denoms []string
+	err := k.PausedFeeTokens.Walk(ctx, nil, func(denom string) (bool, error) {
+		denoms = append(denoms, denom)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return denoms, nil
+}
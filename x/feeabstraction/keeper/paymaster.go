@@ -0,0 +1,298 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
+)
+
+// HasPaymaster returns true if the contract is a registered paymaster
+func (k Keeper) HasPaymaster(ctx context.Context, contract sdk.AccAddress) (bool, error) {
+	return k.Paymasters.Has(ctx, contract)
+}
+
+// isContract returns true if the address is a CosmWasm or an EVM contract
+func (k Keeper) isContract(ctx sdk.Context, address sdk.AccAddress) bool {
+	if k.wasmKeeper != nil && k.wasmKeeper.HasContractInfo(ctx, address) {
+		return true
+	}
+	return k.evmKeeper.IsContract(ctx, common.BytesToAddress(address))
+}
+
+// GetPaymaster returns a registered paymaster
+func (k Keeper) GetPaymaster(ctx context.Context, contract sdk.AccAddress) (types.Paymaster, error) {
+	paymaster, err := k.Paymasters.Get(ctx, contract)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Paymaster{}, errorsmod.Wrapf(types.ErrPaymasterNotFound, "paymaster %s not found", contract)
+		}
+		return types.Paymaster{}, err
+	}
+
+	return paymaster, nil
+}
+
+// GetPaymasters returns all the registered paymasters
+func (k Keeper) GetPaymasters(ctx context.Context) ([]types.Paymaster, error) {
+	// Iterate all the paymasters
+	var paymasters []types.Paymaster
+	err := k.Paymasters.Walk(ctx, nil, func(_ sdk.AccAddress, paymaster types.Paymaster) (bool, error) {
+		paymasters = append(paymasters, paymaster)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return paymasters, nil
+}
+
+// RemovePaymaster removes a paymaster and all its usages
+// The balance is not moved, the caller is responsible for it
+func (k Keeper) RemovePaymaster(ctx context.Context, contract sdk.AccAddress) error {
+	// Remove the user usages
+	rng := collections.NewPrefixedPairRange[sdk.AccAddress, sdk.AccAddress](contract)
+	if err := k.PaymasterUsages.Clear(ctx, rng); err != nil {
+		return err
+	}
+
+	// Remove the block usage and the paymaster
+	if err := k.PaymasterBlockUsages.Remove(ctx, contract); err != nil {
+		return err
+	}
+	return k.Paymasters.Remove(ctx, contract)
+}
+
+// GetPaymasterUsage returns the fees sponsored for the user on the current paymaster window
+// A new empty window is returned if the user has no usage or if its last window is over
+func (k Keeper) GetPaymasterUsage(ctx context.Context, contract, user sdk.AccAddress, window uint64) (types.PaymasterUsage, error) {
+	now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+
+	// Get the stored usage
+	usage, err := k.PaymasterUsages.Get(ctx, collections.Join(contract, user))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.NewPaymasterUsage(contract.String(), user.String(), now), nil
+		}
+		return types.PaymasterUsage{}, err
+	}
+
+	// Start a new window if the last one is over
+	if usage.IsExpired(now, window) {
+		return types.NewPaymasterUsage(contract.String(), user.String(), now), nil
+	}
+
+	return usage, nil
+}
+
+// GetPaymasterUsages returns the user usages of all the paymasters
+func (k Keeper) GetPaymasterUsages(ctx context.Context) ([]types.PaymasterUsage, error) {
+	// Iterate all the usages
+	var usages []types.PaymasterUsage
+	err := k.PaymasterUsages.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, sdk.AccAddress], usage types.PaymasterUsage) (bool, error) {
+		usages = append(usages, usage)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return usages, nil
+}
+
+// GetPaymasterBlockUsage returns the fees sponsored by the paymaster on the current block
+func (k Keeper) GetPaymasterBlockUsage(ctx context.Context, contract sdk.AccAddress) (types.PaymasterBlockUsage, error) {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	// Get the stored usage
+	usage, err := k.PaymasterBlockUsages.Get(ctx, contract)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.NewPaymasterBlockUsage(height), nil
+		}
+		return types.PaymasterBlockUsage{}, err
+	}
+
+	// The usage from older blocks is discarded
+	if usage.Height != height {
+		return types.NewPaymasterBlockUsage(height), nil
+	}
+
+	return usage, nil
+}
+
+// ChargePaymaster charges the paymaster for the fee of a Cosmos transaction
+// Every message on the transaction must be sponsored by the paymaster
+func (k Keeper) ChargePaymaster(ctx sdk.Context, contract, user sdk.AccAddress, msgs []sdk.Msg, fees sdk.Coins) error {
+	// Get the paymaster
+	paymaster, err := k.GetPaymaster(ctx, contract)
+	if err != nil {
+		return err
+	}
+
+	// Check that all the messages are sponsored
+	for _, msg := range msgs {
+		if !paymaster.IsEligibleMsg(msg) {
+			return errorsmod.Wrapf(types.ErrPaymasterNotEligible, "message %s is not sponsored by %s", sdk.MsgTypeURL(msg), paymaster.Contract)
+		}
+	}
+
+	return k.chargePaymaster(ctx, paymaster, contract, user, fees)
+}
+
+// ChargeEVMPaymaster charges the paymaster for the fee of an EVM transaction
+// The callee is the transaction recipient, contract creations are never sponsored
+func (k Keeper) ChargeEVMPaymaster(ctx sdk.Context, contract, user, callee sdk.AccAddress, fees sdk.Coins) error {
+	// Get the paymaster
+	paymaster, err := k.GetPaymaster(ctx, contract)
+	if err != nil {
+		return err
+	}
+
+	// Check that the callee is sponsored
+	if callee.Empty() || !paymaster.IsEligibleCallee(callee) {
+		return errorsmod.Wrapf(types.ErrPaymasterNotEligible, "callee %s is not sponsored by %s", callee, paymaster.Contract)
+	}
+
+	return k.chargePaymaster(ctx, paymaster, contract, user, fees)
+}
+
+// chargePaymaster moves the fee from the paymaster balance to the fee collector
+// The fee must be on the native denom and fit under the paymaster balance and limits
+func (k Keeper) chargePaymaster(ctx sdk.Context, paymaster types.Paymaster, contract, user sdk.AccAddress, fees sdk.Coins) error {
+	// The paymasters are paused with the module
+	paused, err := k.IsPaused(ctx)
+	if err != nil {
+		return err
+	}
+	if paused {
+		return errorsmod.Wrap(types.ErrPaymasterNotEligible, "fee abstraction is paused")
+	}
+
+	// Nothing to charge on zero fees
+	if fees.IsZero() {
+		return nil
+	}
+
+	// The paymaster balance is held on the native denom
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if len(fees) != 1 || fees[0].Denom != params.NativeDenom {
+		return errorsmod.Wrapf(types.ErrPaymasterNotEligible, "paymasters only pay fees in %s, got %s", params.NativeDenom, fees)
+	}
+	amount := fees[0].Amount
+
+	// Check the balance
+	if paymaster.Balance.LT(amount) {
+		return errorsmod.Wrapf(types.ErrPaymasterBalance, "paymaster %s balance %s is lower than the fee %s", paymaster.Contract, paymaster.Balance, amount)
+	}
+
+	// Check the per block limit
+	blockUsage, err := k.GetPaymasterBlockUsage(ctx, contract)
+	if err != nil {
+		return err
+	}
+	if amount.GT(blockUsage.Remaining(paymaster.PerBlockLimit)) {
+		return errorsmod.Wrapf(types.ErrPaymasterLimitReached, "paymaster %s per block limit reached", paymaster.Contract)
+	}
+
+	// Check the per user limit
+	userUsage, err := k.GetPaymasterUsage(ctx, contract, user, paymaster.UserWindow)
+	if err != nil {
+		return err
+	}
+	if amount.GT(userUsage.Remaining(paymaster.PerUserLimit)) {
+		return errorsmod.Wrapf(types.ErrPaymasterLimitReached, "paymaster %s per user limit reached for %s", paymaster.Contract, user)
+	}
+
+	// Pay the fee from the module account
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, fees); err != nil {
+		return err
+	}
+
+	// Update the balance and the usages
+	paymaster.Balance = paymaster.Balance.Sub(amount)
+	if err := k.Paymasters.Set(ctx, contract, paymaster); err != nil {
+		return err
+	}
+	blockUsage.Used = blockUsage.Used.Add(amount)
+	if err := k.PaymasterBlockUsages.Set(ctx, contract, blockUsage); err != nil {
+		return err
+	}
+	userUsage.Used = userUsage.Used.Add(amount)
+	if err := k.PaymasterUsages.Set(ctx, collections.Join(contract, user), userUsage); err != nil {
+		return err
+	}
+
+	// Emit the event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventPaymasterFee,
+			sdk.NewAttribute(types.TypeAttributeContract, paymaster.Contract),
+			sdk.NewAttribute(types.TypeAttributeUser, user.String()),
+			sdk.NewAttribute(types.TypeAttributeAmount, fees.String()),
+			sdk.NewAttribute(types.TypeAttributeBalance, paymaster.Balance.String()),
+		),
+	)
+
+	return nil
+}
+
+// RefundPaymaster moves the leftover gas refund of a sponsored EVM transaction back to the paymaster balance
+// The EVM module refunds the transaction sender, so the refund is taken back from the sender
+func (k Keeper) RefundPaymaster(ctx sdk.Context, contract, user sdk.AccAddress, refund sdk.Coins) error {
+	// Nothing to refund on zero amounts
+	if refund.IsZero() {
+		return nil
+	}
+
+	// Get the paymaster
+	paymaster, err := k.GetPaymaster(ctx, contract)
+	if err != nil {
+		return err
+	}
+
+	// The refund is held on the native denom
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if len(refund) != 1 || refund[0].Denom != params.NativeDenom {
+		return errorsmod.Wrapf(types.ErrInvalidPaymaster, "paymasters are refunded in %s, got %s", params.NativeDenom, refund)
+	}
+
+	// Move the refund to the module account
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, user, types.ModuleName, refund); err != nil {
+		return err
+	}
+
+	// Update the balance
+	paymaster.Balance = paymaster.Balance.Add(refund[0].Amount)
+	if err := k.Paymasters.Set(ctx, contract, paymaster); err != nil {
+		return err
+	}
+
+	// Emit the event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEventPaymasterRefund,
+			sdk.NewAttribute(types.TypeAttributeContract, paymaster.Contract),
+			sdk.NewAttribute(types.TypeAttributeUser, user.String()),
+			sdk.NewAttribute(types.TypeAttributeAmount, refund.String()),
+			sdk.NewAttribute(types.TypeAttributeBalance, paymaster.Balance.String()),
+		),
+	)
+
+	return nil
+}
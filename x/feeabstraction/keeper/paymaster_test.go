@@ -0,0 +1,338 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	"github.com/kiichain/kiichain/v4/app/apptesting"
+	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
+)
+
+// TestChargePaymaster tests the ChargePaymaster method
+func (s *KeeperTestSuite) TestChargePaymaster() {
+	contract := apptesting.RandomAccountAddress()
+	user := apptesting.RandomAccountAddress()
+	nativeDenom := s.nativeDenom()
+	sponsoredMsg := &wasmtypes.MsgExecuteContract{Sender: user.String(), Contract: contract.String()}
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msgs        []sdk.Msg
+		fees        sdk.Coins
+		malleate    func(ctx sdk.Context)
+		errContains string
+	}{
+		{
+			name: "valid - contract execution is sponsored",
+			msgs: []sdk.Msg{sponsoredMsg},
+			fees: sdk.NewCoins(sdk.NewInt64Coin(nativeDenom, 50)),
+		},
+		{
+			name: "valid - declared message type is sponsored",
+			msgs: []sdk.Msg{&banktypes.MsgSend{FromAddress: user.String(), ToAddress: contract.String()}},
+			fees: sdk.NewCoins(sdk.NewInt64Coin(nativeDenom, 50)),
+		},
+		{
+			name: "valid - user window is reset",
+			msgs: []sdk.Msg{sponsoredMsg},
+			fees: sdk.NewCoins(sdk.NewInt64Coin(nativeDenom, 50)),
+			malleate: func(ctx sdk.Context) {
+				usage := types.NewPaymasterUsage(contract.String(), user.String(), ctx.BlockTime().Add(-2*time.Hour).Unix())
+				usage.Used = math.NewInt(100)
+				s.Require().NoError(s.keeper.PaymasterUsages.Set(ctx, collections.Join(contract, user), usage))
+			},
+		},
+		{
+			name:        "invalid - paymaster not found",
+			msgs:        []sdk.Msg{sponsoredMsg},
+			fees:        sdk.NewCoins(sdk.NewInt64Coin(nativeDenom, 50)),
+			malleate:    func(ctx sdk.Context) { s.Require().NoError(s.keeper.Paymasters.Remove(ctx, contract)) },
+			errContains: "paymaster not found",
+		},
+		{
+			name:        "invalid - declared message type sent to another address",
+			msgs:        []sdk.Msg{&banktypes.MsgSend{FromAddress: user.String(), ToAddress: user.String()}},
+			fees:        sdk.NewCoins(sdk.NewInt64Coin(nativeDenom, 50)),
+			errContains: "message /cosmos.bank.v1beta1.MsgSend is not sponsored",
+		},
+		{
+			name:        "invalid - message not sponsored",
+			msgs:        []sdk.Msg{sponsoredMsg, &banktypes.MsgMultiSend{}},
+			fees:        sdk.NewCoins(sdk.NewInt64Coin(nativeDenom, 50)),
+			errContains: "message /cosmos.bank.v1beta1.MsgMultiSend is not sponsored",
+		},
+		{
+			name:        "invalid - module is paused",
+			msgs:        []sdk.Msg{sponsoredMsg},
+			fees:        sdk.NewCoins(sdk.NewInt64Coin(nativeDenom, 50)),
+			malleate:    func(ctx sdk.Context) { s.Require().NoError(s.keeper.Paused.Set(ctx, true)) },
+			errContains: "fee abstraction is paused",
+		},
+		{
+			name:        "invalid - fee not on the native denom",
+			msgs:        []sdk.Msg{sponsoredMsg},
+			fees:        sdk.NewCoins(sdk.NewInt64Coin("coin", 50)),
+			errContains: "paymasters only pay fees in",
+		},
+		{
+			name: "invalid - insufficient balance",
+			msgs: []sdk.Msg{sponsoredMsg},
+			fees: sdk.NewCoins(sdk.NewInt64Coin(nativeDenom, 50)),
+			malleate: func(ctx sdk.Context) {
+				paymaster, err := s.keeper.GetPaymaster(ctx, contract)
+				s.Require().NoError(err)
+				paymaster.Balance = math.NewInt(10)
+				s.Require().NoError(s.keeper.Paymasters.Set(ctx, contract, paymaster))
+			},
+			errContains: "insufficient paymaster balance",
+		},
+		{
+			name: "invalid - per block limit reached",
+			msgs: []sdk.Msg{sponsoredMsg},
+			fees: sdk.NewCoins(sdk.NewInt64Coin(nativeDenom, 50)),
+			malleate: func(ctx sdk.Context) {
+				usage := types.NewPaymasterBlockUsage(ctx.BlockHeight())
+				usage.Used = math.NewInt(980)
+				s.Require().NoError(s.keeper.PaymasterBlockUsages.Set(ctx, contract, usage))
+			},
+			errContains: "per block limit reached",
+		},
+		{
+			name:        "invalid - per user limit reached",
+			msgs:        []sdk.Msg{sponsoredMsg},
+			fees:        sdk.NewCoins(sdk.NewInt64Coin(nativeDenom, 150)),
+			errContains: "per user limit reached",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Set a cached context with a funded paymaster
+			cachedCtx, _ := s.ctx.CacheContext()
+			cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+			s.setupPaymaster(cachedCtx, contract, math.NewInt(500))
+
+			// Malleate if exists
+			if tc.malleate != nil {
+				tc.malleate(cachedCtx)
+			}
+			collectorBefore := s.app.BankKeeper.GetBalance(cachedCtx, authtypes.NewModuleAddress(authtypes.FeeCollectorName), nativeDenom)
+
+			// Charge the paymaster
+			err := s.keeper.ChargePaymaster(cachedCtx, contract, user, tc.msgs, tc.fees)
+
+			// Check for errors
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// The fee is moved to the fee collector
+				collectorAfter := s.app.BankKeeper.GetBalance(cachedCtx, authtypes.NewModuleAddress(authtypes.FeeCollectorName), nativeDenom)
+				s.Require().Equal(tc.fees.AmountOf(nativeDenom), collectorAfter.Amount.Sub(collectorBefore.Amount))
+
+				// The balance and usages are updated
+				paymaster, err := s.keeper.GetPaymaster(cachedCtx, contract)
+				s.Require().NoError(err)
+				s.Require().Equal(math.NewInt(450), paymaster.Balance)
+				usage, err := s.keeper.GetPaymasterUsage(cachedCtx, contract, user, paymaster.UserWindow)
+				s.Require().NoError(err)
+				s.Require().Equal(math.NewInt(50), usage.Used)
+				blockUsage, err := s.keeper.GetPaymasterBlockUsage(cachedCtx, contract)
+				s.Require().NoError(err)
+				s.Require().Equal(math.NewInt(50), blockUsage.Used)
+
+				// Verify the event was emitted
+				s.Require().True(hasEvent(cachedCtx, types.TypeEventPaymasterFee))
+			}
+		})
+	}
+}
+
+// TestChargeEVMPaymaster tests the ChargeEVMPaymaster method
+func (s *KeeperTestSuite) TestChargeEVMPaymaster() {
+	contract := apptesting.RandomAccountAddress()
+	callee := apptesting.RandomAccountAddress()
+	user := apptesting.RandomAccountAddress()
+	fees := sdk.NewCoins(sdk.NewInt64Coin(s.nativeDenom(), 50))
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		callee      sdk.AccAddress
+		errContains string
+	}{
+		{
+			name:   "valid - call to the paymaster contract",
+			callee: contract,
+		},
+		{
+			name:   "valid - call to a declared callee",
+			callee: callee,
+		},
+		{
+			name:        "invalid - call to another contract",
+			callee:      apptesting.RandomAccountAddress(),
+			errContains: "is not sponsored by",
+		},
+		{
+			name:        "invalid - contract creation",
+			callee:      nil,
+			errContains: "is not sponsored by",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Set a cached context with a funded paymaster
+			cachedCtx, _ := s.ctx.CacheContext()
+			cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+			s.setupPaymaster(cachedCtx, contract, math.NewInt(500), callee.String())
+
+			// Charge the paymaster
+			err := s.keeper.ChargeEVMPaymaster(cachedCtx, contract, user, tc.callee, fees)
+
+			// Check for errors
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// The balance is charged
+				paymaster, err := s.keeper.GetPaymaster(cachedCtx, contract)
+				s.Require().NoError(err)
+				s.Require().Equal(math.NewInt(450), paymaster.Balance)
+			}
+		})
+	}
+}
+
+// TestRefundPaymaster tests the refund of the leftover gas to the paymaster
+func (s *KeeperTestSuite) TestRefundPaymaster() {
+	contract := apptesting.RandomAccountAddress()
+	user := apptesting.RandomAccountAddress()
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name            string
+		refund          sdk.Coins
+		expectedBalance math.Int
+		errContains     string
+	}{
+		{
+			name:            "valid - refund is added to the balance",
+			refund:          sdk.NewCoins(sdk.NewInt64Coin(s.nativeDenom(), 30)),
+			expectedBalance: math.NewInt(530),
+		},
+		{
+			name:            "valid - zero refund is a no-op",
+			refund:          sdk.Coins{},
+			expectedBalance: math.NewInt(500),
+		},
+		{
+			name:        "invalid - refund in another denom",
+			refund:      sdk.NewCoins(sdk.NewInt64Coin("uatom", 30)),
+			errContains: "paymasters are refunded in",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			// Set a cached context with a funded paymaster and a refunded user
+			cachedCtx, _ := s.ctx.CacheContext()
+			cachedCtx = cachedCtx.WithEventManager(sdk.NewEventManager())
+			s.setupPaymaster(cachedCtx, contract, math.NewInt(500))
+			s.fundAccount(cachedCtx, user, tc.refund)
+
+			// Refund the paymaster
+			err := s.keeper.RefundPaymaster(cachedCtx, contract, user, tc.refund)
+
+			// Check for errors
+			if tc.errContains != "" {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+
+				// The refund is moved from the user to the paymaster balance
+				paymaster, err := s.keeper.GetPaymaster(cachedCtx, contract)
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expectedBalance, paymaster.Balance)
+				s.Require().True(s.app.BankKeeper.GetAllBalances(cachedCtx, user).IsZero())
+				if !tc.refund.IsZero() {
+					s.Require().True(hasEvent(cachedCtx, types.TypeEventPaymasterRefund))
+				}
+			}
+		})
+	}
+}
+
+// TestGetPaymasterBlockUsage tests that the block usage is reset on new blocks
+func (s *KeeperTestSuite) TestGetPaymasterBlockUsage() {
+	contract := apptesting.RandomAccountAddress()
+
+	// Store a usage for the current block
+	usage := types.NewPaymasterBlockUsage(s.ctx.BlockHeight())
+	usage.Used = math.NewInt(100)
+	s.Require().NoError(s.keeper.PaymasterBlockUsages.Set(s.ctx, contract, usage))
+
+	// The usage is returned on the same block
+	got, err := s.keeper.GetPaymasterBlockUsage(s.ctx, contract)
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(100), got.Used)
+
+	// The usage is reset on the next block
+	got, err = s.keeper.GetPaymasterBlockUsage(s.ctx.WithBlockHeight(s.ctx.BlockHeight()+1), contract)
+	s.Require().NoError(err)
+	s.Require().True(got.Used.IsZero())
+}
+
+// setupPaymaster registers a paymaster and funds it with the native denom
+func (s *KeeperTestSuite) setupPaymaster(ctx sdk.Context, contract sdk.AccAddress, balance math.Int, callees ...string) {
+	// Register the paymaster
+	paymaster := types.Paymaster{
+		Contract:      contract.String(),
+		MsgTypes:      []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+		Callees:       callees,
+		PerUserLimit:  math.NewInt(100),
+		PerBlockLimit: math.NewInt(1000),
+		UserWindow:    3600,
+		Balance:       math.ZeroInt(),
+	}
+	s.Require().NoError(s.keeper.Paymasters.Set(ctx, contract, paymaster))
+
+	// Fund it through the message server
+	funder := apptesting.RandomAccountAddress()
+	amount := sdk.NewCoin(s.nativeDenom(), balance)
+	s.fundAccount(ctx, funder, sdk.NewCoins(amount))
+	_, err := s.msgServer.FundPaymaster(ctx, types.NewMessageFundPaymaster(funder.String(), contract.String(), amount))
+	s.Require().NoError(err)
+}
+
+// setContractCode deploys code on the address, making it an EVM contract
+func (s *KeeperTestSuite) setContractCode(ctx sdk.Context, contract sdk.AccAddress) {
+	s.app.EVMKeeper.SetCodeHash(ctx, contract.Bytes(), crypto.Keccak256([]byte("paymaster")))
+}
+
+// nativeDenom returns the native denom from the module params
+func (s *KeeperTestSuite) nativeDenom() string {
+	params, err := s.keeper.Params.Get(s.ctx)
+	s.Require().NoError(err)
+	return params.NativeDenom
+}
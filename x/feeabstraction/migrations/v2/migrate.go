@@ -0,0 +1,52 @@
+package v2
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
+)
+
+// MigrateStore migrates the x/feeabstraction module state from consensus version 1 to 2
+// The fee tokens are moved from a single collection item into a map keyed by denom
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	// Build both the legacy and the new fee token collections
+	sb := collections.NewSchemaBuilder(storeService)
+	legacyFeeTokens := collections.NewItem(
+		sb,
+		types.FeeTokensCollectionKey,
+		"fee_tokens_collection",
+		codec.CollValue[types.FeeTokenMetadataCollection](cdc),
+	)
+	feeTokens := collections.NewMap(
+		sb,
+		types.FeeTokensKey,
+		"fee_tokens",
+		collections.StringKey,
+		codec.CollValue[types.FeeTokenMetadata](cdc),
+	)
+
+	// Get the legacy fee tokens, nothing to migrate if they were never set
+	legacyCollection, err := legacyFeeTokens.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// Move each fee token into the map
+	for _, feeToken := range legacyCollection.Items {
+		if err := feeTokens.Set(ctx, feeToken.Denom, feeToken); err != nil {
+			return err
+		}
+	}
+
+	// Remove the legacy fee tokens
+	return legacyFeeTokens.Remove(ctx)
+}
@@ -0,0 +1,61 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+
+	v2 "github.com/kiichain/kiichain/v4/x/feeabstraction/migrations/v2"
+	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
+)
+
+// TestMigrateStore tests the migration of the fee tokens into a map
+func TestMigrateStore(t *testing.T) {
+	// Prepare the store and the codec
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+
+	// Prepare the legacy and the new collections
+	sb := collections.NewSchemaBuilder(storeService)
+	legacyFeeTokens := collections.NewItem(sb, types.FeeTokensCollectionKey, "fee_tokens_collection", codec.CollValue[types.FeeTokenMetadataCollection](cdc))
+	feeTokens := collections.NewMap(sb, types.FeeTokensKey, "fee_tokens", collections.StringKey, codec.CollValue[types.FeeTokenMetadata](cdc))
+
+	// Migrating without legacy state is a no-op
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+
+	// Set the legacy fee tokens
+	disabledToken := types.NewFeeTokenMetadata("uatom", "atom", 6, math.LegacyMustNewDecFromStr("0.5"))
+	disabledToken.Enabled = false
+	legacyCollection := types.NewFeeTokenMetadataCollection(
+		types.NewFeeTokenMetadata("usol", "sol", 9, math.LegacyMustNewDecFromStr("0.1")),
+		disabledToken,
+	)
+	require.NoError(t, legacyFeeTokens.Set(ctx, *legacyCollection))
+
+	// Run the migration
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+
+	// The legacy item must be removed
+	exists, err := legacyFeeTokens.Has(ctx)
+	require.NoError(t, err)
+	require.False(t, exists)
+
+	// All the fee tokens must be available under their denom
+	for _, expected := range legacyCollection.Items {
+		feeToken, err := feeTokens.Get(ctx, expected.Denom)
+		require.NoError(t, err)
+		require.Equal(t, expected, feeToken)
+	}
+}
@@ -0,0 +1,32 @@
+package v3
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
+)
+
+// MigrateStore migrates the x/feeabstraction module state from consensus version 2 to 3
+// The conversion cap window param is set to its default value
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	// Build the params collection
+	sb := collections.NewSchemaBuilder(storeService)
+	paramsItem := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+
+	// Get the current params
+	params, err := paramsItem.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Set the default conversion cap window if it's not set
+	if params.ConversionCapWindow == 0 {
+		params.ConversionCapWindow = types.DefaultConversionCapWindow
+	}
+
+	return paramsItem.Set(ctx, params)
+}
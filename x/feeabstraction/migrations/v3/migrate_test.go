@@ -0,0 +1,54 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+
+	v3 "github.com/kiichain/kiichain/v4/x/feeabstraction/migrations/v3"
+	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
+)
+
+// TestMigrateStore tests the migration of the conversion cap window param
+func TestMigrateStore(t *testing.T) {
+	// Prepare the store and the codec
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+
+	// Prepare the params collection
+	sb := collections.NewSchemaBuilder(storeService)
+	paramsItem := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+
+	// Set the params without the conversion cap window
+	legacyParams := types.DefaultParams()
+	legacyParams.ConversionCapWindow = 0
+	require.NoError(t, paramsItem.Set(ctx, legacyParams))
+
+	// Run the migration
+	require.NoError(t, v3.MigrateStore(ctx, storeService, cdc))
+
+	// The window must be set to the default value and the params must be valid
+	params, err := paramsItem.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultConversionCapWindow, params.ConversionCapWindow)
+	require.NoError(t, params.Validate())
+
+	// A custom window is kept
+	params.ConversionCapWindow = 3600
+	require.NoError(t, paramsItem.Set(ctx, params))
+	require.NoError(t, v3.MigrateStore(ctx, storeService, cdc))
+	params, err = paramsItem.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3600), params.ConversionCapWindow)
+}
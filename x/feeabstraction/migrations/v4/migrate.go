@@ -0,0 +1,35 @@
+package v4
+
+import (
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
+)
+
+// MigrateStore migrates the x/feeabstraction module state from consensus version 3 to 4
+// The max price age and min twap coverage params are set to their default values
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	// Build the params collection
+	sb := collections.NewSchemaBuilder(storeService)
+	paramsItem := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+
+	// Get the current params
+	params, err := paramsItem.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Set the default oracle data guards if they are not set
+	if params.MaxPriceAge == 0 {
+		params.MaxPriceAge = types.DefaultMaxPriceAge
+	}
+	if params.MinTwapCoverage == 0 {
+		params.MinTwapCoverage = min(types.DefaultMinTwapCoverage, params.TwapLookbackWindow)
+	}
+
+	return paramsItem.Set(ctx, params)
+}
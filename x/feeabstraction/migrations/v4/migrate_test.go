@@ -0,0 +1,58 @@
+package v4_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+
+	v4 "github.com/kiichain/kiichain/v4/x/feeabstraction/migrations/v4"
+	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
+)
+
+// TestMigrateStore tests the migration of the oracle data guard params
+func TestMigrateStore(t *testing.T) {
+	// Prepare the store and the codec
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+
+	// Prepare the params collection
+	sb := collections.NewSchemaBuilder(storeService)
+	paramsItem := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+
+	// Set the params without the oracle data guards
+	legacyParams := types.DefaultParams()
+	legacyParams.MaxPriceAge = 0
+	legacyParams.MinTwapCoverage = 0
+	require.NoError(t, paramsItem.Set(ctx, legacyParams))
+
+	// Run the migration
+	require.NoError(t, v4.MigrateStore(ctx, storeService, cdc))
+
+	// The guards must be set to the default values and the params must be valid
+	params, err := paramsItem.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultMaxPriceAge, params.MaxPriceAge)
+	require.Equal(t, types.DefaultMinTwapCoverage, params.MinTwapCoverage)
+	require.NoError(t, params.Validate())
+
+	// The coverage never goes above a short lookback window
+	params.MinTwapCoverage = 0
+	params.TwapLookbackWindow = 30
+	require.NoError(t, paramsItem.Set(ctx, params))
+	require.NoError(t, v4.MigrateStore(ctx, storeService, cdc))
+	params, err = paramsItem.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(30), params.MinTwapCoverage)
+	require.NoError(t, params.Validate())
+}
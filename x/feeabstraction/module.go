@@ -33,7 +33,7 @@ var (
 )
 
 // ConsensusVersion defines the current x/feeabstraction module consensus version
-const ConsensusVersion = 1
+const ConsensusVersion = 4
 
 // ----------------------------------------------------------------------------
 // AppModuleBasic
@@ -134,6 +134,18 @@ func (AppModule) QuerierRoute() string { return types.QuerierRoute }
 func (am AppModule) RegisterServices(c module.Configurator) {
 	types.RegisterMsgServer(c.MsgServer(), keeper.NewMsgServer(am.keeper))
 	types.RegisterQueryServer(c.QueryServer(), keeper.NewQuerier(am.keeper))
+
+	// Register the store migrations
+	m := keeper.NewMigrator(am.keeper)
+	if err := c.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+	if err := c.RegisterMigration(types.ModuleName, 2, m.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
+	if err := c.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 3 to 4: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants register the module invariants
@@ -8,8 +8,17 @@ import (
 )
 
 const (
-	MsgUpdateParamsName    = "feeabstraction/update-params"
-	MsgUpdateFeeTokensName = "feeabstraction/update-fee-tokens"
+	MsgUpdateParamsName          = "feeabstraction/update-params"
+	MsgUpdateFeeTokensName       = "feeabstraction/update-fee-tokens"
+	MsgUpdateFeeTokenPricingName = "feeabstraction/update-fee-token-pricing"
+	MsgAddFeeTokenName           = "feeabstraction/add-fee-token"
+	MsgRemoveFeeTokenName        = "feeabstraction/remove-fee-token"
+	MsgSetFeeTokenEnabledName    = "feeabstraction/set-fee-token-enabled"
+	MsgPauseName                 = "feeabstraction/pause"
+	MsgUnpauseName               = "feeabstraction/unpause"
+	MsgSetPaymasterName          = "feeabstraction/set-paymaster"
+	MsgFundPaymasterName         = "feeabstraction/fund-paymaster"
+	MsgRemovePaymasterName       = "feeabstraction/remove-paymaster"
 )
 
 // RegisterInterfaces register all the proto interfaces into the app
@@ -19,6 +28,15 @@ func RegisterInterfaces(r codectypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 		&MsgUpdateFeeTokens{},
+		&MsgUpdateFeeTokenPricing{},
+		&MsgAddFeeToken{},
+		&MsgRemoveFeeToken{},
+		&MsgSetFeeTokenEnabled{},
+		&MsgPause{},
+		&MsgUnpause{},
+		&MsgSetPaymaster{},
+		&MsgFundPaymaster{},
+		&MsgRemovePaymaster{},
 	)
 
 	// Register on the message service
@@ -30,4 +48,13 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	// Register all the concrete types
 	cdc.RegisterConcrete(&MsgUpdateParams{}, MsgUpdateParamsName, nil)
 	cdc.RegisterConcrete(&MsgUpdateFeeTokens{}, MsgUpdateFeeTokensName, nil)
+	cdc.RegisterConcrete(&MsgUpdateFeeTokenPricing{}, MsgUpdateFeeTokenPricingName, nil)
+	cdc.RegisterConcrete(&MsgAddFeeToken{}, MsgAddFeeTokenName, nil)
+	cdc.RegisterConcrete(&MsgRemoveFeeToken{}, MsgRemoveFeeTokenName, nil)
+	cdc.RegisterConcrete(&MsgSetFeeTokenEnabled{}, MsgSetFeeTokenEnabledName, nil)
+	cdc.RegisterConcrete(&MsgPause{}, MsgPauseName, nil)
+	cdc.RegisterConcrete(&MsgUnpause{}, MsgUnpauseName, nil)
+	cdc.RegisterConcrete(&MsgSetPaymaster{}, MsgSetPaymasterName, nil)
+	cdc.RegisterConcrete(&MsgFundPaymaster{}, MsgFundPaymasterName, nil)
+	cdc.RegisterConcrete(&MsgRemovePaymaster{}, MsgRemovePaymasterName, nil)
 }
@@ -27,5 +27,14 @@ func TestRegisterInterfaces(t *testing.T) {
 	require.ElementsMatch(t, interfaces, []string{
 		"/kiichain.feeabstraction.v1beta1.MsgUpdateParams",
 		"/kiichain.feeabstraction.v1beta1.MsgUpdateFeeTokens",
+		"/kiichain.feeabstraction.v1beta1.MsgUpdateFeeTokenPricing",
+		"/kiichain.feeabstraction.v1beta1.MsgAddFeeToken",
+		"/kiichain.feeabstraction.v1beta1.MsgRemoveFeeToken",
+		"/kiichain.feeabstraction.v1beta1.MsgSetFeeTokenEnabled",
+		"/kiichain.feeabstraction.v1beta1.MsgPause",
+		"/kiichain.feeabstraction.v1beta1.MsgUnpause",
+		"/kiichain.feeabstraction.v1beta1.MsgSetPaymaster",
+		"/kiichain.feeabstraction.v1beta1.MsgFundPaymaster",
+		"/kiichain.feeabstraction.v1beta1.MsgRemovePaymaster",
 	})
 }
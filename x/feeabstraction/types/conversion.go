@@ -0,0 +1,121 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ConversionBucketCount is the number of sub-windows the conversion cap window is split into
+// The usage is summed over the trailing sub-windows, so the cap applies to any window of the same length
+const ConversionBucketCount = 24
+
+// NewConversionUsage creates a new empty conversion usage
+func NewConversionUsage(denom string) ConversionUsage {
+	return ConversionUsage{
+		Denom:   denom,
+		Buckets: []ConversionBucket{},
+	}
+}
+
+// NewConversionBucket creates a new conversion bucket
+func NewConversionBucket(start int64, used math.Int) ConversionBucket {
+	return ConversionBucket{
+		Start: start,
+		Used:  used,
+	}
+}
+
+// BucketDuration returns the length in seconds of each sub-window of the conversion cap window
+func BucketDuration(window uint64) int64 {
+	duration := int64(window / ConversionBucketCount)
+	if duration == 0 {
+		return 1
+	}
+	return duration
+}
+
+// Validate validates the ConversionUsage
+func (u ConversionUsage) Validate() error {
+	// Validate the denom
+	if err := sdk.ValidateDenom(u.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidFeeTokenMetadata, "conversion usage denom is invalid")
+	}
+
+	// Validate the buckets, they must be sorted by their start time
+	for i, bucket := range u.Buckets {
+		if bucket.Start < 0 {
+			return errorsmod.Wrap(ErrInvalidFeeTokenMetadata, "conversion usage bucket start cannot be negative")
+		}
+		if i > 0 && bucket.Start <= u.Buckets[i-1].Start {
+			return errorsmod.Wrap(ErrInvalidFeeTokenMetadata, "conversion usage buckets must be sorted by start time")
+		}
+		if bucket.Used.IsNil() || bucket.Used.IsNegative() {
+			return errorsmod.Wrap(ErrInvalidFeeTokenMetadata, "conversion usage used amount cannot be negative")
+		}
+	}
+
+	return nil
+}
+
+// Prune removes the buckets that are out of the rolling window at the given time
+// A bucket leaves the window once its whole sub-window is older than the window length
+func (u ConversionUsage) Prune(now int64, window uint64) ConversionUsage {
+	duration := BucketDuration(window)
+
+	// Keep the buckets still inside the window
+	buckets := make([]ConversionBucket, 0, len(u.Buckets))
+	for _, bucket := range u.Buckets {
+		if now < bucket.Start+duration+int64(window) {
+			buckets = append(buckets, bucket)
+		}
+	}
+	u.Buckets = buckets
+
+	return u
+}
+
+// Add records the native-equivalent amount on the sub-window of the given time
+func (u *ConversionUsage) Add(now int64, window uint64, amount math.Int) {
+	duration := BucketDuration(window)
+	start := now - now%duration
+
+	// Add to the newest bucket if the time falls on it
+	// A window change may align the new sub-window before the newest bucket, so it's added there as well
+	last := len(u.Buckets) - 1
+	if last >= 0 && start <= u.Buckets[last].Start {
+		u.Buckets[last].Used = u.Buckets[last].Used.Add(amount)
+		return
+	}
+
+	u.Buckets = append(u.Buckets, NewConversionBucket(start, amount))
+}
+
+// Used returns the native-equivalent amount paid on the rolling window
+func (u ConversionUsage) Used() math.Int {
+	used := math.ZeroInt()
+	for _, bucket := range u.Buckets {
+		used = used.Add(bucket.Used)
+	}
+	return used
+}
+
+// WindowEnd returns the unix time in seconds where the oldest bucket leaves the rolling window
+// It returns zero if there's no usage
+func (u ConversionUsage) WindowEnd(window uint64) int64 {
+	if len(u.Buckets) == 0 {
+		return 0
+	}
+	return u.Buckets[0].Start + BucketDuration(window) + int64(window)
+}
+
+// Remaining returns the amount still available under the cap
+// It returns zero if the cap was already reached
+func (u ConversionUsage) Remaining(conversionCap math.Int) math.Int {
+	used := u.Used()
+	if used.GTE(conversionCap) {
+		return math.ZeroInt()
+	}
+	return conversionCap.Sub(used)
+}
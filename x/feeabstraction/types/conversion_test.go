@@ -0,0 +1,114 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
+)
+
+// TestConversionUsageValidate tests the Validate method of ConversionUsage
+func TestConversionUsageValidate(t *testing.T) {
+	// Prepare test cases
+	testCases := []struct {
+		name        string
+		usage       types.ConversionUsage
+		errContains string
+	}{
+		{
+			name:  "valid - new usage",
+			usage: types.NewConversionUsage("coin"),
+		},
+		{
+			name: "valid - usage with buckets",
+			usage: types.ConversionUsage{
+				Denom: "coin",
+				Buckets: []types.ConversionBucket{
+					types.NewConversionBucket(100, math.NewInt(10)),
+					types.NewConversionBucket(200, math.NewInt(20)),
+				},
+			},
+		},
+		{
+			name:        "invalid - bad denom",
+			usage:       types.NewConversionUsage("invalid denom!"),
+			errContains: "conversion usage denom is invalid",
+		},
+		{
+			name: "invalid - negative bucket start",
+			usage: types.ConversionUsage{
+				Denom:   "coin",
+				Buckets: []types.ConversionBucket{types.NewConversionBucket(-1, math.NewInt(10))},
+			},
+			errContains: "conversion usage bucket start cannot be negative",
+		},
+		{
+			name: "invalid - unsorted buckets",
+			usage: types.ConversionUsage{
+				Denom: "coin",
+				Buckets: []types.ConversionBucket{
+					types.NewConversionBucket(200, math.NewInt(10)),
+					types.NewConversionBucket(100, math.NewInt(20)),
+				},
+			},
+			errContains: "conversion usage buckets must be sorted by start time",
+		},
+		{
+			name: "invalid - negative used amount",
+			usage: types.ConversionUsage{
+				Denom:   "coin",
+				Buckets: []types.ConversionBucket{types.NewConversionBucket(100, math.NewInt(-1))},
+			},
+			errContains: "conversion usage used amount cannot be negative",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.usage.Validate()
+
+			// Check the error
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errContains)
+			}
+		})
+	}
+}
+
+// TestConversionUsageWindow tests the rolling window helpers of ConversionUsage
+func TestConversionUsageWindow(t *testing.T) {
+	// A window of 2400 seconds has sub-windows of 100 seconds
+	window := uint64(2400)
+	require.Equal(t, int64(100), types.BucketDuration(window))
+	require.Equal(t, int64(1), types.BucketDuration(10))
+
+	// Record amounts on two sub-windows
+	usage := types.NewConversionUsage("coin")
+	require.Zero(t, usage.WindowEnd(window))
+	usage.Add(1050, window, math.NewInt(300))
+	usage.Add(1099, window, math.NewInt(100))
+	usage.Add(2000, window, math.NewInt(200))
+	require.Len(t, usage.Buckets, 2)
+	require.Equal(t, int64(1000), usage.Buckets[0].Start)
+	require.Equal(t, math.NewInt(600), usage.Used())
+
+	// The oldest bucket leaves the window once its whole sub-window is over
+	require.Equal(t, int64(3500), usage.WindowEnd(window))
+	require.Len(t, usage.Prune(3499, window).Buckets, 2)
+	pruned := usage.Prune(3500, window)
+	require.Len(t, pruned.Buckets, 1)
+	require.Equal(t, math.NewInt(200), pruned.Used())
+	require.Empty(t, usage.Prune(4500, window).Buckets)
+
+	// Check the remaining amount
+	require.Equal(t, math.NewInt(400), usage.Remaining(math.NewInt(1000)))
+	require.True(t, usage.Remaining(math.NewInt(600)).IsZero())
+	require.True(t, usage.Remaining(math.NewInt(100)).IsZero())
+}
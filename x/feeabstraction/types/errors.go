@@ -8,4 +8,18 @@ import (
 var (
 	ErrInvalidFeeTokenMetadata = errorsmod.Register(ModuleName, 1, "invalid fee token metadata")
 	ErrInvalidParams           = errorsmod.Register(ModuleName, 2, "invalid fee abstraction params")
+	ErrFeeTokenNotFound        = errorsmod.Register(ModuleName, 3, "fee token not found")
+	ErrFeeTokenAlreadyExists   = errorsmod.Register(ModuleName, 4, "fee token already exists")
+	ErrUnauthorizedPauser      = errorsmod.Register(ModuleName, 5, "sender is not the guardian or the governance account")
+	ErrAlreadyPaused           = errorsmod.Register(ModuleName, 6, "already paused")
+	ErrNotPaused               = errorsmod.Register(ModuleName, 7, "not paused")
+	ErrConversionCapReached    = errorsmod.Register(ModuleName, 8, "fee token conversion cap reached")
+	ErrFeeTokenUnavailable     = errorsmod.Register(ModuleName, 9, "fee token unavailable for fee payment")
+	ErrInvalidPaymaster        = errorsmod.Register(ModuleName, 10, "invalid paymaster")
+	ErrPaymasterNotFound       = errorsmod.Register(ModuleName, 11, "paymaster not found")
+	ErrUnauthorizedPaymaster   = errorsmod.Register(ModuleName, 12, "sender is not the paymaster contract or the governance account")
+	ErrPaymasterNotEligible    = errorsmod.Register(ModuleName, 13, "transaction not eligible for the paymaster")
+	ErrPaymasterLimitReached   = errorsmod.Register(ModuleName, 14, "paymaster limit reached")
+	ErrPaymasterBalance        = errorsmod.Register(ModuleName, 15, "insufficient paymaster balance")
+	ErrUnknownDenomTrace       = errorsmod.Register(ModuleName, 16, "unknown ibc denom trace")
 )
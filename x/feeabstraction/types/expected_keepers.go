@@ -7,9 +7,12 @@ import (
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	erc20types "github.com/cosmos/evm/x/erc20/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 
 	oracletypes "github.com/kiichain/kiichain/v4/x/oracle/types"
 )
@@ -29,10 +32,22 @@ type Erc20Keeper interface {
 	) *big.Int
 }
 
+// EVMKeeper defines the expected interface for the EVM keeper
+type EVMKeeper interface {
+	IsContract(ctx sdk.Context, addr common.Address) bool
+}
+
+// WasmKeeper defines the expected interface for the Wasm keeper
+type WasmKeeper interface {
+	HasContractInfo(ctx context.Context, contractAddress sdk.AccAddress) bool
+}
+
 // BankKeeper defines the expected interface for the Bank keeper
 type BankKeeper interface {
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 }
 
 // OracleKeeper define the expected interface for the Oracle keeper
@@ -40,4 +55,10 @@ type OracleKeeper interface {
 	CalculateTwaps(ctx sdk.Context, lookBackSeconds uint64) (oracletypes.OracleTwaps, error)
 	ValidateLookBackSeconds(ctx sdk.Context, lookBackSeconds uint64) error
 	GetVoteTargets(ctx sdk.Context) ([]string, error)
+	GetBaseExchangeRate(ctx sdk.Context, denom string) (oracletypes.OracleExchangeRate, error)
+}
+
+// TransferKeeper defines the expected interface for the IBC transfer keeper
+type TransferKeeper interface {
+	GetDenomTrace(ctx sdk.Context, denomTraceHash cmtbytes.HexBytes) (transfertypes.DenomTrace, bool)
 }
@@ -49,6 +49,56 @@ func CalculateTokenAmountWithDecimals(
 	return amountInOtherFull.Mul(math.LegacyNewDec(10).Power(decimalsOther)), nil
 }
 
+// CalculateNativeAmountWithDecimals calculates the native amount covered by a token amount
+// This is the inverse of CalculateTokenAmountWithDecimals
+func CalculateNativeAmountWithDecimals(
+	price math.LegacyDec,
+	tokenAmountAtMinimal math.Int,
+	decimalsBase uint64,
+	decimalsOther uint64,
+) (math.LegacyDec, error) {
+	// Check if the values are valid
+	if decimalsBase == 0 || decimalsOther == 0 {
+		return math.LegacyDec{}, fmt.Errorf("invalid decimals: must be > 0")
+	}
+	if tokenAmountAtMinimal.IsZero() || price.IsZero() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	// Calculate the minimal token to full token
+	amountFull := tokenAmountAtMinimal.ToLegacyDec().Quo(math.LegacyNewDec(10).Power(decimalsOther))
+
+	// Divide the amount by the price
+	amountInBaseFull := amountFull.Quo(price)
+
+	// Convert the units back
+	return amountInBaseFull.Mul(math.LegacyNewDec(10).Power(decimalsBase)), nil
+}
+
+// CalculateEffectivePrice calculates the price actually paid for a native amount with a token amount
+// This is the price matching CalculateTokenAmountWithDecimals once the fee bounds and the rounding are applied
+func CalculateEffectivePrice(
+	nativeAmountAtMinimal math.Int,
+	tokenAmountAtMinimal math.Int,
+	decimalsBase uint64,
+	decimalsOther uint64,
+) (math.LegacyDec, error) {
+	// Check if the values are valid
+	if decimalsBase == 0 || decimalsOther == 0 {
+		return math.LegacyDec{}, fmt.Errorf("invalid decimals: must be > 0")
+	}
+	if nativeAmountAtMinimal.IsZero() {
+		return math.LegacyDec{}, fmt.Errorf("invalid input: native amount is zero")
+	}
+
+	// Calculate both amounts as full tokens
+	nativeFull := nativeAmountAtMinimal.ToLegacyDec().Quo(math.LegacyNewDec(10).Power(decimalsBase))
+	tokenFull := tokenAmountAtMinimal.ToLegacyDec().Quo(math.LegacyNewDec(10).Power(decimalsOther))
+
+	// Divide the token amount by the native amount
+	return tokenFull.Quo(nativeFull), nil
+}
+
 // ClampPrice ensures newPrice is within ±clampFactor of prevPrice.
 // If prevPrice is zero, returns newPrice unmodified.
 func ClampPrice(prevPrice, newPrice, clampFactor math.LegacyDec) math.LegacyDec {
@@ -71,3 +121,27 @@ func ClampPrice(prevPrice, newPrice, clampFactor math.LegacyDec) math.LegacyDec
 	// Return the new price as it is within bounds
 	return newPrice
 }
+
+// ApplyPriceAdjustment applies a markup (positive) or discount (negative) over the price
+func ApplyPriceAdjustment(price, adjustment math.LegacyDec) math.LegacyDec {
+	if adjustment.IsZero() {
+		return price
+	}
+	return price.Mul(math.LegacyOneDec().Add(adjustment))
+}
+
+// ApplyFeeBounds raises the amount to the min fee and checks it against the max fee
+// Zero bounds are ignored. It returns false if the amount is above the max fee
+func ApplyFeeBounds(amount, minFee, maxFee math.Int) (math.Int, bool) {
+	// Raise the amount to the min fee
+	if !minFee.IsZero() && amount.LT(minFee) {
+		amount = minFee
+	}
+
+	// Amounts above the max fee can't be paid
+	if !maxFee.IsZero() && amount.GT(maxFee) {
+		return amount, false
+	}
+
+	return amount, true
+}
@@ -186,6 +186,146 @@ func TestCalculateTokenAmountWithDecimals(t *testing.T) {
 	}
 }
 
+// TestCalculateNativeAmountWithDecimals tests the CalculateNativeAmountWithDecimals function
+func TestCalculateNativeAmountWithDecimals(t *testing.T) {
+	// Prepare the test cases
+	testCases := []struct {
+		name          string
+		price         math.LegacyDec
+		amount        math.Int
+		decimalsBase  uint64
+		decimalsOther uint64
+		expected      math.LegacyDec
+		errContains   string
+	}{
+		{
+			// Both tokens have 2 decimals, the price is 10, and the token amount is 1230
+			// The expected result is 123
+			name:          "Same decimals, simple price",
+			price:         math.LegacyNewDec(10),
+			amount:        math.NewInt(1230),
+			decimalsBase:  2,
+			decimalsOther: 2,
+			expected:      math.LegacyMustNewDecFromStr("123"),
+		},
+		{
+			// The inverse of `different decimals, (Kii 18, USD 6)`
+			name:          "different decimals, (Kii 18, USD 6)",
+			price:         math.LegacyMustNewDecFromStr("10"),
+			amount:        math.NewInt(1230000000),
+			decimalsBase:  18,
+			decimalsOther: 6,
+			expected:      math.LegacyNewDec(123).Mul(math.LegacyNewDec(1e18)),
+		},
+		{
+			// Test with zero price, should return zero
+			name:          "zero price",
+			price:         math.LegacyNewDec(0),
+			amount:        math.NewInt(123),
+			decimalsBase:  2,
+			decimalsOther: 2,
+			expected:      math.LegacyZeroDec(),
+		},
+		{
+			// Test with zero decimals, should return an error
+			name:          "zero decimals",
+			price:         math.LegacyNewDec(10),
+			amount:        math.NewInt(123),
+			decimalsBase:  0,
+			decimalsOther: 2,
+			errContains:   "invalid decimals: must be > 0",
+		},
+	}
+
+	// Iterate over the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Calculate the native amount with decimals
+			result, err := types.CalculateNativeAmountWithDecimals(tc.price, tc.amount, tc.decimalsBase, tc.decimalsOther)
+
+			// Check for expected error
+			if tc.errContains != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errContains)
+			} else {
+				require.NoError(t, err)
+				// Check if the result matches the expected value
+				require.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
+
+// TestCalculateEffectivePrice tests the CalculateEffectivePrice function
+func TestCalculateEffectivePrice(t *testing.T) {
+	// Prepare the test cases
+	testCases := []struct {
+		name          string
+		nativeAmount  math.Int
+		tokenAmount   math.Int
+		decimalsBase  uint64
+		decimalsOther uint64
+		expected      math.LegacyDec
+		errContains   string
+	}{
+		{
+			// Both tokens have 2 decimals, 123 is paid with 1230
+			// The expected price is 10
+			name:          "Same decimals, simple price",
+			nativeAmount:  math.NewInt(123),
+			tokenAmount:   math.NewInt(1230),
+			decimalsBase:  2,
+			decimalsOther: 2,
+			expected:      math.LegacyNewDec(10),
+		},
+		{
+			// 1 Kii paid with 2 USD, as a min fee would charge
+			name:          "different decimals, (Kii 18, USD 6)",
+			nativeAmount:  math.NewInt(1e18),
+			tokenAmount:   math.NewInt(2_000_000),
+			decimalsBase:  18,
+			decimalsOther: 6,
+			expected:      math.LegacyNewDec(2),
+		},
+		{
+			// Test with a zero native amount, should return an error
+			name:          "zero native amount",
+			nativeAmount:  math.ZeroInt(),
+			tokenAmount:   math.NewInt(123),
+			decimalsBase:  2,
+			decimalsOther: 2,
+			errContains:   "native amount is zero",
+		},
+		{
+			// Test with zero decimals, should return an error
+			name:          "zero decimals",
+			nativeAmount:  math.NewInt(123),
+			tokenAmount:   math.NewInt(123),
+			decimalsBase:  0,
+			decimalsOther: 2,
+			errContains:   "invalid decimals: must be > 0",
+		},
+	}
+
+	// Iterate over the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Calculate the effective price
+			result, err := types.CalculateEffectivePrice(tc.nativeAmount, tc.tokenAmount, tc.decimalsBase, tc.decimalsOther)
+
+			// Check for expected error
+			if tc.errContains != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errContains)
+			} else {
+				require.NoError(t, err)
+				// Check if the result matches the expected value
+				require.Equal(t, tc.expected, result)
+			}
+		})
+	}
+}
+
 // TestClampPrice tests the ClampPrice function
 func TestClampPrice(t *testing.T) {
 	// Prepare the test cases
@@ -238,3 +378,110 @@ func TestClampPrice(t *testing.T) {
 		require.Equal(t, tc.expected, result)
 	}
 }
+
+// TestApplyPriceAdjustment tests the ApplyPriceAdjustment function
+func TestApplyPriceAdjustment(t *testing.T) {
+	// Prepare the test cases
+	testCases := []struct {
+		name       string
+		price      math.LegacyDec
+		adjustment math.LegacyDec
+		expected   math.LegacyDec
+	}{
+		{
+			name:       "no adjustment",
+			price:      math.LegacyNewDec(100),
+			adjustment: math.LegacyZeroDec(),
+			expected:   math.LegacyNewDec(100),
+		},
+		{
+			name:       "5% markup",
+			price:      math.LegacyNewDec(100),
+			adjustment: math.LegacyMustNewDecFromStr("0.05"),
+			expected:   math.LegacyNewDec(105),
+		},
+		{
+			name:       "10% discount",
+			price:      math.LegacyNewDec(100),
+			adjustment: math.LegacyMustNewDecFromStr("-0.1"),
+			expected:   math.LegacyNewDec(90),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := types.ApplyPriceAdjustment(tc.price, tc.adjustment)
+			require.True(t, tc.expected.Equal(result), "expected %s, got %s", tc.expected, result)
+		})
+	}
+}
+
+// TestApplyFeeBounds tests the ApplyFeeBounds function
+func TestApplyFeeBounds(t *testing.T) {
+	// Prepare the test cases
+	testCases := []struct {
+		name       string
+		amount     math.Int
+		minFee     math.Int
+		maxFee     math.Int
+		expected   math.Int
+		expectedOk bool
+	}{
+		{
+			name:       "no bounds",
+			amount:     math.NewInt(500),
+			minFee:     math.ZeroInt(),
+			maxFee:     math.ZeroInt(),
+			expected:   math.NewInt(500),
+			expectedOk: true,
+		},
+		{
+			name:       "within bounds",
+			amount:     math.NewInt(500),
+			minFee:     math.NewInt(100),
+			maxFee:     math.NewInt(1000),
+			expected:   math.NewInt(500),
+			expectedOk: true,
+		},
+		{
+			name:       "raised to the min fee",
+			amount:     math.NewInt(50),
+			minFee:     math.NewInt(100),
+			maxFee:     math.NewInt(1000),
+			expected:   math.NewInt(100),
+			expectedOk: true,
+		},
+		{
+			name:       "zero amount raised to the min fee",
+			amount:     math.ZeroInt(),
+			minFee:     math.NewInt(100),
+			maxFee:     math.ZeroInt(),
+			expected:   math.NewInt(100),
+			expectedOk: true,
+		},
+		{
+			name:       "equal to the max fee",
+			amount:     math.NewInt(1000),
+			minFee:     math.ZeroInt(),
+			maxFee:     math.NewInt(1000),
+			expected:   math.NewInt(1000),
+			expectedOk: true,
+		},
+		{
+			name:       "above the max fee",
+			amount:     math.NewInt(1001),
+			minFee:     math.ZeroInt(),
+			maxFee:     math.NewInt(1000),
+			expected:   math.NewInt(1001),
+			expectedOk: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, ok := types.ApplyFeeBounds(tc.amount, tc.minFee, tc.maxFee)
+			require.Equal(t, tc.expectedOk, ok)
+			require.Equal(t, tc.expected, result)
+		})
+	}
+}
@@ -2,6 +2,8 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState constructs a genesis state
@@ -39,5 +41,69 @@ func (gs *GenesisState) Validate() error {
 		denomSet[token.Denom] = struct{}{}
 	}
 
+	// Validate the paused fee tokens and check for duplicate denoms
+	pausedSet := make(map[string]struct{})
+	for _, denom := range gs.PausedFeeTokens {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return errorsmod.Wrapf(ErrInvalidFeeTokenMetadata, "paused fee token denom is invalid: %s", denom)
+		}
+		if _, exists := pausedSet[denom]; exists {
+			return errorsmod.Wrapf(ErrInvalidFeeTokenMetadata, "duplicate paused denom found: %s", denom)
+		}
+		pausedSet[denom] = struct{}{}
+	}
+
+	// Validate the stale fee tokens, they must be registered
+	staleSet := make(map[string]struct{})
+	for _, denom := range gs.StaleFeeTokens {
+		if _, exists := denomSet[denom]; !exists {
+			return errorsmod.Wrapf(ErrInvalidFeeTokenMetadata, "stale fee token is not registered: %s", denom)
+		}
+		if _, exists := staleSet[denom]; exists {
+			return errorsmod.Wrapf(ErrInvalidFeeTokenMetadata, "duplicate stale denom found: %s", denom)
+		}
+		staleSet[denom] = struct{}{}
+	}
+
+	// Validate the conversion usages and check for duplicate denoms
+	usageSet := make(map[string]struct{})
+	for _, usage := range gs.ConversionUsages {
+		if err := usage.Validate(); err != nil {
+			return err
+		}
+		if _, exists := usageSet[usage.Denom]; exists {
+			return errorsmod.Wrapf(ErrInvalidFeeTokenMetadata, "duplicate conversion usage denom found: %s", usage.Denom)
+		}
+		usageSet[usage.Denom] = struct{}{}
+	}
+
+	// Validate the paymasters and check for duplicate contracts
+	paymasterSet := make(map[string]struct{})
+	for _, paymaster := range gs.Paymasters {
+		if err := paymaster.Validate(); err != nil {
+			return err
+		}
+		if _, exists := paymasterSet[paymaster.Contract]; exists {
+			return errorsmod.Wrapf(ErrInvalidPaymaster, "duplicate paymaster found: %s", paymaster.Contract)
+		}
+		paymasterSet[paymaster.Contract] = struct{}{}
+	}
+
+	// Validate the paymaster usages, they must belong to a paymaster
+	paymasterUsageSet := make(map[string]struct{})
+	for _, usage := range gs.PaymasterUsages {
+		if err := usage.Validate(); err != nil {
+			return err
+		}
+		if _, exists := paymasterSet[usage.Contract]; !exists {
+			return errorsmod.Wrapf(ErrInvalidPaymaster, "paymaster usage for unknown paymaster: %s", usage.Contract)
+		}
+		key := usage.Contract + "/" + usage.User
+		if _, exists := paymasterUsageSet[key]; exists {
+			return errorsmod.Wrapf(ErrInvalidPaymaster, "duplicate paymaster usage found: %s", key)
+		}
+		paymasterUsageSet[key] = struct{}{}
+	}
+
 	return nil
 }
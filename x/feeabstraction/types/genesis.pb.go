@@ -29,6 +29,21 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// fee_tokens defines the list of fee tokens
 	FeeTokens *FeeTokenMetadataCollection `protobuf:"bytes,2,opt,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens,omitempty"`
+	// paused indicates if the whole module is paused
+	Paused bool `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	// paused_fee_tokens defines the list of paused fee token denoms
+	PausedFeeTokens []string `protobuf:"bytes,4,rep,name=paused_fee_tokens,json=pausedFeeTokens,proto3" json:"paused_fee_tokens,omitempty"`
+	// conversion_usages defines the fee token usage on the rolling conversion
+	// cap windows
+	ConversionUsages []ConversionUsage `protobuf:"bytes,5,rep,name=conversion_usages,json=conversionUsages,proto3" json:"conversion_usages"`
+	// paymasters defines the registered paymasters with their balances
+	Paymasters []Paymaster `protobuf:"bytes,6,rep,name=paymasters,proto3" json:"paymasters"`
+	// paymaster_usages defines the fees sponsored per user on the current
+	// paymaster windows
+	PaymasterUsages []PaymasterUsage `protobuf:"bytes,7,rep,name=paymaster_usages,json=paymasterUsages,proto3" json:"paymaster_usages"`
+	// stale_fee_tokens defines the fee token denoms disabled because of bad
+	// oracle data, they are enabled again once the data is back
+	StaleFeeTokens []string `protobuf:"bytes,8,rep,name=stale_fee_tokens,json=staleFeeTokens,proto3" json:"stale_fee_tokens,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +93,48 @@ func (m *GenesisState) GetFeeTokens() *FeeTokenMetadataCollection {
 	return nil
 }
 
+func (m *GenesisState) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *GenesisState) GetPausedFeeTokens() []string {
+	if m != nil {
+		return m.PausedFeeTokens
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConversionUsages() []ConversionUsage {
+	if m != nil {
+		return m.ConversionUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPaymasters() []Paymaster {
+	if m != nil {
+		return m.Paymasters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPaymasterUsages() []PaymasterUsage {
+	if m != nil {
+		return m.PaymasterUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStaleFeeTokens() []string {
+	if m != nil {
+		return m.StaleFeeTokens
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kiichain.feeabstraction.v1beta1.GenesisState")
 }
@@ -87,23 +144,32 @@ func init() {
 }
 
 var fileDescriptor_a6ed7e5c38ad11fa = []byte{
-	// 256 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcd, 0xce, 0xcc, 0x4c,
-	0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x4f, 0x4b, 0x4d, 0x4d, 0x4c, 0x2a, 0x2e, 0x29, 0x4a, 0x4c, 0x2e,
-	0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b,
-	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x87, 0x29, 0xd7, 0x43, 0x55,
-	0xae, 0x07, 0x55, 0x2e, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xab, 0x0f, 0x62, 0x41, 0xb4,
-	0x49, 0xe9, 0x10, 0xb2, 0xa5, 0x20, 0xb1, 0x28, 0x31, 0x17, 0x6a, 0x89, 0xd2, 0x46, 0x46, 0x2e,
-	0x1e, 0x77, 0x88, 0xb5, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0xae, 0x5c, 0x6c, 0x10, 0x05, 0x12,
-	0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xea, 0x7a, 0x04, 0x9c, 0xa1, 0x17, 0x00, 0x56, 0xee, 0xc4,
-	0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0xb3, 0x50, 0x14, 0x17, 0x57, 0x5a, 0x6a, 0x6a, 0x7c,
-	0x49, 0x7e, 0x76, 0x6a, 0x5e, 0xb1, 0x04, 0x13, 0xd8, 0x28, 0x6b, 0x82, 0x46, 0xb9, 0xa5, 0xa6,
-	0x86, 0x80, 0x74, 0xf8, 0xa6, 0x96, 0x24, 0xa6, 0x24, 0x96, 0x24, 0x3a, 0xe7, 0xe7, 0xe4, 0xa4,
-	0x82, 0x95, 0x04, 0x71, 0xa6, 0x41, 0xe5, 0x8a, 0x9d, 0x7c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0,
-	0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8,
-	0xf1, 0x58, 0x8e, 0x21, 0xca, 0x38, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57,
-	0x1f, 0x1e, 0x0c, 0x70, 0x46, 0x05, 0x7a, 0x88, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81,
-	0x43, 0xc2, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x07, 0xd5, 0x1a, 0xb0, 0x9f, 0x01, 0x00, 0x00,
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xb1, 0x8e, 0xda, 0x40,
+	0x10, 0xb5, 0x03, 0x21, 0xb0, 0x44, 0x01, 0x56, 0x51, 0x64, 0x51, 0x18, 0x2b, 0x4d, 0x2c, 0x94,
+	0xd8, 0x01, 0xca, 0x74, 0xa0, 0x90, 0x0a, 0x09, 0x39, 0x49, 0x43, 0x43, 0xd6, 0x66, 0x30, 0x16,
+	0xe0, 0xb5, 0xbc, 0x0b, 0x0a, 0x7f, 0x91, 0xcf, 0xc9, 0x27, 0x50, 0x52, 0x5e, 0x75, 0x3a, 0xc1,
+	0x8f, 0x9c, 0xbc, 0x5e, 0x2c, 0x73, 0x8d, 0xe9, 0x66, 0x67, 0xde, 0x7b, 0xf3, 0xf6, 0x69, 0xd0,
+	0x97, 0x75, 0x10, 0x78, 0x2b, 0x12, 0x84, 0xf6, 0x12, 0x80, 0xb8, 0x8c, 0xc7, 0xc4, 0xe3, 0x01,
+	0x0d, 0xed, 0x7d, 0xcf, 0x05, 0x4e, 0x7a, 0xb6, 0x0f, 0x21, 0xb0, 0x80, 0x59, 0x51, 0x4c, 0x39,
+	0xc5, 0x9d, 0x2b, 0xdc, 0xba, 0x85, 0x5b, 0x12, 0xde, 0x7e, 0xef, 0x53, 0x9f, 0x0a, 0xac, 0x9d,
+	0x54, 0x29, 0xad, 0xfd, 0xb9, 0x68, 0x4b, 0x44, 0x62, 0xb2, 0x95, 0x4b, 0x3e, 0xfe, 0x2f, 0xa3,
+	0xb7, 0x3f, 0xd2, 0xb5, 0x3f, 0x39, 0xe1, 0x80, 0xbf, 0xa3, 0x4a, 0x0a, 0xd0, 0x54, 0x43, 0x35,
+	0xeb, 0xfd, 0x4f, 0x56, 0x81, 0x0d, 0x6b, 0x2a, 0xe0, 0xc3, 0xf2, 0xf1, 0xb1, 0xa3, 0x38, 0x92,
+	0x8c, 0x67, 0x08, 0x2d, 0x01, 0xe6, 0x9c, 0xae, 0x21, 0x64, 0xda, 0x2b, 0x21, 0xf5, 0xad, 0x50,
+	0x6a, 0x0c, 0xf0, 0x2b, 0x61, 0x4c, 0x80, 0x93, 0x05, 0xe1, 0x64, 0x44, 0x37, 0x1b, 0x10, 0x10,
+	0xa7, 0xb6, 0x94, 0x33, 0x86, 0x3f, 0x24, 0x16, 0x77, 0x0c, 0x16, 0x5a, 0xc9, 0x50, 0xcd, 0xaa,
+	0x23, 0x5f, 0xb8, 0x8b, 0x5a, 0x69, 0x35, 0xcf, 0xad, 0x2e, 0x1b, 0x25, 0xb3, 0xe6, 0x34, 0xd2,
+	0xc1, 0x38, 0xd3, 0xf0, 0x50, 0xcb, 0xa3, 0xe1, 0x1e, 0x62, 0x16, 0xd0, 0x70, 0xbe, 0x63, 0xc4,
+	0x07, 0xa6, 0xbd, 0x36, 0x4a, 0x66, 0xbd, 0xff, 0xb5, 0xd0, 0xe6, 0x28, 0x63, 0xfe, 0x4e, 0x88,
+	0xf2, 0xeb, 0x4d, 0xef, 0xb6, 0xcd, 0xf0, 0x14, 0xa1, 0x88, 0x1c, 0xb6, 0x84, 0x71, 0x88, 0x99,
+	0x56, 0x11, 0xea, 0xdd, 0x3b, 0xf2, 0x94, 0x14, 0xa9, 0x9b, 0xd3, 0xc0, 0x7f, 0x50, 0x33, 0x7b,
+	0x5d, 0x5d, 0xbf, 0x11, 0xba, 0xf6, 0xfd, 0xba, 0x79, 0xd3, 0x8d, 0xe8, 0xa6, 0xcb, 0xb0, 0x89,
+	0x9a, 0x8c, 0x93, 0x0d, 0xe4, 0x33, 0xac, 0x8a, 0x0c, 0xdf, 0x89, 0x7e, 0x16, 0xe1, 0x70, 0x72,
+	0x3c, 0xeb, 0xea, 0xe9, 0xac, 0xab, 0x4f, 0x67, 0x5d, 0xfd, 0x77, 0xd1, 0x95, 0xd3, 0x45, 0x57,
+	0x1e, 0x2e, 0xba, 0x32, 0x1b, 0xf8, 0x01, 0x5f, 0xed, 0x5c, 0xcb, 0xa3, 0x5b, 0x3b, 0xbb, 0xc6,
+	0xac, 0xf8, 0xfb, 0xf2, 0x30, 0xf9, 0x21, 0x02, 0xe6, 0x56, 0xc4, 0x41, 0x0e, 0x9e, 0x03, 0x00,
+	0x00, 0xff, 0xff, 0xdf, 0x33, 0x85, 0xf8, 0x26, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -126,6 +192,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StaleFeeTokens) > 0 {
+		for iNdEx := len(m.StaleFeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StaleFeeTokens[iNdEx])
+			copy(dAtA[i:], m.StaleFeeTokens[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.StaleFeeTokens[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PaymasterUsages) > 0 {
+		for iNdEx := len(m.PaymasterUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymasterUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Paymasters) > 0 {
+		for iNdEx := len(m.Paymasters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Paymasters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ConversionUsages) > 0 {
+		for iNdEx := len(m.ConversionUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConversionUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PausedFeeTokens) > 0 {
+		for iNdEx := len(m.PausedFeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedFeeTokens[iNdEx])
+			copy(dAtA[i:], m.PausedFeeTokens[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PausedFeeTokens[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.FeeTokens != nil {
 		{
 			size, err := m.FeeTokens.MarshalToSizedBuffer(dAtA[:i])
@@ -174,6 +310,39 @@ func (m *GenesisState) Size() (n int) {
 		l = m.FeeTokens.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.Paused {
+		n += 2
+	}
+	if len(m.PausedFeeTokens) > 0 {
+		for _, s := range m.PausedFeeTokens {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConversionUsages) > 0 {
+		for _, e := range m.ConversionUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Paymasters) > 0 {
+		for _, e := range m.Paymasters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PaymasterUsages) > 0 {
+		for _, e := range m.PaymasterUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.StaleFeeTokens) > 0 {
+		for _, s := range m.StaleFeeTokens {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -281,6 +450,192 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedFeeTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedFeeTokens = append(m.PausedFeeTokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionUsages = append(m.ConversionUsages, ConversionUsage{})
+			if err := m.ConversionUsages[len(m.ConversionUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paymasters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paymasters = append(m.Paymasters, Paymaster{})
+			if err := m.Paymasters[len(m.Paymasters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymasterUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymasterUsages = append(m.PaymasterUsages, PaymasterUsage{})
+			if err := m.PaymasterUsages[len(m.PaymasterUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleFeeTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StaleFeeTokens = append(m.StaleFeeTokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
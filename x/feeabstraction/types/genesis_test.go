@@ -7,11 +7,15 @@ import (
 
 	"cosmossdk.io/math"
 
+	"github.com/kiichain/kiichain/v4/app/apptesting"
 	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
 )
 
 // TestGenesisStateValidate tests the Validate method of GenesisState
 func TestGenesisStateValidate(t *testing.T) {
+	paymasterContract := apptesting.RandomAccountAddress().String()
+	paymasterUser := apptesting.RandomAccountAddress().String()
+
 	// Create all the test cases
 	testCases := []struct {
 		name         string
@@ -62,6 +66,111 @@ func TestGenesisStateValidate(t *testing.T) {
 			),
 			errContains: "duplicate denom found: coin",
 		},
+		{
+			name: "valid - paused module and fee tokens",
+			genesisState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				FeeTokens:       types.NewFeeTokenMetadataCollection(),
+				Paused:          true,
+				PausedFeeTokens: []string{"coin", "two"},
+			},
+		},
+		{
+			name: "invalid - bad paused fee token denom",
+			genesisState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				FeeTokens:       types.NewFeeTokenMetadataCollection(),
+				PausedFeeTokens: []string{"invalid denom!"},
+			},
+			errContains: "paused fee token denom is invalid",
+		},
+		{
+			name: "invalid - duplicate paused fee token denom",
+			genesisState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				FeeTokens:       types.NewFeeTokenMetadataCollection(),
+				PausedFeeTokens: []string{"coin", "coin"},
+			},
+			errContains: "duplicate paused denom found: coin",
+		},
+		{
+			name: "invalid - duplicate conversion usage denom",
+			genesisState: &types.GenesisState{
+				Params:    types.DefaultParams(),
+				FeeTokens: types.NewFeeTokenMetadataCollection(),
+				ConversionUsages: []types.ConversionUsage{
+					types.NewConversionUsage("coin"),
+					types.NewConversionUsage("coin"),
+				},
+			},
+			errContains: "duplicate conversion usage denom found: coin",
+		},
+		{
+			name: "valid - paymasters with usages",
+			genesisState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				FeeTokens:       types.NewFeeTokenMetadataCollection(),
+				Paymasters:      []types.Paymaster{newTestPaymaster(paymasterContract)},
+				PaymasterUsages: []types.PaymasterUsage{types.NewPaymasterUsage(paymasterContract, paymasterUser, 100)},
+			},
+		},
+		{
+			name: "invalid - duplicate paymaster",
+			genesisState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				FeeTokens:  types.NewFeeTokenMetadataCollection(),
+				Paymasters: []types.Paymaster{newTestPaymaster(paymasterContract), newTestPaymaster(paymasterContract)},
+			},
+			errContains: "duplicate paymaster found",
+		},
+		{
+			name: "invalid - usage for unknown paymaster",
+			genesisState: &types.GenesisState{
+				Params:          types.DefaultParams(),
+				FeeTokens:       types.NewFeeTokenMetadataCollection(),
+				PaymasterUsages: []types.PaymasterUsage{types.NewPaymasterUsage(paymasterContract, paymasterUser, 100)},
+			},
+			errContains: "paymaster usage for unknown paymaster",
+		},
+		{
+			name: "invalid - duplicate paymaster usage",
+			genesisState: &types.GenesisState{
+				Params:     types.DefaultParams(),
+				FeeTokens:  types.NewFeeTokenMetadataCollection(),
+				Paymasters: []types.Paymaster{newTestPaymaster(paymasterContract)},
+				PaymasterUsages: []types.PaymasterUsage{
+					types.NewPaymasterUsage(paymasterContract, paymasterUser, 100),
+					types.NewPaymasterUsage(paymasterContract, paymasterUser, 200),
+				},
+			},
+			errContains: "duplicate paymaster usage found",
+		},
+		{
+			name: "valid - stale fee tokens",
+			genesisState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				FeeTokens:      types.NewFeeTokenMetadataCollection(types.NewFeeTokenMetadata("coin", "oraclecoin", 6, types.DefaultClampFactor)),
+				StaleFeeTokens: []string{"coin"},
+			},
+		},
+		{
+			name: "invalid - stale fee token not registered",
+			genesisState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				FeeTokens:      types.NewFeeTokenMetadataCollection(),
+				StaleFeeTokens: []string{"coin"},
+			},
+			errContains: "stale fee token is not registered",
+		},
+		{
+			name: "invalid - duplicate stale fee token",
+			genesisState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				FeeTokens:      types.NewFeeTokenMetadataCollection(types.NewFeeTokenMetadata("coin", "oraclecoin", 6, types.DefaultClampFactor)),
+				StaleFeeTokens: []string{"coin", "coin"},
+			},
+			errContains: "duplicate stale denom found",
+		},
 	}
 
 	// Iterate through the test cases
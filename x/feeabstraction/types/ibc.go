@@ -0,0 +1,21 @@
+package types
+
+import (
+	"strings"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+// IsIBCDenom returns true if the denom is an IBC voucher denom (ibc/{hash})
+func IsIBCDenom(denom string) bool {
+	return strings.HasPrefix(denom, transfertypes.DenomPrefix+"/")
+}
+
+// IsDenomTracePath returns true if the denom is a full IBC denom trace path
+// The path is in the format {port}/{channel}/.../{base_denom}, e.g. transfer/channel-0/uusdc
+func IsDenomTracePath(denom string) bool {
+	if IsIBCDenom(denom) {
+		return false
+	}
+	return !transfertypes.ParseDenomTrace(denom).IsNativeDenom()
+}
@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
+)
+
+// TestIBCDenoms tests the IBC denom helpers
+func TestIBCDenoms(t *testing.T) {
+	// Prepare the test cases
+	testCases := []struct {
+		name        string
+		denom       string
+		isIBCDenom  bool
+		isTracePath bool
+	}{
+		{
+			name:  "native denom",
+			denom: "akii",
+		},
+		{
+			name:       "ibc denom",
+			denom:      "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+			isIBCDenom: true,
+		},
+		{
+			name:        "trace path",
+			denom:       "transfer/channel-0/uusdc",
+			isTracePath: true,
+		},
+		{
+			name:        "multi hop trace path",
+			denom:       "transfer/channel-0/transfer/channel-1/uusdc",
+			isTracePath: true,
+		},
+		{
+			name:  "tokenfactory denom",
+			denom: "factory/kii1qqqsyqcyq5rqwzqfpg9scrgwpugpzysn0nxmwt/coin",
+		},
+		{
+			name:  "erc20 denom",
+			denom: "erc20/0x80b5a32E4F032B2a058b4F29EC95EEfEEB87aDcd",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.isIBCDenom, types.IsIBCDenom(tc.denom))
+			require.Equal(t, tc.isTracePath, types.IsDenomTracePath(tc.denom))
+		})
+	}
+}
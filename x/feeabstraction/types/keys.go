@@ -4,8 +4,18 @@ import "cosmossdk.io/collections"
 
 // Defines all the KV keys for the collections
 var (
-	ParamsKey    = collections.NewPrefix(0)
-	FeeTokensKey = collections.NewPrefix(1)
+	ParamsKey = collections.NewPrefix(0)
+	// FeeTokensCollectionKey is the legacy key where all the fee tokens were stored as a single item
+	// It's only kept for the v2 store migration
+	FeeTokensCollectionKey = collections.NewPrefix(1)
+	FeeTokensKey           = collections.NewPrefix(2)
+	PausedKey              = collections.NewPrefix(3)
+	PausedFeeTokensKey     = collections.NewPrefix(4)
+	ConversionUsagesKey    = collections.NewPrefix(5)
+	PaymastersKey          = collections.NewPrefix(6)
+	PaymasterUsagesKey     = collections.NewPrefix(7)
+	PaymasterBlockUsageKey = collections.NewPrefix(8)
+	StaleFeeTokensKey      = collections.NewPrefix(9)
 )
 
 const (
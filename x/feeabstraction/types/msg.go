@@ -1,6 +1,9 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -8,6 +11,15 @@ import (
 var (
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgUpdateFeeTokens)(nil)
+	_ sdk.Msg = (*MsgUpdateFeeTokenPricing)(nil)
+	_ sdk.Msg = (*MsgAddFeeToken)(nil)
+	_ sdk.Msg = (*MsgRemoveFeeToken)(nil)
+	_ sdk.Msg = (*MsgSetFeeTokenEnabled)(nil)
+	_ sdk.Msg = (*MsgPause)(nil)
+	_ sdk.Msg = (*MsgUnpause)(nil)
+	_ sdk.Msg = (*MsgSetPaymaster)(nil)
+	_ sdk.Msg = (*MsgFundPaymaster)(nil)
+	_ sdk.Msg = (*MsgRemovePaymaster)(nil)
 
 	// Define the types for the events
 	TypeEventConvertFees           = "convert_fees"
@@ -15,6 +27,52 @@ var (
 	TypeAttributeOriginalFeeAmount = "original_fee"
 	TypeAttributeConvertedFee      = "converted_fee"
 	TypeAttributePrice             = "price"
+
+	// Define the types for the multi token fee events
+	// A fee portion event is emitted for each coin used to cover the fee
+	TypeEventConvertFeesMulti = "convert_fees_multi"
+	TypeEventFeePortion       = "fee_portion"
+	TypeAttributeAmount       = "amount"
+	TypeAttributeNativeAmount = "native_amount"
+
+	// Define the types for the fee token governance events
+	TypeEventAddFeeToken           = "add_fee_token"
+	TypeEventRemoveFeeToken        = "remove_fee_token"
+	TypeEventSetFeeTokenEnabled    = "set_fee_token_enabled"
+	TypeEventUpdateFeeTokenPricing = "update_fee_token_pricing"
+	TypeAttributeDenom             = "denom"
+	TypeAttributeOracleDenom       = "oracle_denom"
+	TypeAttributeEnabled           = "enabled"
+	TypeAttributePriceAdjustment   = "price_adjustment"
+	TypeAttributeMinFee            = "min_fee"
+	TypeAttributeMaxFee            = "max_fee"
+
+	// Define the types for the pause events
+	// An empty denom attribute means the whole module
+	TypeEventPause      = "pause"
+	TypeEventUnpause    = "unpause"
+	TypeAttributeSender = "sender"
+
+	// Define the types for the paymaster events
+	TypeEventSetPaymaster    = "set_paymaster"
+	TypeEventFundPaymaster   = "fund_paymaster"
+	TypeEventRemovePaymaster = "remove_paymaster"
+	TypeEventPaymasterFee    = "paymaster_fee"
+	TypeEventPaymasterRefund = "paymaster_refund"
+	TypeAttributeContract    = "contract"
+	TypeAttributeUser        = "user"
+	TypeAttributeBalance     = "balance"
+
+	// Define the types for the oracle data guard events
+	// Tokens are disabled when the oracle data goes bad and enabled again once it's back
+	TypeEventFeeTokenAutoDisabled = "fee_token_auto_disabled"
+	TypeEventFeeTokenAutoEnabled  = "fee_token_auto_enabled"
+	TypeAttributeReason           = "reason"
+
+	// Define the reasons for the oracle data guard to disable a token
+	ReasonMissingPrice    = "missing_price"
+	ReasonStalePrice      = "stale_price"
+	ReasonLowTwapCoverage = "low_twap_coverage"
 )
 
 // NewMessageUpdateParams creates a new MsgUpdateParams instance
@@ -54,3 +112,247 @@ func (msg *MsgUpdateFeeTokens) Validate() error {
 	// Validate the fee tokens
 	return msg.FeeTokens.Validate()
 }
+
+// NewMessageUpdateFeeTokenPricing creates a new MsgUpdateFeeTokenPricing instance
+func NewMessageUpdateFeeTokenPricing(
+	authority, denom string,
+	priceAdjustment math.LegacyDec,
+	minFee, maxFee math.Int,
+) *MsgUpdateFeeTokenPricing {
+	return &MsgUpdateFeeTokenPricing{
+		Authority:       authority,
+		Denom:           denom,
+		PriceAdjustment: priceAdjustment,
+		MinFee:          minFee,
+		MaxFee:          maxFee,
+	}
+}
+
+// Validate performs basic validation on the MsgUpdateFeeTokenPricing message
+func (msg *MsgUpdateFeeTokenPricing) Validate() error {
+	// Validate the authority
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	// Validate the denom
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidFeeTokenMetadata, "denom is invalid")
+	}
+
+	// All the pricing values must be set
+	if msg.PriceAdjustment.IsNil() || msg.MinFee.IsNil() || msg.MaxFee.IsNil() {
+		return errorsmod.Wrap(ErrInvalidFeeTokenMetadata, "price adjustment, min fee and max fee must be set")
+	}
+
+	// Validate the pricing values
+	return ValidateFeeTokenPricing(msg.PriceAdjustment, msg.MinFee, msg.MaxFee)
+}
+
+// NewMessageAddFeeToken creates a new MsgAddFeeToken instance
+func NewMessageAddFeeToken(authority string, feeToken FeeTokenMetadata) *MsgAddFeeToken {
+	return &MsgAddFeeToken{
+		Authority: authority,
+		FeeToken:  feeToken,
+	}
+}
+
+// Validate performs basic validation on the MsgAddFeeToken message
+func (msg *MsgAddFeeToken) Validate() error {
+	// Validate the authority
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	// Validate the fee token
+	return msg.FeeToken.Validate()
+}
+
+// NewMessageRemoveFeeToken creates a new MsgRemoveFeeToken instance
+func NewMessageRemoveFeeToken(authority, denom string) *MsgRemoveFeeToken {
+	return &MsgRemoveFeeToken{
+		Authority: authority,
+		Denom:     denom,
+	}
+}
+
+// Validate performs basic validation on the MsgRemoveFeeToken message
+func (msg *MsgRemoveFeeToken) Validate() error {
+	// Validate the authority
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	// Validate the denom
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidFeeTokenMetadata, "denom is invalid")
+	}
+
+	return nil
+}
+
+// NewMessageSetFeeTokenEnabled creates a new MsgSetFeeTokenEnabled instance
+func NewMessageSetFeeTokenEnabled(authority, denom string, enabled bool) *MsgSetFeeTokenEnabled {
+	return &MsgSetFeeTokenEnabled{
+		Authority: authority,
+		Denom:     denom,
+		Enabled:   enabled,
+	}
+}
+
+// Validate performs basic validation on the MsgSetFeeTokenEnabled message
+func (msg *MsgSetFeeTokenEnabled) Validate() error {
+	// Validate the authority
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	// Validate the denom
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidFeeTokenMetadata, "denom is invalid")
+	}
+
+	return nil
+}
+
+// NewMessagePause creates a new MsgPause instance
+// An empty denom pauses the whole module
+func NewMessagePause(sender, denom string) *MsgPause {
+	return &MsgPause{
+		Sender: sender,
+		Denom:  denom,
+	}
+}
+
+// Validate performs basic validation on the MsgPause message
+func (msg *MsgPause) Validate() error {
+	// Validate the sender
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return err
+	}
+
+	// Validate the denom, an empty denom targets the whole module
+	return validatePauseDenom(msg.Denom)
+}
+
+// NewMessageUnpause creates a new MsgUnpause instance
+// An empty denom unpauses the whole module
+func NewMessageUnpause(authority, denom string) *MsgUnpause {
+	return &MsgUnpause{
+		Authority: authority,
+		Denom:     denom,
+	}
+}
+
+// Validate performs basic validation on the MsgUnpause message
+func (msg *MsgUnpause) Validate() error {
+	// Validate the authority
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+
+	// Validate the denom, an empty denom targets the whole module
+	return validatePauseDenom(msg.Denom)
+}
+
+// validatePauseDenom validates the denom of a pause or unpause message
+func validatePauseDenom(denom string) error {
+	if denom == "" {
+		return nil
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidFeeTokenMetadata, "denom is invalid")
+	}
+
+	return nil
+}
+
+// NewMessageSetPaymaster creates a new MsgSetPaymaster instance
+func NewMessageSetPaymaster(
+	sender, contract string,
+	msgTypes, callees []string,
+	perUserLimit, perBlockLimit math.Int,
+	userWindow uint64,
+) *MsgSetPaymaster {
+	return &MsgSetPaymaster{
+		Sender:        sender,
+		Contract:      contract,
+		MsgTypes:      msgTypes,
+		Callees:       callees,
+		PerUserLimit:  perUserLimit,
+		PerBlockLimit: perBlockLimit,
+		UserWindow:    userWindow,
+	}
+}
+
+// Validate performs basic validation on the MsgSetPaymaster message
+func (msg *MsgSetPaymaster) Validate() error {
+	// Validate the sender
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return err
+	}
+
+	// Validate the paymaster configuration
+	return msg.Paymaster(math.ZeroInt()).Validate()
+}
+
+// Paymaster returns the paymaster configured by the message with the given balance
+func (msg *MsgSetPaymaster) Paymaster(balance math.Int) Paymaster {
+	return Paymaster{
+		Contract:      msg.Contract,
+		MsgTypes:      msg.MsgTypes,
+		Callees:       msg.Callees,
+		PerUserLimit:  msg.PerUserLimit,
+		PerBlockLimit: msg.PerBlockLimit,
+		UserWindow:    msg.UserWindow,
+		Balance:       balance,
+	}
+}
+
+// NewMessageFundPaymaster creates a new MsgFundPaymaster instance
+func NewMessageFundPaymaster(sender, contract string, amount sdk.Coin) *MsgFundPaymaster {
+	return &MsgFundPaymaster{
+		Sender:   sender,
+		Contract: contract,
+		Amount:   amount,
+	}
+}
+
+// Validate performs basic validation on the MsgFundPaymaster message
+func (msg *MsgFundPaymaster) Validate() error {
+	// Validate the sender and the contract
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(ErrInvalidPaymaster, "contract address is invalid")
+	}
+
+	// Validate the amount
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidPaymaster, "amount must be positive")
+	}
+
+	return nil
+}
+
+// NewMessageRemovePaymaster creates a new MsgRemovePaymaster instance
+func NewMessageRemovePaymaster(sender, contract string) *MsgRemovePaymaster {
+	return &MsgRemovePaymaster{
+		Sender:   sender,
+		Contract: contract,
+	}
+}
+
+// Validate performs basic validation on the MsgRemovePaymaster message
+func (msg *MsgRemovePaymaster) Validate() error {
+	// Validate the sender and the contract
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(ErrInvalidPaymaster, "contract address is invalid")
+	}
+
+	return nil
+}
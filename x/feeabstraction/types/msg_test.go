@@ -7,9 +7,11 @@ import (
 
 	"cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/kiichain/kiichain/v4/app/apptesting"
 	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
 )
 
@@ -127,3 +129,443 @@ func TestMsgUpdateFeeTokensValidate(t *testing.T) {
 		})
 	}
 }
+
+// TestMsgUpdateFeeTokenPricingValidate tests the Validate method of MsgUpdateFeeTokenPricing
+func TestMsgUpdateFeeTokenPricingValidate(t *testing.T) {
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgUpdateFeeTokenPricing
+		errContains string
+	}{
+		{
+			name: "valid - markup with fee bounds",
+			msg: types.NewMessageUpdateFeeTokenPricing(
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				"coin",
+				math.LegacyMustNewDecFromStr("0.05"),
+				math.NewInt(100),
+				math.NewInt(1000),
+			),
+		},
+		{
+			name: "valid - discount without fee bounds",
+			msg: types.NewMessageUpdateFeeTokenPricing(
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				"coin",
+				math.LegacyMustNewDecFromStr("-0.1"),
+				math.ZeroInt(),
+				math.ZeroInt(),
+			),
+		},
+		{
+			name:        "invalid - empty authority",
+			msg:         types.NewMessageUpdateFeeTokenPricing("", "coin", math.LegacyZeroDec(), math.ZeroInt(), math.ZeroInt()),
+			errContains: "empty address string is not allowed",
+		},
+		{
+			name: "invalid - bad denom",
+			msg: types.NewMessageUpdateFeeTokenPricing(
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				"invalid denom!",
+				math.LegacyZeroDec(),
+				math.ZeroInt(),
+				math.ZeroInt(),
+			),
+			errContains: "denom is invalid",
+		},
+		{
+			name: "invalid - unset values",
+			msg: &types.MsgUpdateFeeTokenPricing{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Denom:     "coin",
+			},
+			errContains: "price adjustment, min fee and max fee must be set",
+		},
+		{
+			name: "invalid - full discount",
+			msg: types.NewMessageUpdateFeeTokenPricing(
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				"coin",
+				math.LegacyMustNewDecFromStr("-1"),
+				math.ZeroInt(),
+				math.ZeroInt(),
+			),
+			errContains: "price adjustment must be greater than -1 and less than or equal to 1",
+		},
+		{
+			name: "invalid - max fee lower than min fee",
+			msg: types.NewMessageUpdateFeeTokenPricing(
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				"coin",
+				math.LegacyZeroDec(),
+				math.NewInt(1000),
+				math.NewInt(100),
+			),
+			errContains: "max fee cannot be lower than min fee",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+
+			// Check the error
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errContains)
+			}
+		})
+	}
+}
+
+// TestMsgAddFeeTokenValidate tests the Validate method of MsgAddFeeToken
+func TestMsgAddFeeTokenValidate(t *testing.T) {
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgAddFeeToken
+		errContains string
+	}{
+		{
+			name: "valid - fee token",
+			msg: types.NewMessageAddFeeToken(
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				types.NewFeeTokenMetadata("coin", "oraclecoin", 6, math.LegacyMustNewDecFromStr("0.01")),
+			),
+		},
+		{
+			name: "invalid - empty authority",
+			msg: types.NewMessageAddFeeToken(
+				"",
+				types.NewFeeTokenMetadata("coin", "oraclecoin", 6, math.LegacyMustNewDecFromStr("0.01")),
+			),
+			errContains: "empty address string is not allowed",
+		},
+		{
+			name: "invalid - bad fee token",
+			msg: types.NewMessageAddFeeToken(
+				authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				types.NewFeeTokenMetadata("coin", "oraclecoin", 0, math.LegacyMustNewDecFromStr("0.01")),
+			),
+			errContains: "decimals must be between 1 and 18",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+
+			// Check the error
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errContains)
+			}
+		})
+	}
+}
+
+// TestMsgRemoveFeeTokenValidate tests the Validate method of MsgRemoveFeeToken
+func TestMsgRemoveFeeTokenValidate(t *testing.T) {
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgRemoveFeeToken
+		errContains string
+	}{
+		{
+			name: "valid - denom",
+			msg:  types.NewMessageRemoveFeeToken(authtypes.NewModuleAddress(govtypes.ModuleName).String(), "coin"),
+		},
+		{
+			name:        "invalid - empty authority",
+			msg:         types.NewMessageRemoveFeeToken("", "coin"),
+			errContains: "empty address string is not allowed",
+		},
+		{
+			name:        "invalid - bad denom",
+			msg:         types.NewMessageRemoveFeeToken(authtypes.NewModuleAddress(govtypes.ModuleName).String(), "invalid denom!"),
+			errContains: "denom is invalid",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+
+			// Check the error
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errContains)
+			}
+		})
+	}
+}
+
+// TestMsgSetFeeTokenEnabledValidate tests the Validate method of MsgSetFeeTokenEnabled
+func TestMsgSetFeeTokenEnabledValidate(t *testing.T) {
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgSetFeeTokenEnabled
+		errContains string
+	}{
+		{
+			name: "valid - enable",
+			msg:  types.NewMessageSetFeeTokenEnabled(authtypes.NewModuleAddress(govtypes.ModuleName).String(), "coin", true),
+		},
+		{
+			name: "valid - disable",
+			msg:  types.NewMessageSetFeeTokenEnabled(authtypes.NewModuleAddress(govtypes.ModuleName).String(), "coin", false),
+		},
+		{
+			name:        "invalid - empty authority",
+			msg:         types.NewMessageSetFeeTokenEnabled("", "coin", true),
+			errContains: "empty address string is not allowed",
+		},
+		{
+			name:        "invalid - bad denom",
+			msg:         types.NewMessageSetFeeTokenEnabled(authtypes.NewModuleAddress(govtypes.ModuleName).String(), "invalid denom!", true),
+			errContains: "denom is invalid",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+
+			// Check the error
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errContains)
+			}
+		})
+	}
+}
+
+// TestMsgPauseValidate tests the Validate method of MsgPause
+func TestMsgPauseValidate(t *testing.T) {
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgPause
+		errContains string
+	}{
+		{
+			name: "valid - single fee token",
+			msg:  types.NewMessagePause(authtypes.NewModuleAddress(govtypes.ModuleName).String(), "coin"),
+		},
+		{
+			name: "valid - whole module",
+			msg:  types.NewMessagePause(authtypes.NewModuleAddress(govtypes.ModuleName).String(), ""),
+		},
+		{
+			name:        "invalid - empty sender",
+			msg:         types.NewMessagePause("", "coin"),
+			errContains: "empty address string is not allowed",
+		},
+		{
+			name:        "invalid - bad denom",
+			msg:         types.NewMessagePause(authtypes.NewModuleAddress(govtypes.ModuleName).String(), "invalid denom!"),
+			errContains: "denom is invalid",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+
+			// Check the error
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errContains)
+			}
+		})
+	}
+}
+
+// TestMsgUnpauseValidate tests the Validate method of MsgUnpause
+func TestMsgUnpauseValidate(t *testing.T) {
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgUnpause
+		errContains string
+	}{
+		{
+			name: "valid - single fee token",
+			msg:  types.NewMessageUnpause(authtypes.NewModuleAddress(govtypes.ModuleName).String(), "coin"),
+		},
+		{
+			name: "valid - whole module",
+			msg:  types.NewMessageUnpause(authtypes.NewModuleAddress(govtypes.ModuleName).String(), ""),
+		},
+		{
+			name:        "invalid - empty authority",
+			msg:         types.NewMessageUnpause("", "coin"),
+			errContains: "empty address string is not allowed",
+		},
+		{
+			name:        "invalid - bad denom",
+			msg:         types.NewMessageUnpause(authtypes.NewModuleAddress(govtypes.ModuleName).String(), "invalid denom!"),
+			errContains: "denom is invalid",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+
+			// Check the error
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errContains)
+			}
+		})
+	}
+}
+
+// TestMsgSetPaymasterValidate tests the Validate method of MsgSetPaymaster
+func TestMsgSetPaymasterValidate(t *testing.T) {
+	contract := apptesting.RandomAccountAddress().String()
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgSetPaymaster
+		errContains string
+	}{
+		{
+			name: "valid - contract sets itself",
+			msg:  types.NewMessageSetPaymaster(contract, contract, nil, nil, math.NewInt(100), math.NewInt(1000), 3600),
+		},
+		{
+			name:        "invalid - empty sender",
+			msg:         types.NewMessageSetPaymaster("", contract, nil, nil, math.NewInt(100), math.NewInt(1000), 3600),
+			errContains: "empty address string is not allowed",
+		},
+		{
+			name:        "invalid - no limits",
+			msg:         types.NewMessageSetPaymaster(contract, contract, nil, nil, math.ZeroInt(), math.NewInt(1000), 3600),
+			errContains: "per user limit must be positive",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+
+			// Check the error
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errContains)
+			}
+		})
+	}
+}
+
+// TestMsgFundPaymasterValidate tests the Validate method of MsgFundPaymaster
+func TestMsgFundPaymasterValidate(t *testing.T) {
+	sender := apptesting.RandomAccountAddress().String()
+	contract := apptesting.RandomAccountAddress().String()
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgFundPaymaster
+		errContains string
+	}{
+		{
+			name: "valid - fund paymaster",
+			msg:  types.NewMessageFundPaymaster(sender, contract, sdk.NewInt64Coin("akii", 100)),
+		},
+		{
+			name:        "invalid - bad contract",
+			msg:         types.NewMessageFundPaymaster(sender, "invalid", sdk.NewInt64Coin("akii", 100)),
+			errContains: "contract address is invalid",
+		},
+		{
+			name:        "invalid - zero amount",
+			msg:         types.NewMessageFundPaymaster(sender, contract, sdk.NewInt64Coin("akii", 0)),
+			errContains: "amount must be positive",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+
+			// Check the error
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errContains)
+			}
+		})
+	}
+}
+
+// TestMsgRemovePaymasterValidate tests the Validate method of MsgRemovePaymaster
+func TestMsgRemovePaymasterValidate(t *testing.T) {
+	contract := apptesting.RandomAccountAddress().String()
+
+	// Prepare all the test cases
+	testCases := []struct {
+		name        string
+		msg         *types.MsgRemovePaymaster
+		errContains string
+	}{
+		{
+			name: "valid - remove paymaster",
+			msg:  types.NewMessageRemovePaymaster(contract, contract),
+		},
+		{
+			name:        "invalid - empty sender",
+			msg:         types.NewMessageRemovePaymaster("", contract),
+			errContains: "empty address string is not allowed",
+		},
+		{
+			name:        "invalid - bad contract",
+			msg:         types.NewMessageRemovePaymaster(contract, "invalid"),
+			errContains: "contract address is invalid",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+
+			// Check the error
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errContains)
+			}
+		})
+	}
+}
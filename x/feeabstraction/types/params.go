@@ -6,6 +6,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
 	"github.com/kiichain/kiichain/v4/app/params"
 )
 
@@ -14,6 +16,9 @@ var (
 	DefaultClampFactor         = math.LegacyMustNewDecFromStr("0.10") // 10%
 	DefaultFallbackNativePrice = math.LegacyMustNewDecFromStr("0.01") // 0.01 USD
 	DefaultTwapLookbackWindow  = uint64(120)                          // 120 seconds (2 minutes)
+	DefaultConversionCapWindow = uint64(86400)                        // 86400 seconds (1 day)
+	DefaultMaxPriceAge         = uint64(600)                          // 600 seconds (10 minutes)
+	DefaultMinTwapCoverage     = uint64(60)                           // 60 seconds (1 minute)
 )
 
 // NewParams returns a new params instance
@@ -30,6 +35,9 @@ func NewParams(
 		Enabled:             enabled,
 		FallbackNativePrice: fallbackNativePrice,
 		TwapLookbackWindow:  twapLookbackWindow,
+		ConversionCapWindow: DefaultConversionCapWindow,
+		MaxPriceAge:         DefaultMaxPriceAge,
+		MinTwapCoverage:     DefaultMinTwapCoverage,
 	}
 }
 
@@ -41,6 +49,9 @@ func DefaultParams() Params {
 		ClampFactor:         DefaultClampFactor,
 		FallbackNativePrice: DefaultFallbackNativePrice,
 		TwapLookbackWindow:  DefaultTwapLookbackWindow,
+		ConversionCapWindow: DefaultConversionCapWindow,
+		MaxPriceAge:         DefaultMaxPriceAge,
+		MinTwapCoverage:     DefaultMinTwapCoverage,
 		Enabled:             true,
 	}
 }
@@ -72,6 +83,23 @@ func (p Params) Validate() error {
 		return errorsmod.Wrap(ErrInvalidParams, "twap lookback window must be greater than 0")
 	}
 
+	// Validate the conversion cap window
+	if p.ConversionCapWindow == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "conversion cap window must be greater than 0")
+	}
+
+	// Validate the min twap coverage, the twap can't cover more than the lookback window
+	if p.MinTwapCoverage > p.TwapLookbackWindow {
+		return errorsmod.Wrap(ErrInvalidParams, "min twap coverage cannot be greater than the twap lookback window")
+	}
+
+	// Validate the guardian, an empty guardian is allowed
+	if p.Guardian != "" {
+		if _, err := sdk.AccAddressFromBech32(p.Guardian); err != nil {
+			return errorsmod.Wrapf(ErrInvalidParams, "guardian address is invalid: %s", err)
+		}
+	}
+
 	return nil
 }
 
@@ -87,15 +115,26 @@ func NewFeeTokenMetadata(
 		Decimals:    decimals,
 		Price:       price,
 		Enabled:     true,
+		// No pricing adjustment or fee bounds by default
+		PriceAdjustment: math.LegacyZeroDec(),
+		MinFee:          math.ZeroInt(),
+		MaxFee:          math.ZeroInt(),
+		// No conversion cap by default
+		ConversionCap: math.ZeroInt(),
 	}
 }
 
 // Validate validates the FeeTokenMetadata
 func (f FeeTokenMetadata) Validate() error {
-	// Validate the denom
+	// Validate the denom, IBC denoms must carry a valid hash
 	if err := sdk.ValidateDenom(f.Denom); err != nil {
 		return errorsmod.Wrap(ErrInvalidFeeTokenMetadata, "denom is invalid")
 	}
+	if IsIBCDenom(f.Denom) {
+		if err := transfertypes.ValidateIBCDenom(f.Denom); err != nil {
+			return errorsmod.Wrapf(ErrInvalidFeeTokenMetadata, "ibc denom is invalid: %s", err)
+		}
+	}
 	// Validate the oracle denom
 	if err := sdk.ValidateDenom(f.OracleDenom); err != nil {
 		return errorsmod.Wrap(ErrInvalidFeeTokenMetadata, "oracle denom is invalid")
@@ -111,9 +150,77 @@ func (f FeeTokenMetadata) Validate() error {
 		return errorsmod.Wrap(ErrInvalidFeeTokenMetadata, "price must be greater than 0")
 	}
 
+	// Validate the conversion cap, an unset cap is considered as zero
+	if !f.ConversionCap.IsNil() && f.ConversionCap.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidFeeTokenMetadata, "conversion cap cannot be negative")
+	}
+
+	// Validate the pricing adjustment and fee bounds
+	return ValidateFeeTokenPricing(f.PriceAdjustment, f.MinFee, f.MaxFee)
+}
+
+// ValidateFeeTokenPricing validates the pricing adjustment and fee bounds of a fee token
+// Unset values are considered as zero
+func ValidateFeeTokenPricing(priceAdjustment math.LegacyDec, minFee, maxFee math.Int) error {
+	// The adjustment must be bigger than -1 (a 100% discount) and at most 1 (a 100% markup)
+	if !priceAdjustment.IsNil() {
+		if priceAdjustment.LTE(math.LegacyOneDec().Neg()) || priceAdjustment.GT(math.LegacyOneDec()) {
+			return errorsmod.Wrap(ErrInvalidFeeTokenMetadata, "price adjustment must be greater than -1 and less than or equal to 1")
+		}
+	}
+
+	// Validate the fee bounds
+	if !minFee.IsNil() && minFee.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidFeeTokenMetadata, "min fee cannot be negative")
+	}
+	if !maxFee.IsNil() && maxFee.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidFeeTokenMetadata, "max fee cannot be negative")
+	}
+	if !minFee.IsNil() && !maxFee.IsNil() && !maxFee.IsZero() && maxFee.LT(minFee) {
+		return errorsmod.Wrap(ErrInvalidFeeTokenMetadata, "max fee cannot be lower than min fee")
+	}
+
 	return nil
 }
 
+// AdjustedPrice returns the token price with the pricing adjustment applied
+func (f FeeTokenMetadata) AdjustedPrice() math.LegacyDec {
+	// Tokens without an adjustment use the plain price
+	if f.PriceAdjustment.IsNil() {
+		return f.Price
+	}
+	return ApplyPriceAdjustment(f.Price, f.PriceAdjustment)
+}
+
+// BoundFeeAmount applies the token fee bounds to the amount
+// It returns false if the amount is above the token max fee
+func (f FeeTokenMetadata) BoundFeeAmount(amount math.Int) (math.Int, bool) {
+	// Unset bounds are treated as zero (no bound)
+	minFee, maxFee := f.MinFee, f.MaxFee
+	if minFee.IsNil() {
+		minFee = math.ZeroInt()
+	}
+	if maxFee.IsNil() {
+		maxFee = math.ZeroInt()
+	}
+	return ApplyFeeBounds(amount, minFee, maxFee)
+}
+
+// BoundPortionAmount applies the token fee bounds to a partial fee amount
+// The amount is lowered to the max fee, it returns false if the amount is below the token min fee
+func (f FeeTokenMetadata) BoundPortionAmount(amount math.Int) (math.Int, bool) {
+	// Lower the amount to the max fee
+	if !f.MaxFee.IsNil() && f.MaxFee.IsPositive() && amount.GT(f.MaxFee) {
+		amount = f.MaxFee
+	}
+
+	// Portions below the min fee can't be paid
+	if !f.MinFee.IsNil() && amount.LT(f.MinFee) {
+		return amount, false
+	}
+	return amount, true
+}
+
 // NewFeeTokenMetadataCollection creates a new FeeTokenMetadataCollection
 func NewFeeTokenMetadataCollection(feeTokens ...FeeTokenMetadata) *FeeTokenMetadataCollection {
 	return &FeeTokenMetadataCollection{
@@ -142,3 +249,8 @@ func (c *FeeTokenMetadataCollection) Validate() error {
 
 	return nil
 }
+
+// HasConversionCap returns true if the token has a conversion cap set
+func (f FeeTokenMetadata) HasConversionCap() bool {
+	return !f.ConversionCap.IsNil() && f.ConversionCap.IsPositive()
+}
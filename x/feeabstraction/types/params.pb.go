@@ -6,6 +6,7 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -39,6 +40,24 @@ type Params struct {
 	// FallbackNativePrice is the fallback price for the native token if the
 	// oracle price is not available (in USD)
 	FallbackNativePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=fallback_native_price,json=fallbackNativePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fallback_native_price" yaml:"fallback_native_price"`
+	// Guardian is the address allowed to pause single fee tokens or the whole
+	// module on emergencies, only governance can unpause
+	// An empty value means no guardian is set
+	Guardian string `protobuf:"bytes,7,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// ConversionCapWindow is the length in seconds of the rolling window used to
+	// track the fee token conversion caps
+	ConversionCapWindow uint64 `protobuf:"varint,8,opt,name=conversion_cap_window,json=conversionCapWindow,proto3" json:"conversion_cap_window,omitempty"`
+	// MultiTokenFees is an opt-in mode that combines the native balance and the
+	// fee token balances to cover a single fee when no token covers it alone
+	MultiTokenFees bool `protobuf:"varint,9,opt,name=multi_token_fees,json=multiTokenFees,proto3" json:"multi_token_fees,omitempty"`
+	// MaxPriceAge is the maximum age in seconds of the oracle price of a fee
+	// token, tokens with older prices are disabled until the price is updated
+	// A zero value disables the check
+	MaxPriceAge uint64 `protobuf:"varint,10,opt,name=max_price_age,json=maxPriceAge,proto3" json:"max_price_age,omitempty"`
+	// MinTwapCoverage is the minimum time in seconds covered by the TWAP of a
+	// fee token, tokens with a shorter coverage are disabled until it's reached
+	// A zero value disables the check
+	MinTwapCoverage uint64 `protobuf:"varint,11,opt,name=min_twap_coverage,json=minTwapCoverage,proto3" json:"min_twap_coverage,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -102,6 +121,41 @@ func (m *Params) GetTwapLookbackWindow() uint64 {
 	return 0
 }
 
+func (m *Params) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *Params) GetConversionCapWindow() uint64 {
+	if m != nil {
+		return m.ConversionCapWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMultiTokenFees() bool {
+	if m != nil {
+		return m.MultiTokenFees
+	}
+	return false
+}
+
+func (m *Params) GetMaxPriceAge() uint64 {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+func (m *Params) GetMinTwapCoverage() uint64 {
+	if m != nil {
+		return m.MinTwapCoverage
+	}
+	return 0
+}
+
 // FeeTokenMetadata defines the metadata for a fee token
 type FeeTokenMetadata struct {
 	// Denom is the token denom
@@ -116,6 +170,21 @@ type FeeTokenMetadata struct {
 	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price" yaml:"price"`
 	// Enabled indicates if the token is enabled for fee abstraction
 	Enabled bool `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// PriceAdjustment is a markup (positive) or discount (negative) applied over
+	// the token price on fee conversion
+	// E.g. 0.05 charges 5% more tokens and -0.05 gives a 5% discount
+	PriceAdjustment cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=price_adjustment,json=priceAdjustment,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price_adjustment" yaml:"price_adjustment"`
+	// MinFee is the minimum amount charged in the token for a single fee
+	// A zero value means no lower bound
+	MinFee cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=min_fee,json=minFee,proto3,customtype=cosmossdk.io/math.Int" json:"min_fee" yaml:"min_fee"`
+	// MaxFee is the maximum amount that can be charged in the token for a single
+	// fee, fees above it can't be paid with the token
+	// A zero value means no upper bound
+	MaxFee cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=max_fee,json=maxFee,proto3,customtype=cosmossdk.io/math.Int" json:"max_fee" yaml:"max_fee"`
+	// ConversionCap is the maximum native-equivalent fee amount that can be
+	// paid with the token on each conversion cap window
+	// A zero value means no cap
+	ConversionCap cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=conversion_cap,json=conversionCap,proto3,customtype=cosmossdk.io/math.Int" json:"conversion_cap" yaml:"conversion_cap"`
 }
 
 func (m *FeeTokenMetadata) Reset()         { *m = FeeTokenMetadata{} }
@@ -225,10 +294,319 @@ func (m *FeeTokenMetadataCollection) GetItems() []FeeTokenMetadata {
 	return nil
 }
 
+// ConversionUsage tracks the native-equivalent fee amount paid with a fee
+// token on the rolling conversion cap window
+type ConversionUsage struct {
+	// Denom is the fee token denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Buckets are the amounts paid on each sub-window of the rolling window,
+	// from the oldest to the newest
+	Buckets []ConversionBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets"`
+}
+
+func (m *ConversionUsage) Reset()         { *m = ConversionUsage{} }
+func (m *ConversionUsage) String() string { return proto.CompactTextString(m) }
+func (*ConversionUsage) ProtoMessage()    {}
+func (*ConversionUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c9ebe382042ec91, []int{3}
+}
+func (m *ConversionUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionUsage.Merge(m, src)
+}
+func (m *ConversionUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionUsage proto.InternalMessageInfo
+
+func (m *ConversionUsage) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ConversionUsage) GetBuckets() []ConversionBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+// ConversionBucket is the native-equivalent fee amount paid with a fee token
+// on a sub-window of the rolling conversion cap window
+type ConversionBucket struct {
+	// Start is the unix time in seconds where the sub-window started
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// Used is the native-equivalent fee amount paid on the sub-window
+	Used cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=used,proto3,customtype=cosmossdk.io/math.Int" json:"used" yaml:"used"`
+}
+
+func (m *ConversionBucket) Reset()         { *m = ConversionBucket{} }
+func (m *ConversionBucket) String() string { return proto.CompactTextString(m) }
+func (*ConversionBucket) ProtoMessage()    {}
+func (*ConversionBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c9ebe382042ec91, []int{4}
+}
+func (m *ConversionBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConversionBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConversionBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConversionBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConversionBucket.Merge(m, src)
+}
+func (m *ConversionBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConversionBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConversionBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConversionBucket proto.InternalMessageInfo
+
+func (m *ConversionBucket) GetStart() int64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+// Paymaster defines a contract that sponsors the fees of the transactions sent
+// to it
+type Paymaster struct {
+	// Contract is the address of the CosmWasm or EVM contract paying the fees
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// MsgTypes are the Cosmos message type URLs sponsored by the paymaster
+	MsgTypes []string `protobuf:"bytes,2,rep,name=msg_types,json=msgTypes,proto3" json:"msg_types,omitempty"`
+	// Callees are the contracts whose calls are sponsored by the paymaster, the
+	// paymaster contract itself is always sponsored
+	Callees []string `protobuf:"bytes,3,rep,name=callees,proto3" json:"callees,omitempty"`
+	// PerUserLimit is the native fee amount sponsored for a single user on each
+	// user window
+	PerUserLimit cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=per_user_limit,json=perUserLimit,proto3,customtype=cosmossdk.io/math.Int" json:"per_user_limit" yaml:"per_user_limit"`
+	// PerBlockLimit is the native fee amount sponsored on a single block
+	PerBlockLimit cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=per_block_limit,json=perBlockLimit,proto3,customtype=cosmossdk.io/math.Int" json:"per_block_limit" yaml:"per_block_limit"`
+	// UserWindow is the length in seconds of the per user limit window
+	UserWindow uint64 `protobuf:"varint,6,opt,name=user_window,json=userWindow,proto3" json:"user_window,omitempty"`
+	// Balance is the native amount deposited to pay the fees
+	Balance cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance" yaml:"balance"`
+}
+
+func (m *Paymaster) Reset()         { *m = Paymaster{} }
+func (m *Paymaster) String() string { return proto.CompactTextString(m) }
+func (*Paymaster) ProtoMessage()    {}
+func (*Paymaster) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c9ebe382042ec91, []int{5}
+}
+func (m *Paymaster) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Paymaster) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Paymaster.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Paymaster) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Paymaster.Merge(m, src)
+}
+func (m *Paymaster) XXX_Size() int {
+	return m.Size()
+}
+func (m *Paymaster) XXX_DiscardUnknown() {
+	xxx_messageInfo_Paymaster.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Paymaster proto.InternalMessageInfo
+
+func (m *Paymaster) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *Paymaster) GetMsgTypes() []string {
+	if m != nil {
+		return m.MsgTypes
+	}
+	return nil
+}
+
+func (m *Paymaster) GetCallees() []string {
+	if m != nil {
+		return m.Callees
+	}
+	return nil
+}
+
+func (m *Paymaster) GetUserWindow() uint64 {
+	if m != nil {
+		return m.UserWindow
+	}
+	return 0
+}
+
+// PaymasterUsage tracks the fees sponsored by a paymaster for a single user on
+// the current user window
+type PaymasterUsage struct {
+	// Contract is the paymaster contract address
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// User is the sponsored user address
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	// WindowStart is the unix time in seconds where the window started
+	WindowStart int64 `protobuf:"varint,3,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	// Used is the native fee amount sponsored on the window
+	Used cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=used,proto3,customtype=cosmossdk.io/math.Int" json:"used" yaml:"used"`
+}
+
+func (m *PaymasterUsage) Reset()         { *m = PaymasterUsage{} }
+func (m *PaymasterUsage) String() string { return proto.CompactTextString(m) }
+func (*PaymasterUsage) ProtoMessage()    {}
+func (*PaymasterUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c9ebe382042ec91, []int{6}
+}
+func (m *PaymasterUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymasterUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymasterUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaymasterUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymasterUsage.Merge(m, src)
+}
+func (m *PaymasterUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymasterUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymasterUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymasterUsage proto.InternalMessageInfo
+
+func (m *PaymasterUsage) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *PaymasterUsage) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *PaymasterUsage) GetWindowStart() int64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
+// PaymasterBlockUsage tracks the fees sponsored by a paymaster on a single
+// block
+type PaymasterBlockUsage struct {
+	// Height is the block height of the usage
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Used is the native fee amount sponsored on the block
+	Used cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=used,proto3,customtype=cosmossdk.io/math.Int" json:"used" yaml:"used"`
+}
+
+func (m *PaymasterBlockUsage) Reset()         { *m = PaymasterBlockUsage{} }
+func (m *PaymasterBlockUsage) String() string { return proto.CompactTextString(m) }
+func (*PaymasterBlockUsage) ProtoMessage()    {}
+func (*PaymasterBlockUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c9ebe382042ec91, []int{7}
+}
+func (m *PaymasterBlockUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PaymasterBlockUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PaymasterBlockUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PaymasterBlockUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymasterBlockUsage.Merge(m, src)
+}
+func (m *PaymasterBlockUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *PaymasterBlockUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymasterBlockUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymasterBlockUsage proto.InternalMessageInfo
+
+func (m *PaymasterBlockUsage) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kiichain.feeabstraction.v1beta1.Params")
 	proto.RegisterType((*FeeTokenMetadata)(nil), "kiichain.feeabstraction.v1beta1.FeeTokenMetadata")
 	proto.RegisterType((*FeeTokenMetadataCollection)(nil), "kiichain.feeabstraction.v1beta1.FeeTokenMetadataCollection")
+	proto.RegisterType((*ConversionUsage)(nil), "kiichain.feeabstraction.v1beta1.ConversionUsage")
+	proto.RegisterType((*ConversionBucket)(nil), "kiichain.feeabstraction.v1beta1.ConversionBucket")
+	proto.RegisterType((*Paymaster)(nil), "kiichain.feeabstraction.v1beta1.Paymaster")
+	proto.RegisterType((*PaymasterUsage)(nil), "kiichain.feeabstraction.v1beta1.PaymasterUsage")
+	proto.RegisterType((*PaymasterBlockUsage)(nil), "kiichain.feeabstraction.v1beta1.PaymasterBlockUsage")
 }
 
 func init() {
@@ -236,38 +614,71 @@ func init() {
 }
 
 var fileDescriptor_4c9ebe382042ec91 = []byte{
-	// 489 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0x6e, 0x76, 0xdb, 0xba, 0x4e, 0x2b, 0xe8, 0xb4, 0x42, 0xa8, 0x92, 0xd6, 0x9c, 0x7a, 0x90,
-	0xc4, 0xda, 0xdb, 0x1e, 0xbb, 0xcb, 0x82, 0xb0, 0xd5, 0x25, 0x08, 0x82, 0x20, 0xe5, 0x65, 0x32,
-	0x6d, 0x87, 0xcc, 0x64, 0x42, 0x32, 0x6e, 0xed, 0xbf, 0xf0, 0x67, 0xed, 0xc1, 0xc3, 0x1e, 0xc5,
-	0x43, 0x91, 0xf6, 0x1f, 0xf8, 0x07, 0x94, 0xcc, 0xa4, 0xa5, 0x5b, 0x16, 0xdc, 0x5b, 0xde, 0xf7,
-	0x7d, 0xf9, 0x78, 0xdf, 0x7b, 0xf3, 0xd0, 0xeb, 0x98, 0x31, 0x32, 0x07, 0x96, 0xf8, 0x53, 0x4a,
-	0x21, 0xcc, 0x55, 0x06, 0x44, 0x31, 0x99, 0xf8, 0xd7, 0x83, 0x90, 0x2a, 0x18, 0xf8, 0x29, 0x64,
-	0x20, 0x72, 0x2f, 0xcd, 0xa4, 0x92, 0xb8, 0xbb, 0x55, 0x7b, 0x77, 0xd5, 0x5e, 0xa9, 0xee, 0xb4,
-	0x67, 0x72, 0x26, 0xb5, 0xd6, 0x2f, 0xbe, 0xcc, 0x6f, 0xee, 0xdf, 0x23, 0x54, 0xbf, 0xd2, 0x3e,
-	0xf8, 0x15, 0x6a, 0x26, 0xa0, 0xd8, 0x35, 0x9d, 0x44, 0x34, 0x91, 0xc2, 0xb6, 0x7a, 0x56, 0xff,
-	0x71, 0xd0, 0x30, 0xd8, 0x79, 0x01, 0x61, 0x0f, 0xb5, 0x4a, 0x89, 0xcc, 0x80, 0xf0, 0xad, 0xf2,
-	0x48, 0x2b, 0x9f, 0x19, 0xea, 0x83, 0x66, 0x8c, 0xde, 0x46, 0x8f, 0x68, 0x02, 0x21, 0xa7, 0x91,
-	0x7d, 0xdc, 0xb3, 0xfa, 0x27, 0xc1, 0xb6, 0xc4, 0x5f, 0x50, 0x93, 0x70, 0x10, 0xe9, 0x64, 0x0a,
-	0x44, 0xc9, 0xcc, 0xae, 0x16, 0x16, 0xa3, 0xd3, 0x9b, 0x55, 0xb7, 0xf2, 0x6b, 0xd5, 0x7d, 0x41,
-	0x64, 0x2e, 0x64, 0x9e, 0x47, 0xb1, 0xc7, 0xa4, 0x2f, 0x40, 0xcd, 0xbd, 0x4b, 0x3a, 0x03, 0xb2,
-	0x3c, 0xa7, 0xe4, 0xcf, 0xaa, 0xdb, 0x5a, 0x82, 0xe0, 0xa7, 0xee, 0xbe, 0x81, 0x1b, 0x34, 0x74,
-	0x79, 0xa1, 0x2b, 0xfc, 0x06, 0xb5, 0xd5, 0x02, 0xd2, 0x09, 0x97, 0x32, 0x0e, 0x81, 0xc4, 0x93,
-	0x05, 0x4b, 0x22, 0xb9, 0xb0, 0x6b, 0x3d, 0xab, 0x5f, 0x0d, 0x70, 0xc1, 0x5d, 0x96, 0xd4, 0x27,
-	0xcd, 0xe0, 0x05, 0x7a, 0x3e, 0x05, 0xce, 0xb5, 0xb8, 0xcc, 0x98, 0x66, 0x8c, 0x50, 0xbb, 0xae,
-	0x3b, 0x3b, 0x7b, 0x58, 0x67, 0x2f, 0x4d, 0x67, 0xf7, 0x3a, 0xb9, 0x41, 0x6b, 0x8b, 0xbf, 0xd7,
-	0xf0, 0x95, 0x46, 0x7f, 0x58, 0xe8, 0xe9, 0x05, 0xa5, 0x1f, 0x65, 0x4c, 0x93, 0x31, 0x55, 0x10,
-	0x81, 0x02, 0xdc, 0x46, 0xb5, 0xfd, 0x25, 0x98, 0xa2, 0xd8, 0xd0, 0x3d, 0x73, 0x6f, 0xc8, 0xbd,
-	0x89, 0x77, 0xd0, 0x49, 0x44, 0x09, 0x13, 0xc0, 0x73, 0x3d, 0xf2, 0x27, 0xc1, 0xae, 0xc6, 0xef,
-	0x50, 0xcd, 0x44, 0x32, 0xc3, 0x1e, 0x3e, 0x2c, 0x52, 0xd3, 0x44, 0x2a, 0x23, 0x18, 0x87, 0xfd,
-	0xc5, 0xd6, 0xef, 0x2c, 0xd6, 0x8d, 0x51, 0xe7, 0x30, 0xcd, 0x99, 0xe4, 0x9c, 0xea, 0xc7, 0x88,
-	0xc7, 0xa8, 0xc6, 0x14, 0x15, 0xb9, 0x6d, 0xf5, 0x8e, 0xfb, 0x8d, 0xb7, 0x03, 0xef, 0x3f, 0xaf,
-	0xd6, 0x3b, 0xf4, 0x1a, 0x55, 0x8b, 0xae, 0x03, 0xe3, 0x32, 0x1a, 0xdf, 0xac, 0x1d, 0xeb, 0x76,
-	0xed, 0x58, 0xbf, 0xd7, 0x8e, 0xf5, 0x7d, 0xe3, 0x54, 0x6e, 0x37, 0x4e, 0xe5, 0xe7, 0xc6, 0xa9,
-	0x7c, 0x1e, 0xce, 0x98, 0x9a, 0x7f, 0x0d, 0x3d, 0x22, 0x85, 0xbf, 0xbb, 0xa3, 0xdd, 0xc7, 0xb7,
-	0xc3, 0x93, 0x52, 0xcb, 0x94, 0xe6, 0x61, 0x5d, 0xdf, 0xc4, 0xf0, 0x5f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x5c, 0xee, 0xa2, 0x85, 0x7a, 0x03, 0x00, 0x00,
+	// 1022 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0x8e, 0x6b, 0xc7, 0xb1, 0xc7, 0xf9, 0xeb, 0x26, 0x29, 0x4b, 0x8a, 0xec, 0xb0, 0x57, 0x11,
+	0x0a, 0x36, 0x69, 0x10, 0x48, 0xbd, 0x40, 0x64, 0x53, 0x45, 0x44, 0x4a, 0x20, 0x6c, 0x53, 0x21,
+	0x21, 0xaa, 0xd5, 0x78, 0xf7, 0x64, 0x33, 0xf5, 0xce, 0xce, 0x6a, 0x67, 0x9c, 0x1f, 0x9e, 0x82,
+	0x77, 0xe0, 0x8e, 0x6b, 0x1e, 0xa2, 0xdc, 0x55, 0x5c, 0x01, 0x17, 0x11, 0x4a, 0xde, 0xa0, 0x4f,
+	0x80, 0xe6, 0xcc, 0xd8, 0xb1, 0x43, 0xab, 0x98, 0x72, 0xb7, 0xe7, 0xef, 0x3b, 0x7f, 0xdf, 0x1c,
+	0x2d, 0xd9, 0xe8, 0x31, 0x16, 0x9d, 0x50, 0x96, 0x75, 0x8e, 0x01, 0x68, 0x57, 0xaa, 0x82, 0x46,
+	0x8a, 0x89, 0xac, 0x73, 0xba, 0xd9, 0x05, 0x45, 0x37, 0x3b, 0x39, 0x2d, 0x28, 0x97, 0xed, 0xbc,
+	0x10, 0x4a, 0x38, 0xad, 0x81, 0x77, 0x7b, 0xdc, 0xbb, 0x6d, 0xbd, 0x57, 0x97, 0x13, 0x91, 0x08,
+	0xf4, 0xed, 0xe8, 0x2f, 0x13, 0xb6, 0xfa, 0x7e, 0x24, 0x24, 0x17, 0x32, 0x34, 0x06, 0x23, 0x18,
+	0x93, 0xf7, 0x5b, 0x85, 0x54, 0x0f, 0x31, 0x85, 0xf3, 0x21, 0x99, 0xcd, 0xa8, 0x62, 0xa7, 0x10,
+	0xc6, 0x90, 0x09, 0xee, 0x96, 0xd6, 0x4a, 0xeb, 0xf5, 0xa0, 0x61, 0x74, 0x4f, 0xb4, 0xca, 0x69,
+	0x93, 0x25, 0xeb, 0x22, 0x0a, 0x1a, 0xa5, 0x03, 0xcf, 0x7b, 0xe8, 0x79, 0xdf, 0x98, 0xbe, 0x41,
+	0x8b, 0xf1, 0x77, 0xc9, 0x0c, 0x64, 0xb4, 0x9b, 0x42, 0xec, 0x96, 0xd7, 0x4a, 0xeb, 0xb5, 0x60,
+	0x20, 0x3a, 0xcf, 0xc9, 0x6c, 0x94, 0x52, 0x9e, 0x87, 0xc7, 0x34, 0x52, 0xa2, 0x70, 0x2b, 0x1a,
+	0xc2, 0x7f, 0xfc, 0xf2, 0xb2, 0x35, 0xf5, 0xd7, 0x65, 0xeb, 0xa1, 0xa9, 0x51, 0xc6, 0xbd, 0x36,
+	0x13, 0x1d, 0x4e, 0xd5, 0x49, 0x7b, 0x1f, 0x12, 0x1a, 0x5d, 0x3c, 0x81, 0xe8, 0xf5, 0x65, 0x6b,
+	0xe9, 0x82, 0xf2, 0xf4, 0xb1, 0x37, 0x0a, 0xe0, 0x05, 0x0d, 0x14, 0x77, 0x51, 0x72, 0x3e, 0x21,
+	0xcb, 0xea, 0x8c, 0xe6, 0x61, 0x2a, 0x44, 0xaf, 0x4b, 0xa3, 0x5e, 0x78, 0xc6, 0xb2, 0x58, 0x9c,
+	0xb9, 0xd3, 0x6b, 0xa5, 0xf5, 0x4a, 0xe0, 0x68, 0xdb, 0xbe, 0x35, 0x7d, 0x87, 0x16, 0xe7, 0x8c,
+	0xac, 0x1c, 0xd3, 0x34, 0x45, 0x67, 0xdb, 0x63, 0x5e, 0xb0, 0x08, 0xdc, 0x2a, 0x56, 0xb6, 0x33,
+	0x59, 0x65, 0x1f, 0x98, 0xca, 0xde, 0x88, 0xe4, 0x05, 0x4b, 0x03, 0xfd, 0xd7, 0xa8, 0x3e, 0xd4,
+	0x5a, 0x67, 0x95, 0xd4, 0x92, 0x3e, 0x2d, 0x62, 0x46, 0x33, 0x77, 0x06, 0x07, 0x39, 0x94, 0x9d,
+	0x47, 0x64, 0x25, 0x12, 0xd9, 0x29, 0x14, 0x92, 0x89, 0x2c, 0x8c, 0x68, 0x3e, 0xe8, 0xa3, 0x86,
+	0x7d, 0x2c, 0xdd, 0x18, 0x77, 0x68, 0x6e, 0x1b, 0x59, 0x27, 0x8b, 0xbc, 0x9f, 0x2a, 0x16, 0x2a,
+	0xd1, 0x83, 0x2c, 0x3c, 0x06, 0x90, 0x6e, 0x1d, 0x87, 0x3f, 0x8f, 0xfa, 0x23, 0xad, 0xde, 0x05,
+	0x90, 0x8e, 0x47, 0xe6, 0x38, 0x3d, 0x37, 0xc5, 0x85, 0x34, 0x01, 0x97, 0x20, 0x6a, 0x83, 0xd3,
+	0x73, 0x2c, 0x6d, 0x3b, 0x01, 0xe7, 0x23, 0x72, 0x9f, 0xb3, 0x2c, 0xc4, 0x61, 0x46, 0xe2, 0x14,
+	0x0a, 0xed, 0xd7, 0x40, 0xbf, 0x05, 0xce, 0xb2, 0xa3, 0x33, 0x9a, 0xef, 0x58, 0xb5, 0xf7, 0x4b,
+	0x85, 0x2c, 0xee, 0x02, 0x60, 0x82, 0x03, 0x50, 0x34, 0xa6, 0x8a, 0x3a, 0xcb, 0x64, 0x7a, 0x94,
+	0x4e, 0x46, 0xd0, 0x5c, 0x7b, 0x03, 0x83, 0x1a, 0x62, 0x84, 0x3b, 0xab, 0xa4, 0x16, 0x43, 0xc4,
+	0x38, 0x4d, 0x25, 0x92, 0x67, 0x2e, 0x18, 0xca, 0xce, 0x1e, 0x99, 0x36, 0xcb, 0x31, 0xb4, 0xd9,
+	0x9a, 0x6c, 0x39, 0xb3, 0x66, 0x39, 0x76, 0x19, 0x06, 0x61, 0x94, 0xa2, 0xd5, 0x71, 0x8a, 0x32,
+	0xb2, 0x68, 0x47, 0x13, 0xbf, 0xe8, 0x4b, 0xc5, 0x21, 0x53, 0x66, 0x41, 0xfe, 0x17, 0x93, 0xe5,
+	0x7b, 0x6f, 0x24, 0xdf, 0x08, 0x88, 0x17, 0x2c, 0xa0, 0x6a, 0x7b, 0xa8, 0x71, 0xbe, 0x22, 0x33,
+	0x7a, 0xca, 0xc7, 0x00, 0xb8, 0xd9, 0xba, 0xdf, 0xb1, 0x19, 0x56, 0xfe, 0x9d, 0x61, 0x2f, 0x53,
+	0xaf, 0x2f, 0x5b, 0xf3, 0x06, 0xdb, 0x46, 0x79, 0x41, 0x95, 0x33, 0xbd, 0x54, 0x44, 0xa2, 0xe7,
+	0x88, 0x54, 0xff, 0x6f, 0x48, 0x26, 0x4a, 0x23, 0xd1, 0x73, 0x8d, 0xf4, 0x9c, 0xcc, 0x8f, 0x73,
+	0x0f, 0xe9, 0x51, 0xf7, 0x3f, 0xbb, 0x0b, 0x70, 0xc5, 0xbe, 0xce, 0xb1, 0x60, 0x2f, 0x98, 0x1b,
+	0x23, 0xab, 0xd7, 0x23, 0xab, 0xb7, 0xb9, 0xb2, 0x23, 0xd2, 0x14, 0xf0, 0x9e, 0x39, 0x07, 0x64,
+	0x9a, 0x29, 0xe0, 0xd2, 0x2d, 0xad, 0x95, 0xd7, 0x1b, 0x8f, 0x36, 0xdb, 0x77, 0x1c, 0xbe, 0xf6,
+	0x6d, 0x2c, 0xbf, 0xa2, 0xcb, 0x0c, 0x0c, 0x8a, 0xf7, 0x23, 0x59, 0xd8, 0x19, 0x66, 0x7f, 0x26,
+	0x69, 0x02, 0x6f, 0xe1, 0xe5, 0xb7, 0x64, 0xa6, 0xdb, 0x8f, 0x7a, 0xa0, 0xa4, 0x7b, 0x6f, 0xc2,
+	0xcc, 0x37, 0xc0, 0x3e, 0x46, 0xda, 0xcc, 0x03, 0x1c, 0xef, 0x05, 0x59, 0xbc, 0xed, 0xa2, 0x93,
+	0x4b, 0x45, 0x0b, 0x85, 0xc9, 0xcb, 0x81, 0x11, 0x9c, 0x2f, 0x49, 0xa5, 0x2f, 0x21, 0x36, 0x8f,
+	0xc1, 0xdf, 0xb8, 0x6b, 0xce, 0x0d, 0x33, 0x67, 0x1d, 0xe2, 0x05, 0x18, 0xe9, 0xfd, 0x5c, 0x26,
+	0xf5, 0x43, 0x7a, 0xc1, 0xa9, 0x54, 0x50, 0x38, 0x9f, 0x92, 0x5a, 0x24, 0x32, 0x2c, 0xd8, 0x74,
+	0xe9, 0xbb, 0xbf, 0xff, 0xfa, 0xf1, 0xb2, 0xbd, 0xff, 0xdb, 0x71, 0x5c, 0x80, 0x94, 0x4f, 0x55,
+	0xc1, 0xb2, 0x24, 0x18, 0x7a, 0x3a, 0x0f, 0x49, 0x9d, 0xcb, 0x24, 0x54, 0x17, 0x39, 0x98, 0x21,
+	0xd4, 0x83, 0x1a, 0x97, 0xc9, 0x91, 0x96, 0xf5, 0x6b, 0x89, 0x68, 0x9a, 0xea, 0x9b, 0x52, 0x46,
+	0xd3, 0x40, 0x74, 0x7e, 0x20, 0xf3, 0x39, 0x14, 0x61, 0x5f, 0x42, 0x11, 0xa6, 0x8c, 0x33, 0x65,
+	0xdf, 0xe6, 0xa4, 0x74, 0x19, 0x0f, 0xf6, 0x82, 0xd9, 0x1c, 0x8a, 0x67, 0x12, 0x8a, 0x7d, 0x2d,
+	0x3a, 0x21, 0x59, 0xd0, 0x0e, 0xdd, 0x54, 0x44, 0x3d, 0x0b, 0x3f, 0x8d, 0xf0, 0x9f, 0xdf, 0x05,
+	0xff, 0xe0, 0x06, 0x7e, 0x24, 0xda, 0x0b, 0xe6, 0x72, 0x28, 0x7c, 0xad, 0x30, 0x09, 0x5a, 0xa4,
+	0x81, 0xd9, 0xed, 0x7d, 0xad, 0xe2, 0x85, 0x23, 0x5a, 0x65, 0xcf, 0xea, 0x1e, 0x99, 0xe9, 0xd2,
+	0x94, 0x66, 0x11, 0xd8, 0x23, 0x30, 0xe9, 0xc3, 0xb2, 0x51, 0x5e, 0x30, 0x88, 0xf7, 0xfe, 0x2c,
+	0x91, 0xf9, 0xe1, 0x96, 0x0c, 0x1b, 0xdf, 0x6d, 0x55, 0x1b, 0x48, 0x98, 0xc2, 0x12, 0xe6, 0xed,
+	0x11, 0xe8, 0xa5, 0x6f, 0xae, 0xe9, 0x2e, 0x34, 0xdc, 0x2b, 0x23, 0xf7, 0x1a, 0x46, 0xf7, 0x74,
+	0x8c, 0x81, 0x95, 0x77, 0x66, 0xa0, 0x20, 0x4b, 0xc3, 0xd6, 0x70, 0xbc, 0xa6, 0xbf, 0x07, 0xa4,
+	0x7a, 0x02, 0x2c, 0x39, 0x19, 0x30, 0xde, 0x4a, 0xff, 0x9f, 0xf2, 0xfe, 0xc1, 0xcb, 0xab, 0x66,
+	0xe9, 0xd5, 0x55, 0xb3, 0xf4, 0xf7, 0x55, 0xb3, 0xf4, 0xd3, 0x75, 0x73, 0xea, 0xd5, 0x75, 0x73,
+	0xea, 0x8f, 0xeb, 0xe6, 0xd4, 0xf7, 0x5b, 0x09, 0x53, 0x27, 0xfd, 0x6e, 0x3b, 0x12, 0xbc, 0x33,
+	0xfc, 0xcb, 0x1a, 0x7e, 0x9c, 0xdf, 0xfe, 0xe1, 0x42, 0xc2, 0x77, 0xab, 0xf8, 0x5b, 0xb4, 0xf5,
+	0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xdb, 0xf4, 0xec, 0x98, 0x09, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -290,6 +701,38 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinTwapCoverage != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinTwapCoverage))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxPriceAge != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPriceAge))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MultiTokenFees {
+		i--
+		if m.MultiTokenFees {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ConversionCapWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ConversionCapWindow))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	{
 		size := m.FallbackNativePrice.Size()
 		i -= size
@@ -362,6 +805,46 @@ func (m *FeeTokenMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ConversionCap.Size()
+		i -= size
+		if _, err := m.ConversionCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.MaxFee.Size()
+		i -= size
+		if _, err := m.MaxFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MinFee.Size()
+		i -= size
+		if _, err := m.MinFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.PriceAdjustment.Size()
+		i -= size
+		if _, err := m.PriceAdjustment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if m.Enabled {
 		i--
 		if m.Enabled {
@@ -441,21 +924,276 @@ func (m *FeeTokenMetadataCollection) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ConversionUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ConversionUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConversionBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConversionBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConversionBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Used.Size()
+		i -= size
+		if _, err := m.Used.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Start != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Paymaster) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Paymaster) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Paymaster) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.UserWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.UserWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.PerBlockLimit.Size()
+		i -= size
+		if _, err := m.PerBlockLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PerUserLimit.Size()
+		i -= size
+		if _, err := m.PerUserLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Callees) > 0 {
+		for iNdEx := len(m.Callees) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Callees[iNdEx])
+			copy(dAtA[i:], m.Callees[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Callees[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypes[iNdEx])
+			copy(dAtA[i:], m.MsgTypes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PaymasterUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymasterUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymasterUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Used.Size()
+		i -= size
+		if _, err := m.Used.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.WindowStart != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PaymasterBlockUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymasterBlockUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymasterBlockUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Used.Size()
+		i -= size
+		if _, err := m.Used.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.NativeDenom)
@@ -476,6 +1214,22 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.FallbackNativePrice.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.ConversionCapWindow != 0 {
+		n += 1 + sovParams(uint64(m.ConversionCapWindow))
+	}
+	if m.MultiTokenFees {
+		n += 2
+	}
+	if m.MaxPriceAge != 0 {
+		n += 1 + sovParams(uint64(m.MaxPriceAge))
+	}
+	if m.MinTwapCoverage != 0 {
+		n += 1 + sovParams(uint64(m.MinTwapCoverage))
+	}
 	return n
 }
 
@@ -501,31 +1255,1098 @@ func (m *FeeTokenMetadata) Size() (n int) {
 	if m.Enabled {
 		n += 2
 	}
-	return n
-}
+	l = m.PriceAdjustment.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.ConversionCap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *FeeTokenMetadataCollection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConversionUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConversionBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Start != 0 {
+		n += 1 + sovParams(uint64(m.Start))
+	}
+	l = m.Used.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *Paymaster) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.MsgTypes) > 0 {
+		for _, s := range m.MsgTypes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.Callees) > 0 {
+		for _, s := range m.Callees {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.PerUserLimit.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.PerBlockLimit.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.UserWindow != 0 {
+		n += 1 + sovParams(uint64(m.UserWindow))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *PaymasterUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.WindowStart != 0 {
+		n += 1 + sovParams(uint64(m.WindowStart))
+	}
+	l = m.Used.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *PaymasterBlockUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovParams(uint64(m.Height))
+	}
+	l = m.Used.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeOracleDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeOracleDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClampFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClampFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackWindow", wireType)
+			}
+			m.TwapLookbackWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwapLookbackWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackNativePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FallbackNativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionCapWindow", wireType)
+			}
+			m.ConversionCapWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConversionCapWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiTokenFees", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MultiTokenFees = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			m.MaxPriceAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTwapCoverage", wireType)
+			}
+			m.MinTwapCoverage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTwapCoverage |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTokenMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTokenMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTokenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceAdjustment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceAdjustment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTokenMetadataCollection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTokenMetadataCollection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTokenMetadataCollection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, FeeTokenMetadata{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConversionUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, ConversionBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *FeeTokenMetadataCollection) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovParams(uint64(l))
+	return nil
+}
+func (m *ConversionBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConversionBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConversionBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			m.Start = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Start |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Used.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Paymaster) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -548,15 +2369,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: Paymaster: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Paymaster: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -584,11 +2405,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NativeDenom = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NativeOracleDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -616,13 +2437,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NativeOracleDenom = string(dAtA[iNdEx:postIndex])
+			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callees", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -632,15 +2453,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callees = append(m.Callees, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClampFactor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PerUserLimit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -668,15 +2501,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClampFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PerUserLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackWindow", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerBlockLimit", wireType)
 			}
-			m.TwapLookbackWindow = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -686,14 +2519,48 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TwapLookbackWindow |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerBlockLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserWindow", wireType)
+			}
+			m.UserWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FallbackNativePrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -721,7 +2588,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FallbackNativePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -746,7 +2613,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeeTokenMetadata) Unmarshal(dAtA []byte) error {
+func (m *PaymasterUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -769,15 +2636,15 @@ func (m *FeeTokenMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeTokenMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: PaymasterUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeTokenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PaymasterUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -805,11 +2672,11 @@ func (m *FeeTokenMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -837,13 +2704,13 @@ func (m *FeeTokenMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleDenom = string(dAtA[iNdEx:postIndex])
+			m.User = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
 			}
-			m.Decimals = 0
+			m.WindowStart = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -853,14 +2720,14 @@ func (m *FeeTokenMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decimals |= uint32(b&0x7F) << shift
+				m.WindowStart |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -888,30 +2755,10 @@ func (m *FeeTokenMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Used.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowParams
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -933,7 +2780,7 @@ func (m *FeeTokenMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeeTokenMetadataCollection) Unmarshal(dAtA []byte) error {
+func (m *PaymasterBlockUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -956,17 +2803,36 @@ func (m *FeeTokenMetadataCollection) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeeTokenMetadataCollection: wiretype end group for non-group")
+			return fmt.Errorf("proto: PaymasterBlockUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeeTokenMetadataCollection: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PaymasterBlockUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -976,23 +2842,23 @@ func (m *FeeTokenMetadataCollection) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, FeeTokenMetadata{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Used.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7,6 +7,9 @@ import (
 
 	"cosmossdk.io/math"
 
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
 	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
 )
 
@@ -141,6 +144,50 @@ func TestValidateParams(t *testing.T) {
 			),
 			errContains: "twap lookback window must be greater than 0",
 		},
+		{
+			name: "valid - params with guardian",
+			params: func() types.Params {
+				params := types.DefaultParams()
+				params.Guardian = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+				return params
+			}(),
+		},
+		{
+			name: "invalid - conversion cap window zero",
+			params: func() types.Params {
+				params := types.DefaultParams()
+				params.ConversionCapWindow = 0
+				return params
+			}(),
+			errContains: "conversion cap window must be greater than 0",
+		},
+		{
+			name: "invalid - bad guardian address",
+			params: func() types.Params {
+				params := types.DefaultParams()
+				params.Guardian = "guardian"
+				return params
+			}(),
+			errContains: "guardian address is invalid",
+		},
+		{
+			name: "valid - oracle data guards disabled",
+			params: func() types.Params {
+				params := types.DefaultParams()
+				params.MaxPriceAge = 0
+				params.MinTwapCoverage = 0
+				return params
+			}(),
+		},
+		{
+			name: "invalid - min twap coverage above the lookback window",
+			params: func() types.Params {
+				params := types.DefaultParams()
+				params.MinTwapCoverage = params.TwapLookbackWindow + 1
+				return params
+			}(),
+			errContains: "min twap coverage cannot be greater than the twap lookback window",
+		},
 	}
 
 	// Iterate through the test cases
@@ -191,6 +238,15 @@ func TestFeeTokenMetadataValidate(t *testing.T) {
 			metadata:    types.NewFeeTokenMetadata("coin", "123", 6, math.LegacyNewDec(100)),
 			errContains: "oracle denom is invalid",
 		},
+		{
+			name:     "valid - ibc denom",
+			metadata: types.NewFeeTokenMetadata("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", "oraclecoin", 6, math.LegacyNewDec(100)),
+		},
+		{
+			name:        "invalid - ibc denom with an invalid hash",
+			metadata:    types.NewFeeTokenMetadata("ibc/xyz", "oraclecoin", 6, math.LegacyNewDec(100)),
+			errContains: "ibc denom is invalid",
+		},
 		{
 			name:        "invalid - decimals zero",
 			metadata:    types.NewFeeTokenMetadata("coin", "oraclecoin", 0, math.LegacyNewDec(100)),
@@ -211,6 +267,52 @@ func TestFeeTokenMetadataValidate(t *testing.T) {
 			metadata:    types.NewFeeTokenMetadata("coin", "oraclecoin", 6, math.LegacyNewDec(0)),
 			errContains: "price must be greater than 0",
 		},
+		{
+			name: "valid - unset pricing values",
+			metadata: types.FeeTokenMetadata{
+				Denom:       "coin",
+				OracleDenom: "oraclecoin",
+				Decimals:    6,
+				Price:       math.LegacyNewDec(100),
+			},
+		},
+		{
+			name: "invalid - price adjustment above 1",
+			metadata: func() types.FeeTokenMetadata {
+				metadata := types.NewFeeTokenMetadata("coin", "oraclecoin", 6, math.LegacyNewDec(100))
+				metadata.PriceAdjustment = math.LegacyMustNewDecFromStr("1.5")
+				return metadata
+			}(),
+			errContains: "price adjustment must be greater than -1 and less than or equal to 1",
+		},
+		{
+			name: "invalid - negative min fee",
+			metadata: func() types.FeeTokenMetadata {
+				metadata := types.NewFeeTokenMetadata("coin", "oraclecoin", 6, math.LegacyNewDec(100))
+				metadata.MinFee = math.NewInt(-1)
+				return metadata
+			}(),
+			errContains: "min fee cannot be negative",
+		},
+		{
+			name: "invalid - max fee lower than min fee",
+			metadata: func() types.FeeTokenMetadata {
+				metadata := types.NewFeeTokenMetadata("coin", "oraclecoin", 6, math.LegacyNewDec(100))
+				metadata.MinFee = math.NewInt(100)
+				metadata.MaxFee = math.NewInt(10)
+				return metadata
+			}(),
+			errContains: "max fee cannot be lower than min fee",
+		},
+		{
+			name: "invalid - negative conversion cap",
+			metadata: func() types.FeeTokenMetadata {
+				metadata := types.NewFeeTokenMetadata("coin", "oraclecoin", 6, math.LegacyNewDec(100))
+				metadata.ConversionCap = math.NewInt(-1)
+				return metadata
+			}(),
+			errContains: "conversion cap cannot be negative",
+		},
 	}
 
 	// Iterate through the test cases
@@ -288,3 +390,57 @@ func TestFeeTokenMetadataCollectionValidate(t *testing.T) {
 		})
 	}
 }
+
+// TestFeeTokenMetadataBoundPortionAmount tests the BoundPortionAmount method of FeeTokenMetadata
+func TestFeeTokenMetadataBoundPortionAmount(t *testing.T) {
+	// Prepare a token with bounds
+	bounded := types.NewFeeTokenMetadata("coin", "oraclecoin", 6, math.LegacyNewDec(100))
+	bounded.MinFee = math.NewInt(10)
+	bounded.MaxFee = math.NewInt(100)
+
+	// Prepare test cases
+	testCases := []struct {
+		name     string
+		feeToken types.FeeTokenMetadata
+		amount   math.Int
+		expected math.Int
+		ok       bool
+	}{
+		{
+			name:     "no bounds",
+			feeToken: types.NewFeeTokenMetadata("coin", "oraclecoin", 6, math.LegacyNewDec(100)),
+			amount:   math.NewInt(5),
+			expected: math.NewInt(5),
+			ok:       true,
+		},
+		{
+			name:     "within bounds",
+			feeToken: bounded,
+			amount:   math.NewInt(50),
+			expected: math.NewInt(50),
+			ok:       true,
+		},
+		{
+			name:     "lowered to the max fee",
+			feeToken: bounded,
+			amount:   math.NewInt(500),
+			expected: math.NewInt(100),
+			ok:       true,
+		},
+		{
+			name:     "below the min fee",
+			feeToken: bounded,
+			amount:   math.NewInt(5),
+			expected: math.NewInt(5),
+			ok:       false,
+		},
+	}
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			amount, ok := tc.feeToken.BoundPortionAmount(tc.amount)
+			require.Equal(t, tc.ok, ok)
+			require.Equal(t, tc.expected, amount)
+		})
+	}
+}
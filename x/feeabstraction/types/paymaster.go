@@ -0,0 +1,194 @@
+package types
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+)
+
+// sponsorableMsgTypes are the Cosmos message types a paymaster can declare
+// They all name a recipient, which must be the paymaster or one of its callees
+var sponsorableMsgTypes = []string{
+	sdk.MsgTypeURL(&wasmtypes.MsgExecuteContract{}),
+	sdk.MsgTypeURL(&banktypes.MsgSend{}),
+}
+
+// msgRecipient returns the recipient of a sponsorable Cosmos message
+func msgRecipient(msg sdk.Msg) (string, bool) {
+	switch msg := msg.(type) {
+	case *wasmtypes.MsgExecuteContract:
+		return msg.Contract, true
+	case *banktypes.MsgSend:
+		return msg.ToAddress, true
+	default:
+		return "", false
+	}
+}
+
+// Validate validates the Paymaster
+func (p Paymaster) Validate() error {
+	// Validate the contract address
+	if _, err := sdk.AccAddressFromBech32(p.Contract); err != nil {
+		return errorsmod.Wrap(ErrInvalidPaymaster, "contract address is invalid")
+	}
+
+	// Validate the message types and check for duplicates
+	msgTypeSet := make(map[string]struct{})
+	for _, msgType := range p.MsgTypes {
+		if !strings.HasPrefix(msgType, "/") || len(msgType) == 1 {
+			return errorsmod.Wrapf(ErrInvalidPaymaster, "message type url is invalid: %s", msgType)
+		}
+		if !slices.Contains(sponsorableMsgTypes, msgType) {
+			return errorsmod.Wrapf(ErrInvalidPaymaster, "message type has no contract recipient: %s", msgType)
+		}
+		if _, exists := msgTypeSet[msgType]; exists {
+			return errorsmod.Wrapf(ErrInvalidPaymaster, "duplicate message type found: %s", msgType)
+		}
+		msgTypeSet[msgType] = struct{}{}
+	}
+
+	// Validate the callees and check for duplicates
+	calleeSet := make(map[string]struct{})
+	for _, callee := range p.Callees {
+		if _, err := sdk.AccAddressFromBech32(callee); err != nil {
+			return errorsmod.Wrapf(ErrInvalidPaymaster, "callee address is invalid: %s", callee)
+		}
+		if _, exists := calleeSet[callee]; exists {
+			return errorsmod.Wrapf(ErrInvalidPaymaster, "duplicate callee found: %s", callee)
+		}
+		calleeSet[callee] = struct{}{}
+	}
+
+	// The limits are required to prevent the balance from being drained
+	if p.PerUserLimit.IsNil() || !p.PerUserLimit.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidPaymaster, "per user limit must be positive")
+	}
+	if p.PerBlockLimit.IsNil() || !p.PerBlockLimit.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidPaymaster, "per block limit must be positive")
+	}
+	if p.UserWindow == 0 {
+		return errorsmod.Wrap(ErrInvalidPaymaster, "user window must be positive")
+	}
+
+	// Validate the balance
+	if p.Balance.IsNil() || p.Balance.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidPaymaster, "balance cannot be negative")
+	}
+
+	return nil
+}
+
+// IsEligibleCallee returns true if calls to the address are sponsored by the paymaster
+// The paymaster contract is always sponsored
+func (p Paymaster) IsEligibleCallee(callee sdk.AccAddress) bool {
+	// Check the paymaster contract
+	if contract, err := sdk.AccAddressFromBech32(p.Contract); err == nil && bytes.Equal(contract, callee) {
+		return true
+	}
+
+	// Check the declared callees
+	for _, eligible := range p.Callees {
+		if addr, err := sdk.AccAddressFromBech32(eligible); err == nil && bytes.Equal(addr, callee) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsEligibleMsg returns true if the Cosmos message is sponsored by the paymaster
+// The message must be sent to the paymaster or one of its callees, contract executions are always
+// sponsored and the other messages must be on the declared message types
+func (p Paymaster) IsEligibleMsg(msg sdk.Msg) bool {
+	// Check the message recipient
+	recipient, ok := msgRecipient(msg)
+	if !ok {
+		return false
+	}
+	if callee, err := sdk.AccAddressFromBech32(recipient); err != nil || !p.IsEligibleCallee(callee) {
+		return false
+	}
+
+	// Check the message type
+	if _, ok := msg.(*wasmtypes.MsgExecuteContract); ok {
+		return true
+	}
+	return slices.Contains(p.MsgTypes, sdk.MsgTypeURL(msg))
+}
+
+// NewPaymasterUsage creates a new empty paymaster usage starting at the given time
+func NewPaymasterUsage(contract, user string, windowStart int64) PaymasterUsage {
+	return PaymasterUsage{
+		Contract:    contract,
+		User:        user,
+		WindowStart: windowStart,
+		Used:        math.ZeroInt(),
+	}
+}
+
+// Validate validates the PaymasterUsage
+func (u PaymasterUsage) Validate() error {
+	// Validate the addresses
+	if _, err := sdk.AccAddressFromBech32(u.Contract); err != nil {
+		return errorsmod.Wrap(ErrInvalidPaymaster, "paymaster usage contract address is invalid")
+	}
+	if _, err := sdk.AccAddressFromBech32(u.User); err != nil {
+		return errorsmod.Wrap(ErrInvalidPaymaster, "paymaster usage user address is invalid")
+	}
+
+	// Validate the window start and the used amount
+	if u.WindowStart < 0 {
+		return errorsmod.Wrap(ErrInvalidPaymaster, "paymaster usage window start cannot be negative")
+	}
+	if u.Used.IsNil() || u.Used.IsNegative() {
+		return errorsmod.Wrap(ErrInvalidPaymaster, "paymaster usage used amount cannot be negative")
+	}
+
+	return nil
+}
+
+// WindowEnd returns the unix time in seconds where the usage window ends
+func (u PaymasterUsage) WindowEnd(window uint64) int64 {
+	return u.WindowStart + int64(window)
+}
+
+// IsExpired returns true if the usage window is over at the given time
+func (u PaymasterUsage) IsExpired(now int64, window uint64) bool {
+	return now >= u.WindowEnd(window)
+}
+
+// Remaining returns the amount still available under the limit
+// It returns zero if the limit was already reached
+func (u PaymasterUsage) Remaining(limit math.Int) math.Int {
+	return remainingUnderLimit(u.Used, limit)
+}
+
+// NewPaymasterBlockUsage creates a new empty paymaster usage for the given height
+func NewPaymasterBlockUsage(height int64) PaymasterBlockUsage {
+	return PaymasterBlockUsage{
+		Height: height,
+		Used:   math.ZeroInt(),
+	}
+}
+
+// Remaining returns the amount still available under the limit
+// It returns zero if the limit was already reached
+func (u PaymasterBlockUsage) Remaining(limit math.Int) math.Int {
+	return remainingUnderLimit(u.Used, limit)
+}
+
+// remainingUnderLimit returns the amount still available under a limit
+func remainingUnderLimit(used, limit math.Int) math.Int {
+	if used.GTE(limit) {
+		return math.ZeroInt()
+	}
+	return limit.Sub(used)
+}
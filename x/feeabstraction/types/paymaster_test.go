@@ -0,0 +1,250 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	"github.com/kiichain/kiichain/v4/app/apptesting"
+	"github.com/kiichain/kiichain/v4/x/feeabstraction/types"
+)
+
+// newTestPaymaster returns a valid paymaster for the tests
+func newTestPaymaster(contract string) types.Paymaster {
+	return types.Paymaster{
+		Contract:      contract,
+		PerUserLimit:  math.NewInt(100),
+		PerBlockLimit: math.NewInt(1000),
+		UserWindow:    3600,
+		Balance:       math.ZeroInt(),
+	}
+}
+
+// TestPaymasterValidate tests the Validate method of Paymaster
+func TestPaymasterValidate(t *testing.T) {
+	contract := apptesting.RandomAccountAddress().String()
+	callee := apptesting.RandomAccountAddress().String()
+
+	// Prepare test cases
+	testCases := []struct {
+		name        string
+		malleate    func(p *types.Paymaster)
+		errContains string
+	}{
+		{
+			name: "valid - default paymaster",
+		},
+		{
+			name: "valid - with msg types and callees",
+			malleate: func(p *types.Paymaster) {
+				p.MsgTypes = []string{"/cosmos.bank.v1beta1.MsgSend"}
+				p.Callees = []string{callee}
+			},
+		},
+		{
+			name: "invalid - bad contract",
+			malleate: func(p *types.Paymaster) {
+				p.Contract = "invalid"
+			},
+			errContains: "contract address is invalid",
+		},
+		{
+			name: "invalid - bad msg type",
+			malleate: func(p *types.Paymaster) {
+				p.MsgTypes = []string{"cosmos.bank.v1beta1.MsgSend"}
+			},
+			errContains: "message type url is invalid",
+		},
+		{
+			name: "invalid - msg type without a contract recipient",
+			malleate: func(p *types.Paymaster) {
+				p.MsgTypes = []string{"/cosmos.staking.v1beta1.MsgDelegate"}
+			},
+			errContains: "message type has no contract recipient",
+		},
+		{
+			name: "invalid - duplicate msg type",
+			malleate: func(p *types.Paymaster) {
+				p.MsgTypes = []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"}
+			},
+			errContains: "duplicate message type found",
+		},
+		{
+			name: "invalid - bad callee",
+			malleate: func(p *types.Paymaster) {
+				p.Callees = []string{"invalid"}
+			},
+			errContains: "callee address is invalid",
+		},
+		{
+			name: "invalid - duplicate callee",
+			malleate: func(p *types.Paymaster) {
+				p.Callees = []string{callee, callee}
+			},
+			errContains: "duplicate callee found",
+		},
+		{
+			name: "invalid - zero per user limit",
+			malleate: func(p *types.Paymaster) {
+				p.PerUserLimit = math.ZeroInt()
+			},
+			errContains: "per user limit must be positive",
+		},
+		{
+			name: "invalid - zero per block limit",
+			malleate: func(p *types.Paymaster) {
+				p.PerBlockLimit = math.ZeroInt()
+			},
+			errContains: "per block limit must be positive",
+		},
+		{
+			name: "invalid - zero user window",
+			malleate: func(p *types.Paymaster) {
+				p.UserWindow = 0
+			},
+			errContains: "user window must be positive",
+		},
+		{
+			name: "invalid - negative balance",
+			malleate: func(p *types.Paymaster) {
+				p.Balance = math.NewInt(-1)
+			},
+			errContains: "balance cannot be negative",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			paymaster := newTestPaymaster(contract)
+			if tc.malleate != nil {
+				tc.malleate(&paymaster)
+			}
+			err := paymaster.Validate()
+
+			// Check the error
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errContains)
+			}
+		})
+	}
+}
+
+// TestPaymasterEligibility tests the eligibility checks of Paymaster
+func TestPaymasterEligibility(t *testing.T) {
+	contract := apptesting.RandomAccountAddress()
+	callee := apptesting.RandomAccountAddress()
+	other := apptesting.RandomAccountAddress()
+
+	paymaster := newTestPaymaster(contract.String())
+	paymaster.Callees = []string{callee.String()}
+	paymaster.MsgTypes = []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+
+	// Check the callees, the contract itself is always eligible
+	require.True(t, paymaster.IsEligibleCallee(contract))
+	require.True(t, paymaster.IsEligibleCallee(callee))
+	require.False(t, paymaster.IsEligibleCallee(other))
+
+	// Check the messages
+	require.True(t, paymaster.IsEligibleMsg(&wasmtypes.MsgExecuteContract{Contract: contract.String()}))
+	require.True(t, paymaster.IsEligibleMsg(&wasmtypes.MsgExecuteContract{Contract: callee.String()}))
+	require.False(t, paymaster.IsEligibleMsg(&wasmtypes.MsgExecuteContract{Contract: other.String()}))
+	require.True(t, paymaster.IsEligibleMsg(&banktypes.MsgSend{ToAddress: contract.String()}))
+	require.True(t, paymaster.IsEligibleMsg(&banktypes.MsgSend{ToAddress: callee.String()}))
+	require.False(t, paymaster.IsEligibleMsg(&banktypes.MsgSend{ToAddress: other.String()}))
+	require.False(t, paymaster.IsEligibleMsg(&banktypes.MsgMultiSend{}))
+
+	// The messages sent to the paymaster must still be declared
+	paymaster.MsgTypes = nil
+	require.True(t, paymaster.IsEligibleMsg(&wasmtypes.MsgExecuteContract{Contract: contract.String()}))
+	require.False(t, paymaster.IsEligibleMsg(&banktypes.MsgSend{ToAddress: contract.String()}))
+}
+
+// TestPaymasterUsageValidate tests the Validate method of PaymasterUsage
+func TestPaymasterUsageValidate(t *testing.T) {
+	contract := apptesting.RandomAccountAddress().String()
+	user := apptesting.RandomAccountAddress().String()
+
+	// Prepare test cases
+	testCases := []struct {
+		name        string
+		usage       types.PaymasterUsage
+		errContains string
+	}{
+		{
+			name:  "valid - new usage",
+			usage: types.NewPaymasterUsage(contract, user, 100),
+		},
+		{
+			name:        "invalid - bad contract",
+			usage:       types.NewPaymasterUsage("invalid", user, 100),
+			errContains: "paymaster usage contract address is invalid",
+		},
+		{
+			name:        "invalid - bad user",
+			usage:       types.NewPaymasterUsage(contract, "invalid", 100),
+			errContains: "paymaster usage user address is invalid",
+		},
+		{
+			name:        "invalid - negative window start",
+			usage:       types.NewPaymasterUsage(contract, user, -1),
+			errContains: "paymaster usage window start cannot be negative",
+		},
+		{
+			name: "invalid - negative used amount",
+			usage: types.PaymasterUsage{
+				Contract:    contract,
+				User:        user,
+				WindowStart: 100,
+				Used:        math.NewInt(-1),
+			},
+			errContains: "paymaster usage used amount cannot be negative",
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.usage.Validate()
+
+			// Check the error
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errContains)
+			}
+		})
+	}
+}
+
+// TestPaymasterUsageWindow tests the window helpers of the paymaster usages
+func TestPaymasterUsageWindow(t *testing.T) {
+	usage := types.NewPaymasterUsage(apptesting.RandomAccountAddress().String(), apptesting.RandomAccountAddress().String(), 1000)
+	usage.Used = math.NewInt(40)
+
+	// Check the window end and expiration
+	require.Equal(t, int64(1100), usage.WindowEnd(100))
+	require.False(t, usage.IsExpired(1099, 100))
+	require.True(t, usage.IsExpired(1100, 100))
+
+	// Check the remaining amount
+	require.Equal(t, math.NewInt(60), usage.Remaining(math.NewInt(100)))
+	require.True(t, usage.Remaining(math.NewInt(10)).IsZero())
+
+	// Check the block usage remaining amount
+	blockUsage := types.NewPaymasterBlockUsage(10)
+	blockUsage.Used = math.NewInt(700)
+	require.Equal(t, math.NewInt(300), blockUsage.Remaining(math.NewInt(1000)))
+	require.True(t, blockUsage.Remaining(math.NewInt(700)).IsZero())
+}
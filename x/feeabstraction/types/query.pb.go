@@ -5,7 +5,9 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -197,339 +199,3164 @@ func (m *QueryFeeTokensResponse) GetFeeTokens() *FeeTokenMetadataCollection {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "kiichain.feeabstraction.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "kiichain.feeabstraction.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryFeeTokensRequest)(nil), "kiichain.feeabstraction.v1beta1.QueryFeeTokensRequest")
-	proto.RegisterType((*QueryFeeTokensResponse)(nil), "kiichain.feeabstraction.v1beta1.QueryFeeTokensResponse")
+// QueryFeeTokenDenomTracesRequest is the request type for the
+// Query/FeeTokenDenomTraces RPC method
+type QueryFeeTokenDenomTracesRequest struct {
 }
 
-func init() {
-	proto.RegisterFile("kiichain/feeabstraction/v1beta1/query.proto", fileDescriptor_88edc16f4ff36bc7)
+func (m *QueryFeeTokenDenomTracesRequest) Reset()         { *m = QueryFeeTokenDenomTracesRequest{} }
+func (m *QueryFeeTokenDenomTracesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokenDenomTracesRequest) ProtoMessage()    {}
+func (*QueryFeeTokenDenomTracesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{4}
 }
-
-var fileDescriptor_88edc16f4ff36bc7 = []byte{
-	// 377 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcd, 0x4e, 0xea, 0x40,
-	0x14, 0xee, 0x90, 0x7b, 0x49, 0x98, 0xbb, 0x9b, 0xcb, 0xbd, 0x9a, 0xc6, 0x14, 0xd3, 0x0d, 0x1a,
-	0xb4, 0x13, 0xa8, 0xd1, 0x85, 0x3b, 0x8c, 0xee, 0x48, 0x94, 0xb8, 0x22, 0x26, 0x66, 0x8a, 0x87,
-	0xd2, 0x00, 0x9d, 0xd2, 0x0e, 0x46, 0xb6, 0x3e, 0x81, 0x89, 0xaf, 0xe0, 0x0b, 0xf8, 0x16, 0x2c,
-	0x49, 0xdc, 0xb8, 0x32, 0xa6, 0xf8, 0x20, 0x86, 0xe9, 0x00, 0x01, 0x17, 0x85, 0xdd, 0xe4, 0x9c,
-	0xef, 0xef, 0x9c, 0x33, 0xb8, 0xd4, 0xf1, 0xbc, 0x66, 0x9b, 0x79, 0x3e, 0x6d, 0x01, 0x30, 0x27,
-	0x12, 0x21, 0x6b, 0x0a, 0x8f, 0xfb, 0xf4, 0xbe, 0xec, 0x80, 0x60, 0x65, 0xda, 0x1f, 0x40, 0x38,
-	0xb4, 0x82, 0x90, 0x0b, 0x4e, 0x0a, 0x33, 0xb0, 0xb5, 0x0c, 0xb6, 0x14, 0x58, 0xcf, 0xbb, 0xdc,
-	0xe5, 0x12, 0x4b, 0xa7, 0xaf, 0x84, 0xa6, 0xef, 0xb8, 0x9c, 0xbb, 0x5d, 0xa0, 0x2c, 0xf0, 0x28,
-	0xf3, 0x7d, 0x2e, 0xd8, 0x94, 0x14, 0xa9, 0xee, 0x41, 0x5a, 0x82, 0x80, 0x85, 0xac, 0xa7, 0xd0,
-	0x66, 0x1e, 0x93, 0xab, 0x69, 0xa2, 0x4b, 0x59, 0xac, 0x43, 0x7f, 0x00, 0x91, 0x30, 0x6f, 0xf0,
-	0xdf, 0xa5, 0x6a, 0x14, 0x70, 0x3f, 0x02, 0x72, 0x8e, 0xb3, 0x09, 0x79, 0x1b, 0xed, 0xa2, 0xbd,
-	0x3f, 0x95, 0xa2, 0x95, 0x32, 0x80, 0x95, 0x08, 0x54, 0x7f, 0x8d, 0x3e, 0x0a, 0x5a, 0x5d, 0x91,
-	0xcd, 0x2d, 0xfc, 0x4f, 0xaa, 0x5f, 0x00, 0x5c, 0xf3, 0x0e, 0xf8, 0x73, 0x5b, 0x81, 0xff, 0xaf,
-	0x36, 0x94, 0x73, 0x03, 0xe3, 0x16, 0xc0, 0xad, 0x90, 0x55, 0xe5, 0x7e, 0x9a, 0xea, 0x3e, 0xd3,
-	0xa9, 0x81, 0x60, 0x77, 0x4c, 0xb0, 0x33, 0xde, 0xed, 0x82, 0x84, 0xd4, 0x73, 0xad, 0x99, 0x47,
-	0x25, 0xce, 0xe0, 0xdf, 0xd2, 0x96, 0xbc, 0x20, 0x9c, 0x4d, 0x12, 0x13, 0x3b, 0x55, 0xfc, 0xe7,
-	0xda, 0xf4, 0xa3, 0xcd, 0x48, 0xc9, 0x6c, 0x26, 0x7d, 0x7c, 0xfb, 0x7a, 0xce, 0xec, 0x93, 0x22,
-	0x5d, 0xef, 0x72, 0xe4, 0x15, 0xe1, 0xdc, 0x7c, 0x45, 0xe4, 0x78, 0x3d, 0xd3, 0xd5, 0x65, 0xeb,
-	0x27, 0x1b, 0xf3, 0x54, 0x5e, 0x5b, 0xe6, 0x3d, 0x24, 0xa5, 0xd4, 0xbc, 0x8b, 0x93, 0x55, 0x6b,
-	0xa3, 0xd8, 0x40, 0xe3, 0xd8, 0x40, 0x9f, 0xb1, 0x81, 0x9e, 0x26, 0x86, 0x36, 0x9e, 0x18, 0xda,
-	0xfb, 0xc4, 0xd0, 0x1a, 0xb6, 0xeb, 0x89, 0xf6, 0xc0, 0xb1, 0x9a, 0xbc, 0xb7, 0x10, 0x9c, 0x3f,
-	0x1e, 0x56, 0xb5, 0xc5, 0x30, 0x80, 0xc8, 0xc9, 0xca, 0xdf, 0x6b, 0x7f, 0x07, 0x00, 0x00, 0xff,
-	0xff, 0x5b, 0x0f, 0x3a, 0x93, 0x6f, 0x03, 0x00, 0x00,
+func (m *QueryFeeTokenDenomTracesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeTokenDenomTracesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokenDenomTracesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeTokenDenomTracesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokenDenomTracesRequest.Merge(m, src)
+}
+func (m *QueryFeeTokenDenomTracesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokenDenomTracesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokenDenomTracesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryFeeTokenDenomTracesRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params defines a gRPC query method that returns the fee abstraction params
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// FeeTokens defines a gRPC query method that returns the fee tokens
-	FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error)
+// QueryFeeTokenDenomTracesResponse is the response type for the
+// Query/FeeTokenDenomTraces RPC method
+type QueryFeeTokenDenomTracesResponse struct {
+	// denom_traces defines the denom traces of the IBC fee tokens
+	DenomTraces []FeeTokenDenomTrace `protobuf:"bytes,1,rep,name=denom_traces,json=denomTraces,proto3" json:"denom_traces"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryFeeTokenDenomTracesResponse) Reset()         { *m = QueryFeeTokenDenomTracesResponse{} }
+func (m *QueryFeeTokenDenomTracesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeTokenDenomTracesResponse) ProtoMessage()    {}
+func (*QueryFeeTokenDenomTracesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{5}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryFeeTokenDenomTracesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFeeTokenDenomTracesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeTokenDenomTracesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFeeTokenDenomTracesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeTokenDenomTracesResponse.Merge(m, src)
+}
+func (m *QueryFeeTokenDenomTracesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeTokenDenomTracesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeTokenDenomTracesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error) {
-	out := new(QueryFeeTokensResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Query/FeeTokens", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeTokenDenomTracesResponse proto.InternalMessageInfo
+
+func (m *QueryFeeTokenDenomTracesResponse) GetDenomTraces() []FeeTokenDenomTrace {
+	if m != nil {
+		return m.DenomTraces
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params defines a gRPC query method that returns the fee abstraction params
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// FeeTokens defines a gRPC query method that returns the fee tokens
-	FeeTokens(context.Context, *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error)
+// FeeTokenDenomTrace defines the IBC denom trace of a fee token
+type FeeTokenDenomTrace struct {
+	// denom is the IBC denom of the fee token (ibc/{hash})
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// oracle_denom is the oracle denom mapped to the fee token
+	OracleDenom string `protobuf:"bytes,2,opt,name=oracle_denom,json=oracleDenom,proto3" json:"oracle_denom,omitempty"`
+	// path is the chain of port and channel identifiers the token went through
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// base_denom is the denom of the token on its source chain
+	BaseDenom string `protobuf:"bytes,4,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *FeeTokenDenomTrace) Reset()         { *m = FeeTokenDenomTrace{} }
+func (m *FeeTokenDenomTrace) String() string { return proto.CompactTextString(m) }
+func (*FeeTokenDenomTrace) ProtoMessage()    {}
+func (*FeeTokenDenomTrace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{6}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *FeeTokenDenomTrace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) FeeTokens(ctx context.Context, req *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FeeTokens not implemented")
+func (m *FeeTokenDenomTrace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTokenDenomTrace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *FeeTokenDenomTrace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTokenDenomTrace.Merge(m, src)
+}
+func (m *FeeTokenDenomTrace) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTokenDenomTrace) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTokenDenomTrace.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.feeabstraction.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_FeeTokenDenomTrace proto.InternalMessageInfo
+
+func (m *FeeTokenDenomTrace) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_FeeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFeeTokensRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FeeTokens(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.feeabstraction.v1beta1.Query/FeeTokens",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FeeTokens(ctx, req.(*QueryFeeTokensRequest))
+func (m *FeeTokenDenomTrace) GetOracleDenom() string {
+	if m != nil {
+		return m.OracleDenom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kiichain.feeabstraction.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "FeeTokens",
-			Handler:    _Query_FeeTokens_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kiichain/feeabstraction/v1beta1/query.proto",
+func (m *FeeTokenDenomTrace) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FeeTokenDenomTrace) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryPauseStateRequest is the request type for the Query/PauseState RPC
+// method
+type QueryPauseStateRequest struct {
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryPauseStateRequest) Reset()         { *m = QueryPauseStateRequest{} }
+func (m *QueryPauseStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseStateRequest) ProtoMessage()    {}
+func (*QueryPauseStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{7}
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPauseStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryPauseStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseStateRequest.Merge(m, src)
+}
+func (m *QueryPauseStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseStateRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryPauseStateRequest proto.InternalMessageInfo
+
+// QueryPauseStateResponse is the response type for the Query/PauseState RPC
+// method
+type QueryPauseStateResponse struct {
+	// paused indicates if the whole module is paused
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// paused_fee_tokens defines the denoms of the paused fee tokens
+	PausedFeeTokens []string `protobuf:"bytes,2,rep,name=paused_fee_tokens,json=pausedFeeTokens,proto3" json:"paused_fee_tokens,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPauseStateResponse) Reset()         { *m = QueryPauseStateResponse{} }
+func (m *QueryPauseStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseStateResponse) ProtoMessage()    {}
+func (*QueryPauseStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{8}
+}
+func (m *QueryPauseStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryFeeTokensRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPauseStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseStateResponse.Merge(m, src)
 }
-
-func (m *QueryFeeTokensRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPauseStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseStateResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFeeTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryPauseStateResponse proto.InternalMessageInfo
+
+func (m *QueryPauseStateResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
 }
 
-func (m *QueryFeeTokensResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPauseStateResponse) GetPausedFeeTokens() []string {
+	if m != nil {
+		return m.PausedFeeTokens
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFeeTokensResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryConversionCapacityRequest is the request type for the
+// Query/ConversionCapacity RPC method
+type QueryConversionCapacityRequest struct {
+	// denom is the fee token denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryFeeTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FeeTokens != nil {
-		{
-			size, err := m.FeeTokens.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryConversionCapacityRequest) Reset()         { *m = QueryConversionCapacityRequest{} }
+func (m *QueryConversionCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConversionCapacityRequest) ProtoMessage()    {}
+func (*QueryConversionCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{9}
+}
+func (m *QueryConversionCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConversionCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConversionCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryConversionCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConversionCapacityRequest.Merge(m, src)
+}
+func (m *QueryConversionCapacityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConversionCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConversionCapacityRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryConversionCapacityRequest proto.InternalMessageInfo
+
+func (m *QueryConversionCapacityRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+// QueryConversionCapacityResponse is the response type for the
+// Query/ConversionCapacity RPC method
+type QueryConversionCapacityResponse struct {
+	// cap is the conversion cap of the fee token, zero means no cap
+	Cap cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=cap,proto3,customtype=cosmossdk.io/math.Int" json:"cap"`
+	// used is the native-equivalent amount used on the rolling window
+	Used cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=used,proto3,customtype=cosmossdk.io/math.Int" json:"used"`
+	// remaining is the native-equivalent amount still available on the rolling
+	// window
+	Remaining cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=remaining,proto3,customtype=cosmossdk.io/math.Int" json:"remaining"`
+	// window_end is the unix time in seconds where the oldest usage leaves the
+	// rolling window, zero if there's no usage
+	WindowEnd int64 `protobuf:"varint,4,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+}
+
+func (m *QueryConversionCapacityResponse) Reset()         { *m = QueryConversionCapacityResponse{} }
+func (m *QueryConversionCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConversionCapacityResponse) ProtoMessage()    {}
+func (*QueryConversionCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{10}
+}
+func (m *QueryConversionCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConversionCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConversionCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryConversionCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConversionCapacityResponse.Merge(m, src)
+}
+func (m *QueryConversionCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConversionCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConversionCapacityResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryConversionCapacityResponse proto.InternalMessageInfo
+
+func (m *QueryConversionCapacityResponse) GetWindowEnd() int64 {
+	if m != nil {
+		return m.WindowEnd
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryFeeTokensRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryPaymasterRequest is the request type for the Query/Paymaster RPC
+// method
+type QueryPaymasterRequest struct {
+	// contract is the paymaster contract address
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *QueryPaymasterRequest) Reset()         { *m = QueryPaymasterRequest{} }
+func (m *QueryPaymasterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymasterRequest) ProtoMessage()    {}
+func (*QueryPaymasterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{11}
+}
+func (m *QueryPaymasterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymasterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymasterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymasterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymasterRequest.Merge(m, src)
+}
+func (m *QueryPaymasterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymasterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymasterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymasterRequest proto.InternalMessageInfo
+
+func (m *QueryPaymasterRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+// QueryPaymasterResponse is the response type for the Query/Paymaster RPC
+// method
+type QueryPaymasterResponse struct {
+	// paymaster is the registered paymaster
+	Paymaster Paymaster `protobuf:"bytes,1,opt,name=paymaster,proto3" json:"paymaster"`
+}
+
+func (m *QueryPaymasterResponse) Reset()         { *m = QueryPaymasterResponse{} }
+func (m *QueryPaymasterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymasterResponse) ProtoMessage()    {}
+func (*QueryPaymasterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{12}
+}
+func (m *QueryPaymasterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymasterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymasterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymasterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymasterResponse.Merge(m, src)
+}
+func (m *QueryPaymasterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymasterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymasterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymasterResponse proto.InternalMessageInfo
+
+func (m *QueryPaymasterResponse) GetPaymaster() Paymaster {
+	if m != nil {
+		return m.Paymaster
+	}
+	return Paymaster{}
+}
+
+// QueryPaymastersRequest is the request type for the Query/Paymasters RPC
+// method
+type QueryPaymastersRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPaymastersRequest) Reset()         { *m = QueryPaymastersRequest{} }
+func (m *QueryPaymastersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymastersRequest) ProtoMessage()    {}
+func (*QueryPaymastersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{13}
+}
+func (m *QueryPaymastersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymastersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymastersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymastersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymastersRequest.Merge(m, src)
+}
+func (m *QueryPaymastersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymastersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymastersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymastersRequest proto.InternalMessageInfo
+
+func (m *QueryPaymastersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPaymastersResponse is the response type for the Query/Paymasters RPC
+// method
+type QueryPaymastersResponse struct {
+	// paymasters are the registered paymasters
+	Paymasters []Paymaster `protobuf:"bytes,1,rep,name=paymasters,proto3" json:"paymasters"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPaymastersResponse) Reset()         { *m = QueryPaymastersResponse{} }
+func (m *QueryPaymastersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymastersResponse) ProtoMessage()    {}
+func (*QueryPaymastersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{14}
+}
+func (m *QueryPaymastersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymastersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymastersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymastersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymastersResponse.Merge(m, src)
+}
+func (m *QueryPaymastersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymastersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymastersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymastersResponse proto.InternalMessageInfo
+
+func (m *QueryPaymastersResponse) GetPaymasters() []Paymaster {
+	if m != nil {
+		return m.Paymasters
+	}
+	return nil
+}
+
+func (m *QueryPaymastersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPaymasterUsageRequest is the request type for the Query/PaymasterUsage
+// RPC method
+type QueryPaymasterUsageRequest struct {
+	// contract is the paymaster contract address
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// user is the sponsored user address
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (m *QueryPaymasterUsageRequest) Reset()         { *m = QueryPaymasterUsageRequest{} }
+func (m *QueryPaymasterUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymasterUsageRequest) ProtoMessage()    {}
+func (*QueryPaymasterUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{15}
+}
+func (m *QueryPaymasterUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymasterUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymasterUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymasterUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymasterUsageRequest.Merge(m, src)
+}
+func (m *QueryPaymasterUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymasterUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymasterUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymasterUsageRequest proto.InternalMessageInfo
+
+func (m *QueryPaymasterUsageRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QueryPaymasterUsageRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+// QueryPaymasterUsageResponse is the response type for the
+// Query/PaymasterUsage RPC method
+type QueryPaymasterUsageResponse struct {
+	// user_used is the native amount sponsored for the user on the current
+	// window
+	UserUsed cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=user_used,json=userUsed,proto3,customtype=cosmossdk.io/math.Int" json:"user_used"`
+	// user_remaining is the native amount still available for the user on the
+	// current window
+	UserRemaining cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=user_remaining,json=userRemaining,proto3,customtype=cosmossdk.io/math.Int" json:"user_remaining"`
+	// window_end is the unix time in seconds where the current user window ends
+	WindowEnd int64 `protobuf:"varint,3,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	// block_used is the native amount sponsored on the current block
+	BlockUsed cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=block_used,json=blockUsed,proto3,customtype=cosmossdk.io/math.Int" json:"block_used"`
+	// block_remaining is the native amount still available on the current
+	// block
+	BlockRemaining cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=block_remaining,json=blockRemaining,proto3,customtype=cosmossdk.io/math.Int" json:"block_remaining"`
+}
+
+func (m *QueryPaymasterUsageResponse) Reset()         { *m = QueryPaymasterUsageResponse{} }
+func (m *QueryPaymasterUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymasterUsageResponse) ProtoMessage()    {}
+func (*QueryPaymasterUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_88edc16f4ff36bc7, []int{16}
+}
+func (m *QueryPaymasterUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymasterUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymasterUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPaymasterUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymasterUsageResponse.Merge(m, src)
+}
+func (m *QueryPaymasterUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymasterUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymasterUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPaymasterUsageResponse proto.InternalMessageInfo
+
+func (m *QueryPaymasterUsageResponse) GetWindowEnd() int64 {
+	if m != nil {
+		return m.WindowEnd
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "kiichain.feeabstraction.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "kiichain.feeabstraction.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryFeeTokensRequest)(nil), "kiichain.feeabstraction.v1beta1.QueryFeeTokensRequest")
+	proto.RegisterType((*QueryFeeTokensResponse)(nil), "kiichain.feeabstraction.v1beta1.QueryFeeTokensResponse")
+	proto.RegisterType((*QueryFeeTokenDenomTracesRequest)(nil), "kiichain.feeabstraction.v1beta1.QueryFeeTokenDenomTracesRequest")
+	proto.RegisterType((*QueryFeeTokenDenomTracesResponse)(nil), "kiichain.feeabstraction.v1beta1.QueryFeeTokenDenomTracesResponse")
+	proto.RegisterType((*FeeTokenDenomTrace)(nil), "kiichain.feeabstraction.v1beta1.FeeTokenDenomTrace")
+	proto.RegisterType((*QueryPauseStateRequest)(nil), "kiichain.feeabstraction.v1beta1.QueryPauseStateRequest")
+	proto.RegisterType((*QueryPauseStateResponse)(nil), "kiichain.feeabstraction.v1beta1.QueryPauseStateResponse")
+	proto.RegisterType((*QueryConversionCapacityRequest)(nil), "kiichain.feeabstraction.v1beta1.QueryConversionCapacityRequest")
+	proto.RegisterType((*QueryConversionCapacityResponse)(nil), "kiichain.feeabstraction.v1beta1.QueryConversionCapacityResponse")
+	proto.RegisterType((*QueryPaymasterRequest)(nil), "kiichain.feeabstraction.v1beta1.QueryPaymasterRequest")
+	proto.RegisterType((*QueryPaymasterResponse)(nil), "kiichain.feeabstraction.v1beta1.QueryPaymasterResponse")
+	proto.RegisterType((*QueryPaymastersRequest)(nil), "kiichain.feeabstraction.v1beta1.QueryPaymastersRequest")
+	proto.RegisterType((*QueryPaymastersResponse)(nil), "kiichain.feeabstraction.v1beta1.QueryPaymastersResponse")
+	proto.RegisterType((*QueryPaymasterUsageRequest)(nil), "kiichain.feeabstraction.v1beta1.QueryPaymasterUsageRequest")
+	proto.RegisterType((*QueryPaymasterUsageResponse)(nil), "kiichain.feeabstraction.v1beta1.QueryPaymasterUsageResponse")
+}
+
+func init() {
+	proto.RegisterFile("kiichain/feeabstraction/v1beta1/query.proto", fileDescriptor_88edc16f4ff36bc7)
+}
+
+var fileDescriptor_88edc16f4ff36bc7 = []byte{
+	// 1086 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0x8e, 0x77, 0x93, 0x28, 0x7e, 0x13, 0x52, 0x31, 0x4d, 0xdb, 0xc8, 0xd0, 0x4d, 0xea, 0x03,
+	0x0d, 0x69, 0xb1, 0x95, 0xa4, 0x4a, 0x0a, 0x89, 0xda, 0x92, 0x2f, 0x84, 0xa0, 0x28, 0x98, 0xf6,
+	0x52, 0x15, 0x2d, 0xb3, 0xde, 0xc9, 0xc6, 0xca, 0xae, 0xc7, 0xf5, 0xcc, 0xb6, 0x44, 0x51, 0x24,
+	0xc4, 0x2f, 0x40, 0xe2, 0x2f, 0x70, 0xe1, 0x88, 0x54, 0x6e, 0x1c, 0x39, 0xf4, 0x58, 0x89, 0x4b,
+	0x05, 0xa2, 0x82, 0x84, 0x1f, 0x82, 0xe6, 0xc3, 0xf6, 0x7e, 0x24, 0xac, 0x1d, 0x6e, 0xde, 0x99,
+	0xf7, 0x79, 0xdf, 0xe7, 0xfd, 0x9a, 0x47, 0x0b, 0x37, 0xf6, 0x83, 0xc0, 0xdf, 0xc3, 0x41, 0xe8,
+	0xee, 0x12, 0x82, 0x6b, 0x8c, 0xc7, 0xd8, 0xe7, 0x01, 0x0d, 0xdd, 0xa7, 0x0b, 0x35, 0xc2, 0xf1,
+	0x82, 0xfb, 0xa4, 0x4d, 0xe2, 0x03, 0x27, 0x8a, 0x29, 0xa7, 0x68, 0x26, 0x31, 0x76, 0xba, 0x8d,
+	0x1d, 0x6d, 0x6c, 0x4d, 0x35, 0x68, 0x83, 0x4a, 0x5b, 0x57, 0x7c, 0x29, 0x98, 0xf5, 0x76, 0x83,
+	0xd2, 0x46, 0x93, 0xb8, 0x38, 0x0a, 0x5c, 0x1c, 0x86, 0x94, 0x63, 0x01, 0x62, 0xfa, 0x76, 0xde,
+	0xa7, 0xac, 0x45, 0x99, 0x5b, 0xc3, 0x8c, 0xa8, 0x68, 0x69, 0xec, 0x08, 0x37, 0x82, 0x50, 0x1a,
+	0x6b, 0xdb, 0x9b, 0x83, 0xd8, 0x46, 0x38, 0xc6, 0x2d, 0xed, 0xd9, 0x9e, 0x02, 0xf4, 0xb9, 0xf0,
+	0xb7, 0x23, 0x0f, 0x3d, 0xf2, 0xa4, 0x4d, 0x18, 0xb7, 0x1f, 0xc3, 0xc5, 0xae, 0x53, 0x16, 0xd1,
+	0x90, 0x11, 0xb4, 0x05, 0xa3, 0x0a, 0x3c, 0x6d, 0xcc, 0x1a, 0x73, 0xe3, 0x8b, 0xd7, 0x9d, 0x01,
+	0xc9, 0x3a, 0xca, 0xc1, 0xfa, 0xf0, 0x8b, 0xd7, 0x33, 0x43, 0x9e, 0x06, 0xdb, 0x57, 0xe0, 0x92,
+	0xf4, 0xbe, 0x4d, 0xc8, 0x03, 0xba, 0x4f, 0xc2, 0x34, 0x2c, 0x87, 0xcb, 0xbd, 0x17, 0x3a, 0xf2,
+	0x23, 0x80, 0x5d, 0x42, 0xaa, 0x5c, 0x9e, 0xea, 0xe8, 0xab, 0x03, 0xa3, 0x27, 0x7e, 0xee, 0x13,
+	0x8e, 0xeb, 0x98, 0xe3, 0x0d, 0xda, 0x6c, 0x12, 0x69, 0xe2, 0x99, 0xbb, 0x49, 0x0c, 0xfb, 0x1a,
+	0xcc, 0x74, 0x45, 0xdd, 0x24, 0x21, 0x6d, 0x3d, 0x88, 0xb1, 0x4f, 0x52, 0x62, 0xdf, 0x18, 0x30,
+	0x7b, 0xb6, 0x8d, 0xe6, 0xf8, 0x18, 0x26, 0xea, 0xe2, 0xb8, 0xca, 0xe5, 0xf9, 0xb4, 0x31, 0x5b,
+	0x9e, 0x1b, 0x5f, 0x5c, 0xca, 0xcd, 0x32, 0xf3, 0xa9, 0xeb, 0x35, 0x5e, 0xcf, 0xa2, 0x08, 0x0a,
+	0xa8, 0xdf, 0x12, 0x4d, 0xc1, 0x88, 0xb4, 0x92, 0x35, 0x31, 0x3d, 0xf5, 0x03, 0x5d, 0x83, 0x09,
+	0x1a, 0x63, 0xbf, 0x49, 0xaa, 0xea, 0xb2, 0x24, 0x2f, 0xc7, 0xd5, 0x99, 0x44, 0x23, 0x04, 0xc3,
+	0x11, 0xe6, 0x7b, 0xd3, 0x65, 0x79, 0x25, 0xbf, 0xd1, 0x55, 0x00, 0x31, 0x61, 0x1a, 0x34, 0x2c,
+	0x6f, 0x4c, 0x71, 0x22, 0x21, 0xf6, 0xb4, 0x6e, 0xcf, 0x0e, 0x6e, 0x33, 0xf2, 0x05, 0xc7, 0x9c,
+	0x24, 0xf5, 0xf9, 0x12, 0xae, 0xf4, 0xdd, 0xe8, 0xaa, 0x5c, 0x16, 0x33, 0xd3, 0x66, 0xa4, 0x2e,
+	0x19, 0x8e, 0x79, 0xfa, 0x17, 0x9a, 0x87, 0x37, 0xd5, 0x57, 0xb5, 0xa3, 0xb1, 0xa5, 0xd9, 0xf2,
+	0x9c, 0xe9, 0x5d, 0x50, 0x17, 0xe9, 0x14, 0xd8, 0xcb, 0x50, 0x91, 0xee, 0x37, 0x68, 0xf8, 0x94,
+	0xc4, 0x2c, 0xa0, 0xe1, 0x06, 0x8e, 0xb0, 0x1f, 0xf0, 0x03, 0x4d, 0xe0, 0xf4, 0x32, 0xd8, 0x7f,
+	0x1b, 0xba, 0xb5, 0xa7, 0x01, 0x35, 0x3f, 0x17, 0xca, 0x3e, 0x8e, 0x14, 0x6e, 0xfd, 0xaa, 0xa8,
+	0xfb, 0xef, 0xaf, 0x67, 0x2e, 0xa9, 0x7d, 0x63, 0xf5, 0x7d, 0x27, 0xa0, 0x6e, 0x0b, 0xf3, 0x3d,
+	0xe7, 0xe3, 0x90, 0x7b, 0xc2, 0x12, 0x2d, 0xc0, 0xb0, 0x4c, 0xa7, 0x94, 0x07, 0x21, 0x4d, 0xd1,
+	0x2a, 0x98, 0x31, 0x69, 0xe1, 0x20, 0x0c, 0xc2, 0x86, 0x2a, 0xf8, 0x20, 0x5c, 0x66, 0x2f, 0x9a,
+	0xf2, 0x2c, 0x08, 0xeb, 0xf4, 0x59, 0x95, 0x84, 0x75, 0xd9, 0x94, 0xb2, 0x67, 0xaa, 0x93, 0xad,
+	0xb0, 0x6e, 0x2f, 0xe9, 0x65, 0xda, 0xc1, 0x07, 0x2d, 0xcc, 0x38, 0x89, 0x93, 0x92, 0x58, 0x30,
+	0xe6, 0xd3, 0x50, 0x4e, 0x9b, 0xae, 0x4a, 0xfa, 0xdb, 0xde, 0x4b, 0x3b, 0x99, 0x82, 0x74, 0x39,
+	0x3e, 0x03, 0x33, 0x4a, 0x0e, 0xf5, 0x9e, 0xcd, 0xe7, 0xd8, 0x72, 0x8d, 0xd0, 0x83, 0x9b, 0xb9,
+	0xb0, 0xbf, 0xea, 0x8d, 0x94, 0xec, 0x14, 0xda, 0x06, 0xc8, 0xde, 0x2e, 0x1d, 0xea, 0x1d, 0x47,
+	0x95, 0xc3, 0x11, 0x43, 0xe7, 0xa8, 0x67, 0x35, 0x0b, 0xd2, 0x48, 0xe6, 0xcd, 0xeb, 0x40, 0xda,
+	0xcf, 0x8d, 0x74, 0xf8, 0xb2, 0x10, 0x3a, 0x9b, 0x1d, 0x11, 0x23, 0x39, 0xd5, 0x0b, 0x59, 0x3c,
+	0x9d, 0x0e, 0x1f, 0xe8, 0xa3, 0x2e, 0xd6, 0x25, 0xfd, 0x0c, 0x0e, 0x62, 0xad, 0xe8, 0x74, 0xd1,
+	0xfe, 0x14, 0xac, 0x6e, 0xd6, 0x0f, 0x59, 0x96, 0xe0, 0x7f, 0x35, 0x4f, 0x6c, 0x6e, 0x9b, 0x91,
+	0x58, 0x2f, 0xb5, 0xfc, 0xb6, 0x7f, 0x2d, 0xc1, 0x5b, 0xa7, 0xba, 0xd3, 0x85, 0xf8, 0x00, 0x4c,
+	0x61, 0x57, 0x4d, 0x17, 0x71, 0xe0, 0x04, 0x8e, 0x09, 0xfb, 0x87, 0x62, 0x7a, 0x37, 0x61, 0x52,
+	0x62, 0xb3, 0x11, 0xce, 0x35, 0xfa, 0x6f, 0x08, 0x90, 0x77, 0xc6, 0x18, 0x97, 0x7b, 0xc6, 0x18,
+	0xad, 0x01, 0xd4, 0x9a, 0xd4, 0xdf, 0x57, 0x0c, 0x87, 0x73, 0xed, 0x88, 0x04, 0x48, 0x8a, 0xdb,
+	0x70, 0x41, 0xa1, 0x33, 0x8e, 0x23, 0x79, 0x5c, 0x4c, 0x4a, 0x54, 0x4a, 0x72, 0xf1, 0xc7, 0x09,
+	0x18, 0x91, 0x65, 0x44, 0x3f, 0x18, 0x30, 0xaa, 0xc4, 0x0b, 0x0d, 0x7e, 0xc1, 0xfb, 0x15, 0xd4,
+	0xba, 0x55, 0x0c, 0xa4, 0xda, 0x64, 0xbb, 0xdf, 0xfe, 0xf6, 0xcf, 0xf7, 0xa5, 0x77, 0xd1, 0x75,
+	0x37, 0x9f, 0x88, 0xa3, 0x9f, 0x0c, 0x30, 0xd3, 0x77, 0x12, 0x2d, 0xe7, 0x0b, 0xda, 0xab, 0xbb,
+	0xd6, 0x4a, 0x61, 0x9c, 0xe6, 0xbb, 0x24, 0xf9, 0xbe, 0x87, 0x6e, 0x0c, 0xe4, 0x9b, 0x3d, 0xf2,
+	0xe8, 0x0f, 0x03, 0x2e, 0x9e, 0xa2, 0xa3, 0xe8, 0x5e, 0x31, 0x16, 0xfd, 0x32, 0x6d, 0x7d, 0xf8,
+	0x3f, 0x3c, 0xe8, 0x8c, 0xee, 0xca, 0x8c, 0xde, 0x47, 0x2b, 0xf9, 0x33, 0xaa, 0x76, 0xaa, 0x3e,
+	0xfa, 0xd9, 0x00, 0xc8, 0x64, 0x10, 0xad, 0xe4, 0x9d, 0x83, 0x1e, 0x49, 0xb5, 0x6e, 0x17, 0x07,
+	0xea, 0x14, 0x6e, 0xc9, 0x14, 0x1c, 0x74, 0x33, 0xc7, 0x10, 0xb5, 0x19, 0xa9, 0x32, 0x49, 0xf4,
+	0x4f, 0x03, 0x50, 0xbf, 0x4c, 0xa2, 0xbb, 0xf9, 0x68, 0x9c, 0xa9, 0xcc, 0xd6, 0xbd, 0xf3, 0x3b,
+	0xd0, 0xf9, 0x6c, 0xca, 0x7c, 0xee, 0xa0, 0xb5, 0x81, 0xf9, 0xf8, 0xa9, 0x93, 0xaa, 0xaf, 0xbd,
+	0xb8, 0x87, 0xb2, 0x3b, 0x47, 0xe8, 0x17, 0x03, 0xcc, 0xf4, 0x71, 0xcc, 0xbb, 0x29, 0xbd, 0xa2,
+	0x6a, 0xad, 0x14, 0xc6, 0xe9, 0x24, 0xee, 0xc8, 0x24, 0x6e, 0xa3, 0xe5, 0x1c, 0x4d, 0x49, 0xc4,
+	0xc6, 0x3d, 0x4c, 0xde, 0xfc, 0x23, 0xf4, 0x5c, 0x8e, 0x55, 0x2a, 0x43, 0x45, 0x79, 0xb0, 0xc2,
+	0x63, 0xd5, 0xab, 0xa5, 0x05, 0x76, 0xbd, 0x43, 0x2e, 0x5f, 0x19, 0x30, 0xd9, 0x2d, 0x49, 0x68,
+	0xb5, 0x20, 0x83, 0x4e, 0x5d, 0xb4, 0xd6, 0xce, 0x07, 0xd6, 0x29, 0x7c, 0x22, 0x53, 0xd8, 0x42,
+	0x1b, 0xe7, 0x6b, 0x82, 0xdb, 0x16, 0xde, 0xdc, 0x43, 0x21, 0x6c, 0x47, 0xeb, 0xf7, 0x5f, 0x1c,
+	0x57, 0x8c, 0x97, 0xc7, 0x15, 0xe3, 0xaf, 0xe3, 0x8a, 0xf1, 0xdd, 0x49, 0x65, 0xe8, 0xe5, 0x49,
+	0x65, 0xe8, 0xd5, 0x49, 0x65, 0xe8, 0xd1, 0x52, 0x23, 0xe0, 0x7b, 0xed, 0x9a, 0xe3, 0xd3, 0x56,
+	0x16, 0x28, 0xfd, 0xf8, 0xba, 0x37, 0x26, 0x3f, 0x88, 0x08, 0xab, 0x8d, 0xca, 0xff, 0x63, 0x4b,
+	0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xb3, 0xc2, 0xd2, 0xd4, 0x6d, 0x0e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params defines a gRPC query method that returns the fee abstraction params
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// FeeTokens defines a gRPC query method that returns the fee tokens
+	FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error)
+	// FeeTokenDenomTraces defines a gRPC query method that returns the IBC
+	// denom traces of the IBC fee tokens
+	FeeTokenDenomTraces(ctx context.Context, in *QueryFeeTokenDenomTracesRequest, opts ...grpc.CallOption) (*QueryFeeTokenDenomTracesResponse, error)
+	// PauseState defines a gRPC query method that returns the module and fee
+	// tokens pause state
+	PauseState(ctx context.Context, in *QueryPauseStateRequest, opts ...grpc.CallOption) (*QueryPauseStateResponse, error)
+	// ConversionCapacity defines a gRPC query method that returns the remaining
+	// conversion capacity of a fee token on the rolling window
+	ConversionCapacity(ctx context.Context, in *QueryConversionCapacityRequest, opts ...grpc.CallOption) (*QueryConversionCapacityResponse, error)
+	// Paymaster defines a gRPC query method that returns a single paymaster
+	Paymaster(ctx context.Context, in *QueryPaymasterRequest, opts ...grpc.CallOption) (*QueryPaymasterResponse, error)
+	// Paymasters defines a gRPC query method that returns all the paymasters
+	Paymasters(ctx context.Context, in *QueryPaymastersRequest, opts ...grpc.CallOption) (*QueryPaymastersResponse, error)
+	// PaymasterUsage defines a gRPC query method that returns the fees
+	// sponsored by a paymaster for a user and on the current block
+	PaymasterUsage(ctx context.Context, in *QueryPaymasterUsageRequest, opts ...grpc.CallOption) (*QueryPaymasterUsageResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error) {
+	out := new(QueryFeeTokensResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Query/FeeTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeTokenDenomTraces(ctx context.Context, in *QueryFeeTokenDenomTracesRequest, opts ...grpc.CallOption) (*QueryFeeTokenDenomTracesResponse, error) {
+	out := new(QueryFeeTokenDenomTracesResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Query/FeeTokenDenomTraces", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PauseState(ctx context.Context, in *QueryPauseStateRequest, opts ...grpc.CallOption) (*QueryPauseStateResponse, error) {
+	out := new(QueryPauseStateResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Query/PauseState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ConversionCapacity(ctx context.Context, in *QueryConversionCapacityRequest, opts ...grpc.CallOption) (*QueryConversionCapacityResponse, error) {
+	out := new(QueryConversionCapacityResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Query/ConversionCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Paymaster(ctx context.Context, in *QueryPaymasterRequest, opts ...grpc.CallOption) (*QueryPaymasterResponse, error) {
+	out := new(QueryPaymasterResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Query/Paymaster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Paymasters(ctx context.Context, in *QueryPaymastersRequest, opts ...grpc.CallOption) (*QueryPaymastersResponse, error) {
+	out := new(QueryPaymastersResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Query/Paymasters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PaymasterUsage(ctx context.Context, in *QueryPaymasterUsageRequest, opts ...grpc.CallOption) (*QueryPaymasterUsageResponse, error) {
+	out := new(QueryPaymasterUsageResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Query/PaymasterUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params defines a gRPC query method that returns the fee abstraction params
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// FeeTokens defines a gRPC query method that returns the fee tokens
+	FeeTokens(context.Context, *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error)
+	// FeeTokenDenomTraces defines a gRPC query method that returns the IBC
+	// denom traces of the IBC fee tokens
+	FeeTokenDenomTraces(context.Context, *QueryFeeTokenDenomTracesRequest) (*QueryFeeTokenDenomTracesResponse, error)
+	// PauseState defines a gRPC query method that returns the module and fee
+	// tokens pause state
+	PauseState(context.Context, *QueryPauseStateRequest) (*QueryPauseStateResponse, error)
+	// ConversionCapacity defines a gRPC query method that returns the remaining
+	// conversion capacity of a fee token on the rolling window
+	ConversionCapacity(context.Context, *QueryConversionCapacityRequest) (*QueryConversionCapacityResponse, error)
+	// Paymaster defines a gRPC query method that returns a single paymaster
+	Paymaster(context.Context, *QueryPaymasterRequest) (*QueryPaymasterResponse, error)
+	// Paymasters defines a gRPC query method that returns all the paymasters
+	Paymasters(context.Context, *QueryPaymastersRequest) (*QueryPaymastersResponse, error)
+	// PaymasterUsage defines a gRPC query method that returns the fees
+	// sponsored by a paymaster for a user and on the current block
+	PaymasterUsage(context.Context, *QueryPaymasterUsageRequest) (*QueryPaymasterUsageResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) FeeTokens(ctx context.Context, req *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeTokens not implemented")
+}
+func (*UnimplementedQueryServer) FeeTokenDenomTraces(ctx context.Context, req *QueryFeeTokenDenomTracesRequest) (*QueryFeeTokenDenomTracesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeTokenDenomTraces not implemented")
+}
+func (*UnimplementedQueryServer) PauseState(ctx context.Context, req *QueryPauseStateRequest) (*QueryPauseStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseState not implemented")
+}
+func (*UnimplementedQueryServer) ConversionCapacity(ctx context.Context, req *QueryConversionCapacityRequest) (*QueryConversionCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConversionCapacity not implemented")
+}
+func (*UnimplementedQueryServer) Paymaster(ctx context.Context, req *QueryPaymasterRequest) (*QueryPaymasterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Paymaster not implemented")
+}
+func (*UnimplementedQueryServer) Paymasters(ctx context.Context, req *QueryPaymastersRequest) (*QueryPaymastersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Paymasters not implemented")
+}
+func (*UnimplementedQueryServer) PaymasterUsage(ctx context.Context, req *QueryPaymasterUsageRequest) (*QueryPaymasterUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymasterUsage not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Query/FeeTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeTokens(ctx, req.(*QueryFeeTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeTokenDenomTraces_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeTokenDenomTracesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeTokenDenomTraces(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Query/FeeTokenDenomTraces",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeTokenDenomTraces(ctx, req.(*QueryFeeTokenDenomTracesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PauseState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PauseState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Query/PauseState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PauseState(ctx, req.(*QueryPauseStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ConversionCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConversionCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ConversionCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Query/ConversionCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ConversionCapacity(ctx, req.(*QueryConversionCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Paymaster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymasterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Paymaster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Query/Paymaster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Paymaster(ctx, req.(*QueryPaymasterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Paymasters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymastersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Paymasters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Query/Paymasters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Paymasters(ctx, req.(*QueryPaymastersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymasterUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymasterUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymasterUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Query/PaymasterUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymasterUsage(ctx, req.(*QueryPaymasterUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kiichain.feeabstraction.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "FeeTokens",
+			Handler:    _Query_FeeTokens_Handler,
+		},
+		{
+			MethodName: "FeeTokenDenomTraces",
+			Handler:    _Query_FeeTokenDenomTraces_Handler,
+		},
+		{
+			MethodName: "PauseState",
+			Handler:    _Query_PauseState_Handler,
+		},
+		{
+			MethodName: "ConversionCapacity",
+			Handler:    _Query_ConversionCapacity_Handler,
+		},
+		{
+			MethodName: "Paymaster",
+			Handler:    _Query_Paymaster_Handler,
+		},
+		{
+			MethodName: "Paymasters",
+			Handler:    _Query_Paymasters_Handler,
+		},
+		{
+			MethodName: "PaymasterUsage",
+			Handler:    _Query_PaymasterUsage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kiichain/feeabstraction/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTokensRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokensRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokensRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeTokens != nil {
+		{
+			size, err := m.FeeTokens.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTokenDenomTracesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokenDenomTracesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokenDenomTracesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeTokenDenomTracesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeTokenDenomTracesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeTokenDenomTracesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomTraces) > 0 {
+		for iNdEx := len(m.DenomTraces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomTraces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeTokenDenomTrace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeTokenDenomTrace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTokenDenomTrace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OracleDenom) > 0 {
+		i -= len(m.OracleDenom)
+		copy(dAtA[i:], m.OracleDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OracleDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PausedFeeTokens) > 0 {
+		for iNdEx := len(m.PausedFeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedFeeTokens[iNdEx])
+			copy(dAtA[i:], m.PausedFeeTokens[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.PausedFeeTokens[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConversionCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConversionCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConversionCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConversionCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowEnd != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowEnd))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Remaining.Size()
+		i -= size
+		if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Used.Size()
+		i -= size
+		if _, err := m.Used.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymasterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymasterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymasterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymasterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymasterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymasterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Paymaster.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymastersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymastersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymastersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymastersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymastersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymastersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Paymasters) > 0 {
+		for iNdEx := len(m.Paymasters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Paymasters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymasterUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymasterUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymasterUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymasterUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymasterUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymasterUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BlockRemaining.Size()
+		i -= size
+		if _, err := m.BlockRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BlockUsed.Size()
+		i -= size
+		if _, err := m.BlockUsed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.WindowEnd != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowEnd))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.UserRemaining.Size()
+		i -= size
+		if _, err := m.UserRemaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.UserUsed.Size()
+		i -= size
+		if _, err := m.UserUsed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeeTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeeTokens != nil {
+		l = m.FeeTokens.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeTokenDenomTracesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeTokenDenomTracesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomTraces) > 0 {
+		for _, e := range m.DenomTraces {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeTokenDenomTrace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OracleDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPauseStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPauseStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	if len(m.PausedFeeTokens) > 0 {
+		for _, s := range m.PausedFeeTokens {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConversionCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConversionCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Cap.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Used.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Remaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.WindowEnd != 0 {
+		n += 1 + sovQuery(uint64(m.WindowEnd))
+	}
+	return n
+}
+
+func (m *QueryPaymasterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymasterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Paymaster.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPaymastersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymastersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Paymasters) > 0 {
+		for _, e := range m.Paymasters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymasterUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymasterUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UserUsed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.UserRemaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.WindowEnd != 0 {
+		n += 1 + sovQuery(uint64(m.WindowEnd))
+	}
+	l = m.BlockUsed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BlockRemaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokensRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeTokens == nil {
+				m.FeeTokens = &FeeTokenMetadataCollection{}
+			}
+			if err := m.FeeTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTokenDenomTracesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokenDenomTracesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokenDenomTracesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeTokenDenomTracesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeTokenDenomTracesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeTokenDenomTracesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTraces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomTraces = append(m.DenomTraces, FeeTokenDenomTrace{})
+			if err := m.DenomTraces[len(m.DenomTraces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTokenDenomTrace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTokenDenomTrace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTokenDenomTrace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPauseStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauseStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauseStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPauseStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauseStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauseStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedFeeTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedFeeTokens = append(m.PausedFeeTokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConversionCapacityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConversionCapacityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConversionCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConversionCapacityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConversionCapacityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConversionCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Used.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEnd", wireType)
+			}
+			m.WindowEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowEnd |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymasterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymasterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymasterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPaymasterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymasterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymasterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paymaster", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Paymaster.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryFeeTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FeeTokens != nil {
-		l = m.FeeTokens.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymastersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -552,12 +3379,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymastersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymastersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -579,7 +3442,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymastersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -602,15 +3465,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymastersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymastersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paymasters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -637,7 +3500,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Paymasters = append(m.Paymasters, Paymaster{})
+			if err := m.Paymasters[len(m.Paymasters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -662,7 +3562,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymasterUsageRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -685,12 +3585,76 @@ func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeTokensRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymasterUsageRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeTokensRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymasterUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -712,7 +3676,7 @@ func (m *QueryFeeTokensRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymasterUsageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -735,17 +3699,17 @@ func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFeeTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymasterUsageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFeeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymasterUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserUsed", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -755,25 +3719,144 @@ func (m *QueryFeeTokensResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FeeTokens == nil {
-				m.FeeTokens = &FeeTokenMetadataCollection{}
+			if err := m.UserUsed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.FeeTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UserRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEnd", wireType)
+			}
+			m.WindowEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowEnd |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockUsed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockUsed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlockRemaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5,8 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -217,364 +219,4330 @@ func (m *MsgUpdateFeeTokensResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateFeeTokensResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "kiichain.feeabstraction.v1beta1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgUpdateFeeTokens)(nil), "kiichain.feeabstraction.v1beta1.MsgUpdateFeeTokens")
-	proto.RegisterType((*MsgUpdateFeeTokensResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgUpdateFeeTokensResponse")
+// MsgUpdateFeeTokenPricing is the Msg/UpdateFeeTokenPricing request type.
+type MsgUpdateFeeTokenPricing struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the fee token to be updated
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// price_adjustment is the new markup (positive) or discount (negative)
+	// applied over the token price
+	PriceAdjustment cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=price_adjustment,json=priceAdjustment,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price_adjustment"`
+	// min_fee is the new minimum amount charged in the token
+	MinFee cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=min_fee,json=minFee,proto3,customtype=cosmossdk.io/math.Int" json:"min_fee"`
+	// max_fee is the new maximum amount charged in the token
+	MaxFee cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=max_fee,json=maxFee,proto3,customtype=cosmossdk.io/math.Int" json:"max_fee"`
 }
 
-func init() {
-	proto.RegisterFile("kiichain/feeabstraction/v1beta1/tx.proto", fileDescriptor_6352be81da2292da)
+func (m *MsgUpdateFeeTokenPricing) Reset()         { *m = MsgUpdateFeeTokenPricing{} }
+func (m *MsgUpdateFeeTokenPricing) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFeeTokenPricing) ProtoMessage()    {}
+func (*MsgUpdateFeeTokenPricing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{4}
 }
-
-var fileDescriptor_6352be81da2292da = []byte{
-	// 459 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x41, 0x6b, 0xd4, 0x40,
-	0x14, 0xde, 0xa9, 0x5a, 0xd8, 0x51, 0x28, 0x86, 0x42, 0xb7, 0xa1, 0xa4, 0xcb, 0x5e, 0x5c, 0x16,
-	0x37, 0xe3, 0x36, 0x20, 0xd2, 0x9e, 0x5c, 0xd1, 0x5b, 0x40, 0x56, 0xbd, 0x78, 0xa9, 0x93, 0xe4,
-	0x25, 0x3b, 0xb4, 0xc9, 0x84, 0xcc, 0x6c, 0x69, 0x3d, 0x89, 0xde, 0x3c, 0x88, 0x3f, 0x65, 0x0f,
-	0xfe, 0x01, 0x6f, 0x3d, 0x16, 0x4f, 0x9e, 0x44, 0x36, 0x87, 0xfd, 0x1b, 0xd2, 0xcc, 0x24, 0xa5,
-	0xa9, 0x10, 0xdd, 0x4b, 0x32, 0xc9, 0xfb, 0xbe, 0xef, 0x7d, 0xdf, 0x1b, 0x1e, 0xee, 0x1f, 0x31,
-	0xe6, 0x4f, 0x29, 0x4b, 0x48, 0x08, 0x40, 0x3d, 0x21, 0x33, 0xea, 0x4b, 0xc6, 0x13, 0x72, 0x32,
-	0xf2, 0x40, 0xd2, 0x11, 0x91, 0xa7, 0x76, 0x9a, 0x71, 0xc9, 0x8d, 0xdd, 0x12, 0x69, 0x5f, 0x47,
-	0xda, 0x1a, 0x69, 0x6e, 0x46, 0x3c, 0xe2, 0x05, 0x96, 0x5c, 0x9e, 0x14, 0xcd, 0xdc, 0xf2, 0xb9,
-	0x88, 0xb9, 0x20, 0xb1, 0x88, 0xc8, 0xc9, 0xe8, 0xf2, 0xa5, 0x0b, 0x0f, 0x9b, 0x3a, 0xa7, 0x34,
-	0xa3, 0xb1, 0xd0, 0xe8, 0xfb, 0x34, 0x66, 0x09, 0x27, 0xc5, 0x53, 0xff, 0xda, 0x56, 0xca, 0x87,
-	0xaa, 0xa5, 0xfa, 0x50, 0xa5, 0xde, 0x77, 0x84, 0x37, 0x5c, 0x11, 0xbd, 0x49, 0x03, 0x2a, 0xe1,
-	0x65, 0xa1, 0x63, 0x3c, 0xc6, 0x6d, 0x3a, 0x93, 0x53, 0x9e, 0x31, 0x79, 0xd6, 0x41, 0x5d, 0xd4,
-	0x6f, 0x8f, 0x3b, 0x3f, 0xbe, 0x0d, 0x37, 0x35, 0xf1, 0x69, 0x10, 0x64, 0x20, 0xc4, 0x2b, 0x99,
-	0xb1, 0x24, 0x9a, 0x5c, 0x41, 0x8d, 0xe7, 0x78, 0x5d, 0x39, 0xe9, 0xac, 0x75, 0x51, 0xff, 0xee,
-	0xde, 0x03, 0xbb, 0x61, 0x10, 0xb6, 0x6a, 0x38, 0xbe, 0x7d, 0xfe, 0x6b, 0xb7, 0x35, 0xd1, 0xe4,
-	0x7d, 0xf2, 0x71, 0x39, 0x1f, 0x5c, 0xc9, 0x7e, 0x5e, 0xce, 0x07, 0x3b, 0xb5, 0xe0, 0xb3, 0xc2,
-	0xee, 0x50, 0x11, 0x7a, 0xdb, 0x78, 0xab, 0x16, 0x61, 0x02, 0x22, 0xe5, 0x89, 0x80, 0x5e, 0x8e,
-	0xb0, 0x51, 0xd5, 0x5e, 0x00, 0xbc, 0xe6, 0x47, 0x90, 0xac, 0x9e, 0xf0, 0x1d, 0xc6, 0x21, 0xc0,
-	0xa1, 0x2c, 0x54, 0x74, 0xca, 0x83, 0xc6, 0x94, 0x65, 0x5f, 0x17, 0x24, 0x0d, 0xa8, 0xa4, 0xcf,
-	0xf8, 0xf1, 0x31, 0x14, 0x10, 0x9d, 0xbc, 0x1d, 0x96, 0xce, 0xf6, 0x9d, 0x9b, 0xe1, 0xbb, 0x7f,
-	0x0f, 0x1f, 0x02, 0x0c, 0x95, 0x91, 0xde, 0x0e, 0x36, 0x6f, 0x86, 0x2c, 0x67, 0xb0, 0xf7, 0x65,
-	0x0d, 0xdf, 0x72, 0x45, 0x64, 0xbc, 0xc7, 0xf7, 0xae, 0x5d, 0xf3, 0xa3, 0x46, 0xe3, 0xb5, 0xa9,
-	0x9a, 0x4f, 0xfe, 0x97, 0x51, 0x7a, 0x30, 0x3e, 0x21, 0xbc, 0x51, 0xbf, 0x04, 0xe7, 0xdf, 0xd5,
-	0x2a, 0x92, 0x79, 0xb0, 0x02, 0xa9, 0x74, 0x61, 0xde, 0xf9, 0xb0, 0x9c, 0x0f, 0xd0, 0xd8, 0x3d,
-	0x5f, 0x58, 0xe8, 0x62, 0x61, 0xa1, 0xdf, 0x0b, 0x0b, 0x7d, 0xcd, 0xad, 0xd6, 0x45, 0x6e, 0xb5,
-	0x7e, 0xe6, 0x56, 0xeb, 0xad, 0x13, 0x31, 0x39, 0x9d, 0x79, 0xb6, 0xcf, 0x63, 0x52, 0x2d, 0x5d,
-	0x75, 0x38, 0xad, 0xef, 0x9f, 0x3c, 0x4b, 0x41, 0x78, 0xeb, 0xc5, 0x26, 0x39, 0x7f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0xa3, 0xbd, 0xb3, 0xe2, 0x21, 0x04, 0x00, 0x00,
+func (m *MsgUpdateFeeTokenPricing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a governance operation for updating the
-	// x/feeabstraction params
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// UpdateFeeTokens defines a governance operation for updating the fee tokens
-	UpdateFeeTokens(ctx context.Context, in *MsgUpdateFeeTokens, opts ...grpc.CallOption) (*MsgUpdateFeeTokensResponse, error)
+func (m *MsgUpdateFeeTokenPricing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFeeTokenPricing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateFeeTokenPricing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFeeTokenPricing.Merge(m, src)
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUpdateFeeTokenPricing) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFeeTokenPricing) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFeeTokenPricing.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateFeeTokenPricing proto.InternalMessageInfo
+
+func (m *MsgUpdateFeeTokenPricing) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateFeeTokens(ctx context.Context, in *MsgUpdateFeeTokens, opts ...grpc.CallOption) (*MsgUpdateFeeTokensResponse, error) {
-	out := new(MsgUpdateFeeTokensResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/UpdateFeeTokens", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateFeeTokenPricing) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a governance operation for updating the
-	// x/feeabstraction params
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// UpdateFeeTokens defines a governance operation for updating the fee tokens
-	UpdateFeeTokens(context.Context, *MsgUpdateFeeTokens) (*MsgUpdateFeeTokensResponse, error)
+// MsgUpdateFeeTokenPricingResponse defines the response structure for update
+// fee token pricing
+type MsgUpdateFeeTokenPricingResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgUpdateFeeTokenPricingResponse) Reset()         { *m = MsgUpdateFeeTokenPricingResponse{} }
+func (m *MsgUpdateFeeTokenPricingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFeeTokenPricingResponse) ProtoMessage()    {}
+func (*MsgUpdateFeeTokenPricingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{5}
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgUpdateFeeTokenPricingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateFeeTokens(ctx context.Context, req *MsgUpdateFeeTokens) (*MsgUpdateFeeTokensResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeeTokens not implemented")
+func (m *MsgUpdateFeeTokenPricingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFeeTokenPricingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateFeeTokenPricingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFeeTokenPricingResponse.Merge(m, src)
+}
+func (m *MsgUpdateFeeTokenPricingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFeeTokenPricingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFeeTokenPricingResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgUpdateFeeTokenPricingResponse proto.InternalMessageInfo
+
+// MsgAddFeeToken is the Msg/AddFeeToken request type.
+type MsgAddFeeToken struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// fee_token defines the fee token to be added
+	FeeToken FeeTokenMetadata `protobuf:"bytes,2,opt,name=fee_token,json=feeToken,proto3" json:"fee_token"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgAddFeeToken) Reset()         { *m = MsgAddFeeToken{} }
+func (m *MsgAddFeeToken) String() string { return proto.CompactTextString(m) }
+func (*MsgAddFeeToken) ProtoMessage()    {}
+func (*MsgAddFeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{6}
+}
+func (m *MsgAddFeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddFeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddFeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAddFeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddFeeToken.Merge(m, src)
+}
+func (m *MsgAddFeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddFeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddFeeToken.DiscardUnknown(m)
 }
 
-func _Msg_UpdateFeeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateFeeTokens)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateFeeTokens(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/UpdateFeeTokens",
+var xxx_messageInfo_MsgAddFeeToken proto.InternalMessageInfo
+
+func (m *MsgAddFeeToken) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateFeeTokens(ctx, req.(*MsgUpdateFeeTokens))
+	return ""
+}
+
+func (m *MsgAddFeeToken) GetFeeToken() FeeTokenMetadata {
+	if m != nil {
+		return m.FeeToken
 	}
-	return interceptor(ctx, in, info, handler)
+	return FeeTokenMetadata{}
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kiichain.feeabstraction.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "UpdateFeeTokens",
-			Handler:    _Msg_UpdateFeeTokens_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kiichain/feeabstraction/v1beta1/tx.proto",
+// MsgAddFeeTokenResponse defines the response structure for add fee token
+type MsgAddFeeTokenResponse struct {
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAddFeeTokenResponse) Reset()         { *m = MsgAddFeeTokenResponse{} }
+func (m *MsgAddFeeTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddFeeTokenResponse) ProtoMessage()    {}
+func (*MsgAddFeeTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{7}
+}
+func (m *MsgAddFeeTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddFeeTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddFeeTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgAddFeeTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddFeeTokenResponse.Merge(m, src)
+}
+func (m *MsgAddFeeTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddFeeTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddFeeTokenResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAddFeeTokenResponse proto.InternalMessageInfo
+
+// MsgRemoveFeeToken is the Msg/RemoveFeeToken request type.
+type MsgRemoveFeeToken struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the fee token to be removed
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRemoveFeeToken) Reset()         { *m = MsgRemoveFeeToken{} }
+func (m *MsgRemoveFeeToken) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeeToken) ProtoMessage()    {}
+func (*MsgRemoveFeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{8}
+}
+func (m *MsgRemoveFeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRemoveFeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeeToken.Merge(m, src)
+}
+func (m *MsgRemoveFeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeeToken.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRemoveFeeToken proto.InternalMessageInfo
+
+func (m *MsgRemoveFeeToken) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveFeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRemoveFeeTokenResponse defines the response structure for remove fee
+// token
+type MsgRemoveFeeTokenResponse struct {
 }
 
-func (m *MsgUpdateFeeTokens) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRemoveFeeTokenResponse) Reset()         { *m = MsgRemoveFeeTokenResponse{} }
+func (m *MsgRemoveFeeTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeeTokenResponse) ProtoMessage()    {}
+func (*MsgRemoveFeeTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{9}
+}
+func (m *MsgRemoveFeeTokenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFeeTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFeeTokenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveFeeTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeeTokenResponse.Merge(m, src)
+}
+func (m *MsgRemoveFeeTokenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFeeTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeeTokenResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateFeeTokens) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRemoveFeeTokenResponse proto.InternalMessageInfo
+
+// MsgSetFeeTokenEnabled is the Msg/SetFeeTokenEnabled request type.
+type MsgSetFeeTokenEnabled struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the fee token to be updated
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// enabled indicates if the fee token should be enabled or disabled
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (m *MsgUpdateFeeTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.FeeTokens.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetFeeTokenEnabled) Reset()         { *m = MsgSetFeeTokenEnabled{} }
+func (m *MsgSetFeeTokenEnabled) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeTokenEnabled) ProtoMessage()    {}
+func (*MsgSetFeeTokenEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{10}
+}
+func (m *MsgSetFeeTokenEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeTokenEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeTokenEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeTokenEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeTokenEnabled.Merge(m, src)
+}
+func (m *MsgSetFeeTokenEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeTokenEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeTokenEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeTokenEnabled proto.InternalMessageInfo
+
+func (m *MsgSetFeeTokenEnabled) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetFeeTokenEnabled) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgSetFeeTokenEnabled) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// MsgSetFeeTokenEnabledResponse defines the response structure for set fee
+// token enabled
+type MsgSetFeeTokenEnabledResponse struct {
+}
+
+func (m *MsgSetFeeTokenEnabledResponse) Reset()         { *m = MsgSetFeeTokenEnabledResponse{} }
+func (m *MsgSetFeeTokenEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeTokenEnabledResponse) ProtoMessage()    {}
+func (*MsgSetFeeTokenEnabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{11}
+}
+func (m *MsgSetFeeTokenEnabledResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeTokenEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeTokenEnabledResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeTokenEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeTokenEnabledResponse.Merge(m, src)
+}
+func (m *MsgSetFeeTokenEnabledResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeTokenEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeTokenEnabledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeTokenEnabledResponse proto.InternalMessageInfo
+
+// MsgPause is the Msg/Pause request type.
+type MsgPause struct {
+	// sender is the address of the guardian or the governance account.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// denom is the fee token to be paused, an empty denom pauses the whole
+	// module
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgPause) Reset()         { *m = MsgPause{} }
+func (m *MsgPause) String() string { return proto.CompactTextString(m) }
+func (*MsgPause) ProtoMessage()    {}
+func (*MsgPause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{12}
+}
+func (m *MsgPause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPause.Merge(m, src)
+}
+func (m *MsgPause) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPause) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPause proto.InternalMessageInfo
+
+func (m *MsgPause) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgPause) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgPauseResponse defines the response structure for pause
+type MsgPauseResponse struct {
+}
+
+func (m *MsgPauseResponse) Reset()         { *m = MsgPauseResponse{} }
+func (m *MsgPauseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseResponse) ProtoMessage()    {}
+func (*MsgPauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{13}
+}
+func (m *MsgPauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseResponse.Merge(m, src)
+}
+func (m *MsgPauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseResponse proto.InternalMessageInfo
+
+// MsgUnpause is the Msg/Unpause request type.
+type MsgUnpause struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// denom is the fee token to be unpaused, an empty denom unpauses the whole
+	// module
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgUnpause) Reset()         { *m = MsgUnpause{} }
+func (m *MsgUnpause) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpause) ProtoMessage()    {}
+func (*MsgUnpause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{14}
+}
+func (m *MsgUnpause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpause.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpause.Merge(m, src)
+}
+func (m *MsgUnpause) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpause) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpause proto.InternalMessageInfo
+
+func (m *MsgUnpause) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUnpause) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgUnpauseResponse defines the response structure for unpause
+type MsgUnpauseResponse struct {
+}
+
+func (m *MsgUnpauseResponse) Reset()         { *m = MsgUnpauseResponse{} }
+func (m *MsgUnpauseResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnpauseResponse) ProtoMessage()    {}
+func (*MsgUnpauseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{15}
+}
+func (m *MsgUnpauseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnpauseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnpauseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnpauseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnpauseResponse.Merge(m, src)
+}
+func (m *MsgUnpauseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnpauseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnpauseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnpauseResponse proto.InternalMessageInfo
+
+// MsgSetPaymaster is the Msg/SetPaymaster request type.
+type MsgSetPaymaster struct {
+	// sender is the address of the paymaster contract or the governance account.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// contract is the address of the paymaster contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// msg_types are the Cosmos message type URLs sponsored by the paymaster
+	MsgTypes []string `protobuf:"bytes,3,rep,name=msg_types,json=msgTypes,proto3" json:"msg_types,omitempty"`
+	// callees are the contracts whose calls are sponsored by the paymaster
+	Callees []string `protobuf:"bytes,4,rep,name=callees,proto3" json:"callees,omitempty"`
+	// per_user_limit is the native fee amount sponsored for a single user on
+	// each user window
+	PerUserLimit cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=per_user_limit,json=perUserLimit,proto3,customtype=cosmossdk.io/math.Int" json:"per_user_limit"`
+	// per_block_limit is the native fee amount sponsored on a single block
+	PerBlockLimit cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=per_block_limit,json=perBlockLimit,proto3,customtype=cosmossdk.io/math.Int" json:"per_block_limit"`
+	// user_window is the length in seconds of the per user limit window
+	UserWindow uint64 `protobuf:"varint,7,opt,name=user_window,json=userWindow,proto3" json:"user_window,omitempty"`
+}
+
+func (m *MsgSetPaymaster) Reset()         { *m = MsgSetPaymaster{} }
+func (m *MsgSetPaymaster) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPaymaster) ProtoMessage()    {}
+func (*MsgSetPaymaster) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{16}
+}
+func (m *MsgSetPaymaster) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPaymaster) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPaymaster.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPaymaster) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPaymaster.Merge(m, src)
+}
+func (m *MsgSetPaymaster) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPaymaster) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPaymaster.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPaymaster proto.InternalMessageInfo
+
+func (m *MsgSetPaymaster) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetPaymaster) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *MsgSetPaymaster) GetMsgTypes() []string {
+	if m != nil {
+		return m.MsgTypes
+	}
+	return nil
+}
+
+func (m *MsgSetPaymaster) GetCallees() []string {
+	if m != nil {
+		return m.Callees
+	}
+	return nil
+}
+
+func (m *MsgSetPaymaster) GetUserWindow() uint64 {
+	if m != nil {
+		return m.UserWindow
+	}
+	return 0
+}
+
+// MsgSetPaymasterResponse defines the response structure for set paymaster
+type MsgSetPaymasterResponse struct {
+}
+
+func (m *MsgSetPaymasterResponse) Reset()         { *m = MsgSetPaymasterResponse{} }
+func (m *MsgSetPaymasterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPaymasterResponse) ProtoMessage()    {}
+func (*MsgSetPaymasterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{17}
+}
+func (m *MsgSetPaymasterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPaymasterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPaymasterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPaymasterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPaymasterResponse.Merge(m, src)
+}
+func (m *MsgSetPaymasterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPaymasterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPaymasterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPaymasterResponse proto.InternalMessageInfo
+
+// MsgFundPaymaster is the Msg/FundPaymaster request type.
+type MsgFundPaymaster struct {
+	// sender is the address of the account funding the paymaster.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// contract is the address of the paymaster contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// amount is the native amount deposited on the paymaster balance
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgFundPaymaster) Reset()         { *m = MsgFundPaymaster{} }
+func (m *MsgFundPaymaster) String() string { return proto.CompactTextString(m) }
+func (*MsgFundPaymaster) ProtoMessage()    {}
+func (*MsgFundPaymaster) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{18}
+}
+func (m *MsgFundPaymaster) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundPaymaster) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundPaymaster.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundPaymaster) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundPaymaster.Merge(m, src)
+}
+func (m *MsgFundPaymaster) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundPaymaster) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundPaymaster.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundPaymaster proto.InternalMessageInfo
+
+func (m *MsgFundPaymaster) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgFundPaymaster) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *MsgFundPaymaster) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgFundPaymasterResponse defines the response structure for fund paymaster
+type MsgFundPaymasterResponse struct {
+}
+
+func (m *MsgFundPaymasterResponse) Reset()         { *m = MsgFundPaymasterResponse{} }
+func (m *MsgFundPaymasterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundPaymasterResponse) ProtoMessage()    {}
+func (*MsgFundPaymasterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{19}
+}
+func (m *MsgFundPaymasterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundPaymasterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundPaymasterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundPaymasterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundPaymasterResponse.Merge(m, src)
+}
+func (m *MsgFundPaymasterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundPaymasterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundPaymasterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundPaymasterResponse proto.InternalMessageInfo
+
+// MsgRemovePaymaster is the Msg/RemovePaymaster request type.
+type MsgRemovePaymaster struct {
+	// sender is the address of the paymaster contract or the governance account.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// contract is the address of the paymaster contract
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+}
+
+func (m *MsgRemovePaymaster) Reset()         { *m = MsgRemovePaymaster{} }
+func (m *MsgRemovePaymaster) String() string { return proto.CompactTextString(m) }
+func (*MsgRemovePaymaster) ProtoMessage()    {}
+func (*MsgRemovePaymaster) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{20}
+}
+func (m *MsgRemovePaymaster) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemovePaymaster) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemovePaymaster.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemovePaymaster) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemovePaymaster.Merge(m, src)
+}
+func (m *MsgRemovePaymaster) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemovePaymaster) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemovePaymaster.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemovePaymaster proto.InternalMessageInfo
+
+func (m *MsgRemovePaymaster) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRemovePaymaster) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+// MsgRemovePaymasterResponse defines the response structure for remove
+// paymaster
+type MsgRemovePaymasterResponse struct {
+}
+
+func (m *MsgRemovePaymasterResponse) Reset()         { *m = MsgRemovePaymasterResponse{} }
+func (m *MsgRemovePaymasterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemovePaymasterResponse) ProtoMessage()    {}
+func (*MsgRemovePaymasterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6352be81da2292da, []int{21}
+}
+func (m *MsgRemovePaymasterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemovePaymasterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemovePaymasterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemovePaymasterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemovePaymasterResponse.Merge(m, src)
+}
+func (m *MsgRemovePaymasterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemovePaymasterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemovePaymasterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemovePaymasterResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "kiichain.feeabstraction.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateFeeTokens)(nil), "kiichain.feeabstraction.v1beta1.MsgUpdateFeeTokens")
+	proto.RegisterType((*MsgUpdateFeeTokensResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgUpdateFeeTokensResponse")
+	proto.RegisterType((*MsgUpdateFeeTokenPricing)(nil), "kiichain.feeabstraction.v1beta1.MsgUpdateFeeTokenPricing")
+	proto.RegisterType((*MsgUpdateFeeTokenPricingResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgUpdateFeeTokenPricingResponse")
+	proto.RegisterType((*MsgAddFeeToken)(nil), "kiichain.feeabstraction.v1beta1.MsgAddFeeToken")
+	proto.RegisterType((*MsgAddFeeTokenResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgAddFeeTokenResponse")
+	proto.RegisterType((*MsgRemoveFeeToken)(nil), "kiichain.feeabstraction.v1beta1.MsgRemoveFeeToken")
+	proto.RegisterType((*MsgRemoveFeeTokenResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgRemoveFeeTokenResponse")
+	proto.RegisterType((*MsgSetFeeTokenEnabled)(nil), "kiichain.feeabstraction.v1beta1.MsgSetFeeTokenEnabled")
+	proto.RegisterType((*MsgSetFeeTokenEnabledResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgSetFeeTokenEnabledResponse")
+	proto.RegisterType((*MsgPause)(nil), "kiichain.feeabstraction.v1beta1.MsgPause")
+	proto.RegisterType((*MsgPauseResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgPauseResponse")
+	proto.RegisterType((*MsgUnpause)(nil), "kiichain.feeabstraction.v1beta1.MsgUnpause")
+	proto.RegisterType((*MsgUnpauseResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgUnpauseResponse")
+	proto.RegisterType((*MsgSetPaymaster)(nil), "kiichain.feeabstraction.v1beta1.MsgSetPaymaster")
+	proto.RegisterType((*MsgSetPaymasterResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgSetPaymasterResponse")
+	proto.RegisterType((*MsgFundPaymaster)(nil), "kiichain.feeabstraction.v1beta1.MsgFundPaymaster")
+	proto.RegisterType((*MsgFundPaymasterResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgFundPaymasterResponse")
+	proto.RegisterType((*MsgRemovePaymaster)(nil), "kiichain.feeabstraction.v1beta1.MsgRemovePaymaster")
+	proto.RegisterType((*MsgRemovePaymasterResponse)(nil), "kiichain.feeabstraction.v1beta1.MsgRemovePaymasterResponse")
+}
+
+func init() {
+	proto.RegisterFile("kiichain/feeabstraction/v1beta1/tx.proto", fileDescriptor_6352be81da2292da)
+}
+
+var fileDescriptor_6352be81da2292da = []byte{
+	// 1205 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x4f, 0x1b, 0x47,
+	0x14, 0x66, 0x03, 0x18, 0xfc, 0x48, 0x42, 0xb2, 0x82, 0xc4, 0x2c, 0x60, 0x5b, 0x6e, 0xa4, 0x10,
+	0x1a, 0xec, 0x80, 0xab, 0xd0, 0x18, 0xa9, 0x12, 0x50, 0x90, 0x2a, 0xc5, 0x15, 0x32, 0x44, 0x95,
+	0x7a, 0x71, 0xc7, 0xbb, 0xcf, 0xcb, 0x16, 0xef, 0x0f, 0xed, 0xac, 0xf9, 0x11, 0xa9, 0x52, 0xd4,
+	0xaa, 0x3d, 0xb4, 0x3d, 0xf4, 0x92, 0x3f, 0xa3, 0x12, 0x87, 0x1e, 0x7a, 0xed, 0x2d, 0xb7, 0x46,
+	0x3d, 0x55, 0x3d, 0x44, 0x15, 0x54, 0xe2, 0xdf, 0xa8, 0x76, 0x66, 0x77, 0xb0, 0xd7, 0xa6, 0x5e,
+	0xbb, 0x51, 0x2e, 0xe0, 0xf1, 0xbc, 0xef, 0xbd, 0xef, 0x7b, 0xf3, 0x66, 0xde, 0x93, 0x61, 0xe1,
+	0xc0, 0x30, 0xd4, 0x7d, 0x62, 0x58, 0x85, 0x3a, 0x22, 0xa9, 0x51, 0xcf, 0x25, 0xaa, 0x67, 0xd8,
+	0x56, 0xe1, 0x70, 0xb9, 0x86, 0x1e, 0x59, 0x2e, 0x78, 0xc7, 0x79, 0xc7, 0xb5, 0x3d, 0x5b, 0xce,
+	0x84, 0x96, 0xf9, 0x76, 0xcb, 0x7c, 0x60, 0xa9, 0x4c, 0xe9, 0xb6, 0x6e, 0x33, 0xdb, 0x82, 0xff,
+	0x89, 0xc3, 0x94, 0xbb, 0xaa, 0x4d, 0x4d, 0x9b, 0x16, 0x4c, 0xaa, 0x17, 0x0e, 0x97, 0xfd, 0x7f,
+	0xc1, 0xc6, 0xc3, 0x5e, 0x91, 0x1d, 0xe2, 0x12, 0x93, 0x06, 0xd6, 0xb7, 0x89, 0x69, 0x58, 0x76,
+	0x81, 0xfd, 0x0d, 0xbe, 0x9a, 0xe1, 0x9e, 0xab, 0x3c, 0x24, 0x5f, 0x04, 0x5b, 0xe9, 0x20, 0x68,
+	0x8d, 0x50, 0x14, 0xfe, 0x54, 0xdb, 0xb0, 0xf8, 0x7e, 0xee, 0x37, 0x09, 0x26, 0xcb, 0x54, 0x7f,
+	0xe6, 0x68, 0xc4, 0xc3, 0x1d, 0x16, 0x47, 0x7e, 0x0c, 0x49, 0xd2, 0xf4, 0xf6, 0x6d, 0xd7, 0xf0,
+	0x4e, 0x52, 0x52, 0x56, 0x5a, 0x48, 0x6e, 0xa4, 0xfe, 0xf8, 0x65, 0x69, 0x2a, 0x70, 0xbc, 0xae,
+	0x69, 0x2e, 0x52, 0xba, 0xeb, 0xb9, 0x86, 0xa5, 0x57, 0x2e, 0x4d, 0xe5, 0x2d, 0x48, 0x70, 0xa6,
+	0xa9, 0x6b, 0x59, 0x69, 0x61, 0x62, 0xe5, 0x7e, 0xbe, 0x47, 0xa2, 0xf2, 0x3c, 0xe0, 0xc6, 0xc8,
+	0xab, 0x37, 0x99, 0xa1, 0x4a, 0x00, 0x2e, 0x15, 0xbe, 0xbe, 0x38, 0x5d, 0xbc, 0x74, 0xfb, 0xfd,
+	0xc5, 0xe9, 0xe2, 0x5c, 0x24, 0x31, 0x4d, 0x46, 0x77, 0x89, 0x03, 0x72, 0x33, 0x70, 0x37, 0x22,
+	0xa1, 0x82, 0xd4, 0xb1, 0x2d, 0x8a, 0xb9, 0x73, 0x09, 0x64, 0xb1, 0xb7, 0x8d, 0xb8, 0x67, 0x1f,
+	0xa0, 0x35, 0xb8, 0xc2, 0x2f, 0x00, 0xea, 0x88, 0x55, 0x8f, 0x79, 0x09, 0x54, 0xae, 0xf5, 0x54,
+	0x19, 0xc6, 0x2d, 0xa3, 0x47, 0x34, 0xe2, 0x91, 0x4d, 0xbb, 0xd1, 0x40, 0x66, 0x12, 0x28, 0x4f,
+	0xd6, 0x43, 0x66, 0xa5, 0x62, 0xa7, 0xf8, 0x6c, 0x77, 0xf1, 0x75, 0xc4, 0x25, 0x4e, 0x24, 0x37,
+	0x07, 0x4a, 0xa7, 0x48, 0x91, 0x83, 0x7f, 0xae, 0x41, 0xaa, 0x63, 0x7b, 0xc7, 0x35, 0x54, 0xc3,
+	0xd2, 0x07, 0xce, 0xc4, 0x14, 0x8c, 0x6a, 0x68, 0xd9, 0x26, 0x4b, 0x42, 0xb2, 0xc2, 0x17, 0xf2,
+	0xa7, 0x70, 0xcb, 0x71, 0x0d, 0x15, 0xab, 0x44, 0xfb, 0xb2, 0x49, 0x3d, 0x13, 0x2d, 0x2f, 0x35,
+	0xcc, 0x9c, 0xbe, 0xe7, 0x0b, 0xfd, 0xeb, 0x4d, 0x66, 0x96, 0x3b, 0xa6, 0xda, 0x41, 0xde, 0xb0,
+	0x0b, 0x26, 0xf1, 0xf6, 0xf3, 0x4f, 0x51, 0x27, 0xea, 0xc9, 0xc7, 0xa8, 0x56, 0x26, 0x19, 0x78,
+	0x5d, 0x60, 0xe5, 0xc7, 0x30, 0x66, 0x1a, 0x56, 0xb5, 0x8e, 0x98, 0x1a, 0x61, 0x6e, 0xe6, 0x03,
+	0x37, 0xd3, 0x9d, 0x6e, 0x3e, 0xb1, 0xbc, 0x4a, 0xc2, 0x34, 0xac, 0x6d, 0x44, 0x86, 0x23, 0xc7,
+	0x0c, 0x37, 0x1a, 0x0f, 0x47, 0x8e, 0xb7, 0x11, 0x4b, 0xa5, 0xce, 0xec, 0xdf, 0xef, 0x91, 0xfd,
+	0x25, 0x87, 0x67, 0x32, 0x97, 0x83, 0xec, 0x55, 0x59, 0x16, 0x47, 0xf1, 0xbb, 0x04, 0x37, 0xcb,
+	0x54, 0x5f, 0xd7, 0xb4, 0xd0, 0x62, 0xe0, 0x03, 0xd8, 0x83, 0xa4, 0x28, 0xc5, 0xa0, 0x12, 0x97,
+	0xfb, 0xae, 0xc4, 0xa0, 0xfe, 0xc6, 0xc3, 0xfa, 0x8b, 0x73, 0xf7, 0x88, 0xa6, 0x5d, 0xaa, 0xcf,
+	0xa5, 0xe0, 0x4e, 0xbb, 0x20, 0xa1, 0xf5, 0xa5, 0x04, 0xb7, 0xcb, 0x54, 0xaf, 0xa0, 0x69, 0x1f,
+	0xe2, 0xff, 0x96, 0xdb, 0xb5, 0xde, 0x4a, 0x2b, 0x9d, 0x74, 0x33, 0x11, 0xba, 0x2e, 0x8b, 0xdf,
+	0xc2, 0x78, 0x16, 0x66, 0x3a, 0x68, 0x09, 0xd2, 0xbf, 0x4a, 0x30, 0x5d, 0xa6, 0xfa, 0x2e, 0x7a,
+	0xe1, 0xd6, 0x96, 0x45, 0x6a, 0x0d, 0xd4, 0xde, 0xf2, 0x45, 0x49, 0xc1, 0x18, 0x72, 0xc7, 0xec,
+	0x7e, 0x8c, 0x57, 0xc2, 0x65, 0x69, 0xb5, 0x53, 0xd2, 0xbd, 0x88, 0x24, 0x8a, 0x5e, 0x4b, 0xfd,
+	0x05, 0xc0, 0x5c, 0x06, 0xe6, 0xbb, 0x32, 0x17, 0xda, 0x8e, 0x61, 0xbc, 0x4c, 0xf5, 0x1d, 0xd2,
+	0xa4, 0x28, 0x3f, 0x82, 0x04, 0x45, 0x4b, 0x43, 0xb7, 0xa7, 0x94, 0xc0, 0xee, 0x8a, 0x03, 0xb8,
+	0xe7, 0xb3, 0x0d, 0x4c, 0x7c, 0xaa, 0x53, 0x11, 0xaa, 0x8e, 0x1f, 0x2d, 0x27, 0xc3, 0xad, 0x30,
+	0xb2, 0x60, 0xf3, 0xad, 0x04, 0xe0, 0xdf, 0x17, 0x8b, 0x99, 0xbc, 0xe5, 0xba, 0x78, 0xd0, 0x99,
+	0xc4, 0x3b, 0xd1, 0x7b, 0xcc, 0x03, 0xe7, 0xa6, 0x78, 0x83, 0xe0, 0x2b, 0xc1, 0xee, 0x87, 0x61,
+	0xd6, 0x16, 0x77, 0xd1, 0xdb, 0x21, 0x27, 0x26, 0xa1, 0x1e, 0xba, 0x03, 0xe4, 0xec, 0x03, 0x18,
+	0x57, 0x6d, 0x8b, 0x85, 0xe4, 0xfc, 0xfe, 0x03, 0x23, 0x2c, 0xe5, 0x59, 0x48, 0x9a, 0x54, 0xaf,
+	0x7a, 0x27, 0x0e, 0xd2, 0xd4, 0x70, 0x76, 0x78, 0x21, 0x59, 0x19, 0x37, 0xa9, 0xbe, 0xe7, 0xaf,
+	0xfd, 0xc2, 0x51, 0x49, 0xa3, 0x81, 0x48, 0x53, 0x23, 0x6c, 0x2b, 0x5c, 0xca, 0x9b, 0x70, 0xd3,
+	0x41, 0xb7, 0xda, 0xa4, 0xe8, 0x56, 0x1b, 0x86, 0x69, 0x78, 0xf1, 0x9e, 0xbe, 0xeb, 0x0e, 0xba,
+	0xcf, 0x28, 0xba, 0x4f, 0x7d, 0x88, 0xbc, 0x05, 0x93, 0xbe, 0x93, 0x5a, 0xc3, 0x56, 0x0f, 0x02,
+	0x2f, 0x89, 0x38, 0x5e, 0x6e, 0x38, 0xe8, 0x6e, 0xf8, 0x20, 0xee, 0x26, 0x03, 0x13, 0x8c, 0xc7,
+	0x91, 0x61, 0x69, 0xf6, 0x51, 0x6a, 0x2c, 0x2b, 0x2d, 0x8c, 0x54, 0xc0, 0xff, 0xea, 0x33, 0xf6,
+	0x4d, 0xe9, 0x61, 0xa4, 0x6e, 0xe6, 0xba, 0x94, 0xb8, 0x13, 0x66, 0x3e, 0x68, 0xf0, 0xad, 0x87,
+	0x21, 0x0e, 0xea, 0x4c, 0x62, 0xb5, 0xb5, 0xdd, 0xb4, 0xb4, 0x77, 0x7f, 0x52, 0xab, 0x90, 0x20,
+	0xa6, 0xdd, 0x0c, 0x9a, 0xdc, 0xc4, 0xca, 0x4c, 0x3e, 0x00, 0xf8, 0xd3, 0x96, 0x78, 0x74, 0x37,
+	0x6d, 0x23, 0x6c, 0xf4, 0x81, 0x79, 0x69, 0x29, 0x22, 0x7f, 0x3e, 0x22, 0xbf, 0xde, 0xb4, 0xb4,
+	0x16, 0xfd, 0x0a, 0x6b, 0xe0, 0x6d, 0x1a, 0x45, 0x02, 0x7e, 0xe6, 0x13, 0x0e, 0x7f, 0xcf, 0xde,
+	0x79, 0x0a, 0x78, 0xc3, 0x68, 0x51, 0x72, 0xc5, 0xf3, 0x7b, 0xa9, 0x85, 0xcf, 0x2a, 0x11, 0xba,
+	0xa1, 0x9a, 0x95, 0xef, 0x26, 0x60, 0xb8, 0x4c, 0x75, 0xf9, 0x39, 0x5c, 0x6f, 0x1b, 0x49, 0x1f,
+	0xf5, 0x6c, 0x6d, 0x91, 0x09, 0x50, 0xf9, 0xb0, 0x5f, 0x44, 0xc8, 0x41, 0xfe, 0x46, 0x82, 0xc9,
+	0xe8, 0xc0, 0x58, 0x8c, 0xef, 0x4d, 0x80, 0x94, 0xb5, 0x01, 0x40, 0x82, 0xc5, 0x4b, 0x09, 0xa6,
+	0xbb, 0x8f, 0x6c, 0x4f, 0xfa, 0x77, 0x1b, 0x40, 0x95, 0xf5, 0x81, 0xa1, 0x82, 0xd7, 0x11, 0x4c,
+	0xb4, 0x8e, 0x2f, 0x85, 0x38, 0x1e, 0x5b, 0x00, 0xca, 0x6a, 0x9f, 0x00, 0x11, 0xf8, 0x85, 0x04,
+	0x37, 0x23, 0xc3, 0xc4, 0x4a, 0x1c, 0x5f, 0xed, 0x18, 0xa5, 0xd4, 0x3f, 0x46, 0x50, 0xf8, 0x51,
+	0x02, 0xb9, 0xdb, 0x68, 0x10, 0xc7, 0x65, 0x27, 0x4e, 0xf9, 0x68, 0x30, 0x9c, 0xa0, 0x83, 0x30,
+	0xca, 0xbb, 0xf9, 0x83, 0x38, 0x8e, 0x98, 0xa9, 0xb2, 0x1c, 0xdb, 0x54, 0x84, 0x39, 0x80, 0xb1,
+	0xb0, 0x4b, 0xbf, 0x1f, 0xab, 0x7e, 0xb8, 0xb1, 0x52, 0xec, 0xc3, 0x58, 0x04, 0x7b, 0x0e, 0xd7,
+	0xdb, 0x9b, 0x6e, 0xcc, 0x1c, 0x09, 0x44, 0xbc, 0x8b, 0xdf, 0xad, 0x97, 0xc8, 0x5f, 0xc1, 0x8d,
+	0xf6, 0x3e, 0x12, 0x2b, 0x59, 0x6d, 0x10, 0xe5, 0x49, 0xdf, 0x90, 0xb6, 0x77, 0x27, 0xfa, 0x8c,
+	0x17, 0xe3, 0x57, 0xeb, 0x25, 0x87, 0xb5, 0x01, 0x40, 0x21, 0x0b, 0x65, 0xf4, 0xc5, 0xc5, 0xe9,
+	0xa2, 0xb4, 0x51, 0x7e, 0x75, 0x96, 0x96, 0x5e, 0x9f, 0xa5, 0xa5, 0xbf, 0xcf, 0xd2, 0xd2, 0x4f,
+	0xe7, 0xe9, 0xa1, 0xd7, 0xe7, 0xe9, 0xa1, 0x3f, 0xcf, 0xd3, 0x43, 0x9f, 0x17, 0x75, 0xc3, 0xdb,
+	0x6f, 0xd6, 0xf2, 0xaa, 0x6d, 0x16, 0xc4, 0x0f, 0x17, 0xe2, 0xc3, 0x71, 0xf4, 0x37, 0x0c, 0x36,
+	0xc6, 0xd4, 0x12, 0xec, 0xd7, 0x86, 0xe2, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc5, 0x28, 0xa1,
+	0x7f, 0x65, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a governance operation for updating the
+	// x/feeabstraction params
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateFeeTokens defines a governance operation for updating the fee tokens
+	UpdateFeeTokens(ctx context.Context, in *MsgUpdateFeeTokens, opts ...grpc.CallOption) (*MsgUpdateFeeTokensResponse, error)
+	// UpdateFeeTokenPricing defines a governance operation for updating the
+	// pricing adjustment and fee bounds of a single fee token
+	UpdateFeeTokenPricing(ctx context.Context, in *MsgUpdateFeeTokenPricing, opts ...grpc.CallOption) (*MsgUpdateFeeTokenPricingResponse, error)
+	// AddFeeToken defines a governance operation for adding a single fee token
+	AddFeeToken(ctx context.Context, in *MsgAddFeeToken, opts ...grpc.CallOption) (*MsgAddFeeTokenResponse, error)
+	// RemoveFeeToken defines a governance operation for removing a single fee
+	// token
+	RemoveFeeToken(ctx context.Context, in *MsgRemoveFeeToken, opts ...grpc.CallOption) (*MsgRemoveFeeTokenResponse, error)
+	// SetFeeTokenEnabled defines a governance operation for enabling or
+	// disabling a single fee token
+	SetFeeTokenEnabled(ctx context.Context, in *MsgSetFeeTokenEnabled, opts ...grpc.CallOption) (*MsgSetFeeTokenEnabledResponse, error)
+	// Pause defines an emergency operation for pausing a single fee token or
+	// the whole module, it can be executed by the guardian or governance
+	Pause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error)
+	// Unpause defines a governance operation for unpausing a single fee token
+	// or the whole module
+	Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error)
+	// SetPaymaster defines an operation for registering or updating a
+	// paymaster, it can be executed by the contract itself or governance
+	SetPaymaster(ctx context.Context, in *MsgSetPaymaster, opts ...grpc.CallOption) (*MsgSetPaymasterResponse, error)
+	// FundPaymaster defines an operation for depositing native coins on a
+	// paymaster balance, it can be executed by any account
+	FundPaymaster(ctx context.Context, in *MsgFundPaymaster, opts ...grpc.CallOption) (*MsgFundPaymasterResponse, error)
+	// RemovePaymaster defines an operation for removing a paymaster and
+	// returning its balance to the contract, it can be executed by the contract
+	// itself or governance
+	RemovePaymaster(ctx context.Context, in *MsgRemovePaymaster, opts ...grpc.CallOption) (*MsgRemovePaymasterResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFeeTokens(ctx context.Context, in *MsgUpdateFeeTokens, opts ...grpc.CallOption) (*MsgUpdateFeeTokensResponse, error) {
+	out := new(MsgUpdateFeeTokensResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/UpdateFeeTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFeeTokenPricing(ctx context.Context, in *MsgUpdateFeeTokenPricing, opts ...grpc.CallOption) (*MsgUpdateFeeTokenPricingResponse, error) {
+	out := new(MsgUpdateFeeTokenPricingResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/UpdateFeeTokenPricing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddFeeToken(ctx context.Context, in *MsgAddFeeToken, opts ...grpc.CallOption) (*MsgAddFeeTokenResponse, error) {
+	out := new(MsgAddFeeTokenResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/AddFeeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFeeToken(ctx context.Context, in *MsgRemoveFeeToken, opts ...grpc.CallOption) (*MsgRemoveFeeTokenResponse, error) {
+	out := new(MsgRemoveFeeTokenResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/RemoveFeeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeeTokenEnabled(ctx context.Context, in *MsgSetFeeTokenEnabled, opts ...grpc.CallOption) (*MsgSetFeeTokenEnabledResponse, error) {
+	out := new(MsgSetFeeTokenEnabledResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/SetFeeTokenEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Pause(ctx context.Context, in *MsgPause, opts ...grpc.CallOption) (*MsgPauseResponse, error) {
+	out := new(MsgPauseResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/Pause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unpause(ctx context.Context, in *MsgUnpause, opts ...grpc.CallOption) (*MsgUnpauseResponse, error) {
+	out := new(MsgUnpauseResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/Unpause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPaymaster(ctx context.Context, in *MsgSetPaymaster, opts ...grpc.CallOption) (*MsgSetPaymasterResponse, error) {
+	out := new(MsgSetPaymasterResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/SetPaymaster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundPaymaster(ctx context.Context, in *MsgFundPaymaster, opts ...grpc.CallOption) (*MsgFundPaymasterResponse, error) {
+	out := new(MsgFundPaymasterResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/FundPaymaster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemovePaymaster(ctx context.Context, in *MsgRemovePaymaster, opts ...grpc.CallOption) (*MsgRemovePaymasterResponse, error) {
+	out := new(MsgRemovePaymasterResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.feeabstraction.v1beta1.Msg/RemovePaymaster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a governance operation for updating the
+	// x/feeabstraction params
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateFeeTokens defines a governance operation for updating the fee tokens
+	UpdateFeeTokens(context.Context, *MsgUpdateFeeTokens) (*MsgUpdateFeeTokensResponse, error)
+	// UpdateFeeTokenPricing defines a governance operation for updating the
+	// pricing adjustment and fee bounds of a single fee token
+	UpdateFeeTokenPricing(context.Context, *MsgUpdateFeeTokenPricing) (*MsgUpdateFeeTokenPricingResponse, error)
+	// AddFeeToken defines a governance operation for adding a single fee token
+	AddFeeToken(context.Context, *MsgAddFeeToken) (*MsgAddFeeTokenResponse, error)
+	// RemoveFeeToken defines a governance operation for removing a single fee
+	// token
+	RemoveFeeToken(context.Context, *MsgRemoveFeeToken) (*MsgRemoveFeeTokenResponse, error)
+	// SetFeeTokenEnabled defines a governance operation for enabling or
+	// disabling a single fee token
+	SetFeeTokenEnabled(context.Context, *MsgSetFeeTokenEnabled) (*MsgSetFeeTokenEnabledResponse, error)
+	// Pause defines an emergency operation for pausing a single fee token or
+	// the whole module, it can be executed by the guardian or governance
+	Pause(context.Context, *MsgPause) (*MsgPauseResponse, error)
+	// Unpause defines a governance operation for unpausing a single fee token
+	// or the whole module
+	Unpause(context.Context, *MsgUnpause) (*MsgUnpauseResponse, error)
+	// SetPaymaster defines an operation for registering or updating a
+	// paymaster, it can be executed by the contract itself or governance
+	SetPaymaster(context.Context, *MsgSetPaymaster) (*MsgSetPaymasterResponse, error)
+	// FundPaymaster defines an operation for depositing native coins on a
+	// paymaster balance, it can be executed by any account
+	FundPaymaster(context.Context, *MsgFundPaymaster) (*MsgFundPaymasterResponse, error)
+	// RemovePaymaster defines an operation for removing a paymaster and
+	// returning its balance to the contract, it can be executed by the contract
+	// itself or governance
+	RemovePaymaster(context.Context, *MsgRemovePaymaster) (*MsgRemovePaymasterResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateFeeTokens(ctx context.Context, req *MsgUpdateFeeTokens) (*MsgUpdateFeeTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeeTokens not implemented")
+}
+func (*UnimplementedMsgServer) UpdateFeeTokenPricing(ctx context.Context, req *MsgUpdateFeeTokenPricing) (*MsgUpdateFeeTokenPricingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeeTokenPricing not implemented")
+}
+func (*UnimplementedMsgServer) AddFeeToken(ctx context.Context, req *MsgAddFeeToken) (*MsgAddFeeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFeeToken not implemented")
+}
+func (*UnimplementedMsgServer) RemoveFeeToken(ctx context.Context, req *MsgRemoveFeeToken) (*MsgRemoveFeeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFeeToken not implemented")
+}
+func (*UnimplementedMsgServer) SetFeeTokenEnabled(ctx context.Context, req *MsgSetFeeTokenEnabled) (*MsgSetFeeTokenEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeTokenEnabled not implemented")
+}
+func (*UnimplementedMsgServer) Pause(ctx context.Context, req *MsgPause) (*MsgPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pause not implemented")
+}
+func (*UnimplementedMsgServer) Unpause(ctx context.Context, req *MsgUnpause) (*MsgUnpauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unpause not implemented")
+}
+func (*UnimplementedMsgServer) SetPaymaster(ctx context.Context, req *MsgSetPaymaster) (*MsgSetPaymasterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPaymaster not implemented")
+}
+func (*UnimplementedMsgServer) FundPaymaster(ctx context.Context, req *MsgFundPaymaster) (*MsgFundPaymasterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundPaymaster not implemented")
+}
+func (*UnimplementedMsgServer) RemovePaymaster(ctx context.Context, req *MsgRemovePaymaster) (*MsgRemovePaymasterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePaymaster not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFeeTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeeTokens)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeeTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/UpdateFeeTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeeTokens(ctx, req.(*MsgUpdateFeeTokens))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFeeTokenPricing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFeeTokenPricing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFeeTokenPricing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/UpdateFeeTokenPricing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFeeTokenPricing(ctx, req.(*MsgUpdateFeeTokenPricing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddFeeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddFeeToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddFeeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/AddFeeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddFeeToken(ctx, req.(*MsgAddFeeToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFeeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFeeToken)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFeeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/RemoveFeeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFeeToken(ctx, req.(*MsgRemoveFeeToken))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeTokenEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeTokenEnabled)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeTokenEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/SetFeeTokenEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeTokenEnabled(ctx, req.(*MsgSetFeeTokenEnabled))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Pause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Pause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/Pause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Pause(ctx, req.(*MsgPause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unpause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnpause)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unpause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/Unpause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unpause(ctx, req.(*MsgUnpause))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPaymaster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPaymaster)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPaymaster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/SetPaymaster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPaymaster(ctx, req.(*MsgSetPaymaster))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundPaymaster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundPaymaster)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundPaymaster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/FundPaymaster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundPaymaster(ctx, req.(*MsgFundPaymaster))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemovePaymaster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemovePaymaster)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemovePaymaster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.feeabstraction.v1beta1.Msg/RemovePaymaster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemovePaymaster(ctx, req.(*MsgRemovePaymaster))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kiichain.feeabstraction.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "UpdateFeeTokens",
+			Handler:    _Msg_UpdateFeeTokens_Handler,
+		},
+		{
+			MethodName: "UpdateFeeTokenPricing",
+			Handler:    _Msg_UpdateFeeTokenPricing_Handler,
+		},
+		{
+			MethodName: "AddFeeToken",
+			Handler:    _Msg_AddFeeToken_Handler,
+		},
+		{
+			MethodName: "RemoveFeeToken",
+			Handler:    _Msg_RemoveFeeToken_Handler,
+		},
+		{
+			MethodName: "SetFeeTokenEnabled",
+			Handler:    _Msg_SetFeeTokenEnabled_Handler,
+		},
+		{
+			MethodName: "Pause",
+			Handler:    _Msg_Pause_Handler,
+		},
+		{
+			MethodName: "Unpause",
+			Handler:    _Msg_Unpause_Handler,
+		},
+		{
+			MethodName: "SetPaymaster",
+			Handler:    _Msg_SetPaymaster_Handler,
+		},
+		{
+			MethodName: "FundPaymaster",
+			Handler:    _Msg_FundPaymaster_Handler,
+		},
+		{
+			MethodName: "RemovePaymaster",
+			Handler:    _Msg_RemovePaymaster_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kiichain/feeabstraction/v1beta1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFeeTokens) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeeTokens) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeeTokens) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeTokens.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFeeTokensResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeeTokensResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeeTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFeeTokenPricing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeeTokenPricing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeeTokenPricing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxFee.Size()
+		i -= size
+		if _, err := m.MaxFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinFee.Size()
+		i -= size
+		if _, err := m.MinFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PriceAdjustment.Size()
+		i -= size
+		if _, err := m.PriceAdjustment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFeeTokenPricingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFeeTokenPricingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFeeTokenPricingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddFeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddFeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddFeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FeeToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddFeeTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddFeeTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddFeeTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeeTokenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeeTokenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeeTokenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeTokenEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeTokenEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeTokenEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFeeTokenEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeTokenEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeTokenEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpause) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpause) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPaymaster) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPaymaster) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPaymaster) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UserWindow != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UserWindow))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.PerBlockLimit.Size()
+		i -= size
+		if _, err := m.PerBlockLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.PerUserLimit.Size()
+		i -= size
+		if _, err := m.PerUserLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Callees) > 0 {
+		for iNdEx := len(m.Callees) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Callees[iNdEx])
+			copy(dAtA[i:], m.Callees[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Callees[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypes[iNdEx])
+			copy(dAtA[i:], m.MsgTypes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPaymasterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPaymasterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPaymasterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundPaymaster) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundPaymaster) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundPaymaster) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundPaymasterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundPaymasterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundPaymasterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemovePaymaster) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemovePaymaster) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemovePaymaster) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemovePaymasterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemovePaymasterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemovePaymasterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateFeeTokens) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.FeeTokens.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateFeeTokensResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateFeeTokenPricing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PriceAdjustment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MinFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateFeeTokenPricingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddFeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.FeeToken.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddFeeTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveFeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveFeeTokenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFeeTokenEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetFeeTokenEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnpause) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnpauseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPaymaster) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MsgTypes) > 0 {
+		for _, s := range m.MsgTypes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Callees) > 0 {
+		for _, s := range m.Callees {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.PerUserLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PerBlockLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.UserWindow != 0 {
+		n += 1 + sovTx(uint64(m.UserWindow))
+	}
+	return n
+}
+
+func (m *MsgSetPaymasterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundPaymaster) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFundPaymasterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemovePaymaster) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemovePaymasterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFeeTokens) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFeeTokens: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFeeTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFeeTokensResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFeeTokensResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFeeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFeeTokenPricing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFeeTokenPricing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFeeTokenPricing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceAdjustment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceAdjustment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFeeTokenPricingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFeeTokenPricingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFeeTokenPricingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFeeTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFeeTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFeeTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveFeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveFeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveFeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveFeeTokenResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveFeeTokenResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveFeeTokenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeeTokenEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeTokenEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeTokenEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFeeTokenEnabledResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFeeTokenEnabledResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFeeTokenEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpauseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPaymaster) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPaymaster: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPaymaster: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callees = append(m.Callees, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerUserLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerUserLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerBlockLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerBlockLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserWindow", wireType)
+			}
+			m.UserWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgUpdateFeeTokensResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateFeeTokensResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateFeeTokensResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateFeeTokens) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetPaymasterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPaymasterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPaymasterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.FeeTokens.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateFeeTokensResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgFundPaymaster) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -597,15 +4565,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundPaymaster: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundPaymaster: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -633,11 +4601,43 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -664,7 +4664,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -689,7 +4689,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgFundPaymasterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -712,10 +4712,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgFundPaymasterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgFundPaymasterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -739,7 +4739,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateFeeTokens) Unmarshal(dAtA []byte) error {
+func (m *MsgRemovePaymaster) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -762,15 +4762,15 @@ func (m *MsgUpdateFeeTokens) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateFeeTokens: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemovePaymaster: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateFeeTokens: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemovePaymaster: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -798,13 +4798,13 @@ func (m *MsgUpdateFeeTokens) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -814,24 +4814,23 @@ func (m *MsgUpdateFeeTokens) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeeTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -854,7 +4853,7 @@ func (m *MsgUpdateFeeTokens) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateFeeTokensResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemovePaymasterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -877,10 +4876,10 @@ func (m *MsgUpdateFeeTokensResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateFeeTokensResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemovePaymasterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateFeeTokensResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemovePaymasterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
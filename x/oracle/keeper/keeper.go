@@ -116,6 +116,20 @@ func (k Keeper) SetBaseExchangeRateWithDefault(ctx sdk.Context, denom string, ex
 	return k.ExchangeRate.Set(ctx, denom, rate)
 }
 
+// GetBaseExchangeRate returns the exchange rate by denom from the KVStore
+// The exchange rate carries the block height and timestamp of its last update
+func (k Keeper) GetBaseExchangeRate(ctx sdk.Context, denom string) (types.OracleExchangeRate, error) {
+	rate, err := k.ExchangeRate.Get(ctx, denom)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.OracleExchangeRate{}, cosmoserrors.Wrap(types.ErrUnknownDenom, denom)
+		}
+		return types.OracleExchangeRate{}, err
+	}
+
+	return rate, nil
+}
+
 // SetBaseExchangeRateWithEvent calls SetBaseExchangeRate and generate an event about that denom creation
 func (k Keeper) SetBaseExchangeRateWithEvent(ctx sdk.Context, denom string, exchangeRate math.LegacyDec) error {
 	// Set exchange rate by denom
@@ -109,6 +109,13 @@ func TestExchangeRateLogic(t *testing.T) {
 	_, err = oracleKeeper.ExchangeRate.Get(ctx, BtcUsd)
 	require.Error(t, err) // Validate error
 
+	// The getter returns the rate with its last update and fails on removed denoms
+	ethUsdRate, err = oracleKeeper.GetBaseExchangeRate(ctx, EthUsd)
+	require.NoError(t, err)
+	require.Equal(t, ts.UnixMilli(), ethUsdRate.LastUpdateTimestamp)
+	_, err = oracleKeeper.GetBaseExchangeRate(ctx, BtcUsd)
+	require.ErrorIs(t, err, types.ErrUnknownDenom)
+
 	// test iteration function
 	exchangeRateAmount := 0
 	iterationHandler := func(denom string, exchangeRate types.OracleExchangeRate) (bool, error) {
@@ -3,15 +3,21 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/kiichain/kiichain/v4/x/rewards/types"
 )
 
+// FlagBlockTime is the block time used by the per block emission of the runway query
+const FlagBlockTime = "block-time"
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -25,7 +31,12 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetCmdQueryParams(),
 		GetCmdQueryReleaseSchedule(),
+		GetCmdQueryReleaseSchedules(),
+		GetCmdQueryReleaseProjection(),
 		GetCmdQueryRewardPool(),
+		GetCmdQueryPoolHealth(),
+		GetCmdQueryRunway(),
+		GetCmdQueryStakingAPR(),
 	)
 
 	return cmd
@@ -61,8 +72,80 @@ func GetCmdQueryParams() *cobra.Command {
 // GetCmdQueryReleaseSchedule implements the release-schedule query command.
 func GetCmdQueryReleaseSchedule() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "release-schedule",
-		Short: "Query the current rewards release schedule",
+		Use:   "release-schedule [id]",
+		Short: "Query a rewards release schedule by its id",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid schedule id: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReleaseSchedule(context.Background(), &types.QueryReleaseScheduleRequest{Id: id})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryReleaseProjection implements the release-projection query command.
+func GetCmdQueryReleaseProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "release-projection [id] [time]",
+		Short:   "Query the expected cumulative release of a schedule at a future time",
+		Example: fmt.Sprintf("%s query %s release-projection 1 2030-01-01T00:00:00Z", version.AppName, types.ModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid schedule id: %w", err)
+			}
+			projectionTime, err := time.Parse(time.RFC3339, args[1])
+			if err != nil {
+				return fmt.Errorf("invalid projection time, expected RFC3339: %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReleaseProjection(context.Background(), &types.QueryReleaseProjectionRequest{
+				Id:   id,
+				Time: projectionTime,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryReleaseSchedules implements the release-schedules query command.
+func GetCmdQueryReleaseSchedules() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-schedules",
+		Short: "Query all the rewards release schedules",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -70,9 +153,14 @@ func GetCmdQueryReleaseSchedule() *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.ReleaseSchedule(context.Background(), &types.QueryReleaseScheduleRequest{})
+			res, err := queryClient.ReleaseSchedules(context.Background(), &types.QueryReleaseSchedulesRequest{Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -82,6 +170,7 @@ func GetCmdQueryReleaseSchedule() *cobra.Command {
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "release-schedules")
 	return cmd
 }
 
@@ -111,3 +200,103 @@ func GetCmdQueryRewardPool() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryPoolHealth implements the pool-health query command.
+func GetCmdQueryPoolHealth() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-health",
+		Short: "Run the reward pool accounting checks",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PoolHealth(context.Background(), &types.QueryPoolHealthRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRunway implements the runway query command.
+func GetCmdQueryRunway() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "runway [denom]",
+		Short: "Query the emission rate, the projected end time and the unallocated funds of a denom",
+		Long: `Query the emission rate per second and per block, the projected end time with the current funding and the
+unallocated funds of the pool on a denom. The token denom is used if no denom is given.`,
+		Example: fmt.Sprintf("%s query %s runway akii --%s 1s", version.AppName, types.ModuleName, FlagBlockTime),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var denom string
+			if len(args) > 0 {
+				denom = args[0]
+			}
+			blockTime, err := cmd.Flags().GetDuration(FlagBlockTime)
+			if err != nil {
+				return err
+			}
+			if blockTime < 0 {
+				return fmt.Errorf("block time cannot be negative")
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Runway(context.Background(), &types.QueryRunwayRequest{
+				Denom:       denom,
+				BlockTimeMs: uint64(blockTime.Milliseconds()),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Duration(FlagBlockTime, types.DefaultBlockTime, "Block time used by the per block emission")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryStakingAPR implements the staking-apr query command.
+func GetCmdQueryStakingAPR() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staking-apr",
+		Short: "Query the estimated APR added to the stakers by the release schedules",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.StakingAPR(context.Background(), &types.QueryStakingAPRRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -26,7 +27,12 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		NewFundPoolCmd(),
 		NewUpdateParamsCmd(),
-		NewChangeScheduleCmd(),
+		NewCreateScheduleCmd(),
+		NewAmendScheduleCmd(),
+		NewCancelScheduleCmd(),
+		NewPauseScheduleCmd(),
+		NewResumeScheduleCmd(),
+		NewClawbackCmd(),
 	)
 
 	return cmd
@@ -89,13 +95,13 @@ $ %s tx gov submit-proposal update-rewards-params <path/to/params.json> --from m
 	return cmd
 }
 
-// NewChangeScheduleCmd implements the change-schedule tx command.
-func NewChangeScheduleCmd() *cobra.Command {
+// NewCreateScheduleCmd implements the create-schedule tx command.
+func NewCreateScheduleCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "change-schedule [schedule-json]",
-		Short: "Change schedule information (gov proposal)",
-		Long: `Change schedule information through a governance proposal. Example:
-$ %s tx gov submit-proposal change-schedule <path/to/schedule.json> --from mykey
+		Use:   "create-schedule [schedule-json]",
+		Short: "Create a new release schedule (gov proposal)",
+		Long: `Create a new release schedule through a governance proposal. Example:
+$ %s tx gov submit-proposal create-schedule <path/to/schedule.json> --from mykey
 `,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -106,10 +112,151 @@ $ %s tx gov submit-proposal change-schedule <path/to/schedule.json> --from mykey
 
 			var schedule types.ReleaseSchedule
 			if err := clientCtx.Codec.UnmarshalJSON([]byte(args[0]), &schedule); err != nil {
-				return fmt.Errorf("failed to parse params: %w", err)
+				return fmt.Errorf("failed to parse schedule: %w", err)
+			}
+
+			msg := types.NewMsgCreateSchedule(clientCtx.GetFromAddress().String(), schedule)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewAmendScheduleCmd implements the amend-schedule tx command.
+func NewAmendScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "amend-schedule [schedule-json]",
+		Short: "Amend an existing release schedule by its id (gov proposal)",
+		Long: `Amend an existing release schedule through a governance proposal. Example:
+$ %s tx gov submit-proposal amend-schedule <path/to/schedule.json> --from mykey
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var schedule types.ReleaseSchedule
+			if err := clientCtx.Codec.UnmarshalJSON([]byte(args[0]), &schedule); err != nil {
+				return fmt.Errorf("failed to parse schedule: %w", err)
+			}
+
+			msg := types.NewMsgAmendSchedule(clientCtx.GetFromAddress().String(), schedule)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelScheduleCmd implements the cancel-schedule tx command.
+func NewCancelScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-schedule [id]",
+		Short: "Cancel a release schedule by its id (gov proposal)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid schedule id: %w", err)
+			}
+
+			msg := types.NewMsgCancelSchedule(clientCtx.GetFromAddress().String(), id)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewPauseScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-schedule [id]",
+		Short: "Pause the releases of a schedule by its id (gov proposal)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid schedule id: %w", err)
+			}
+
+			msg := types.NewMsgPauseSchedule(clientCtx.GetFromAddress().String(), id)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewResumeScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-schedule [id]",
+		Short: "Resume the releases of a paused schedule by its id (gov proposal)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid schedule id: %w", err)
+			}
+
+			msg := types.NewMsgResumeSchedule(clientCtx.GetFromAddress().String(), id)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [amount] [recipient]",
+		Short: "Move funds not committed to schedules out of the reward pool (gov proposal)",
+		Long:  "Move funds not committed to schedules out of the reward pool, without a recipient the funds go to the distribution community pool",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid amount: %w", err)
+			}
+
+			recipient := ""
+			if len(args) > 1 {
+				recipient = args[1]
 			}
 
-			msg := types.NewMsgChangeSchedule(clientCtx.GetFromAddress().String(), schedule)
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress().String(), amount, recipient)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -1,44 +1,77 @@
 package keeper
 
 import (
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kiichain/kiichain/v4/x/rewards/types"
 )
 
-// BeginBlocker calculates reward amt and sends it to the distribution pool
+// BeginBlocker redirects the fee share into the pool, then calculates reward amt for each schedule and sends it to
+// its destination. Schedules are released in ascending id order
 func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 	// Apply telemetry metrics
 	defer telemetry.ModuleMeasureSince(types.ModuleName, telemetry.Now(), telemetry.MetricKeyBeginBlocker)
 
-	// Get release schedule
-	schedule, err := k.ReleaseSchedule.Get(ctx)
+	// Redirect the fee share before anything is released into the fee collector
+	if _, err := k.RedirectFeeShare(ctx); err != nil {
+		return err
+	}
+
+	// Get release schedules, ordered by id
+	schedules, err := k.GetReleaseSchedules(ctx)
 	if err != nil {
 		return err
 	}
 
+	for _, schedule := range schedules {
+		if err := k.releaseSchedule(ctx, schedule); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// releaseSchedule releases the amount due on the current block for a single schedule
+func (k Keeper) releaseSchedule(ctx sdk.Context, schedule types.ReleaseSchedule) error {
 	// Early exit if inactive or nothing to release
 	if !schedule.Active || schedule.TotalAmount.IsZero() {
 		return nil
 	}
 
+	// Wait until the schedule starts
+	if !schedule.StartTime.IsZero() && ctx.BlockTime().Before(schedule.StartTime) {
+		return nil
+	}
+
 	// If active and there is no previous time stamp, set it as current block's and skip this time
 	if schedule.LastReleaseTime.IsZero() {
 		schedule.LastReleaseTime = ctx.BlockTime()
-		return k.ReleaseSchedule.Set(ctx, schedule)
+		return k.ReleaseSchedules.Set(ctx, schedule.Id, schedule)
 	}
 
 	// Calculate the amount to distribute this block
 	amountToDistribute, err := types.CalculateReward(ctx.BlockTime(), schedule)
 	if err != nil {
-		return err
+		k.releaseFailed(ctx, schedule, sdk.NewCoin(schedule.TotalAmount.Denom, math.ZeroInt()), err)
+		return nil
 	}
 
-	// If nothing to distribute, sets up as inactive for early exit next time
+	// If nothing to distribute, the curve may be flat for now (before a cliff or between steps)
 	if amountToDistribute.IsZero() {
+		if unreleasedAmount(schedule).IsPositive() {
+			return nil
+		}
+
+		// Everything was released, sets up as inactive for early exit next time
 		schedule.Active = false
-		return k.ReleaseSchedule.Set(ctx, schedule)
+		return k.ReleaseSchedules.Set(ctx, schedule.Id, schedule)
 	}
 
 	// Get the current RewardPool from state
@@ -47,16 +80,21 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 		return err
 	}
 
-	// Set up coins
-	coinsToDistribute := sdk.NewCoins(amountToDistribute)
-
-	// Send to distribution pool
-	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, coinsToDistribute); err != nil {
-		return err
+	// Deduct from RewardPool, the schedules share the pool so it may not cover the release
+	communityPool, hasNeg := rewardPool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(amountToDistribute))
+	if hasNeg {
+		k.releaseFailed(ctx, schedule, amountToDistribute, fmt.Errorf("reward pool %s can't cover the release", rewardPool.CommunityPool))
+		return nil
 	}
+	rewardPool.CommunityPool = communityPool
 
-	// Deduct from RewardPool
-	rewardPool.CommunityPool = rewardPool.CommunityPool.Sub(sdk.NewDecCoinsFromCoins(coinsToDistribute...))
+	// Send to the schedule destinations
+	// A destination rejecting the funds must not halt the chain, the schedule is skipped for this block
+	// Its last release time is kept, so the amount is released once the destination accepts it again
+	if err := k.releaseToSplits(ctx, schedule, amountToDistribute); err != nil {
+		k.releaseFailed(ctx, schedule, amountToDistribute, err)
+		return nil
+	}
 
 	// Save change
 	if err := k.RewardPool.Set(ctx, rewardPool); err != nil {
@@ -66,5 +104,18 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) error {
 	// Update release schedule
 	schedule.LastReleaseTime = ctx.BlockTime()
 	schedule.ReleasedAmount = schedule.ReleasedAmount.Add(amountToDistribute)
-	return k.ReleaseSchedule.Set(ctx, schedule)
+	return k.ReleaseSchedules.Set(ctx, schedule.Id, schedule)
+}
+
+// releaseFailed logs and emits the failure of a schedule release
+// The schedule is skipped for the block, so a failed release never halts the chain
+func (k Keeper) releaseFailed(ctx sdk.Context, schedule types.ReleaseSchedule, amount sdk.Coin, err error) {
+	k.Logger(ctx).Error("failed to release schedule", "schedule_id", schedule.Id, "amount", amount, "error", err)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeReleaseFailed,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(schedule.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		),
+	)
 }
@@ -6,10 +6,14 @@ import (
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
+	oracletypes "github.com/kiichain/kiichain/v4/x/oracle/types"
 	"github.com/kiichain/kiichain/v4/x/rewards/types"
 )
 
+// TestEndBlocker tests the release of a single schedule
 func (suite *KeeperTestSuite) TestEndBlocker() {
 	// Set up default params
 	defaultParams := types.DefaultParams()
@@ -143,7 +147,8 @@ func (suite *KeeperTestSuite) TestEndBlocker() {
 			ctx := suite.Ctx.WithBlockTime(tc.blockTime)
 
 			// Set initial schedule state
-			err := suite.App.RewardsKeeper.ReleaseSchedule.Set(ctx, tc.initialSchedule)
+			tc.initialSchedule.Id = 1
+			err := suite.App.RewardsKeeper.ReleaseSchedules.Set(ctx, tc.initialSchedule.Id, tc.initialSchedule)
 			suite.Require().NoError(err)
 
 			// Set initial pool state if needed
@@ -164,7 +169,7 @@ func (suite *KeeperTestSuite) TestEndBlocker() {
 
 			if tc.expectedChange {
 				// Verify schedule state
-				schedule, err := suite.App.RewardsKeeper.ReleaseSchedule.Get(ctx)
+				schedule, err := suite.App.RewardsKeeper.ReleaseSchedules.Get(ctx, tc.initialSchedule.Id)
 				suite.Require().NoError(err)
 				suite.Require().Equal(tc.expectedSchedule.Active, schedule.Active)
 				suite.Require().Equal(tc.expectedSchedule.ReleasedAmount, schedule.ReleasedAmount)
@@ -189,3 +194,406 @@ func (suite *KeeperTestSuite) TestEndBlocker() {
 		})
 	}
 }
+
+// TestBeginBlockerMultipleSchedules tests the release of concurrent schedules
+func (suite *KeeperTestSuite) TestBeginBlockerMultipleSchedules() {
+	// Set up default params
+	defaultParams := types.DefaultParams()
+	denom := defaultParams.TokenDenom
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+
+	// Fund the reward pool
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(10000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	now := time.Now()
+	destination := suite.TestAccs[1]
+
+	// Prepare the schedules
+	schedules := []types.ReleaseSchedule{
+		{
+			// Releases half of the amount to the fee collector
+			Id:              1,
+			TotalAmount:     sdk.NewCoin(denom, math.NewInt(1000)),
+			ReleasedAmount:  sdk.NewCoin(denom, math.ZeroInt()),
+			LastReleaseTime: now,
+			EndTime:         now.Add(time.Hour * 2),
+			Active:          true,
+		},
+		{
+			// Releases half of the amount to the destination
+			Id:              2,
+			TotalAmount:     sdk.NewCoin(denom, math.NewInt(4000)),
+			ReleasedAmount:  sdk.NewCoin(denom, math.ZeroInt()),
+			LastReleaseTime: now,
+			EndTime:         now.Add(time.Hour * 2),
+			Destination:     destination.String(),
+			Active:          true,
+		},
+		{
+			// Has not started yet
+			Id:             3,
+			TotalAmount:    sdk.NewCoin(denom, math.NewInt(2000)),
+			ReleasedAmount: sdk.NewCoin(denom, math.ZeroInt()),
+			StartTime:      now.Add(time.Hour * 2),
+			EndTime:        now.Add(time.Hour * 3),
+			Active:         true,
+		},
+		{
+			// Inactive
+			Id:              4,
+			TotalAmount:     sdk.NewCoin(denom, math.NewInt(2000)),
+			ReleasedAmount:  sdk.NewCoin(denom, math.ZeroInt()),
+			LastReleaseTime: now,
+			EndTime:         now.Add(time.Hour * 2),
+			Active:          false,
+		},
+	}
+	for _, schedule := range schedules {
+		err := suite.App.RewardsKeeper.ReleaseSchedules.Set(suite.Ctx, schedule.Id, schedule)
+		suite.Require().NoError(err)
+	}
+
+	// Get initial balances
+	ctx := suite.Ctx.WithBlockTime(now.Add(time.Hour))
+	feeCollectorAddr := suite.App.AccountKeeper.GetModuleAddress("fee_collector")
+	initialFeeCollectorBalance := suite.App.BankKeeper.GetBalance(ctx, feeCollectorAddr, denom)
+	initialDestinationBalance := suite.App.BankKeeper.GetBalance(ctx, destination, denom)
+
+	// Execute BeginBlocker
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+
+	// Check the released amounts
+	expectedReleased := []math.Int{math.NewInt(500), math.NewInt(2000), math.ZeroInt(), math.ZeroInt()}
+	for i, expected := range expectedReleased {
+		schedule, err := suite.App.RewardsKeeper.ReleaseSchedules.Get(ctx, schedules[i].Id)
+		suite.Require().NoError(err)
+		suite.Require().Equal(expected, schedule.ReleasedAmount.Amount)
+	}
+
+	// Each schedule was sent to its destination
+	feeCollectorBalance := suite.App.BankKeeper.GetBalance(ctx, feeCollectorAddr, denom)
+	suite.Require().Equal(initialFeeCollectorBalance.Amount.AddRaw(500), feeCollectorBalance.Amount)
+	destinationBalance := suite.App.BankKeeper.GetBalance(ctx, destination, denom)
+	suite.Require().Equal(initialDestinationBalance.Amount.AddRaw(2000), destinationBalance.Amount)
+
+	// The pool was deducted by the sum of the releases
+	rewardPool, err := suite.App.RewardsKeeper.RewardPool.Get(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.LegacyNewDec(7500), rewardPool.CommunityPool.AmountOf(denom))
+
+	// The schedule that has not started yet sets its first release time once it starts
+	ctx = suite.Ctx.WithBlockTime(now.Add(time.Hour * 2))
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+	schedule, err := suite.App.RewardsKeeper.ReleaseSchedules.Get(ctx, 3)
+	suite.Require().NoError(err)
+	suite.Require().True(schedule.LastReleaseTime.Equal(now.Add(time.Hour * 2)))
+	suite.Require().True(schedule.ReleasedAmount.IsZero())
+}
+
+// TestBeginBlockerCliffSchedule tests that a cliff schedule stays active until the cliff and then catches up
+func (suite *KeeperTestSuite) TestBeginBlockerCliffSchedule() {
+	// Set up default params
+	defaultParams := types.DefaultParams()
+	denom := defaultParams.TokenDenom
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+
+	// Fund the reward pool
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(1000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	// Prepare a schedule with a cliff on half of its duration
+	now := time.Now()
+	schedule := types.ReleaseSchedule{
+		Id:             1,
+		TotalAmount:    sdk.NewCoin(denom, math.NewInt(1000)),
+		ReleasedAmount: sdk.NewCoin(denom, math.ZeroInt()),
+		StartTime:      now,
+		EndTime:        now.Add(time.Hour * 4),
+		Curve:          types.ReleaseCurveCliff,
+		CliffTime:      now.Add(time.Hour * 2),
+		Active:         true,
+	}
+	err = suite.App.RewardsKeeper.ReleaseSchedules.Set(suite.Ctx, schedule.Id, schedule)
+	suite.Require().NoError(err)
+
+	// Release on every hour and check the released amounts
+	expectedReleased := []int64{0, 0, 500, 750, 1000}
+	for hour, expected := range expectedReleased {
+		ctx := suite.Ctx.WithBlockTime(now.Add(time.Duration(hour) * time.Hour))
+		err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+		suite.Require().NoError(err)
+
+		schedule, err = suite.App.RewardsKeeper.ReleaseSchedules.Get(ctx, 1)
+		suite.Require().NoError(err)
+		suite.Require().Equal(math.NewInt(expected), schedule.ReleasedAmount.Amount, "hour %d", hour)
+
+		// The schedule stays active before the cliff, even with nothing to release
+		suite.Require().True(schedule.Active, "hour %d", hour)
+	}
+
+	// Nothing is left after the end time, so the schedule is set inactive
+	ctx := suite.Ctx.WithBlockTime(now.Add(time.Hour * 5))
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+	schedule, err = suite.App.RewardsKeeper.ReleaseSchedules.Get(ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().False(schedule.Active)
+}
+
+// TestBeginBlockerSplits tests the release of a schedule split across destinations
+func (suite *KeeperTestSuite) TestBeginBlockerSplits() {
+	// Set up default params
+	defaultParams := types.DefaultParams()
+	denom := defaultParams.TokenDenom
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+
+	// Fund the reward pool
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(10000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	now := time.Now()
+	destination := suite.TestAccs[1]
+	feeCollectorAddr := suite.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	oracleAddr := suite.App.AccountKeeper.GetModuleAddress(oracletypes.ModuleName)
+
+	// Releases 1000 on the block, split on all the destinations
+	schedule := types.ReleaseSchedule{
+		Id:              1,
+		TotalAmount:     sdk.NewCoin(denom, math.NewInt(2000)),
+		ReleasedAmount:  sdk.NewCoin(denom, math.ZeroInt()),
+		LastReleaseTime: now,
+		EndTime:         now.Add(time.Hour * 2),
+		Active:          true,
+		Splits: []types.ReleaseSplit{
+			{Type: types.DestinationFeeCollector, Weight: math.LegacyNewDecWithPrec(4, 1)},
+			{Type: types.DestinationCommunityPool, Weight: math.LegacyNewDecWithPrec(3, 1)},
+			{Type: types.DestinationModuleAccount, Target: oracletypes.ModuleName, Weight: math.LegacyNewDecWithPrec(2, 1)},
+			{Type: types.DestinationAddress, Target: destination.String(), Weight: math.LegacyNewDecWithPrec(1, 1)},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		malleate     func(schedule *types.ReleaseSchedule)
+		expectedPass bool
+	}{
+		{
+			name:         "success - release split across destinations",
+			malleate:     func(*types.ReleaseSchedule) {},
+			expectedPass: true,
+		},
+		{
+			name: "skip - a destination can't receive, nothing is sent",
+			malleate: func(schedule *types.ReleaseSchedule) {
+				// The last destination is blocked, after the others were already sent
+				schedule.Splits[3].Target = suite.App.AccountKeeper.GetModuleAddress(distrtypes.ModuleName).String()
+			},
+			expectedPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Set a cached context
+			ctx, _ := suite.Ctx.CacheContext()
+			ctx = ctx.WithBlockTime(now.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+
+			// Set the schedule
+			caseSchedule := schedule
+			caseSchedule.Splits = append([]types.ReleaseSplit{}, schedule.Splits...)
+			tc.malleate(&caseSchedule)
+			err := suite.App.RewardsKeeper.ReleaseSchedules.Set(ctx, caseSchedule.Id, caseSchedule)
+			suite.Require().NoError(err)
+
+			// Get initial balances
+			initialFeeCollector := suite.App.BankKeeper.GetBalance(ctx, feeCollectorAddr, denom).Amount
+			initialOracle := suite.App.BankKeeper.GetBalance(ctx, oracleAddr, denom).Amount
+			initialDestination := suite.App.BankKeeper.GetBalance(ctx, destination, denom).Amount
+			initialFeePool, err := suite.App.DistrKeeper.FeePool.Get(ctx)
+			suite.Require().NoError(err)
+			initialCommunityPool := initialFeePool.CommunityPool.AmountOf(denom)
+
+			// Execute BeginBlocker
+			err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+
+			// Check the shares of each destination
+			expectedShares := []int64{400, 300, 200, 100}
+			expectedEvents := len(expectedShares)
+			// A failed release doesn't halt the chain
+			suite.Require().NoError(err)
+			if !tc.expectedPass {
+				expectedShares = []int64{0, 0, 0, 0}
+				expectedEvents = 0
+			}
+
+			feePool, err := suite.App.DistrKeeper.FeePool.Get(ctx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(initialFeeCollector.AddRaw(expectedShares[0]), suite.App.BankKeeper.GetBalance(ctx, feeCollectorAddr, denom).Amount)
+			suite.Require().Equal(initialCommunityPool.Add(math.LegacyNewDec(expectedShares[1])), feePool.CommunityPool.AmountOf(denom))
+			suite.Require().Equal(initialOracle.AddRaw(expectedShares[2]), suite.App.BankKeeper.GetBalance(ctx, oracleAddr, denom).Amount)
+			suite.Require().Equal(initialDestination.AddRaw(expectedShares[3]), suite.App.BankKeeper.GetBalance(ctx, destination, denom).Amount)
+
+			// An event is emitted for each destination
+			releaseEvents := 0
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeRelease {
+					releaseEvents++
+				}
+			}
+			suite.Require().Equal(expectedEvents, releaseEvents)
+
+			// A failed release is skipped, the schedule keeps its release state and it's retried on the next block
+			if !tc.expectedPass {
+				stored, err := suite.App.RewardsKeeper.ReleaseSchedules.Get(ctx, caseSchedule.Id)
+				suite.Require().NoError(err)
+				suite.Require().True(stored.Active)
+				suite.Require().True(stored.ReleasedAmount.IsZero())
+				suite.Require().True(now.Equal(stored.LastReleaseTime))
+
+				rewardPool, err := suite.App.RewardsKeeper.RewardPool.Get(ctx)
+				suite.Require().NoError(err)
+				suite.Require().Equal(math.LegacyNewDec(10000), rewardPool.CommunityPool.AmountOf(denom))
+
+				failedEvents := 0
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type == types.EventTypeReleaseFailed {
+						failedEvents++
+					}
+				}
+				suite.Require().Equal(1, failedEvents)
+			}
+		})
+	}
+}
+
+// TestBeginBlockerFailedRelease tests that a schedule failing to release doesn't stop the other schedules
+func (suite *KeeperTestSuite) TestBeginBlockerFailedRelease() {
+	// Set up default params
+	defaultParams := types.DefaultParams()
+	denom := defaultParams.TokenDenom
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+
+	// Fund the reward pool
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(10000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	now := time.Now()
+	ctx, _ := suite.Ctx.CacheContext()
+	ctx = ctx.WithBlockTime(now.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+
+	// Both schedules release 1000 on the block, the first one to a destination rejecting the funds
+	blocked := types.ReleaseSchedule{
+		Id:              1,
+		TotalAmount:     sdk.NewCoin(denom, math.NewInt(2000)),
+		ReleasedAmount:  sdk.NewCoin(denom, math.ZeroInt()),
+		LastReleaseTime: now,
+		EndTime:         now.Add(time.Hour * 2),
+		Destination:     suite.App.AccountKeeper.GetModuleAddress(distrtypes.ModuleName).String(),
+		Active:          true,
+	}
+	valid := blocked
+	valid.Id = 2
+	valid.Destination = suite.TestAccs[1].String()
+	for _, schedule := range []types.ReleaseSchedule{blocked, valid} {
+		suite.Require().NoError(suite.App.RewardsKeeper.ReleaseSchedules.Set(ctx, schedule.Id, schedule))
+	}
+	initialDestination := suite.App.BankKeeper.GetBalance(ctx, suite.TestAccs[1], denom).Amount
+
+	// Execute BeginBlocker
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+
+	// The blocked schedule is skipped
+	stored, err := suite.App.RewardsKeeper.ReleaseSchedules.Get(ctx, blocked.Id)
+	suite.Require().NoError(err)
+	suite.Require().True(stored.ReleasedAmount.IsZero())
+	suite.Require().True(now.Equal(stored.LastReleaseTime))
+	suite.Require().True(hasEventAttribute(ctx, types.EventTypeReleaseFailed, types.AttributeKeyScheduleID, "1"))
+
+	// The other schedule is released
+	stored, err = suite.App.RewardsKeeper.ReleaseSchedules.Get(ctx, valid.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(1000), stored.ReleasedAmount.Amount)
+	suite.Require().Equal(initialDestination.AddRaw(1000), suite.App.BankKeeper.GetBalance(ctx, suite.TestAccs[1], denom).Amount)
+
+	// Only the released amount left the pool
+	rewardPool, err := suite.App.RewardsKeeper.RewardPool.Get(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.LegacyNewDec(9000), rewardPool.CommunityPool.AmountOf(denom))
+}
+
+// TestBeginBlockerInvalidRelease tests that the schedules that can't compute or cover their release are skipped
+func (suite *KeeperTestSuite) TestBeginBlockerInvalidRelease() {
+	// Set up default params
+	defaultParams := types.DefaultParams()
+	denom := defaultParams.TokenDenom
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+
+	now := time.Now()
+	testCases := []struct {
+		name     string
+		poolFund int64
+		schedule types.ReleaseSchedule
+	}{
+		{
+			name:     "end time equal to the last release",
+			poolFund: 10000,
+			schedule: types.ReleaseSchedule{
+				Id:              1,
+				TotalAmount:     sdk.NewCoin(denom, math.NewInt(1000)),
+				ReleasedAmount:  sdk.NewCoin(denom, math.ZeroInt()),
+				LastReleaseTime: now,
+				EndTime:         now,
+				Active:          true,
+			},
+		},
+		{
+			name:     "reward pool below the release",
+			poolFund: 100,
+			schedule: types.ReleaseSchedule{
+				Id:              1,
+				TotalAmount:     sdk.NewCoin(denom, math.NewInt(1000)),
+				ReleasedAmount:  sdk.NewCoin(denom, math.ZeroInt()),
+				LastReleaseTime: now,
+				EndTime:         now.Add(time.Hour * 2),
+				Active:          true,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.Ctx.CacheContext()
+			ctx = ctx.WithBlockTime(now.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+
+			// Fund the reward pool and set the schedule
+			err := suite.App.RewardsKeeper.FundCommunityPool(ctx, sdk.NewCoin(denom, math.NewInt(tc.poolFund)), suite.TestAccs[0])
+			suite.Require().NoError(err)
+			suite.Require().NoError(suite.App.RewardsKeeper.ReleaseSchedules.Set(ctx, tc.schedule.Id, tc.schedule))
+
+			// The chain doesn't halt
+			err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+			suite.Require().NoError(err)
+
+			// The schedule is skipped
+			stored, err := suite.App.RewardsKeeper.ReleaseSchedules.Get(ctx, tc.schedule.Id)
+			suite.Require().NoError(err)
+			suite.Require().True(stored.ReleasedAmount.IsZero())
+			suite.Require().True(now.Equal(stored.LastReleaseTime))
+			suite.Require().True(hasEventAttribute(ctx, types.EventTypeReleaseFailed, types.AttributeKeyScheduleID, "1"))
+
+			// The pool is untouched
+			rewardPool, err := suite.App.RewardsKeeper.RewardPool.Get(ctx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(math.LegacyNewDec(tc.poolFund), rewardPool.CommunityPool.AmountOf(denom))
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v4/x/rewards/types"
+)
+
+// RedirectFeeShare moves the fee share of the fee collector balance on the token denom into the reward pool
+// It must run before the distribution module allocates the fee collector balance, and before the schedules
+// release into the fee collector, so released rewards are not sent back to the pool
+func (k Keeper) RedirectFeeShare(ctx context.Context) (sdk.Coin, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// Early exit if the fee share is disabled
+	if !params.IsFeeShareEnabled() {
+		return sdk.NewCoin(params.TokenDenom, math.ZeroInt()), nil
+	}
+
+	// Calculate the share of the fee collector balance, rounded down
+	feeCollector := k.accountKeeper.GetModuleAddress(k.feeCollectorName)
+	balance := k.bankKeeper.GetBalance(ctx, feeCollector, params.TokenDenom)
+	share := sdk.NewCoin(params.TokenDenom, params.FeeShare.MulInt(balance.Amount).TruncateInt())
+	if share.IsZero() {
+		return share, nil
+	}
+
+	// Move the share into the module account and the pool
+	coins := sdk.NewCoins(share)
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, coins); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	rewardPool, err := k.RewardPool.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	rewardPool.CommunityPool = rewardPool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(coins...)...)
+	if err := k.RewardPool.Set(ctx, rewardPool); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeFeeShare,
+			sdk.NewAttribute(types.AttributeKeyFeeShare, params.FeeShare.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, share.String()),
+		),
+	)
+
+	return share, nil
+}
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kiichain/kiichain/v4/x/rewards/types"
+)
+
+// TestRedirectFeeShare tests the share of the fee collector balance moved into the pool
+func (suite *KeeperTestSuite) TestRedirectFeeShare() {
+	denom := types.DefaultParams().TokenDenom
+	feeCollectorAddr := suite.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	testCases := []struct {
+		name           string
+		feeShare       math.LegacyDec
+		fees           sdk.Coins
+		expectedAmount math.Int
+	}{
+		{
+			name:           "disabled - zero fee share",
+			feeShare:       math.LegacyZeroDec(),
+			fees:           sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1000))),
+			expectedAmount: math.ZeroInt(),
+		},
+		{
+			name:           "disabled - unset fee share",
+			feeShare:       math.LegacyDec{},
+			fees:           sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1000))),
+			expectedAmount: math.ZeroInt(),
+		},
+		{
+			name:           "success - share of the fees",
+			feeShare:       math.LegacyNewDecWithPrec(1, 1),
+			fees:           sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1000))),
+			expectedAmount: math.NewInt(100),
+		},
+		{
+			name:           "success - share is rounded down",
+			feeShare:       math.LegacyNewDecWithPrec(1, 1),
+			fees:           sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1009))),
+			expectedAmount: math.NewInt(100),
+		},
+		{
+			name:           "success - all the fees",
+			feeShare:       math.LegacyOneDec(),
+			fees:           sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1000))),
+			expectedAmount: math.NewInt(1000),
+		},
+		{
+			name:           "success - other denoms are not taken",
+			feeShare:       math.LegacyNewDecWithPrec(5, 1),
+			fees:           sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(1000))),
+			expectedAmount: math.ZeroInt(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Set a cached context
+			ctx, _ := suite.Ctx.CacheContext()
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+			// Set the fee share
+			params := types.DefaultParams()
+			params.FeeShare = tc.feeShare
+			err := suite.App.RewardsKeeper.Params.Set(ctx, params)
+			suite.Require().NoError(err)
+
+			// Empty the fee collector and add the fees of the block
+			balance := suite.App.BankKeeper.GetAllBalances(ctx, feeCollectorAddr)
+			err = suite.App.BankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, suite.TestAccs[1], balance)
+			suite.Require().NoError(err)
+			suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(1000))))
+			err = suite.App.BankKeeper.SendCoinsFromAccountToModule(ctx, suite.TestAccs[0], authtypes.FeeCollectorName, tc.fees)
+			suite.Require().NoError(err)
+
+			initialPool, err := suite.App.RewardsKeeper.RewardPool.Get(ctx)
+			suite.Require().NoError(err)
+
+			// Redirect the fee share
+			share, err := suite.App.RewardsKeeper.RedirectFeeShare(ctx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedAmount.String(), share.Amount.String())
+
+			// The share left the fee collector and reached the pool
+			feeCollectorBalance := suite.App.BankKeeper.GetBalance(ctx, feeCollectorAddr, denom).Amount
+			suite.Require().Equal(tc.fees.AmountOf(denom).Sub(tc.expectedAmount).String(), feeCollectorBalance.String())
+			rewardPool, err := suite.App.RewardsKeeper.RewardPool.Get(ctx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(
+				initialPool.CommunityPool.AmountOf(denom).Add(math.LegacyNewDecFromInt(tc.expectedAmount)),
+				rewardPool.CommunityPool.AmountOf(denom),
+			)
+
+			// An event is only emitted when funds are moved
+			numEvents := 0
+			if tc.expectedAmount.IsPositive() {
+				numEvents = 1
+			}
+			suite.AssertEventEmitted(ctx, types.EventTypeFeeShare, numEvents)
+		})
+	}
+}
+
+// TestBeginBlockerFeeShareBeforeRelease tests that the released rewards are not redirected back into the pool
+func (suite *KeeperTestSuite) TestBeginBlockerFeeShareBeforeRelease() {
+	denom := types.DefaultParams().TokenDenom
+	feeCollectorAddr := suite.App.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	now := time.Now()
+
+	ctx, _ := suite.Ctx.CacheContext()
+	ctx = ctx.WithBlockTime(now.Add(time.Hour))
+
+	// Redirect half of the fees
+	params := types.DefaultParams()
+	params.FeeShare = math.LegacyNewDecWithPrec(5, 1)
+	err := suite.App.RewardsKeeper.Params.Set(ctx, params)
+	suite.Require().NoError(err)
+
+	// Fund the pool and add 1000 of fees on the fee collector
+	err = suite.App.RewardsKeeper.FundCommunityPool(ctx, sdk.NewCoin(denom, math.NewInt(10000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+	balance := suite.App.BankKeeper.GetAllBalances(ctx, feeCollectorAddr)
+	err = suite.App.BankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, suite.TestAccs[1], balance)
+	suite.Require().NoError(err)
+	err = suite.App.BankKeeper.SendCoinsFromAccountToModule(ctx, suite.TestAccs[0], authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(1000))))
+	suite.Require().NoError(err)
+
+	// Releases 1000 on the block to the fee collector
+	err = suite.App.RewardsKeeper.ReleaseSchedules.Set(ctx, 1, types.ReleaseSchedule{
+		Id:              1,
+		TotalAmount:     sdk.NewCoin(denom, math.NewInt(2000)),
+		ReleasedAmount:  sdk.NewCoin(denom, math.ZeroInt()),
+		LastReleaseTime: now,
+		EndTime:         now.Add(time.Hour * 2),
+		Active:          true,
+	})
+	suite.Require().NoError(err)
+
+	// Run the begin blocker
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+
+	// Half of the fees went to the pool, the release stays on the fee collector
+	feeCollectorBalance := suite.App.BankKeeper.GetBalance(ctx, feeCollectorAddr, denom).Amount
+	suite.Require().Equal(math.NewInt(1500), feeCollectorBalance)
+	rewardPool, err := suite.App.RewardsKeeper.RewardPool.Get(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.LegacyNewDec(9500), rewardPool.CommunityPool.AmountOf(denom))
+}
@@ -16,7 +16,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		panic(err)
 	}
 
-	if err := k.ReleaseSchedule.Set(ctx, data.ReleaseSchedule); err != nil {
+	for _, schedule := range data.ReleaseSchedules {
+		if err := k.ReleaseSchedules.Set(ctx, schedule.Id, schedule); err != nil {
+			panic(err)
+		}
+	}
+
+	if err := k.NextScheduleID.Set(ctx, data.NextScheduleId); err != nil {
 		panic(err)
 	}
 }
@@ -33,10 +39,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
-	releaseSchedule, err := k.ReleaseSchedule.Get(ctx)
+	releaseSchedules, err := k.GetReleaseSchedules(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	nextScheduleID, err := k.NextScheduleID.Peek(ctx)
 	if err != nil {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, rewardPool, releaseSchedule)
+	return types.NewGenesisState(params, rewardPool, releaseSchedules, nextScheduleID)
 }
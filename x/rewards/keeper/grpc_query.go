@@ -2,6 +2,15 @@ package keeper
 
 import (
 	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/kiichain/kiichain/v4/x/rewards/types"
 )
@@ -32,14 +41,140 @@ func (k Querier) RewardPool(ctx context.Context, _ *types.QueryRewardPoolRequest
 	if err != nil {
 		return nil, err
 	}
-	return &types.QueryRewardPoolResponse{RewardPool: pool}, nil
+	balances, err := k.Keeper.GetPoolBalances(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryRewardPoolResponse{RewardPool: pool, Balances: balances}, nil
 }
 
-// ReleaseSchedule queries the schedule information
-func (k Querier) ReleaseSchedule(ctx context.Context, _ *types.QueryReleaseScheduleRequest) (*types.QueryReleaseScheduleResponse, error) {
-	schedule, err := k.Keeper.ReleaseSchedule.Get(ctx)
+// ReleaseSchedule queries the information of a single schedule
+func (k Querier) ReleaseSchedule(ctx context.Context, req *types.QueryReleaseScheduleRequest) (*types.QueryReleaseScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	schedule, err := k.Keeper.GetReleaseSchedule(ctx, req.Id)
 	if err != nil {
-		return nil, err
+		return nil, status.Error(codes.NotFound, err.Error())
 	}
 	return &types.QueryReleaseScheduleResponse{ReleaseSchedule: schedule}, nil
 }
+
+// ReleaseSchedules queries the information of all the schedules
+func (k Querier) ReleaseSchedules(ctx context.Context, req *types.QueryReleaseSchedulesRequest) (*types.QueryReleaseSchedulesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	schedules, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.Keeper.ReleaseSchedules,
+		req.Pagination,
+		func(_ uint64, schedule types.ReleaseSchedule) (types.ReleaseSchedule, error) {
+			return schedule, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryReleaseSchedulesResponse{ReleaseSchedules: schedules, Pagination: pageRes}, nil
+}
+
+// ReleaseProjection queries the expected cumulative release of a schedule at a future time
+func (k Querier) ReleaseProjection(ctx context.Context, req *types.QueryReleaseProjectionRequest) (*types.QueryReleaseProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	schedule, err := k.Keeper.GetReleaseSchedule(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	// Only future times can be projected
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if req.Time.Before(blockTime) {
+		return nil, status.Errorf(codes.InvalidArgument, "projection time %s is before the block time %s", req.Time, blockTime)
+	}
+
+	released := types.ProjectRelease(schedule, blockTime, req.Time)
+	return &types.QueryReleaseProjectionResponse{
+		ReleasedAmount:  sdk.NewCoin(schedule.TotalAmount.Denom, released),
+		RemainingAmount: sdk.NewCoin(schedule.TotalAmount.Denom, schedule.TotalAmount.Amount.Sub(released)),
+	}, nil
+}
+
+// PoolHealth runs the reward pool accounting checks, the same ones registered as invariants
+func (k Querier) PoolHealth(ctx context.Context, _ *types.QueryPoolHealthRequest) (*types.QueryPoolHealthResponse, error) {
+	checks := k.Keeper.RunAccountingChecks(sdk.UnwrapSDKContext(ctx))
+
+	healthy := true
+	for _, check := range checks {
+		healthy = healthy && !check.Broken
+	}
+
+	return &types.QueryPoolHealthResponse{Healthy: healthy, Checks: checks}, nil
+}
+
+// Runway queries the emission rate, the projected end time and the unallocated funds of a denom
+func (k Querier) Runway(ctx context.Context, req *types.QueryRunwayRequest) (*types.QueryRunwayResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// Default to the token denom and block time
+	denom := req.Denom
+	if denom == "" {
+		params, err := k.Keeper.Params.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		denom = params.TokenDenom
+	}
+	blockTime := types.DefaultBlockTime
+	if req.BlockTimeMs != 0 {
+		blockTime = time.Duration(req.BlockTimeMs) * time.Millisecond
+	}
+
+	rate, err := k.Keeper.GetEmissionRate(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+	end, funded, err := k.Keeper.GetProjectedEndTime(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// The unallocated amount is the free amount of the pool on the denom
+	unallocated := math.LegacyZeroDec()
+	balances, err := k.Keeper.GetPoolBalances(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, balance := range balances {
+		if balance.Denom == denom {
+			unallocated = balance.FreeAmount
+		}
+	}
+
+	return &types.QueryRunwayResponse{
+		Denom:             denom,
+		EmissionPerSecond: rate,
+		EmissionPerBlock:  rate.Mul(math.LegacyNewDec(blockTime.Milliseconds())).QuoInt64(1000),
+		ProjectedEndTime:  end,
+		FullyFunded:       funded,
+		UnallocatedAmount: unallocated,
+	}, nil
+}
+
+// StakingAPR queries the estimated APR added to the stakers by the schedules
+func (k Querier) StakingAPR(ctx context.Context, _ *types.QueryStakingAPRRequest) (*types.QueryStakingAPRResponse, error) {
+	apr, annual, bonded, err := k.Keeper.GetStakingAPR(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryStakingAPRResponse{Apr: apr, AnnualEmission: annual, BondedTokens: bonded}, nil
+}
@@ -1,9 +1,12 @@
 package keeper_test
 
 import (
+	"time"
+
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/kiichain/kiichain/v4/x/rewards/keeper"
 	"github.com/kiichain/kiichain/v4/x/rewards/types"
@@ -106,6 +109,11 @@ func (suite *KeeperTestSuite) TestQuerierRewardPool() {
 				expectedPool, err := suite.App.RewardsKeeper.RewardPool.Get(suite.Ctx)
 				suite.Require().NoError(err)
 				suite.Require().Equal(expectedPool, res.RewardPool)
+
+				// Verify the balances per denom
+				expectedBalances, err := suite.App.RewardsKeeper.GetPoolBalances(suite.Ctx)
+				suite.Require().NoError(err)
+				suite.Require().Equal(expectedBalances, res.Balances)
 			} else {
 				suite.Require().Error(err)
 			}
@@ -113,53 +121,101 @@ func (suite *KeeperTestSuite) TestQuerierRewardPool() {
 	}
 }
 
-func (suite *KeeperTestSuite) TestQuerierReleaseSchedule() {
-	// Initialize an empty schedule
-	err := suite.App.RewardsKeeper.ReleaseSchedule.Set(suite.Ctx, types.ReleaseSchedule{})
+// TestQuerierRewardPoolBalances tests the committed and free amounts per denom of the pool
+func (suite *KeeperTestSuite) TestQuerierRewardPoolBalances() {
+	querier := keeper.NewQuerier(suite.App.RewardsKeeper)
+	denom := types.DefaultParams().TokenDenom
+
+	// Fund the pool with two denoms
+	suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(5000))))
+	err := suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(10000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin("uusdc", math.NewInt(5000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	// Commit part of the token denom and more than the pool holds of a third denom
+	err = suite.App.RewardsKeeper.ReleaseSchedules.Set(suite.Ctx, 1, types.ReleaseSchedule{
+		Id:             1,
+		TotalAmount:    sdk.NewCoin(denom, math.NewInt(6000)),
+		ReleasedAmount: sdk.NewCoin(denom, math.NewInt(1000)),
+		Active:         true,
+	})
+	suite.Require().NoError(err)
+	err = suite.App.RewardsKeeper.ReleaseSchedules.Set(suite.Ctx, 2, types.ReleaseSchedule{
+		Id:             2,
+		TotalAmount:    sdk.NewCoin("uatom", math.NewInt(300)),
+		ReleasedAmount: sdk.NewCoin("uatom", math.ZeroInt()),
+		Active:         true,
+	})
 	suite.Require().NoError(err)
 
+	res, err := querier.RewardPool(suite.Ctx, &types.QueryRewardPoolRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Balances, 3)
+
+	expected := []struct {
+		denom                 string
+		pool, committed, free int64
+	}{
+		{denom, 10000, 5000, 5000},
+		{"uatom", 0, 300, 0},
+		{"uusdc", 5000, 0, 5000},
+	}
+	for i, e := range expected {
+		balance := res.Balances[i]
+		suite.Require().Equal(e.denom, balance.Denom)
+		suite.Require().Equal(e.pool, balance.PoolAmount.TruncateInt64())
+		suite.Require().Equal(e.committed, balance.CommittedAmount.Int64())
+		suite.Require().Equal(e.free, balance.FreeAmount.TruncateInt64())
+	}
+}
+
+// TestQuerierReleaseSchedule tests querying a single schedule
+func (suite *KeeperTestSuite) TestQuerierReleaseSchedule() {
 	querier := keeper.NewQuerier(suite.App.RewardsKeeper)
 
+	// Set up an active schedule
+	schedule := types.ReleaseSchedule{
+		Id:              1,
+		TotalAmount:     sdk.NewCoin("akii", math.NewInt(10000)),
+		ReleasedAmount:  sdk.NewCoin("akii", math.NewInt(2000)),
+		EndTime:         suite.Ctx.BlockTime().AddDate(0, 0, 7), // 1 week from now
+		LastReleaseTime: suite.Ctx.BlockTime(),
+		Active:          true,
+	}
+	err := suite.App.RewardsKeeper.ReleaseSchedules.Set(suite.Ctx, schedule.Id, schedule)
+	suite.Require().NoError(err)
+
 	testCases := []struct {
 		name         string
-		setup        func()
+		req          *types.QueryReleaseScheduleRequest
 		expectedPass bool
 	}{
 		{
-			name: "success - empty schedule",
-			setup: func() {
-				// Already set up empty schedule
-			},
+			name:         "success - existing schedule",
+			req:          &types.QueryReleaseScheduleRequest{Id: schedule.Id},
 			expectedPass: true,
 		},
 		{
-			name: "success - with active schedule",
-			setup: func() {
-				// Set up an active schedule
-				schedule := types.ReleaseSchedule{
-					TotalAmount:     sdk.NewCoin("akii", math.NewInt(10000)),
-					ReleasedAmount:  sdk.NewCoin("akii", math.NewInt(2000)),
-					EndTime:         suite.Ctx.BlockTime().AddDate(0, 0, 7), // 1 week from now
-					LastReleaseTime: suite.Ctx.BlockTime(),
-					Active:          true,
-				}
-				err := suite.App.RewardsKeeper.ReleaseSchedule.Set(suite.Ctx, schedule)
-				suite.Require().NoError(err)
-			},
-			expectedPass: true,
+			name:         "fail - unknown schedule",
+			req:          &types.QueryReleaseScheduleRequest{Id: 2},
+			expectedPass: false,
+		},
+		{
+			name:         "fail - nil request",
+			req:          nil,
+			expectedPass: false,
 		},
 	}
 
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			tc.setup()
-
-			res, err := querier.ReleaseSchedule(suite.Ctx, &types.QueryReleaseScheduleRequest{})
+			res, err := querier.ReleaseSchedule(suite.Ctx, tc.req)
 			if tc.expectedPass {
 				suite.Require().NoError(err)
 
 				// Verify returned schedule matches what we expect
-				expectedSchedule, err := suite.App.RewardsKeeper.ReleaseSchedule.Get(suite.Ctx)
+				expectedSchedule, err := suite.App.RewardsKeeper.ReleaseSchedules.Get(suite.Ctx, tc.req.Id)
 				suite.Require().NoError(err)
 				suite.Require().Equal(expectedSchedule, res.ReleaseSchedule)
 			} else {
@@ -168,3 +224,334 @@ func (suite *KeeperTestSuite) TestQuerierReleaseSchedule() {
 		})
 	}
 }
+
+// TestQuerierReleaseSchedules tests querying all the schedules with pagination
+func (suite *KeeperTestSuite) TestQuerierReleaseSchedules() {
+	querier := keeper.NewQuerier(suite.App.RewardsKeeper)
+
+	// No schedules are stored by default
+	res, err := querier.ReleaseSchedules(suite.Ctx, &types.QueryReleaseSchedulesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.ReleaseSchedules)
+
+	// Store a few schedules
+	for id := uint64(1); id <= 3; id++ {
+		schedule := types.ReleaseSchedule{
+			Id:             id,
+			TotalAmount:    sdk.NewCoin("akii", math.NewInt(int64(id)*1000)),
+			ReleasedAmount: sdk.NewCoin("akii", math.ZeroInt()),
+			EndTime:        suite.Ctx.BlockTime().AddDate(0, 0, 7),
+			Active:         true,
+		}
+		err := suite.App.RewardsKeeper.ReleaseSchedules.Set(suite.Ctx, id, schedule)
+		suite.Require().NoError(err)
+	}
+
+	// Query all the schedules, ordered by id
+	res, err = querier.ReleaseSchedules(suite.Ctx, &types.QueryReleaseSchedulesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.ReleaseSchedules, 3)
+	for i, schedule := range res.ReleaseSchedules {
+		suite.Require().Equal(uint64(i+1), schedule.Id)
+	}
+
+	// Query with pagination
+	res, err = querier.ReleaseSchedules(suite.Ctx, &types.QueryReleaseSchedulesRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.ReleaseSchedules, 2)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	// A nil request fails
+	_, err = querier.ReleaseSchedules(suite.Ctx, nil)
+	suite.Require().Error(err)
+}
+
+// TestQuerierReleaseProjection tests the projection of a schedule release at a future time
+func (suite *KeeperTestSuite) TestQuerierReleaseProjection() {
+	querier := keeper.NewQuerier(suite.App.RewardsKeeper)
+	blockTime := suite.Ctx.BlockTime()
+
+	// Set up a linear and a step schedule
+	schedules := []types.ReleaseSchedule{
+		{
+			Id:              1,
+			TotalAmount:     sdk.NewCoin("akii", math.NewInt(10000)),
+			ReleasedAmount:  sdk.NewCoin("akii", math.NewInt(2000)),
+			EndTime:         blockTime.Add(time.Hour * 8),
+			LastReleaseTime: blockTime,
+			Active:          true,
+		},
+		{
+			Id:             2,
+			TotalAmount:    sdk.NewCoin("akii", math.NewInt(10000)),
+			ReleasedAmount: sdk.NewCoin("akii", math.ZeroInt()),
+			StartTime:      blockTime,
+			EndTime:        blockTime.Add(time.Hour * 10),
+			Curve:          types.ReleaseCurveStep,
+			StepDuration:   uint64((time.Hour * 5).Seconds()),
+			Active:         true,
+		},
+	}
+	for _, schedule := range schedules {
+		err := suite.App.RewardsKeeper.ReleaseSchedules.Set(suite.Ctx, schedule.Id, schedule)
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name             string
+		req              *types.QueryReleaseProjectionRequest
+		expectedReleased math.Int
+		expectedPass     bool
+	}{
+		{
+			name:             "success - linear halfway",
+			req:              &types.QueryReleaseProjectionRequest{Id: 1, Time: blockTime.Add(time.Hour * 4)},
+			expectedReleased: math.NewInt(6000),
+			expectedPass:     true,
+		},
+		{
+			name:             "success - linear after end",
+			req:              &types.QueryReleaseProjectionRequest{Id: 1, Time: blockTime.Add(time.Hour * 24)},
+			expectedReleased: math.NewInt(10000),
+			expectedPass:     true,
+		},
+		{
+			name:             "success - step before the first step ends",
+			req:              &types.QueryReleaseProjectionRequest{Id: 2, Time: blockTime.Add(time.Hour * 4)},
+			expectedReleased: math.ZeroInt(),
+			expectedPass:     true,
+		},
+		{
+			name:             "success - step after the first step",
+			req:              &types.QueryReleaseProjectionRequest{Id: 2, Time: blockTime.Add(time.Hour * 6)},
+			expectedReleased: math.NewInt(5000),
+			expectedPass:     true,
+		},
+		{
+			name:         "fail - time before the block time",
+			req:          &types.QueryReleaseProjectionRequest{Id: 1, Time: blockTime.Add(-time.Hour)},
+			expectedPass: false,
+		},
+		{
+			name:         "fail - unknown schedule",
+			req:          &types.QueryReleaseProjectionRequest{Id: 3, Time: blockTime.Add(time.Hour)},
+			expectedPass: false,
+		},
+		{
+			name:         "fail - nil request",
+			req:          nil,
+			expectedPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := querier.ReleaseProjection(suite.Ctx, tc.req)
+			if tc.expectedPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expectedReleased, res.ReleasedAmount.Amount)
+				suite.Require().Equal(math.NewInt(10000).Sub(tc.expectedReleased), res.RemainingAmount.Amount)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestQuerierPoolHealth tests the reward pool accounting checks query
+func (suite *KeeperTestSuite) TestQuerierPoolHealth() {
+	querier := keeper.NewQuerier(suite.App.RewardsKeeper)
+	denom := types.DefaultParams().TokenDenom
+
+	// Fund the pool
+	err := suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(10000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name           string
+		malleate       func(ctx sdk.Context)
+		expectedBroken []string
+	}{
+		{
+			name:     "healthy pool",
+			malleate: func(sdk.Context) {},
+		},
+		{
+			name: "pool above the balance and the released amounts",
+			malleate: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.App.RewardsKeeper.RewardPool.Set(ctx, types.RewardPool{
+					CommunityPool: sdk.NewDecCoins(sdk.NewDecCoin(denom, math.NewInt(20000))),
+				}))
+				suite.Require().NoError(suite.App.RewardsKeeper.ReleaseSchedules.Set(ctx, 1, types.ReleaseSchedule{
+					Id:             1,
+					TotalAmount:    sdk.NewCoin(denom, math.NewInt(100)),
+					ReleasedAmount: sdk.NewCoin(denom, math.NewInt(200)),
+				}))
+			},
+			expectedBroken: []string{keeper.ModuleBalanceInvariantRoute, keeper.ReleasedAmountsInvariantRoute},
+		},
+		{
+			name: "schedules above the pool",
+			malleate: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.App.RewardsKeeper.ReleaseSchedules.Set(ctx, 1, types.ReleaseSchedule{
+					Id:             1,
+					TotalAmount:    sdk.NewCoin(denom, math.NewInt(20000)),
+					ReleasedAmount: sdk.NewCoin(denom, math.ZeroInt()),
+					Active:         true,
+				}))
+			},
+			expectedBroken: []string{keeper.CommittedFundsInvariantRoute},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Set a cached context
+			ctx, _ := suite.Ctx.CacheContext()
+			tc.malleate(ctx)
+
+			res, err := querier.PoolHealth(ctx, &types.QueryPoolHealthRequest{})
+			suite.Require().NoError(err)
+			suite.Require().Equal(len(tc.expectedBroken) == 0, res.Healthy)
+			suite.Require().Len(res.Checks, 3)
+
+			var broken []string
+			for _, check := range res.Checks {
+				if check.Broken {
+					suite.Require().NotEmpty(check.Message)
+					broken = append(broken, check.Name)
+				}
+			}
+			suite.Require().ElementsMatch(tc.expectedBroken, broken)
+		})
+	}
+}
+
+// TestQuerierRunway tests the emission rate, the projected end time and the unallocated funds of a denom
+func (suite *KeeperTestSuite) TestQuerierRunway() {
+	querier := keeper.NewQuerier(suite.App.RewardsKeeper)
+	denom := types.DefaultParams().TokenDenom
+	blockTime := suite.Ctx.BlockTime()
+
+	// A schedule releasing one token per second for two days
+	schedule := types.ReleaseSchedule{
+		Id:             1,
+		TotalAmount:    sdk.NewCoin(denom, math.NewInt(172800)),
+		ReleasedAmount: sdk.NewCoin(denom, math.ZeroInt()),
+		EndTime:        blockTime.Add(48 * time.Hour),
+		Active:         true,
+	}
+
+	testCases := []struct {
+		name             string
+		funds            int64
+		req              *types.QueryRunwayRequest
+		expectedPerBlock math.LegacyDec
+		expectedEnd      time.Time
+		expectedFunded   bool
+		unallocated      int64
+		errContains      string
+	}{
+		{
+			name:             "valid - fully funded",
+			funds:            200000,
+			req:              &types.QueryRunwayRequest{},
+			expectedPerBlock: math.LegacyNewDec(2),
+			expectedEnd:      blockTime.Add(48 * time.Hour),
+			expectedFunded:   true,
+			unallocated:      27200,
+		},
+		{
+			name:             "valid - funds run out",
+			funds:            100000,
+			req:              &types.QueryRunwayRequest{Denom: denom, BlockTimeMs: 500},
+			expectedPerBlock: math.LegacyNewDecWithPrec(5, 1),
+			expectedEnd:      blockTime.Add(100001 * time.Second),
+		},
+		{
+			name:        "invalid - empty request",
+			errContains: "empty request",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Set a cached context
+			ctx, _ := suite.Ctx.CacheContext()
+			suite.Require().NoError(suite.App.RewardsKeeper.RewardPool.Set(ctx, types.RewardPool{
+				CommunityPool: sdk.NewDecCoins(sdk.NewDecCoin(denom, math.NewInt(tc.funds))),
+			}))
+			suite.Require().NoError(suite.App.RewardsKeeper.ReleaseSchedules.Set(ctx, schedule.Id, schedule))
+
+			res, err := querier.Runway(ctx, tc.req)
+			if tc.errContains != "" {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(denom, res.Denom)
+			suite.Require().Equal(math.LegacyOneDec().String(), res.EmissionPerSecond.String())
+			suite.Require().Equal(tc.expectedPerBlock.String(), res.EmissionPerBlock.String())
+			suite.Require().Equal(tc.expectedEnd, res.ProjectedEndTime)
+			suite.Require().Equal(tc.expectedFunded, res.FullyFunded)
+			suite.Require().Equal(tc.unallocated, res.UnallocatedAmount.TruncateInt64())
+		})
+	}
+
+	// A denom without schedules has no emission
+	res, err := querier.Runway(suite.Ctx, &types.QueryRunwayRequest{Denom: "uusdc"})
+	suite.Require().NoError(err)
+	suite.Require().True(res.EmissionPerSecond.IsZero())
+	suite.Require().True(res.ProjectedEndTime.IsZero())
+	suite.Require().True(res.FullyFunded)
+}
+
+// TestQuerierStakingAPR tests the APR estimate of the schedules releasing to the fee collector
+func (suite *KeeperTestSuite) TestQuerierStakingAPR() {
+	querier := keeper.NewQuerier(suite.App.RewardsKeeper)
+	denom := types.DefaultParams().TokenDenom
+	blockTime := suite.Ctx.BlockTime()
+
+	bonded, err := suite.App.StakingKeeper.TotalBondedTokens(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(bonded.IsPositive())
+
+	// Without schedules there is no APR
+	res, err := querier.StakingAPR(suite.Ctx, &types.QueryStakingAPRRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Apr.IsZero())
+	suite.Require().Equal(bonded, res.BondedTokens)
+
+	// A schedule releasing 100 tokens per second, half of it to the fee collector
+	err = suite.App.RewardsKeeper.ReleaseSchedules.Set(suite.Ctx, 1, types.ReleaseSchedule{
+		Id:             1,
+		TotalAmount:    sdk.NewCoin(denom, math.NewInt(100*172800)),
+		ReleasedAmount: sdk.NewCoin(denom, math.ZeroInt()),
+		EndTime:        blockTime.Add(48 * time.Hour),
+		Active:         true,
+		Splits: []types.ReleaseSplit{
+			{Type: types.DestinationFeeCollector, Weight: math.LegacyNewDecWithPrec(5, 1)},
+			{Type: types.DestinationCommunityPool, Weight: math.LegacyNewDecWithPrec(5, 1)},
+		},
+	})
+	suite.Require().NoError(err)
+
+	// Schedules on other denoms are not counted
+	err = suite.App.RewardsKeeper.ReleaseSchedules.Set(suite.Ctx, 2, types.ReleaseSchedule{
+		Id:             2,
+		TotalAmount:    sdk.NewCoin("uusdc", math.NewInt(100*172800)),
+		ReleasedAmount: sdk.NewCoin("uusdc", math.ZeroInt()),
+		EndTime:        blockTime.Add(48 * time.Hour),
+		Active:         true,
+	})
+	suite.Require().NoError(err)
+
+	res, err = querier.StakingAPR(suite.Ctx, &types.QueryStakingAPRRequest{})
+	suite.Require().NoError(err)
+	annual := math.NewInt(50 * types.SecondsPerYear)
+	suite.Require().Equal(sdk.NewCoin(denom, annual), res.AnnualEmission)
+	suite.Require().Equal(math.LegacyNewDecFromInt(annual).QuoInt(bonded), res.Apr)
+}
@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v4/x/rewards/types"
+)
+
+// Invariant routes, also used as the names of the pool health checks
+const (
+	ModuleBalanceInvariantRoute   = "module-balance"
+	CommittedFundsInvariantRoute  = "committed-funds"
+	ReleasedAmountsInvariantRoute = "released-amounts"
+)
+
+// accountingCheck is a single reward pool accounting check
+// It returns a description of the failures and true if the check is broken
+type accountingCheck func(ctx sdk.Context, k Keeper) (string, bool)
+
+// accountingChecks are the reward pool accounting checks, in the order they are run
+var accountingChecks = []struct {
+	route string
+	check accountingCheck
+}{
+	{ModuleBalanceInvariantRoute, checkModuleBalance},
+	{CommittedFundsInvariantRoute, checkCommittedFunds},
+	{ReleasedAmountsInvariantRoute, checkReleasedAmounts},
+}
+
+// RegisterInvariants registers all the rewards module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	for _, c := range accountingChecks {
+		ir.RegisterRoute(types.ModuleName, c.route, newInvariant(k, c.route, c.check))
+	}
+}
+
+// AllInvariants runs all the rewards module invariants
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			res    string
+			broken bool
+		)
+		for _, c := range accountingChecks {
+			msg, stop := newInvariant(k, c.route, c.check)(ctx)
+			res += msg
+			broken = broken || stop
+		}
+		return res, broken
+	}
+}
+
+// ModuleBalanceInvariant checks that the module account holds at least the reward pool
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return newInvariant(k, ModuleBalanceInvariantRoute, checkModuleBalance)
+}
+
+// CommittedFundsInvariant checks that the reward pool holds at least the unreleased amounts of all the schedules
+// Paused schedules are counted, since they can be resumed
+func CommittedFundsInvariant(k Keeper) sdk.Invariant {
+	return newInvariant(k, CommittedFundsInvariantRoute, checkCommittedFunds)
+}
+
+// ReleasedAmountsInvariant checks that no schedule released more than its total amount
+func ReleasedAmountsInvariant(k Keeper) sdk.Invariant {
+	return newInvariant(k, ReleasedAmountsInvariantRoute, checkReleasedAmounts)
+}
+
+// RunAccountingChecks runs all the accounting checks and returns their results
+func (k Keeper) RunAccountingChecks(ctx sdk.Context) []types.HealthCheck {
+	results := make([]types.HealthCheck, 0, len(accountingChecks))
+	for _, c := range accountingChecks {
+		msg, broken := c.check(ctx, k)
+		results = append(results, types.HealthCheck{Name: c.route, Broken: broken, Message: msg})
+	}
+	return results
+}
+
+// newInvariant wraps an accounting check into an invariant
+func newInvariant(k Keeper, route string, check accountingCheck) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := check(ctx, k)
+		return sdk.FormatInvariant(types.ModuleName, route, msg), broken
+	}
+}
+
+// checkModuleBalance checks that the module account holds at least the reward pool
+func checkModuleBalance(ctx sdk.Context, k Keeper) (string, bool) {
+	rewardPool, err := k.RewardPool.Get(ctx)
+	if err != nil {
+		return fmt.Sprintf("\tfailed to get the reward pool: %s\n", err), true
+	}
+	balance := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+
+	var (
+		msg    string
+		broken bool
+	)
+	for _, poolCoin := range rewardPool.CommunityPool {
+		balanceAmount := math.LegacyNewDecFromInt(balance.AmountOf(poolCoin.Denom))
+		if balanceAmount.LT(poolCoin.Amount) {
+			broken = true
+			msg += fmt.Sprintf("\tmodule balance has %s%s, less than the %s%s on the reward pool\n",
+				balanceAmount, poolCoin.Denom, poolCoin.Amount, poolCoin.Denom)
+		}
+	}
+
+	return msg, broken
+}
+
+// checkCommittedFunds checks that the reward pool holds at least the unreleased amounts of all the schedules
+func checkCommittedFunds(ctx sdk.Context, k Keeper) (string, bool) {
+	rewardPool, err := k.RewardPool.Get(ctx)
+	if err != nil {
+		return fmt.Sprintf("\tfailed to get the reward pool: %s\n", err), true
+	}
+	schedules, err := k.GetReleaseSchedules(ctx)
+	if err != nil {
+		return fmt.Sprintf("\tfailed to get the schedules: %s\n", err), true
+	}
+
+	// Sum the unreleased amounts per denom
+	committed := make(map[string]math.Int)
+	var denoms []string
+	for _, schedule := range schedules {
+		denom := schedule.TotalAmount.Denom
+		if _, ok := committed[denom]; !ok {
+			committed[denom] = math.ZeroInt()
+			denoms = append(denoms, denom)
+		}
+		committed[denom] = committed[denom].Add(unreleasedAmount(schedule))
+	}
+
+	var (
+		msg    string
+		broken bool
+	)
+	for _, denom := range denoms {
+		poolAmount := rewardPool.CommunityPool.AmountOf(denom)
+		if poolAmount.LT(math.LegacyNewDecFromInt(committed[denom])) {
+			broken = true
+			msg += fmt.Sprintf("\treward pool has %s%s, less than the %s%s committed to the schedules\n",
+				poolAmount, denom, committed[denom], denom)
+		}
+	}
+
+	return msg, broken
+}
+
+// checkReleasedAmounts checks that no schedule released more than its total amount
+func checkReleasedAmounts(ctx sdk.Context, k Keeper) (string, bool) {
+	schedules, err := k.GetReleaseSchedules(ctx)
+	if err != nil {
+		return fmt.Sprintf("\tfailed to get the schedules: %s\n", err), true
+	}
+
+	var (
+		msg    string
+		broken bool
+	)
+	for _, schedule := range schedules {
+		if schedule.ReleasedAmount.IsNil() || schedule.ReleasedAmount.IsZero() {
+			continue
+		}
+
+		if schedule.ReleasedAmount.Denom != schedule.TotalAmount.Denom {
+			broken = true
+			msg += fmt.Sprintf("\tschedule %d released %s, a different denom than its total %s\n",
+				schedule.Id, schedule.ReleasedAmount, schedule.TotalAmount)
+			continue
+		}
+		if schedule.TotalAmount.IsNil() || schedule.ReleasedAmount.Amount.GT(schedule.TotalAmount.Amount) {
+			broken = true
+			msg += fmt.Sprintf("\tschedule %d released %s, more than its total %s\n",
+				schedule.Id, schedule.ReleasedAmount, schedule.TotalAmount)
+		}
+	}
+
+	return msg, broken
+}
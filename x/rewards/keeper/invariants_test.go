@@ -0,0 +1,240 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v4/x/rewards/keeper"
+	"github.com/kiichain/kiichain/v4/x/rewards/types"
+)
+
+// TestCommittedFundsInvariant tests the invariant of the funds committed to the schedules
+func (suite *KeeperTestSuite) TestCommittedFundsInvariant() {
+	denom := types.DefaultParams().TokenDenom
+
+	// Fund the pool
+	err := suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(10000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	schedule := types.ReleaseSchedule{
+		Id:             1,
+		TotalAmount:    sdk.NewCoin(denom, math.NewInt(10000)),
+		ReleasedAmount: sdk.NewCoin(denom, math.NewInt(2000)),
+		EndTime:        suite.Ctx.BlockTime().Add(time.Hour),
+		Active:         true,
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(ctx sdk.Context)
+		broken   bool
+	}{
+		{
+			name:     "valid - no schedules",
+			malleate: func(sdk.Context) {},
+		},
+		{
+			name: "valid - pool holds the unreleased amount",
+			malleate: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.App.RewardsKeeper.ReleaseSchedules.Set(ctx, schedule.Id, schedule))
+			},
+		},
+		{
+			name: "broken - paused schedule over the pool",
+			malleate: func(ctx sdk.Context) {
+				paused := schedule
+				paused.Active = false
+				paused.ReleasedAmount = sdk.NewCoin(denom, math.ZeroInt())
+				paused.TotalAmount = sdk.NewCoin(denom, math.NewInt(10001))
+				suite.Require().NoError(suite.App.RewardsKeeper.ReleaseSchedules.Set(ctx, paused.Id, paused))
+			},
+			broken: true,
+		},
+		{
+			name: "broken - pool drained under the schedules",
+			malleate: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.App.RewardsKeeper.ReleaseSchedules.Set(ctx, schedule.Id, schedule))
+				suite.Require().NoError(suite.App.RewardsKeeper.RewardPool.Set(ctx, types.RewardPool{
+					CommunityPool: sdk.NewDecCoins(sdk.NewDecCoin(denom, math.NewInt(7999))),
+				}))
+			},
+			broken: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Set a cached context
+			ctx, _ := suite.Ctx.CacheContext()
+			tc.malleate(ctx)
+
+			msg, broken := keeper.AllInvariants(suite.App.RewardsKeeper)(ctx)
+			suite.Require().Equal(tc.broken, broken, msg)
+		})
+	}
+}
+
+// TestModuleBalanceInvariant tests the invariant of the module balance against the reward pool
+func (suite *KeeperTestSuite) TestModuleBalanceInvariant() {
+	denom := types.DefaultParams().TokenDenom
+
+	// Fund the pool
+	err := suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewCoin(denom, math.NewInt(10000)), suite.TestAccs[0])
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name     string
+		malleate func(ctx sdk.Context)
+		broken   bool
+	}{
+		{
+			name:     "valid - balance matches the pool",
+			malleate: func(sdk.Context) {},
+		},
+		{
+			name: "valid - balance above the pool",
+			malleate: func(ctx sdk.Context) {
+				// Funds sent straight to the module account are not on the pool
+				moduleAddr := suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+				coins := sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(500)))
+				suite.Require().NoError(suite.App.BankKeeper.SendCoins(ctx, suite.TestAccs[0], moduleAddr, coins))
+			},
+		},
+		{
+			name: "broken - pool above the balance",
+			malleate: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.App.RewardsKeeper.RewardPool.Set(ctx, types.RewardPool{
+					CommunityPool: sdk.NewDecCoins(sdk.NewDecCoin(denom, math.NewInt(10001))),
+				}))
+			},
+			broken: true,
+		},
+		{
+			name: "broken - fractional pool above the balance",
+			malleate: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.App.RewardsKeeper.RewardPool.Set(ctx, types.RewardPool{
+					CommunityPool: sdk.NewDecCoins(sdk.NewDecCoinFromDec(denom, math.LegacyMustNewDecFromStr("10000.5"))),
+				}))
+			},
+			broken: true,
+		},
+		{
+			name: "broken - pool denom without balance",
+			malleate: func(ctx sdk.Context) {
+				suite.Require().NoError(suite.App.RewardsKeeper.RewardPool.Set(ctx, types.RewardPool{
+					CommunityPool: sdk.NewDecCoins(sdk.NewDecCoin(denom, math.NewInt(10000)), sdk.NewDecCoin("other", math.NewInt(1))),
+				}))
+			},
+			broken: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Set a cached context
+			ctx, _ := suite.Ctx.CacheContext()
+			tc.malleate(ctx)
+
+			msg, broken := keeper.ModuleBalanceInvariant(suite.App.RewardsKeeper)(ctx)
+			suite.Require().Equal(tc.broken, broken, msg)
+		})
+	}
+}
+
+// TestReleasedAmountsInvariant tests the invariant of the released amounts against the total amounts
+func (suite *KeeperTestSuite) TestReleasedAmountsInvariant() {
+	denom := types.DefaultParams().TokenDenom
+	schedule := types.ReleaseSchedule{
+		Id:             1,
+		TotalAmount:    sdk.NewCoin(denom, math.NewInt(10000)),
+		ReleasedAmount: sdk.NewCoin(denom, math.NewInt(10000)),
+		EndTime:        suite.Ctx.BlockTime().Add(time.Hour),
+		Active:         false,
+	}
+
+	testCases := []struct {
+		name   string
+		modify func(schedule types.ReleaseSchedule) types.ReleaseSchedule
+		broken bool
+	}{
+		{
+			name:   "valid - everything released",
+			modify: func(s types.ReleaseSchedule) types.ReleaseSchedule { return s },
+		},
+		{
+			name: "valid - unset released amount",
+			modify: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.ReleasedAmount = sdk.Coin{}
+				return s
+			},
+		},
+		{
+			name: "broken - released above the total",
+			modify: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.ReleasedAmount.Amount = math.NewInt(10001)
+				return s
+			},
+			broken: true,
+		},
+		{
+			name: "broken - released on another denom",
+			modify: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.ReleasedAmount.Denom = "other"
+				return s
+			},
+			broken: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Set a cached context
+			ctx, _ := suite.Ctx.CacheContext()
+			modified := tc.modify(schedule)
+			suite.Require().NoError(suite.App.RewardsKeeper.ReleaseSchedules.Set(ctx, modified.Id, modified))
+
+			msg, broken := keeper.ReleasedAmountsInvariant(suite.App.RewardsKeeper)(ctx)
+			suite.Require().Equal(tc.broken, broken, msg)
+
+			// The failure is also seen when running all the invariants
+			if tc.broken {
+				_, broken = keeper.AllInvariants(suite.App.RewardsKeeper)(ctx)
+				suite.Require().True(broken)
+			}
+		})
+	}
+}
+
+// TestInvariantsAfterReleases tests that the invariants hold across releases and clawbacks
+func (suite *KeeperTestSuite) TestInvariantsAfterReleases() {
+	// Set up default params
+	defaultParams := types.DefaultParams()
+	denom := defaultParams.TokenDenom
+	suite.Require().NoError(suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams))
+
+	// Fund the pool and create a schedule
+	_, err := suite.msgServer.FundPool(suite.Ctx, types.NewMsgFundPool(suite.TestAccs[0], sdk.NewCoin(denom, math.NewInt(10000))))
+	suite.Require().NoError(err)
+	start := suite.Ctx.BlockTime()
+	authority := suite.App.RewardsKeeper.GetAuthority()
+	_, err = suite.msgServer.CreateSchedule(suite.Ctx, types.NewMsgCreateSchedule(authority, types.ReleaseSchedule{
+		TotalAmount: sdk.NewCoin(denom, math.NewInt(7000)),
+		EndTime:     start.Add(time.Hour * 7),
+		Active:      true,
+	}))
+	suite.Require().NoError(err)
+
+	// Claw back the free funds and release until the end
+	_, err = suite.msgServer.Clawback(suite.Ctx, types.NewMsgClawback(authority, sdk.NewCoin(denom, math.NewInt(3000)), ""))
+	suite.Require().NoError(err)
+	for hour := 0; hour <= 8; hour++ {
+		ctx := suite.Ctx.WithBlockTime(start.Add(time.Duration(hour) * time.Hour))
+		suite.Require().NoError(suite.App.RewardsKeeper.BeginBlocker(ctx))
+
+		msg, broken := keeper.AllInvariants(suite.App.RewardsKeeper)(ctx)
+		suite.Require().False(broken, msg)
+	}
+}
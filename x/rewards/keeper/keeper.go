@@ -16,19 +16,25 @@ import (
 
 type (
 	Keeper struct {
-		cdc codec.BinaryCodec
+		cdc          codec.BinaryCodec
+		storeService store.KVStoreService
 
-		bankKeeper types.BankKeeper
+		accountKeeper types.AccountKeeper
+		bankKeeper    types.BankKeeper
+		distrKeeper   types.DistributionKeeper
+		stakingKeeper types.StakingKeeper
+		wasmKeeper    types.WasmKeeper
 
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
 		authority        string
 		feeCollectorName string // name of the FeeCollector ModuleAccount
 
-		Schema          collections.Schema
-		Params          collections.Item[types.Params]
-		RewardPool      collections.Item[types.RewardPool]
-		ReleaseSchedule collections.Item[types.ReleaseSchedule]
+		Schema           collections.Schema
+		Params           collections.Item[types.Params]
+		RewardPool       collections.Item[types.RewardPool]
+		ReleaseSchedules collections.Map[uint64, types.ReleaseSchedule]
+		NextScheduleID   collections.Sequence
 	}
 )
 
@@ -36,21 +42,29 @@ type (
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService store.KVStoreService,
+	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
+	stakingKeeper types.StakingKeeper,
 	authority, feeCollectorName string,
 ) Keeper {
 	sb := collections.NewSchemaBuilder(storeService)
 	k := Keeper{
-		cdc: cdc,
+		cdc:          cdc,
+		storeService: storeService,
 
-		bankKeeper: bankKeeper,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
+		stakingKeeper: stakingKeeper,
 
 		authority:        authority,
 		feeCollectorName: feeCollectorName,
 
-		Params:          collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		RewardPool:      collections.NewItem(sb, types.RewardPoolKey, "reward_pool", codec.CollValue[types.RewardPool](cdc)),
-		ReleaseSchedule: collections.NewItem(sb, types.ReleaseScheduleKey, "release_schedule", codec.CollValue[types.ReleaseSchedule](cdc)),
+		Params:           collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		RewardPool:       collections.NewItem(sb, types.RewardPoolKey, "reward_pool", codec.CollValue[types.RewardPool](cdc)),
+		ReleaseSchedules: collections.NewMap(sb, types.ReleaseSchedulesKey, "release_schedules", collections.Uint64Key, codec.CollValue[types.ReleaseSchedule](cdc)),
+		NextScheduleID:   collections.NewSequence(sb, types.NextScheduleIDKey, "next_schedule_id"),
 	}
 
 	schema, err := sb.Build()
@@ -62,6 +76,12 @@ func NewKeeper(
 	return k
 }
 
+// SetWasmKeeper sets the wasm keeper used to check the contract destinations
+// The wasm keeper is created after this keeper, so it can't be given on the constructor
+func (k *Keeper) SetWasmKeeper(wasmKeeper types.WasmKeeper) {
+	k.wasmKeeper = wasmKeeper
+}
+
 // GetAuthority returns the x/mint module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -90,3 +110,44 @@ func (k Keeper) FundCommunityPool(ctx context.Context, amount sdk.Coin, sender s
 	rewardPool.CommunityPool = rewardPool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(coins...)...)
 	return k.RewardPool.Set(ctx, rewardPool)
 }
+
+// Clawback moves an amount out of the reward pool to the recipient, or to the
+// distribution community pool if there is no recipient. It doesn't check the
+// amounts committed to the schedules, the caller is responsible for it.
+func (k Keeper) Clawback(ctx context.Context, amount sdk.Coin, recipient string) error {
+	coins := sdk.NewCoins(amount)
+
+	rewardPool, err := k.RewardPool.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Deduct from the pool, it can't go negative
+	newPool, negative := rewardPool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(coins...))
+	if negative {
+		return fmt.Errorf("reward pool (%s) has less funds than the clawback (%s)", rewardPool.CommunityPool, amount)
+	}
+	rewardPool.CommunityPool = newPool
+	if err := k.RewardPool.Set(ctx, rewardPool); err != nil {
+		return err
+	}
+
+	// Send the funds out of the module account
+	split := types.ReleaseSplit{Type: types.DestinationCommunityPool}
+	if recipient != "" {
+		split = types.ReleaseSplit{Type: types.DestinationAddress, Target: recipient}
+	}
+	if err := k.sendToSplit(ctx, split, coins); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeClawback,
+			sdk.NewAttribute(types.AttributeKeyDestinationType, split.Type.String()),
+			sdk.NewAttribute(types.AttributeKeyDestination, recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
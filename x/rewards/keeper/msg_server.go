@@ -3,6 +3,10 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -54,8 +58,8 @@ func (k msgServer) FundPool(ctx context.Context, msg *types.MsgFundPool) (*types
 	if err != nil {
 		return nil, err
 	}
-	if params.TokenDenom != msg.Amount.Denom {
-		return nil, fmt.Errorf("denom %s does not match expected denom: %s", msg.Amount.Denom, params.TokenDenom)
+	if !params.IsAllowedDenom(msg.Amount.Denom) {
+		return nil, fmt.Errorf("denom %s is not allowed by the rewards pool", msg.Amount.Denom)
 	}
 
 	if err := k.Keeper.FundCommunityPool(ctx, msg.Amount, depositor); err != nil {
@@ -65,28 +69,242 @@ func (k msgServer) FundPool(ctx context.Context, msg *types.MsgFundPool) (*types
 	return &types.MsgFundPoolResponse{}, nil
 }
 
-// ChangeSchedule validates changes to the release scheduler
-func (k msgServer) ChangeSchedule(ctx context.Context, msg *types.MsgChangeSchedule) (*types.MsgChangeScheduleResponse, error) {
+// CreateSchedule validates and stores a new release schedule
+func (k msgServer) CreateSchedule(ctx context.Context, msg *types.MsgCreateSchedule) (*types.MsgCreateScheduleResponse, error) {
 	// Authority validation
 	if err := k.validateAuthority(msg.Authority); err != nil {
 		return nil, err
 	}
 
-	// Check if schedule is sound
+	// An unset released amount starts at zero
 	schedule := msg.Schedule
+	if schedule.ReleasedAmount.IsNil() {
+		schedule.ReleasedAmount = sdk.Coin{Denom: schedule.TotalAmount.Denom, Amount: math.ZeroInt()}
+	}
+
+	// Check if schedule is sound
 	if err := k.validateSchedule(ctx, schedule); err != nil {
 		return nil, fmt.Errorf("invalid schedule: %w", err)
 	}
 
+	// A new schedule starts without releases
+	if !schedule.ReleasedAmount.IsZero() || !schedule.LastReleaseTime.IsZero() {
+		return nil, fmt.Errorf("invalid schedule: new schedule cannot have releases")
+	}
+	if !schedule.PausedTime.IsZero() {
+		return nil, fmt.Errorf("invalid schedule: new schedule cannot have a paused time")
+	}
+
 	// Check available funds
-	if err := k.fundsAvailable(ctx, schedule.TotalAmount); err != nil {
+	if err := k.fundsAvailable(ctx, schedule.TotalAmount, 0); err != nil {
+		return nil, fmt.Errorf("insufficient funds: %w", err)
+	}
+
+	// Save the new schedule under the next id
+	id, err := k.Keeper.NextScheduleID.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	schedule.Id = id
+	if err := k.Keeper.ReleaseSchedules.Set(ctx, id, schedule); err != nil {
+		return nil, fmt.Errorf("failed to set release schedule: %w", err)
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeCreateSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, schedule.TotalAmount.String()),
+		),
+	)
+
+	return &types.MsgCreateScheduleResponse{Id: id}, nil
+}
+
+// AmendSchedule validates changes to an existing release schedule
+func (k msgServer) AmendSchedule(ctx context.Context, msg *types.MsgAmendSchedule) (*types.MsgAmendScheduleResponse, error) {
+	// Authority validation
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	// Get the stored schedule
+	current, err := k.GetReleaseSchedule(ctx, msg.Schedule.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// The released values and the paused time are kept
+	schedule := msg.Schedule
+	schedule.ReleasedAmount = current.ReleasedAmount
+	schedule.LastReleaseTime = current.LastReleaseTime
+	schedule.PausedTime = current.PausedTime
+
+	// Check if schedule is sound
+	if err := k.validateSchedule(ctx, schedule); err != nil {
+		return nil, fmt.Errorf("invalid schedule: %w", err)
+	}
+
+	// Check available funds for the amount still to be released
+	unreleased := sdk.Coin{Denom: schedule.TotalAmount.Denom, Amount: unreleasedAmount(schedule)}
+	if err := k.fundsAvailable(ctx, unreleased, schedule.Id); err != nil {
 		return nil, fmt.Errorf("insufficient funds: %w", err)
 	}
 
-	// Save the new schedule
-	if err := k.Keeper.ReleaseSchedule.Set(ctx, schedule); err != nil {
+	// Save the amended schedule
+	if err := k.Keeper.ReleaseSchedules.Set(ctx, schedule.Id, schedule); err != nil {
+		return nil, fmt.Errorf("failed to set release schedule: %w", err)
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeAmendSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(schedule.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, schedule.TotalAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyUnreleased, unreleased.String()),
+		),
+	)
+
+	return &types.MsgAmendScheduleResponse{}, nil
+}
+
+// CancelSchedule removes a release schedule, its unreleased amount stays in the pool
+func (k msgServer) CancelSchedule(ctx context.Context, msg *types.MsgCancelSchedule) (*types.MsgCancelScheduleResponse, error) {
+	// Authority validation
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	// Check the schedule exists
+	schedule, err := k.GetReleaseSchedule(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Remove the schedule
+	if err := k.Keeper.ReleaseSchedules.Remove(ctx, msg.Id); err != nil {
+		return nil, fmt.Errorf("failed to remove release schedule: %w", err)
+	}
+
+	// The unreleased amount is freed on the pool
+	unreleased := sdk.Coin{Denom: schedule.TotalAmount.Denom, Amount: unreleasedAmount(schedule)}
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeCancelSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(schedule.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyUnreleased, unreleased.String()),
+		),
+	)
+
+	return &types.MsgCancelScheduleResponse{}, nil
+}
+
+// PauseSchedule stops the releases of a schedule, the released amount and last release are kept
+// The pause time is stored, so the schedule times are shifted by the paused duration once resumed
+func (k msgServer) PauseSchedule(ctx context.Context, msg *types.MsgPauseSchedule) (*types.MsgPauseScheduleResponse, error) {
+	// Authority validation
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	schedule, err := k.GetReleaseSchedule(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !schedule.Active {
+		return nil, fmt.Errorf("release schedule %d is not active", msg.Id)
+	}
+
+	// Stop the releases, the unreleased amount stays committed
+	schedule.Active = false
+	schedule.PausedTime = sdk.UnwrapSDKContext(ctx).BlockTime()
+	if err := k.Keeper.ReleaseSchedules.Set(ctx, schedule.Id, schedule); err != nil {
+		return nil, fmt.Errorf("failed to set release schedule: %w", err)
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypePauseSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(schedule.Id, 10)),
+		),
+	)
+
+	return &types.MsgPauseScheduleResponse{}, nil
+}
+
+// ResumeSchedule restarts the releases of a paused schedule
+// The schedule times are shifted by the paused duration, so nothing is released for the time it was paused
+func (k msgServer) ResumeSchedule(ctx context.Context, msg *types.MsgResumeSchedule) (*types.MsgResumeScheduleResponse, error) {
+	// Authority validation
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	schedule, err := k.GetReleaseSchedule(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if schedule.Active {
+		return nil, fmt.Errorf("release schedule %d is already active", msg.Id)
+	}
+
+	// Only schedules with something left to release can be resumed
+	if !unreleasedAmount(schedule).IsPositive() {
+		return nil, fmt.Errorf("release schedule %d has nothing left to release", msg.Id)
+	}
+
+	// Shift the schedule by the paused duration, the curve continues from where it was paused
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	if !schedule.PausedTime.IsZero() && blockTime.After(schedule.PausedTime) {
+		schedule = schedule.ShiftTimes(blockTime.Sub(schedule.PausedTime))
+	}
+	schedule.PausedTime = time.Time{}
+
+	if err := validateEndTime(schedule.EndTime, blockTime); err != nil {
+		return nil, fmt.Errorf("release schedule %d ended, amend its end time instead: %w", msg.Id, err)
+	}
+
+	// The schedule can't be resumed without the funds to finish it
+	if err := k.fundsAvailable(ctx, sdk.NewCoin(schedule.TotalAmount.Denom, unreleasedAmount(schedule)), schedule.Id); err != nil {
+		return nil, err
+	}
+
+	schedule.Active = true
+	if err := k.Keeper.ReleaseSchedules.Set(ctx, schedule.Id, schedule); err != nil {
 		return nil, fmt.Errorf("failed to set release schedule: %w", err)
 	}
 
-	return &types.MsgChangeScheduleResponse{}, nil
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeResumeSchedule,
+			sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(schedule.Id, 10)),
+		),
+	)
+
+	return &types.MsgResumeScheduleResponse{}, nil
+}
+
+// Clawback moves funds not committed to any schedule out of the reward pool
+func (k msgServer) Clawback(ctx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	// Authority validation
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	// Validate the amount and the recipient
+	if err := validateAmount(msg.Amount); err != nil {
+		return nil, err
+	}
+	if !msg.Amount.IsPositive() {
+		return nil, fmt.Errorf("clawback amount must be positive")
+	}
+	if err := k.validateDestination(msg.Recipient); err != nil {
+		return nil, err
+	}
+
+	// The funds committed to the schedules can't be clawed back
+	if err := k.fundsAvailable(ctx, msg.Amount, 0); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.Clawback(ctx, msg.Amount, msg.Recipient); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClawbackResponse{}, nil
 }
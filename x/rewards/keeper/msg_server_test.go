@@ -1,12 +1,15 @@
 package keeper_test
 
 import (
+	"context"
+	"strconv"
 	"time"
 
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/kiichain/kiichain/v4/x/rewards/keeper"
 	"github.com/kiichain/kiichain/v4/x/rewards/types"
 )
 
@@ -112,8 +115,107 @@ func (suite *KeeperTestSuite) TestFundPool() {
 	}
 }
 
-// TestChangeSchedule tests changes to the release schedule
-func (suite *KeeperTestSuite) TestChangeSchedule() {
+// TestMultiDenomPool tests funding the pool and creating schedules with the allowed denoms
+func (suite *KeeperTestSuite) TestMultiDenomPool() {
+	params := types.DefaultParams()
+	params.AllowedDenoms = []string{"uusdc"}
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, params)
+	suite.Require().NoError(err)
+
+	// Give the sender some of the extra denom
+	suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(sdk.NewCoin("uusdc", math.NewInt(100000))))
+	suite.FundAcc(suite.TestAccs[0], sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(100000))))
+	authority := suite.App.RewardsKeeper.GetAuthority()
+
+	testCases := []struct {
+		name        string
+		fund        sdk.Coin
+		schedule    sdk.Coin
+		errContains string
+	}{
+		{
+			name:     "valid - token denom",
+			fund:     sdk.NewCoin(params.TokenDenom, math.NewInt(1000)),
+			schedule: sdk.NewCoin(params.TokenDenom, math.NewInt(1000)),
+		},
+		{
+			name:     "valid - allowed denom",
+			fund:     sdk.NewCoin("uusdc", math.NewInt(1000)),
+			schedule: sdk.NewCoin("uusdc", math.NewInt(1000)),
+		},
+		{
+			name:        "invalid - fund with a denom not allowed",
+			fund:        sdk.NewCoin("uatom", math.NewInt(1000)),
+			errContains: "is not allowed by the rewards pool",
+		},
+		{
+			name:        "invalid - schedule funded by another denom",
+			fund:        sdk.NewCoin(params.TokenDenom, math.NewInt(1000)),
+			schedule:    sdk.NewCoin("uusdc", math.NewInt(1000)),
+			errContains: "insufficient funds",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Set a cached context
+			ctx, _ := suite.Ctx.CacheContext()
+
+			_, err := suite.msgServer.FundPool(ctx, types.NewMsgFundPool(suite.TestAccs[0], tc.fund))
+			if tc.schedule.IsNil() {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			suite.Require().NoError(err)
+
+			_, err = suite.msgServer.CreateSchedule(ctx, &types.MsgCreateSchedule{
+				Authority: authority,
+				Schedule: types.ReleaseSchedule{
+					TotalAmount:    tc.schedule,
+					ReleasedAmount: sdk.NewCoin(tc.schedule.Denom, math.ZeroInt()),
+					EndTime:        ctx.BlockTime().Add(time.Hour),
+					Active:         true,
+				},
+			})
+			if tc.errContains != "" {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// The pool holds the funded denom
+			pool, err := suite.App.RewardsKeeper.RewardPool.Get(ctx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.fund.Amount.String(), pool.CommunityPool.AmountOf(tc.fund.Denom).TruncateInt().String())
+		})
+	}
+
+	// Removing a denom from the params keeps the existing schedules
+	_, err = suite.msgServer.FundPool(suite.Ctx, types.NewMsgFundPool(suite.TestAccs[0], sdk.NewCoin("uusdc", math.NewInt(1000))))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.CreateSchedule(suite.Ctx, &types.MsgCreateSchedule{
+		Authority: authority,
+		Schedule: types.ReleaseSchedule{
+			TotalAmount:    sdk.NewCoin("uusdc", math.NewInt(1000)),
+			ReleasedAmount: sdk.NewCoin("uusdc", math.ZeroInt()),
+			EndTime:        suite.Ctx.BlockTime().Add(time.Hour),
+			Active:         true,
+		},
+	})
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.App.RewardsKeeper.Params.Set(suite.Ctx, types.DefaultParams()))
+
+	suite.Require().NoError(suite.App.RewardsKeeper.BeginBlocker(suite.Ctx))
+	suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(2 * time.Hour))
+	suite.Require().NoError(suite.App.RewardsKeeper.BeginBlocker(suite.Ctx))
+	schedules, err := suite.App.RewardsKeeper.GetReleaseSchedules(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(schedules, 1)
+	suite.Require().Equal(int64(1000), schedules[0].ReleasedAmount.Amount.Int64())
+}
+
+// TestCreateSchedule tests the creation of release schedules
+func (suite *KeeperTestSuite) TestCreateSchedule() {
 	// Set up default params
 	defaultParams := types.DefaultParams()
 	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
@@ -142,6 +244,7 @@ func (suite *KeeperTestSuite) TestChangeSchedule() {
 	testCases := []struct {
 		name           string
 		authority      string
+		malleate       func(ctx sdk.Context)
 		modifySchedule func(types.ReleaseSchedule) types.ReleaseSchedule
 		expectedPass   bool
 	}{
@@ -216,6 +319,24 @@ func (suite *KeeperTestSuite) TestChangeSchedule() {
 			},
 			expectedPass: false,
 		},
+		{
+			name:      "released amount on a new schedule",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.ReleasedAmount.Amount = math.NewInt(100)
+				return s
+			},
+			expectedPass: false,
+		},
+		{
+			name:      "unset released amount",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.ReleasedAmount = sdk.Coin{}
+				return s
+			},
+			expectedPass: true,
+		},
 		{
 			name:      "last release in future",
 			authority: authority,
@@ -252,35 +373,1084 @@ func (suite *KeeperTestSuite) TestChangeSchedule() {
 			},
 			expectedPass: false,
 		},
+		{
+			name:      "valid schedule with start time and destination",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.StartTime = time.Now().Add(time.Hour)
+				s.Destination = suite.TestAccs[1].String()
+				return s
+			},
+			expectedPass: true,
+		},
+		{
+			name:      "start time after end time",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.StartTime = s.EndTime.Add(time.Hour)
+				return s
+			},
+			expectedPass: false,
+		},
+		{
+			name:      "invalid destination",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.Destination = "invalid"
+				return s
+			},
+			expectedPass: false,
+		},
+		{
+			name:      "blocked destination",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.Destination = suite.App.AccountKeeper.GetModuleAddress(types.ModuleName).String()
+				return s
+			},
+			expectedPass: false,
+		},
+		{
+			name:      "valid schedule with a cliff curve",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.StartTime = time.Now().Add(time.Hour)
+				s.Curve = types.ReleaseCurveCliff
+				s.CliffTime = time.Now().Add(time.Hour * 12)
+				return s
+			},
+			expectedPass: true,
+		},
+		{
+			name:      "valid schedule with an exponential curve",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.StartTime = time.Now()
+				s.Curve = types.ReleaseCurveExponential
+				s.HalfLife = uint64((time.Hour * 4).Seconds())
+				return s
+			},
+			expectedPass: true,
+		},
+		{
+			name:      "curve without start time",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.Curve = types.ReleaseCurveStep
+				s.StepDuration = uint64(time.Hour.Seconds())
+				return s
+			},
+			expectedPass: false,
+		},
+		{
+			name:      "cliff after end time",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.StartTime = time.Now()
+				s.Curve = types.ReleaseCurveCliff
+				s.CliffTime = s.EndTime.Add(time.Hour)
+				return s
+			},
+			expectedPass: false,
+		},
+		{
+			name:      "step longer than the schedule",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.StartTime = time.Now()
+				s.Curve = types.ReleaseCurveStep
+				s.StepDuration = uint64((time.Hour * 48).Seconds())
+				return s
+			},
+			expectedPass: false,
+		},
+		{
+			name:      "linear schedule with a half life",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.HalfLife = 100
+				return s
+			},
+			expectedPass: false,
+		},
+		{
+			name:      "valid schedule with splits",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.Splits = []types.ReleaseSplit{
+					{Type: types.DestinationFeeCollector, Weight: math.LegacyNewDecWithPrec(5, 1)},
+					{Type: types.DestinationCommunityPool, Weight: math.LegacyNewDecWithPrec(2, 1)},
+					{Type: types.DestinationModuleAccount, Target: "oracle", Weight: math.LegacyNewDecWithPrec(2, 1)},
+					{Type: types.DestinationAddress, Target: suite.TestAccs[1].String(), Weight: math.LegacyNewDecWithPrec(1, 1)},
+				}
+				return s
+			},
+			expectedPass: true,
+		},
+		{
+			name:      "split weights not adding up to one",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.Splits = []types.ReleaseSplit{
+					{Type: types.DestinationFeeCollector, Weight: math.LegacyNewDecWithPrec(5, 1)},
+				}
+				return s
+			},
+			expectedPass: false,
+		},
+		{
+			name:      "split to an unknown module account",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.Splits = []types.ReleaseSplit{
+					{Type: types.DestinationModuleAccount, Target: "unknown", Weight: math.LegacyOneDec()},
+				}
+				return s
+			},
+			expectedPass: false,
+		},
+		{
+			name:      "split to a blocked address",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.Splits = []types.ReleaseSplit{
+					{Type: types.DestinationAddress, Target: suite.App.AccountKeeper.GetModuleAddress("distribution").String(), Weight: math.LegacyOneDec()},
+				}
+				return s
+			},
+			expectedPass: false,
+		},
+		{
+			name:      "split to an address that is not a contract",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.Splits = []types.ReleaseSplit{
+					{Type: types.DestinationContract, Target: suite.TestAccs[1].String(), Weight: math.LegacyOneDec()},
+				}
+				return s
+			},
+			expectedPass: false,
+		},
+		{
+			name:      "funds committed to other schedules",
+			authority: authority,
+			malleate: func(ctx sdk.Context) {
+				// Commit most of the pool to another schedule
+				committed := validSchedule
+				committed.Id = 100
+				committed.TotalAmount.Amount = math.NewInt(80000)
+				err := suite.App.RewardsKeeper.ReleaseSchedules.Set(ctx, committed.Id, committed)
+				suite.Require().NoError(err)
+			},
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				return s
+			},
+			expectedPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
+			// Set a cached context
+			ctx, _ := suite.Ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
 			// Create modified schedule
 			modifiedSchedule := tc.modifySchedule(validSchedule)
 
 			// Create message
-			msg := &types.MsgChangeSchedule{
-				Authority: tc.authority,
-				Schedule:  modifiedSchedule,
-			}
+			msg := types.NewMsgCreateSchedule(tc.authority, modifiedSchedule)
 
-			_, err := suite.msgServer.ChangeSchedule(suite.Ctx, msg)
+			res, err := suite.msgServer.CreateSchedule(ctx, msg)
 			if tc.expectedPass {
 				suite.Require().NoError(err)
 
-				// Verify schedule was updated
-				storedSchedule, err := suite.App.RewardsKeeper.ReleaseSchedule.Get(suite.Ctx)
+				// Verify schedule was created under the returned id
+				storedSchedule, err := suite.App.RewardsKeeper.ReleaseSchedules.Get(ctx, res.Id)
 				suite.Require().NoError(err)
+				suite.Require().Equal(res.Id, storedSchedule.Id)
 				// Check individually cause times can be utc vs local but same stamp
 				suite.Require().Equal(modifiedSchedule.Active, storedSchedule.Active)
-				suite.Require().Equal(modifiedSchedule.ReleasedAmount, storedSchedule.ReleasedAmount)
+				suite.Require().True(storedSchedule.ReleasedAmount.IsZero())
+				suite.Require().Equal(modifiedSchedule.TotalAmount.Denom, storedSchedule.ReleasedAmount.Denom)
 				suite.Require().Equal(modifiedSchedule.TotalAmount, storedSchedule.TotalAmount)
+				suite.Require().Equal(modifiedSchedule.Destination, storedSchedule.Destination)
 				suite.Require().True(modifiedSchedule.LastReleaseTime.Equal(storedSchedule.LastReleaseTime))
+				suite.Require().True(modifiedSchedule.StartTime.Equal(storedSchedule.StartTime))
 				suite.Require().True(modifiedSchedule.EndTime.Equal(storedSchedule.EndTime))
+				suite.Require().Equal(modifiedSchedule.Curve, storedSchedule.Curve)
+				suite.Require().Equal(modifiedSchedule.Splits, storedSchedule.Splits)
+
+				// Verify the event was emitted
+				suite.requireScheduleEvent(ctx, types.EventTypeCreateSchedule, res.Id)
 			} else {
 				suite.Require().Error(err)
 			}
 		})
 	}
 }
+
+// mockWasmKeeper is a wasm keeper that knows a fixed set of contracts
+type mockWasmKeeper struct {
+	contracts map[string]bool
+}
+
+// HasContractInfo returns true if the contract is on the mock set
+func (m mockWasmKeeper) HasContractInfo(_ context.Context, contractAddress sdk.AccAddress) bool {
+	return m.contracts[contractAddress.String()]
+}
+
+// TestCreateScheduleContractSplit tests the creation of schedules releasing to contracts
+func (suite *KeeperTestSuite) TestCreateScheduleContractSplit() {
+	// Set up default params
+	defaultParams := types.DefaultParams()
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+
+	// Fund the pool first
+	_, err = suite.msgServer.FundPool(suite.Ctx, types.NewMsgFundPool(
+		suite.TestAccs[0],
+		sdk.NewCoin(defaultParams.TokenDenom, math.NewInt(100000))))
+	suite.Require().NoError(err)
+
+	// Use a keeper that knows a single contract
+	contract := sdk.AccAddress("contract")
+	rewardsKeeper := suite.App.RewardsKeeper
+	rewardsKeeper.SetWasmKeeper(mockWasmKeeper{contracts: map[string]bool{contract.String(): true}})
+	suite.OverrideMsgServer(rewardsKeeper)
+
+	testCases := []struct {
+		name         string
+		contract     sdk.AccAddress
+		expectedPass bool
+	}{
+		{
+			name:         "valid - existing contract",
+			contract:     contract,
+			expectedPass: true,
+		},
+		{
+			name:         "invalid - unknown contract",
+			contract:     sdk.AccAddress("other"),
+			expectedPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Set a cached context
+			ctx, _ := suite.Ctx.CacheContext()
+
+			schedule := types.ReleaseSchedule{
+				TotalAmount: sdk.NewCoin(defaultParams.TokenDenom, math.NewInt(50000)),
+				EndTime:     ctx.BlockTime().Add(time.Hour * 24),
+				Active:      true,
+				Splits: []types.ReleaseSplit{
+					{Type: types.DestinationFeeCollector, Weight: math.LegacyNewDecWithPrec(5, 1)},
+					{Type: types.DestinationContract, Target: tc.contract.String(), Weight: math.LegacyNewDecWithPrec(5, 1)},
+				},
+			}
+			_, err := suite.msgServer.CreateSchedule(ctx, types.NewMsgCreateSchedule(suite.App.RewardsKeeper.GetAuthority(), schedule))
+			if tc.expectedPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorContains(err, "does not exist")
+			}
+		})
+	}
+}
+
+// TestCreateScheduleIDs tests that concurrent schedules get sequential ids
+func (suite *KeeperTestSuite) TestCreateScheduleIDs() {
+	// Set up default params
+	defaultParams := types.DefaultParams()
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+
+	// Fund the pool first
+	fundMsg := types.NewMsgFundPool(
+		suite.TestAccs[0],
+		sdk.NewCoin(defaultParams.TokenDenom, math.NewInt(100000)))
+	_, err = suite.msgServer.FundPool(suite.Ctx, fundMsg)
+	suite.Require().NoError(err)
+
+	// Create schedules until the pool is fully committed
+	schedule := types.ReleaseSchedule{
+		TotalAmount: sdk.NewCoin(defaultParams.TokenDenom, math.NewInt(50000)),
+		EndTime:     time.Now().Add(time.Hour * 24),
+		Active:      true,
+	}
+	authority := suite.App.RewardsKeeper.GetAuthority()
+	for _, expectedID := range []uint64{1, 2} {
+		res, err := suite.msgServer.CreateSchedule(suite.Ctx, types.NewMsgCreateSchedule(authority, schedule))
+		suite.Require().NoError(err)
+		suite.Require().Equal(expectedID, res.Id)
+	}
+
+	// The pool has no more free funds
+	_, err = suite.msgServer.CreateSchedule(suite.Ctx, types.NewMsgCreateSchedule(authority, schedule))
+	suite.Require().ErrorContains(err, "insufficient funds")
+
+	// Both schedules are stored
+	schedules, err := suite.App.RewardsKeeper.GetReleaseSchedules(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(schedules, 2)
+}
+
+// TestAmendSchedule tests changes to an existing release schedule
+func (suite *KeeperTestSuite) TestAmendSchedule() {
+	// Set up default params
+	defaultParams := types.DefaultParams()
+	denom := defaultParams.TokenDenom
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+
+	// Fund the pool first
+	fundMsg := types.NewMsgFundPool(suite.TestAccs[0], sdk.NewCoin(denom, math.NewInt(100000)))
+	_, err = suite.msgServer.FundPool(suite.Ctx, fundMsg)
+	suite.Require().NoError(err)
+
+	// Get module authority
+	authority := suite.App.RewardsKeeper.GetAuthority()
+
+	// Store a schedule that already released part of the amount
+	lastRelease := time.Now().Add(-time.Hour)
+	stored := types.ReleaseSchedule{
+		Id:              1,
+		TotalAmount:     sdk.NewCoin(denom, math.NewInt(50000)),
+		ReleasedAmount:  sdk.NewCoin(denom, math.NewInt(10000)),
+		EndTime:         time.Now().Add(time.Hour * 24),
+		LastReleaseTime: lastRelease,
+		Active:          true,
+	}
+	err = suite.App.RewardsKeeper.ReleaseSchedules.Set(suite.Ctx, stored.Id, stored)
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name           string
+		authority      string
+		modifySchedule func(types.ReleaseSchedule) types.ReleaseSchedule
+		errContains    string
+	}{
+		{
+			name:      "valid - extend the schedule",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.TotalAmount.Amount = math.NewInt(90000)
+				s.EndTime = s.EndTime.Add(time.Hour * 24)
+				s.Destination = suite.TestAccs[1].String()
+				return s
+			},
+		},
+		{
+			name:      "valid - released values are kept",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.ReleasedAmount = sdk.NewCoin(denom, math.ZeroInt())
+				s.LastReleaseTime = time.Time{}
+				return s
+			},
+		},
+		{
+			name:      "invalid - authority",
+			authority: suite.TestAccs[0].String(),
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				return s
+			},
+			errContains: "invalid authority",
+		},
+		{
+			name:      "invalid - unknown schedule",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.Id = 2
+				return s
+			},
+			errContains: "release schedule 2 not found",
+		},
+		{
+			name:      "invalid - total below the released amount",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.TotalAmount.Amount = math.NewInt(5000)
+				return s
+			},
+			errContains: "cannot exceed total amount",
+		},
+		{
+			name:      "invalid - insufficient funds",
+			authority: authority,
+			modifySchedule: func(s types.ReleaseSchedule) types.ReleaseSchedule {
+				s.TotalAmount.Amount = math.NewInt(200000)
+				return s
+			},
+			errContains: "insufficient funds",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Set a cached context
+			ctx, _ := suite.Ctx.CacheContext()
+
+			// Create message
+			modifiedSchedule := tc.modifySchedule(stored)
+			msg := types.NewMsgAmendSchedule(tc.authority, modifiedSchedule)
+
+			_, err := suite.msgServer.AmendSchedule(ctx, msg)
+			if tc.errContains != "" {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// Verify the schedule was amended and the released values kept
+			storedSchedule, err := suite.App.RewardsKeeper.ReleaseSchedules.Get(ctx, stored.Id)
+			suite.Require().NoError(err)
+			suite.Require().Equal(modifiedSchedule.TotalAmount, storedSchedule.TotalAmount)
+			suite.Require().Equal(modifiedSchedule.Destination, storedSchedule.Destination)
+			suite.Require().True(modifiedSchedule.EndTime.Equal(storedSchedule.EndTime))
+			suite.Require().Equal(stored.ReleasedAmount, storedSchedule.ReleasedAmount)
+			suite.Require().True(stored.LastReleaseTime.Equal(storedSchedule.LastReleaseTime))
+
+			// Verify the event was emitted
+			suite.requireScheduleEvent(ctx, types.EventTypeAmendSchedule, stored.Id)
+		})
+	}
+}
+
+// TestCancelSchedule tests the removal of release schedules
+func (suite *KeeperTestSuite) TestCancelSchedule() {
+	// Store a schedule
+	denom := types.DefaultParams().TokenDenom
+	stored := types.ReleaseSchedule{
+		Id:             1,
+		TotalAmount:    sdk.NewCoin(denom, math.NewInt(50000)),
+		ReleasedAmount: sdk.NewCoin(denom, math.ZeroInt()),
+		EndTime:        time.Now().Add(time.Hour * 24),
+		Active:         true,
+	}
+	err := suite.App.RewardsKeeper.ReleaseSchedules.Set(suite.Ctx, stored.Id, stored)
+	suite.Require().NoError(err)
+
+	// Get module authority
+	authority := suite.App.RewardsKeeper.GetAuthority()
+
+	testCases := []struct {
+		name        string
+		msg         *types.MsgCancelSchedule
+		errContains string
+	}{
+		{
+			name: "valid - cancel the schedule",
+			msg:  types.NewMsgCancelSchedule(authority, stored.Id),
+		},
+		{
+			name:        "invalid - authority",
+			msg:         types.NewMsgCancelSchedule(suite.TestAccs[0].String(), stored.Id),
+			errContains: "invalid authority",
+		},
+		{
+			name:        "invalid - unknown schedule",
+			msg:         types.NewMsgCancelSchedule(authority, 2),
+			errContains: "release schedule 2 not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Set a cached context
+			ctx, _ := suite.Ctx.CacheContext()
+
+			_, err := suite.msgServer.CancelSchedule(ctx, tc.msg)
+			if tc.errContains != "" {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// Verify the schedule was removed
+			exists, err := suite.App.RewardsKeeper.ReleaseSchedules.Has(ctx, tc.msg.Id)
+			suite.Require().NoError(err)
+			suite.Require().False(exists)
+
+			// Verify the event was emitted with the freed amount
+			suite.requireScheduleEvent(ctx, types.EventTypeCancelSchedule, tc.msg.Id)
+			suite.Require().True(hasEventAttribute(ctx, types.EventTypeCancelSchedule, types.AttributeKeyUnreleased, stored.TotalAmount.String()))
+		})
+	}
+}
+
+// TestScheduleValidationBlockTime tests that the schedules are validated against the block time
+func (suite *KeeperTestSuite) TestScheduleValidationBlockTime() {
+	// Set up default params
+	defaultParams := types.DefaultParams()
+	denom := defaultParams.TokenDenom
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+
+	// Fund the pool first
+	fundMsg := types.NewMsgFundPool(suite.TestAccs[0], sdk.NewCoin(denom, math.NewInt(100000)))
+	_, err = suite.msgServer.FundPool(suite.Ctx, fundMsg)
+	suite.Require().NoError(err)
+
+	// A historical block time, far behind the wall clock
+	historical := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	authority := suite.App.RewardsKeeper.GetAuthority()
+
+	testCases := []struct {
+		name        string
+		blockTime   time.Time
+		schedule    types.ReleaseSchedule
+		errContains string
+	}{
+		{
+			name:      "valid - end time after a historical block time",
+			blockTime: historical,
+			schedule: types.ReleaseSchedule{
+				TotalAmount: sdk.NewCoin(denom, math.NewInt(1000)),
+				EndTime:     historical.Add(time.Hour * 24),
+				Active:      true,
+			},
+		},
+		{
+			name:      "valid - future start time",
+			blockTime: historical,
+			schedule: types.ReleaseSchedule{
+				TotalAmount: sdk.NewCoin(denom, math.NewInt(1000)),
+				StartTime:   historical.Add(time.Hour * 24),
+				EndTime:     historical.Add(time.Hour * 48),
+				Active:      true,
+			},
+		},
+		{
+			name:      "invalid - end time before the block time",
+			blockTime: historical.Add(time.Hour * 48),
+			schedule: types.ReleaseSchedule{
+				TotalAmount: sdk.NewCoin(denom, math.NewInt(1000)),
+				EndTime:     historical.Add(time.Hour * 24),
+				Active:      true,
+			},
+			errContains: "is not in the future",
+		},
+		{
+			name:      "invalid - end time equal to the block time",
+			blockTime: historical,
+			schedule: types.ReleaseSchedule{
+				TotalAmount: sdk.NewCoin(denom, math.NewInt(1000)),
+				EndTime:     historical,
+				Active:      true,
+			},
+			errContains: "is not in the future",
+		},
+		{
+			name:      "invalid - end time after the wall clock but before the block time",
+			blockTime: time.Now().Add(time.Hour * 48),
+			schedule: types.ReleaseSchedule{
+				TotalAmount: sdk.NewCoin(denom, math.NewInt(1000)),
+				EndTime:     time.Now().Add(time.Hour * 24),
+				Active:      true,
+			},
+			errContains: "is not in the future",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Set a cached context at the block time
+			ctx, _ := suite.Ctx.CacheContext()
+			ctx = ctx.WithBlockTime(tc.blockTime)
+
+			_, err := suite.msgServer.CreateSchedule(ctx, types.NewMsgCreateSchedule(authority, tc.schedule))
+			if tc.errContains != "" {
+				suite.Require().ErrorContains(err, tc.errContains)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
+// TestScheduleReplay tests that replaying the same messages and blocks gives the same state
+func (suite *KeeperTestSuite) TestScheduleReplay() {
+	// Set up default params
+	defaultParams := types.DefaultParams()
+	denom := defaultParams.TokenDenom
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+
+	// Fund the pool first
+	fundMsg := types.NewMsgFundPool(suite.TestAccs[0], sdk.NewCoin(denom, math.NewInt(100000)))
+	_, err = suite.msgServer.FundPool(suite.Ctx, fundMsg)
+	suite.Require().NoError(err)
+
+	// The replayed history starts on a historical block time
+	historical := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	authority := suite.App.RewardsKeeper.GetAuthority()
+
+	// replay runs the history on a fresh cached context and returns the final state
+	replay := func() ([]types.ReleaseSchedule, types.RewardPool) {
+		ctx, _ := suite.Ctx.CacheContext()
+
+		// Create a schedule starting right away and another starting a day later
+		ctx = ctx.WithBlockTime(historical)
+		_, err := suite.msgServer.CreateSchedule(ctx, types.NewMsgCreateSchedule(authority, types.ReleaseSchedule{
+			TotalAmount: sdk.NewCoin(denom, math.NewInt(10000)),
+			EndTime:     historical.Add(time.Hour * 48),
+			Active:      true,
+		}))
+		suite.Require().NoError(err)
+		_, err = suite.msgServer.CreateSchedule(ctx, types.NewMsgCreateSchedule(authority, types.ReleaseSchedule{
+			TotalAmount: sdk.NewCoin(denom, math.NewInt(20000)),
+			StartTime:   historical.Add(time.Hour * 24),
+			EndTime:     historical.Add(time.Hour * 72),
+			Active:      true,
+		}))
+		suite.Require().NoError(err)
+
+		// Run blocks every hour for three days
+		for hour := 0; hour <= 72; hour++ {
+			ctx = ctx.WithBlockTime(historical.Add(time.Hour * time.Duration(hour)))
+			suite.Require().NoError(suite.App.RewardsKeeper.BeginBlocker(ctx))
+
+			// The second schedule stays idle until its start time
+			if hour < 24 {
+				schedule, err := suite.App.RewardsKeeper.GetReleaseSchedule(ctx, 2)
+				suite.Require().NoError(err)
+				suite.Require().True(schedule.LastReleaseTime.IsZero())
+				suite.Require().True(schedule.ReleasedAmount.IsZero())
+			}
+
+			// Amend the first schedule in the middle of the history
+			if hour == 12 {
+				_, err := suite.msgServer.AmendSchedule(ctx, types.NewMsgAmendSchedule(authority, types.ReleaseSchedule{
+					Id:          1,
+					TotalAmount: sdk.NewCoin(denom, math.NewInt(15000)),
+					EndTime:     historical.Add(time.Hour * 36),
+					Active:      true,
+				}))
+				suite.Require().NoError(err)
+			}
+		}
+
+		schedules, err := suite.App.RewardsKeeper.GetReleaseSchedules(ctx)
+		suite.Require().NoError(err)
+		pool, err := suite.App.RewardsKeeper.RewardPool.Get(ctx)
+		suite.Require().NoError(err)
+		return schedules, pool
+	}
+
+	// Both replays must end on the same state
+	firstSchedules, firstPool := replay()
+	secondSchedules, secondPool := replay()
+	suite.Require().Equal(firstSchedules, secondSchedules)
+	suite.Require().Equal(firstPool, secondPool)
+
+	// All the amounts were released
+	suite.Require().Len(firstSchedules, 2)
+	suite.Require().Equal(math.NewInt(15000), firstSchedules[0].ReleasedAmount.Amount)
+	suite.Require().Equal(math.NewInt(20000), firstSchedules[1].ReleasedAmount.Amount)
+	suite.Require().Equal(math.LegacyNewDec(65000), firstPool.CommunityPool.AmountOf(denom))
+}
+
+// TestPauseSchedule tests pausing the releases of a schedule
+func (suite *KeeperTestSuite) TestPauseSchedule() {
+	// Store an active and a paused schedule
+	denom := types.DefaultParams().TokenDenom
+	active := types.ReleaseSchedule{
+		Id:              1,
+		TotalAmount:     sdk.NewCoin(denom, math.NewInt(50000)),
+		ReleasedAmount:  sdk.NewCoin(denom, math.NewInt(1000)),
+		LastReleaseTime: suite.Ctx.BlockTime(),
+		EndTime:         suite.Ctx.BlockTime().Add(time.Hour * 24),
+		Active:          true,
+	}
+	paused := active
+	paused.Id = 2
+	paused.Active = false
+	for _, schedule := range []types.ReleaseSchedule{active, paused} {
+		err := suite.App.RewardsKeeper.ReleaseSchedules.Set(suite.Ctx, schedule.Id, schedule)
+		suite.Require().NoError(err)
+	}
+
+	// Get module authority
+	authority := suite.App.RewardsKeeper.GetAuthority()
+
+	testCases := []struct {
+		name        string
+		msg         *types.MsgPauseSchedule
+		errContains string
+	}{
+		{
+			name: "valid - pause the schedule",
+			msg:  types.NewMsgPauseSchedule(authority, active.Id),
+		},
+		{
+			name:        "invalid - authority",
+			msg:         types.NewMsgPauseSchedule(suite.TestAccs[0].String(), active.Id),
+			errContains: "invalid authority",
+		},
+		{
+			name:        "invalid - already paused",
+			msg:         types.NewMsgPauseSchedule(authority, paused.Id),
+			errContains: "is not active",
+		},
+		{
+			name:        "invalid - unknown schedule",
+			msg:         types.NewMsgPauseSchedule(authority, 3),
+			errContains: "release schedule 3 not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Set a cached context
+			ctx, _ := suite.Ctx.CacheContext()
+
+			_, err := suite.msgServer.PauseSchedule(ctx, tc.msg)
+			if tc.errContains != "" {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// Verify only the active flag changed
+			schedule, err := suite.App.RewardsKeeper.ReleaseSchedules.Get(ctx, tc.msg.Id)
+			suite.Require().NoError(err)
+			suite.Require().False(schedule.Active)
+			suite.Require().Equal(active.ReleasedAmount, schedule.ReleasedAmount)
+			suite.Require().True(active.LastReleaseTime.Equal(schedule.LastReleaseTime))
+			suite.Require().True(ctx.BlockTime().Equal(schedule.PausedTime))
+
+			// Verify the event was emitted
+			suite.requireScheduleEvent(ctx, types.EventTypePauseSchedule, tc.msg.Id)
+		})
+	}
+}
+
+// TestResumeSchedule tests resuming the releases of a paused schedule
+func (suite *KeeperTestSuite) TestResumeSchedule() {
+	// Set up default params
+	defaultParams := types.DefaultParams()
+	denom := defaultParams.TokenDenom
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+
+	// Fund the pool
+	_, err = suite.msgServer.FundPool(suite.Ctx, types.NewMsgFundPool(suite.TestAccs[0], sdk.NewCoin(denom, math.NewInt(50000))))
+	suite.Require().NoError(err)
+
+	// Store a paused schedule
+	paused := types.ReleaseSchedule{
+		Id:              1,
+		TotalAmount:     sdk.NewCoin(denom, math.NewInt(20000)),
+		ReleasedAmount:  sdk.NewCoin(denom, math.NewInt(1000)),
+		LastReleaseTime: suite.Ctx.BlockTime(),
+		EndTime:         suite.Ctx.BlockTime().Add(time.Hour * 24),
+		Active:          false,
+	}
+	err = suite.App.RewardsKeeper.ReleaseSchedules.Set(suite.Ctx, paused.Id, paused)
+	suite.Require().NoError(err)
+
+	// Get module authority
+	authority := suite.App.RewardsKeeper.GetAuthority()
+
+	testCases := []struct {
+		name        string
+		malleate    func(ctx sdk.Context)
+		msg         *types.MsgResumeSchedule
+		shift       time.Duration
+		errContains string
+	}{
+		{
+			name: "valid - resume the schedule",
+			msg:  types.NewMsgResumeSchedule(authority, paused.Id),
+		},
+		{
+			name: "valid - resume shifts the schedule by the paused duration",
+			malleate: func(ctx sdk.Context) {
+				schedule := paused
+				schedule.PausedTime = ctx.BlockTime().Add(-time.Hour)
+				suite.Require().NoError(suite.App.RewardsKeeper.ReleaseSchedules.Set(ctx, schedule.Id, schedule))
+			},
+			msg:   types.NewMsgResumeSchedule(authority, paused.Id),
+			shift: time.Hour,
+		},
+		{
+			name:        "invalid - authority",
+			msg:         types.NewMsgResumeSchedule(suite.TestAccs[0].String(), paused.Id),
+			errContains: "invalid authority",
+		},
+		{
+			name:        "invalid - unknown schedule",
+			msg:         types.NewMsgResumeSchedule(authority, 2),
+			errContains: "release schedule 2 not found",
+		},
+		{
+			name: "invalid - already active",
+			malleate: func(ctx sdk.Context) {
+				schedule := paused
+				schedule.Active = true
+				suite.Require().NoError(suite.App.RewardsKeeper.ReleaseSchedules.Set(ctx, schedule.Id, schedule))
+			},
+			msg:         types.NewMsgResumeSchedule(authority, paused.Id),
+			errContains: "is already active",
+		},
+		{
+			name: "invalid - nothing left to release",
+			malleate: func(ctx sdk.Context) {
+				schedule := paused
+				schedule.ReleasedAmount = schedule.TotalAmount
+				suite.Require().NoError(suite.App.RewardsKeeper.ReleaseSchedules.Set(ctx, schedule.Id, schedule))
+			},
+			msg:         types.NewMsgResumeSchedule(authority, paused.Id),
+			errContains: "nothing left to release",
+		},
+		{
+			name: "invalid - schedule ended",
+			malleate: func(ctx sdk.Context) {
+				schedule := paused
+				schedule.EndTime = ctx.BlockTime().Add(-time.Hour)
+				schedule.LastReleaseTime = ctx.BlockTime().Add(-time.Hour * 2)
+				suite.Require().NoError(suite.App.RewardsKeeper.ReleaseSchedules.Set(ctx, schedule.Id, schedule))
+			},
+			msg:         types.NewMsgResumeSchedule(authority, paused.Id),
+			errContains: "amend its end time",
+		},
+		{
+			name: "invalid - funds clawed back",
+			malleate: func(ctx sdk.Context) {
+				// Another schedule takes the funds
+				other := paused
+				other.Id = 2
+				other.TotalAmount = sdk.NewCoin(denom, math.NewInt(40000))
+				other.ReleasedAmount = sdk.NewCoin(denom, math.ZeroInt())
+				suite.Require().NoError(suite.App.RewardsKeeper.ReleaseSchedules.Set(ctx, other.Id, other))
+			},
+			msg:         types.NewMsgResumeSchedule(authority, paused.Id),
+			errContains: "has less funds than requested",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Set a cached context
+			ctx, _ := suite.Ctx.CacheContext()
+			if tc.malleate != nil {
+				tc.malleate(ctx)
+			}
+
+			_, err := suite.msgServer.ResumeSchedule(ctx, tc.msg)
+			if tc.errContains != "" {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// Verify the schedule is active with the same accounting, shifted by the paused duration
+			schedule, err := suite.App.RewardsKeeper.ReleaseSchedules.Get(ctx, tc.msg.Id)
+			suite.Require().NoError(err)
+			suite.Require().True(schedule.Active)
+			suite.Require().True(schedule.PausedTime.IsZero())
+			suite.Require().Equal(paused.ReleasedAmount, schedule.ReleasedAmount)
+			suite.Require().True(paused.LastReleaseTime.Add(tc.shift).Equal(schedule.LastReleaseTime))
+			suite.Require().True(paused.EndTime.Add(tc.shift).Equal(schedule.EndTime))
+
+			// Verify the event was emitted
+			suite.requireScheduleEvent(ctx, types.EventTypeResumeSchedule, tc.msg.Id)
+		})
+	}
+}
+
+// TestPauseResumeRelease tests that a paused schedule doesn't release and continues from where it was paused once resumed
+func (suite *KeeperTestSuite) TestPauseResumeRelease() {
+	// Set up default params
+	defaultParams := types.DefaultParams()
+	denom := defaultParams.TokenDenom
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+
+	// Fund the pool and create a schedule releasing 1000 per hour
+	_, err = suite.msgServer.FundPool(suite.Ctx, types.NewMsgFundPool(suite.TestAccs[0], sdk.NewCoin(denom, math.NewInt(10000))))
+	suite.Require().NoError(err)
+	start := suite.Ctx.BlockTime()
+	authority := suite.App.RewardsKeeper.GetAuthority()
+	res, err := suite.msgServer.CreateSchedule(suite.Ctx, types.NewMsgCreateSchedule(authority, types.ReleaseSchedule{
+		TotalAmount: sdk.NewCoin(denom, math.NewInt(10000)),
+		StartTime:   start,
+		EndTime:     start.Add(time.Hour * 10),
+		Active:      true,
+	}))
+	suite.Require().NoError(err)
+
+	// Run the blocks, pausing on hour 3 and resuming on hour 5
+	var releasedBeforePause, releasedAfterResume math.Int
+	for hour := 0; hour <= 5; hour++ {
+		ctx := suite.Ctx.WithBlockTime(start.Add(time.Duration(hour) * time.Hour))
+		switch hour {
+		case 3:
+			_, err = suite.msgServer.PauseSchedule(ctx, types.NewMsgPauseSchedule(authority, res.Id))
+			suite.Require().NoError(err)
+		case 5:
+			_, err = suite.msgServer.ResumeSchedule(ctx, types.NewMsgResumeSchedule(authority, res.Id))
+			suite.Require().NoError(err)
+		}
+		suite.Require().NoError(suite.App.RewardsKeeper.BeginBlocker(ctx))
+
+		// Nothing is released while paused
+		schedule, err := suite.App.RewardsKeeper.ReleaseSchedules.Get(ctx, res.Id)
+		suite.Require().NoError(err)
+		switch hour {
+		case 2:
+			releasedBeforePause = schedule.ReleasedAmount.Amount
+		case 3, 4:
+			suite.Require().Equal(releasedBeforePause, schedule.ReleasedAmount.Amount, "hour %d", hour)
+		case 5:
+			releasedAfterResume = schedule.ReleasedAmount.Amount
+		}
+	}
+
+	// The schedule was shifted by the two paused hours, it now ends on hour 12
+	schedule, err := suite.App.RewardsKeeper.ReleaseSchedules.Get(suite.Ctx, res.Id)
+	suite.Require().NoError(err)
+	suite.Require().True(start.Add(time.Hour * 12).Equal(schedule.EndTime))
+	suite.Require().True(schedule.PausedTime.IsZero())
+
+	// The first block after resuming releases a single hour, the one between the last release and the pause
+	// The remaining amount from hour 2 is released over the 8 hours left from the shifted last release
+	expectedReleased := releasedBeforePause.Add(math.NewInt(10000).Sub(releasedBeforePause).QuoRaw(8))
+	suite.Require().Equal(expectedReleased, releasedAfterResume)
+
+	// The pool keeps the unreleased amount
+	rewardPool, err := suite.App.RewardsKeeper.RewardPool.Get(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.LegacyNewDecFromInt(math.NewInt(10000).Sub(expectedReleased)), rewardPool.CommunityPool.AmountOf(denom))
+}
+
+// TestClawback tests moving the funds not committed to schedules out of the pool
+func (suite *KeeperTestSuite) TestClawback() {
+	// Set up default params
+	defaultParams := types.DefaultParams()
+	denom := defaultParams.TokenDenom
+	err := suite.App.RewardsKeeper.Params.Set(suite.Ctx, defaultParams)
+	suite.Require().NoError(err)
+
+	// Fund the pool and commit part of it to a paused schedule
+	_, err = suite.msgServer.FundPool(suite.Ctx, types.NewMsgFundPool(suite.TestAccs[0], sdk.NewCoin(denom, math.NewInt(10000))))
+	suite.Require().NoError(err)
+	committed := types.ReleaseSchedule{
+		Id:             1,
+		TotalAmount:    sdk.NewCoin(denom, math.NewInt(8000)),
+		ReleasedAmount: sdk.NewCoin(denom, math.NewInt(2000)),
+		EndTime:        suite.Ctx.BlockTime().Add(time.Hour),
+		Active:         false,
+	}
+	err = suite.App.RewardsKeeper.ReleaseSchedules.Set(suite.Ctx, committed.Id, committed)
+	suite.Require().NoError(err)
+
+	// Get module authority
+	authority := suite.App.RewardsKeeper.GetAuthority()
+	recipient := suite.TestAccs[1]
+
+	testCases := []struct {
+		name        string
+		msg         *types.MsgClawback
+		errContains string
+	}{
+		{
+			name: "valid - all the free funds to an address",
+			msg:  types.NewMsgClawback(authority, sdk.NewCoin(denom, math.NewInt(4000)), recipient.String()),
+		},
+		{
+			name: "valid - to the community pool",
+			msg:  types.NewMsgClawback(authority, sdk.NewCoin(denom, math.NewInt(1000)), ""),
+		},
+		{
+			name:        "invalid - authority",
+			msg:         types.NewMsgClawback(suite.TestAccs[0].String(), sdk.NewCoin(denom, math.NewInt(1000)), ""),
+			errContains: "invalid authority",
+		},
+		{
+			name:        "invalid - committed funds",
+			msg:         types.NewMsgClawback(authority, sdk.NewCoin(denom, math.NewInt(4001)), recipient.String()),
+			errContains: "has less funds than requested",
+		},
+		{
+			name:        "invalid - zero amount",
+			msg:         types.NewMsgClawback(authority, sdk.NewCoin(denom, math.ZeroInt()), ""),
+			errContains: "must be positive",
+		},
+		{
+			name:        "invalid - recipient",
+			msg:         types.NewMsgClawback(authority, sdk.NewCoin(denom, math.NewInt(1000)), "invalid"),
+			errContains: "invalid destination address",
+		},
+		{
+			name:        "invalid - blocked recipient",
+			msg:         types.NewMsgClawback(authority, sdk.NewCoin(denom, math.NewInt(1000)), suite.App.AccountKeeper.GetModuleAddress("distribution").String()),
+			errContains: "is not allowed to receive funds",
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Set a cached context
+			ctx, _ := suite.Ctx.CacheContext()
+
+			// Get the initial balances
+			initialRecipient := suite.App.BankKeeper.GetBalance(ctx, recipient, denom).Amount
+			initialFeePool, err := suite.App.DistrKeeper.FeePool.Get(ctx)
+			suite.Require().NoError(err)
+
+			_, err = suite.msgServer.Clawback(ctx, tc.msg)
+			if tc.errContains != "" {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// The pool was deducted
+			rewardPool, err := suite.App.RewardsKeeper.RewardPool.Get(ctx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(math.LegacyNewDecFromInt(math.NewInt(10000).Sub(tc.msg.Amount.Amount)), rewardPool.CommunityPool.AmountOf(denom))
+
+			// The funds reached the recipient
+			if tc.msg.Recipient == "" {
+				feePool, err := suite.App.DistrKeeper.FeePool.Get(ctx)
+				suite.Require().NoError(err)
+				suite.Require().Equal(
+					initialFeePool.CommunityPool.AmountOf(denom).Add(math.LegacyNewDecFromInt(tc.msg.Amount.Amount)),
+					feePool.CommunityPool.AmountOf(denom),
+				)
+			} else {
+				suite.Require().Equal(initialRecipient.Add(tc.msg.Amount.Amount), suite.App.BankKeeper.GetBalance(ctx, recipient, denom).Amount)
+			}
+
+			// The committed funds are still in the pool
+			_, broken := keeper.CommittedFundsInvariant(suite.App.RewardsKeeper)(ctx)
+			suite.Require().False(broken)
+		})
+	}
+}
+
+// requireScheduleEvent checks that an event of the type was emitted for the schedule
+func (suite *KeeperTestSuite) requireScheduleEvent(ctx sdk.Context, eventType string, id uint64) {
+	suite.Require().True(hasEventAttribute(ctx, eventType, types.AttributeKeyScheduleID, strconv.FormatUint(id, 10)))
+}
+
+// hasEventAttribute returns true if an event of the type was emitted with the attribute
+func hasEventAttribute(ctx sdk.Context, eventType, key, value string) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != eventType {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == key && attr.Value == value {
+				return true
+			}
+		}
+	}
+	return false
+}
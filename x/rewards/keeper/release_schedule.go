@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kiichain/kiichain/v4/x/rewards/types"
+)
+
+// GetReleaseSchedule returns a release schedule by its id
+func (k Keeper) GetReleaseSchedule(ctx context.Context, id uint64) (types.ReleaseSchedule, error) {
+	schedule, err := k.ReleaseSchedules.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ReleaseSchedule{}, fmt.Errorf("release schedule %d not found", id)
+		}
+		return types.ReleaseSchedule{}, err
+	}
+
+	return schedule, nil
+}
+
+// GetReleaseSchedules returns all the release schedules ordered by id
+func (k Keeper) GetReleaseSchedules(ctx context.Context) ([]types.ReleaseSchedule, error) {
+	schedules := []types.ReleaseSchedule{}
+	err := k.ReleaseSchedules.Walk(ctx, nil, func(_ uint64, schedule types.ReleaseSchedule) (bool, error) {
+		schedules = append(schedules, schedule)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// GetCommittedAmount returns the amount still to be released by the schedules of a denom
+// The schedule with the excluded id is not counted, zero excludes nothing
+func (k Keeper) GetCommittedAmount(ctx context.Context, denom string, excludeID uint64) (math.Int, error) {
+	committed := math.ZeroInt()
+	err := k.ReleaseSchedules.Walk(ctx, nil, func(id uint64, schedule types.ReleaseSchedule) (bool, error) {
+		if id == excludeID || schedule.TotalAmount.Denom != denom {
+			return false, nil
+		}
+		committed = committed.Add(unreleasedAmount(schedule))
+		return false, nil
+	})
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	return committed, nil
+}
+
+// GetPoolBalances returns the pool funds per denom, split between the committed and the free amounts
+// The denoms of the pool and of the schedules are listed in alphabetical order
+func (k Keeper) GetPoolBalances(ctx context.Context) ([]types.PoolDenomBalance, error) {
+	rewardPool, err := k.RewardPool.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sum the unreleased amounts per denom
+	committed := make(map[string]math.Int)
+	err = k.ReleaseSchedules.Walk(ctx, nil, func(_ uint64, schedule types.ReleaseSchedule) (bool, error) {
+		denom := schedule.TotalAmount.Denom
+		if _, ok := committed[denom]; !ok {
+			committed[denom] = math.ZeroInt()
+		}
+		committed[denom] = committed[denom].Add(unreleasedAmount(schedule))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// List every denom of the pool and of the schedules
+	denoms := make([]string, 0, len(committed))
+	for denom := range committed {
+		denoms = append(denoms, denom)
+	}
+	for _, coin := range rewardPool.CommunityPool {
+		if _, ok := committed[coin.Denom]; !ok {
+			committed[coin.Denom] = math.ZeroInt()
+			denoms = append(denoms, coin.Denom)
+		}
+	}
+	sort.Strings(denoms)
+
+	balances := make([]types.PoolDenomBalance, 0, len(denoms))
+	for _, denom := range denoms {
+		poolAmount := rewardPool.CommunityPool.AmountOf(denom)
+		free := math.LegacyMaxDec(poolAmount.Sub(math.LegacyNewDecFromInt(committed[denom])), math.LegacyZeroDec())
+		balances = append(balances, types.PoolDenomBalance{
+			Denom:           denom,
+			PoolAmount:      poolAmount,
+			CommittedAmount: committed[denom],
+			FreeAmount:      free,
+		})
+	}
+
+	return balances, nil
+}
+
+// unreleasedAmount returns the amount of a schedule that was not released yet
+func unreleasedAmount(schedule types.ReleaseSchedule) math.Int {
+	if schedule.TotalAmount.IsNil() {
+		return math.ZeroInt()
+	}
+
+	released := math.ZeroInt()
+	if !schedule.ReleasedAmount.IsNil() {
+		released = schedule.ReleasedAmount.Amount
+	}
+
+	return math.MaxInt(schedule.TotalAmount.Amount.Sub(released), math.ZeroInt())
+}
+
+// releaseToSplits sends the released amount of a schedule to each of its destinations
+// All the sends happen on a cached context, so either every destination receives its share or none does
+func (k Keeper) releaseToSplits(ctx sdk.Context, schedule types.ReleaseSchedule, amount sdk.Coin) error {
+	splits := schedule.EffectiveSplits()
+	shares := types.SplitAmounts(amount, splits)
+
+	cacheCtx, write := ctx.CacheContext()
+	for i, split := range splits {
+		// Nothing to send on this block, for example a small weight of a small release
+		if shares[i].IsZero() {
+			continue
+		}
+
+		if err := k.sendToSplit(cacheCtx, split, sdk.NewCoins(shares[i])); err != nil {
+			return fmt.Errorf("failed to release to %s %s: %w", split.Type, split.Target, err)
+		}
+
+		cacheCtx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeRelease,
+				sdk.NewAttribute(types.AttributeKeyScheduleID, strconv.FormatUint(schedule.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyDestinationType, split.Type.String()),
+				sdk.NewAttribute(types.AttributeKeyDestination, split.Target),
+				sdk.NewAttribute(types.AttributeKeyAmount, shares[i].String()),
+			),
+		)
+	}
+	write()
+
+	return nil
+}
+
+// sendToSplit sends coins from the module account to a single split destination
+func (k Keeper) sendToSplit(ctx context.Context, split types.ReleaseSplit, coins sdk.Coins) error {
+	switch split.Type {
+	case types.DestinationFeeCollector:
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, coins)
+	case types.DestinationCommunityPool:
+		return k.distrKeeper.FundCommunityPool(ctx, coins, authtypes.NewModuleAddress(types.ModuleName))
+	case types.DestinationModuleAccount:
+		// The bank keeper panics on unknown module accounts
+		if k.accountKeeper.GetModuleAddress(split.Target) == nil {
+			return fmt.Errorf("module account %s does not exist", split.Target)
+		}
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, split.Target, coins)
+	case types.DestinationAddress, types.DestinationContract:
+		recipient, err := sdk.AccAddressFromBech32(split.Target)
+		if err != nil {
+			return err
+		}
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, coins)
+	default:
+		return fmt.Errorf("unknown destination type %d", split.Type)
+	}
+}
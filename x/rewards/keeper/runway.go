@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v4/x/rewards/types"
+)
+
+// GetSchedulesByDenom returns the schedules releasing a denom
+func (k Keeper) GetSchedulesByDenom(ctx context.Context, denom string) ([]types.ReleaseSchedule, error) {
+	schedules := []types.ReleaseSchedule{}
+	err := k.ReleaseSchedules.Walk(ctx, nil, func(_ uint64, schedule types.ReleaseSchedule) (bool, error) {
+		if schedule.TotalAmount.Denom == denom {
+			schedules = append(schedules, schedule)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// GetEmissionRate returns the amount of a denom released per second by the active schedules
+func (k Keeper) GetEmissionRate(ctx context.Context, denom string) (math.LegacyDec, error) {
+	schedules, err := k.GetSchedulesByDenom(ctx, denom)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	rate := math.LegacyZeroDec()
+	for _, schedule := range schedules {
+		rate = rate.Add(types.EmissionRate(schedule, blockTime))
+	}
+
+	return rate, nil
+}
+
+// GetProjectedEndTime returns when the schedules of a denom are expected to stop releasing with the pool funds,
+// and true if the pool covers them until their end
+func (k Keeper) GetProjectedEndTime(ctx context.Context, denom string) (time.Time, bool, error) {
+	schedules, err := k.GetSchedulesByDenom(ctx, denom)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	rewardPool, err := k.RewardPool.Get(ctx)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	available := rewardPool.CommunityPool.AmountOf(denom).TruncateInt()
+	end, funded := types.ProjectedEndTime(schedules, available, sdk.UnwrapSDKContext(ctx).BlockTime())
+	return end, funded, nil
+}
+
+// GetStakingAPR estimates the APR added to the stakers by the schedules releasing the bond denom to the fee collector
+// It returns the APR, the yearly emission to the fee collector and the bonded tokens
+// The community tax and the validator commissions are not deducted
+func (k Keeper) GetStakingAPR(ctx context.Context) (math.LegacyDec, sdk.Coin, math.Int, error) {
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return math.LegacyDec{}, sdk.Coin{}, math.Int{}, err
+	}
+	bonded, err := k.stakingKeeper.TotalBondedTokens(ctx)
+	if err != nil {
+		return math.LegacyDec{}, sdk.Coin{}, math.Int{}, err
+	}
+	schedules, err := k.GetSchedulesByDenom(ctx, bondDenom)
+	if err != nil {
+		return math.LegacyDec{}, sdk.Coin{}, math.Int{}, err
+	}
+
+	// Only the share sent to the fee collector is distributed to the stakers
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	rate := math.LegacyZeroDec()
+	for _, schedule := range schedules {
+		rate = rate.Add(types.EmissionRate(schedule, blockTime).Mul(schedule.FeeCollectorWeight()))
+	}
+	annual := rate.MulInt64(types.SecondsPerYear)
+
+	apr := math.LegacyZeroDec()
+	if bonded.IsPositive() {
+		apr = annual.QuoInt(bonded)
+	}
+
+	return apr, sdk.NewCoin(bondDenom, annual.TruncateInt()), bonded, nil
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -36,9 +37,9 @@ func validateAmount(amount sdk.Coin) error {
 	return nil
 }
 
-// validateEndTime checks if time is in the past
-func validateEndTime(endTime time.Time) error {
-	if endTime.Before(time.Now()) {
+// validateEndTime checks if time is in the past of the block time
+func validateEndTime(endTime, blockTime time.Time) error {
+	if !endTime.After(blockTime) {
 		return fmt.Errorf("end time %s is not in the future", endTime)
 	}
 
@@ -46,23 +47,77 @@ func validateEndTime(endTime time.Time) error {
 }
 
 // fundsAvailable checks if the asked funds are available in the pool
-func (k Keeper) fundsAvailable(ctx context.Context, amount sdk.Coin) error {
+// The amounts committed to the other schedules are not available, the schedule with excludeID is not counted
+func (k Keeper) fundsAvailable(ctx context.Context, amount sdk.Coin, excludeID uint64) error {
 	// Get reward pool
 	rewardPool, err := k.RewardPool.Get(ctx)
 	if err != nil {
 		return err
 	}
 
+	// Get the amount committed to the other schedules
+	committed, err := k.GetCommittedAmount(ctx, amount.Denom, excludeID)
+	if err != nil {
+		return err
+	}
+
 	// Check if it is trying to use more funds than available
 	poolAmount := rewardPool.CommunityPool.AmountOf(amount.Denom)
-	if sdk.NewDecCoinFromCoin(amount).Amount.GT(poolAmount) {
-		return fmt.Errorf("reward pool (%s) has less funds than requested (%s)", poolAmount, amount)
+	requested := sdk.NewDecCoinFromCoin(amount).Amount.Add(math.LegacyNewDecFromInt(committed))
+	if requested.GT(poolAmount) {
+		return fmt.Errorf("reward pool (%s) has less funds than requested (%s) plus committed (%s)", poolAmount, amount, committed)
+	}
+
+	return nil
+}
+
+// validateDestination checks if the destination can receive the released amount
+func (k Keeper) validateDestination(destination string) error {
+	// An empty destination sends to the fee collector
+	if destination == "" {
+		return nil
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(destination)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid destination address: %s", err)
+	}
+	if k.bankKeeper.BlockedAddr(recipient) {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "destination %s is not allowed to receive funds", destination)
 	}
 
 	return nil
 }
 
-// validateSchedule checks if the asked funds are available in the pool
+// validateSplits checks if the split destinations exist and can receive the released amount
+func (k Keeper) validateSplits(ctx context.Context, schedule types.ReleaseSchedule) error {
+	if err := schedule.ValidateSplits(); err != nil {
+		return err
+	}
+
+	for _, split := range schedule.Splits {
+		switch split.Type {
+		case types.DestinationModuleAccount:
+			if k.accountKeeper.GetModuleAddress(split.Target) == nil {
+				return fmt.Errorf("module account %s does not exist", split.Target)
+			}
+		case types.DestinationAddress:
+			if err := k.validateDestination(split.Target); err != nil {
+				return err
+			}
+		case types.DestinationContract:
+			contract := sdk.MustAccAddressFromBech32(split.Target)
+			if k.wasmKeeper == nil || !k.wasmKeeper.HasContractInfo(ctx, contract) {
+				return fmt.Errorf("contract %s does not exist", split.Target)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateSchedule checks if the schedule is sound
+// Times are compared against the block time, so the result is the same on every node and replay
 func (k Keeper) validateSchedule(ctx context.Context, schedule types.ReleaseSchedule) error {
 	// Validate TotalAmount
 	if err := validateAmount(schedule.TotalAmount); err != nil {
@@ -74,9 +129,8 @@ func (k Keeper) validateSchedule(ctx context.Context, schedule types.ReleaseSche
 	if err != nil {
 		return fmt.Errorf("failed to get module params: %w", err)
 	}
-	if params.TokenDenom != schedule.TotalAmount.Denom {
-		return fmt.Errorf("denom %s does not match expected denom: %s",
-			schedule.TotalAmount.Denom, params.TokenDenom)
+	if !params.IsAllowedDenom(schedule.TotalAmount.Denom) {
+		return fmt.Errorf("denom %s is not allowed by the rewards pool", schedule.TotalAmount.Denom)
 	}
 
 	// Validate ReleasedAmount
@@ -95,16 +149,20 @@ func (k Keeper) validateSchedule(ctx context.Context, schedule types.ReleaseSche
 	}
 
 	// Time validations
-	currentTime := time.Now()
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
 	if schedule.EndTime.IsZero() {
 		return fmt.Errorf("end time cannot be zero")
 	}
-	if err = validateEndTime(schedule.EndTime); err != nil {
+	if err = validateEndTime(schedule.EndTime, blockTime); err != nil {
 		return err
 	}
+	if !schedule.StartTime.IsZero() && !schedule.StartTime.Before(schedule.EndTime) {
+		return fmt.Errorf("start time %s must be before end time %s",
+			schedule.StartTime, schedule.EndTime)
+	}
 
 	if !schedule.LastReleaseTime.IsZero() {
-		if schedule.LastReleaseTime.After(currentTime) {
+		if schedule.LastReleaseTime.After(blockTime) {
 			return fmt.Errorf("last release time %s cannot be in the future",
 				schedule.LastReleaseTime)
 		}
@@ -114,7 +172,20 @@ func (k Keeper) validateSchedule(ctx context.Context, schedule types.ReleaseSche
 		}
 	}
 
-	// 6. Active state consistency
+	// Curve validation
+	if err := schedule.ValidateCurve(); err != nil {
+		return err
+	}
+
+	// Destination validation
+	if err := k.validateDestination(schedule.Destination); err != nil {
+		return err
+	}
+	if err := k.validateSplits(ctx, schedule); err != nil {
+		return err
+	}
+
+	// Active state consistency
 	if schedule.Active {
 		if schedule.TotalAmount.IsZero() {
 			return fmt.Errorf("active schedule cannot have zero total amount")
@@ -0,0 +1,64 @@
+package v2
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v4/x/rewards/types"
+)
+
+// legacyScheduleID is the id given to the migrated single schedule
+const legacyScheduleID uint64 = 1
+
+// MigrateStore migrates the x/rewards module state from consensus version 1 to 2
+// The single release schedule is moved into a map keyed by id
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	// Build both the legacy and the new schedule collections
+	sb := collections.NewSchemaBuilder(storeService)
+	legacySchedule := collections.NewItem(
+		sb,
+		types.ReleaseScheduleKey,
+		"release_schedule",
+		codec.CollValue[types.ReleaseSchedule](cdc),
+	)
+	schedules := collections.NewMap(
+		sb,
+		types.ReleaseSchedulesKey,
+		"release_schedules",
+		collections.Uint64Key,
+		codec.CollValue[types.ReleaseSchedule](cdc),
+	)
+	nextScheduleID := collections.NewSequence(sb, types.NextScheduleIDKey, "next_schedule_id")
+
+	// Get the legacy schedule, an unset schedule only initializes the next id
+	schedule, err := legacySchedule.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nextScheduleID.Set(ctx, types.DefaultNextScheduleID)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Empty schedules are dropped, anything else is kept under the first id
+	if schedule.TotalAmount.IsNil() || schedule.TotalAmount.IsZero() {
+		if err := nextScheduleID.Set(ctx, types.DefaultNextScheduleID); err != nil {
+			return err
+		}
+	} else {
+		schedule.Id = legacyScheduleID
+		if err := schedules.Set(ctx, schedule.Id, schedule); err != nil {
+			return err
+		}
+		if err := nextScheduleID.Set(ctx, legacyScheduleID+1); err != nil {
+			return err
+		}
+	}
+
+	// Remove the legacy schedule
+	return legacySchedule.Remove(ctx)
+}
@@ -0,0 +1,113 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/kiichain/kiichain/v4/x/rewards/migrations/v2"
+	"github.com/kiichain/kiichain/v4/x/rewards/types"
+)
+
+// TestMigrateStore tests the migration of the single release schedule into a map
+func TestMigrateStore(t *testing.T) {
+	now := time.Now().UTC()
+
+	// Prepare the test cases
+	testCases := []struct {
+		name         string
+		legacy       *types.ReleaseSchedule
+		expSchedules int
+		expNextID    uint64
+	}{
+		{
+			name:         "no legacy schedule",
+			expSchedules: 0,
+			expNextID:    1,
+		},
+		{
+			name:         "empty legacy schedule is dropped",
+			legacy:       &types.ReleaseSchedule{},
+			expSchedules: 0,
+			expNextID:    1,
+		},
+		{
+			name: "legacy schedule is moved under the first id",
+			legacy: &types.ReleaseSchedule{
+				TotalAmount:     sdk.NewCoin("akii", math.NewInt(1000)),
+				ReleasedAmount:  sdk.NewCoin("akii", math.NewInt(400)),
+				EndTime:         now.Add(time.Hour),
+				LastReleaseTime: now,
+				Active:          true,
+			},
+			expSchedules: 1,
+			expNextID:    2,
+		},
+	}
+
+	// Iterate through the test cases
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Prepare the store and the codec
+			cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+			storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+			tKey := storetypes.NewTransientStoreKey("transient_test")
+			ctx := testutil.DefaultContext(storeKey, tKey)
+			storeService := runtime.NewKVStoreService(storeKey)
+
+			// Prepare the legacy and the new collections
+			sb := collections.NewSchemaBuilder(storeService)
+			legacySchedule := collections.NewItem(sb, types.ReleaseScheduleKey, "release_schedule", codec.CollValue[types.ReleaseSchedule](cdc))
+			schedules := collections.NewMap(sb, types.ReleaseSchedulesKey, "release_schedules", collections.Uint64Key, codec.CollValue[types.ReleaseSchedule](cdc))
+			nextScheduleID := collections.NewSequence(sb, types.NextScheduleIDKey, "next_schedule_id")
+
+			// Set the legacy schedule
+			if tc.legacy != nil {
+				require.NoError(t, legacySchedule.Set(ctx, *tc.legacy))
+			}
+
+			// Run the migration
+			require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+
+			// The legacy item must be removed
+			exists, err := legacySchedule.Has(ctx)
+			require.NoError(t, err)
+			require.False(t, exists)
+
+			// Check the next id
+			nextID, err := nextScheduleID.Peek(ctx)
+			require.NoError(t, err)
+			require.Equal(t, tc.expNextID, nextID)
+
+			// Check the migrated schedules
+			var migrated []types.ReleaseSchedule
+			err = schedules.Walk(ctx, nil, func(_ uint64, schedule types.ReleaseSchedule) (bool, error) {
+				migrated = append(migrated, schedule)
+				return false, nil
+			})
+			require.NoError(t, err)
+			require.Len(t, migrated, tc.expSchedules)
+			if tc.expSchedules > 0 {
+				expected := *tc.legacy
+				expected.Id = 1
+				require.Equal(t, expected.Id, migrated[0].Id)
+				require.Equal(t, expected.TotalAmount, migrated[0].TotalAmount)
+				require.Equal(t, expected.ReleasedAmount, migrated[0].ReleasedAmount)
+				require.True(t, expected.EndTime.Equal(migrated[0].EndTime))
+				require.True(t, expected.LastReleaseTime.Equal(migrated[0].LastReleaseTime))
+				require.Equal(t, expected.Active, migrated[0].Active)
+			}
+		})
+	}
+}
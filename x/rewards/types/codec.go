@@ -14,7 +14,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 		&MsgFundPool{},
-		&MsgChangeSchedule{},
+		&MsgCreateSchedule{},
+		&MsgAmendSchedule{},
+		&MsgCancelSchedule{},
+		&MsgPauseSchedule{},
+		&MsgResumeSchedule{},
+		&MsgClawback{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -27,5 +32,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	// Register all your concrete types
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "rewards/update-params", nil)
 	cdc.RegisterConcrete(&MsgFundPool{}, "rewards/fund-pool", nil)
-	cdc.RegisterConcrete(&MsgChangeSchedule{}, "rewards/change-schedule", nil)
+	cdc.RegisterConcrete(&MsgCreateSchedule{}, "rewards/create-schedule", nil)
+	cdc.RegisterConcrete(&MsgAmendSchedule{}, "rewards/amend-schedule", nil)
+	cdc.RegisterConcrete(&MsgCancelSchedule{}, "rewards/cancel-schedule", nil)
+	cdc.RegisterConcrete(&MsgPauseSchedule{}, "rewards/pause-schedule", nil)
+	cdc.RegisterConcrete(&MsgResumeSchedule{}, "rewards/resume-schedule", nil)
+	cdc.RegisterConcrete(&MsgClawback{}, "rewards/clawback", nil)
 }
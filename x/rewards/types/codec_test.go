@@ -23,9 +23,14 @@ func (suite *CodecTestSuite) TestRegisterInterfaces() {
 	RegisterInterfaces(registry)
 
 	impls := registry.ListImplementations(sdk.MsgInterfaceProtoName)
-	suite.Require().Equal(3, len(impls))
+	suite.Require().Equal(8, len(impls))
 	suite.Require().ElementsMatch([]string{
-		"/kiichain.rewards.v1beta1.MsgChangeSchedule",
+		"/kiichain.rewards.v1beta1.MsgCreateSchedule",
+		"/kiichain.rewards.v1beta1.MsgAmendSchedule",
+		"/kiichain.rewards.v1beta1.MsgCancelSchedule",
+		"/kiichain.rewards.v1beta1.MsgPauseSchedule",
+		"/kiichain.rewards.v1beta1.MsgResumeSchedule",
+		"/kiichain.rewards.v1beta1.MsgClawback",
 		"/kiichain.rewards.v1beta1.MsgFundPool",
 		"/kiichain.rewards.v1beta1.MsgUpdateParams",
 	}, impls)
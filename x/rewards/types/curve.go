@@ -0,0 +1,172 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+var (
+	// ln2 is the natural logarithm of 2 with the LegacyDec precision
+	ln2 = math.LegacyMustNewDecFromStr("0.693147180559945309")
+	// expSeriesTerms is the number of Taylor series terms used to approximate e^-x for x in [0, ln2)
+	expSeriesTerms = 24
+)
+
+// ValidateCurve checks if the curve and its parameters are consistent with the schedule times
+func (rr ReleaseSchedule) ValidateCurve() error {
+	// Only the parameters of the selected curve can be set
+	if rr.Curve != ReleaseCurveCliff && !rr.CliffTime.IsZero() {
+		return fmt.Errorf("cliff time is only used by the cliff curve")
+	}
+	if rr.Curve != ReleaseCurveStep && rr.StepDuration != 0 {
+		return fmt.Errorf("step duration is only used by the step curve")
+	}
+	if rr.Curve != ReleaseCurveExponential && rr.HalfLife != 0 {
+		return fmt.Errorf("half life is only used by the exponential curve")
+	}
+
+	// The linear curve runs from the last release
+	if rr.Curve == ReleaseCurveLinear {
+		return nil
+	}
+
+	// The other curves run from the start time
+	if _, ok := ReleaseCurve_name[int32(rr.Curve)]; !ok {
+		return fmt.Errorf("unknown release curve %d", rr.Curve)
+	}
+	if rr.StartTime.IsZero() {
+		return fmt.Errorf("%s requires a start time", rr.Curve)
+	}
+
+	switch rr.Curve {
+	case ReleaseCurveCliff:
+		if !rr.CliffTime.After(rr.StartTime) || !rr.CliffTime.Before(rr.EndTime) {
+			return fmt.Errorf("cliff time %s must be between start time %s and end time %s", rr.CliffTime, rr.StartTime, rr.EndTime)
+		}
+	case ReleaseCurveStep:
+		if rr.StepDuration == 0 {
+			return fmt.Errorf("step duration must be positive")
+		}
+		if rr.StepDuration > uint64(rr.EndTime.Sub(rr.StartTime).Seconds()) {
+			return fmt.Errorf("step duration %d cannot be longer than the schedule", rr.StepDuration)
+		}
+	case ReleaseCurveExponential:
+		if rr.HalfLife == 0 {
+			return fmt.Errorf("half life must be positive")
+		}
+	}
+
+	return nil
+}
+
+// CumulativeRelease returns the total amount released by the curve of the schedule at the given time
+// The curve runs from the start time to the end time and never goes above the total amount
+// The linear curve is released from the last release, so its cumulative amount is computed from the start time
+func CumulativeRelease(schedule ReleaseSchedule, t time.Time) math.Int {
+	total := schedule.TotalAmount.Amount
+	start, end := schedule.StartTime, schedule.EndTime
+
+	switch schedule.Curve {
+	case ReleaseCurveCliff:
+		return CliffRelease(total, start, schedule.CliffTime, end, t)
+	case ReleaseCurveStep:
+		return StepRelease(total, start, end, time.Duration(schedule.StepDuration)*time.Second, t)
+	case ReleaseCurveExponential:
+		return ExponentialRelease(total, start, end, time.Duration(schedule.HalfLife)*time.Second, t)
+	default:
+		return LinearRelease(total, start, end, t)
+	}
+}
+
+// LinearRelease returns the amount released at a time by a linear curve between start and end
+func LinearRelease(total math.Int, start, end, t time.Time) math.Int {
+	if !t.After(start) {
+		return math.ZeroInt()
+	}
+	if !t.Before(end) {
+		return total
+	}
+
+	// Use truncated seconds, like the block releases
+	elapsed := math.LegacyNewDec(int64(t.Sub(start).Seconds()))
+	duration := math.LegacyNewDec(int64(end.Sub(start).Seconds()))
+	if duration.IsZero() {
+		return total
+	}
+
+	return clampRelease(math.LegacyNewDecFromInt(total).Mul(elapsed).Quo(duration).TruncateInt(), total)
+}
+
+// CliffRelease returns the amount released at a time by a linear curve that releases nothing before the cliff
+// The amount accrued between the start and the cliff is released at once on the cliff
+func CliffRelease(total math.Int, start, cliff, end, t time.Time) math.Int {
+	if t.Before(cliff) {
+		return math.ZeroInt()
+	}
+
+	return LinearRelease(total, start, end, t)
+}
+
+// StepRelease returns the amount released at a time by a linear curve that only releases at the end of each step
+func StepRelease(total math.Int, start, end time.Time, step time.Duration, t time.Time) math.Int {
+	if !t.Before(end) {
+		return total
+	}
+	if step <= 0 || !t.After(start) {
+		return math.ZeroInt()
+	}
+
+	// Round the time down to the last finished step
+	steps := t.Sub(start) / step
+	return LinearRelease(total, start, end, start.Add(steps*step))
+}
+
+// ExponentialRelease returns the amount released at a time by a curve that releases half of the amount left on
+// each half life, the amount left at the end time is released at once
+func ExponentialRelease(total math.Int, start, end time.Time, halfLife time.Duration, t time.Time) math.Int {
+	if !t.Before(end) {
+		return total
+	}
+	if halfLife <= 0 || !t.After(start) {
+		return math.ZeroInt()
+	}
+
+	// The amount left is total * 2^(-elapsed/halfLife)
+	elapsed := int64(t.Sub(start).Seconds())
+	halfLifeSeconds := int64(halfLife.Seconds())
+	if halfLifeSeconds == 0 {
+		return total
+	}
+	remaining := remainingFraction(elapsed, halfLifeSeconds)
+
+	released := math.LegacyNewDecFromInt(total).Mul(math.LegacyOneDec().Sub(remaining)).TruncateInt()
+	return clampRelease(released, total)
+}
+
+// remainingFraction returns 2^(-elapsed/halfLife) for positive values
+// The whole half lives are halved exactly and the fraction of the last one uses a Taylor series of e^-x
+func remainingFraction(elapsed, halfLife int64) math.LegacyDec {
+	// Past 128 half lives nothing is left with the LegacyDec precision
+	halvings := elapsed / halfLife
+	if halvings >= 128 {
+		return math.LegacyZeroDec()
+	}
+	fraction := math.LegacyOneDec().Quo(math.LegacyNewDec(2).Power(uint64(halvings)))
+
+	// 2^-f = e^(-f*ln2) with f in [0, 1)
+	x := math.LegacyNewDec(elapsed % halfLife).Quo(math.LegacyNewDec(halfLife)).Mul(ln2)
+	sum, term := math.LegacyOneDec(), math.LegacyOneDec()
+	for k := 1; k <= expSeriesTerms; k++ {
+		term = term.Mul(x).QuoInt64(int64(k)).Neg()
+		sum = sum.Add(term)
+	}
+
+	return fraction.Mul(sum)
+}
+
+// clampRelease keeps the released amount between zero and the total amount
+func clampRelease(amount, total math.Int) math.Int {
+	return math.MaxInt(math.MinInt(amount, total), math.ZeroInt())
+}
@@ -0,0 +1,247 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v4/x/rewards/types"
+)
+
+// TestCumulativeRelease tests the cumulative amount released by each curve
+func TestCumulativeRelease(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(100 * time.Hour)
+	total := sdk.NewCoin("akii", math.NewInt(1_000_000))
+
+	linear := types.ReleaseSchedule{TotalAmount: total, StartTime: start, EndTime: end}
+	cliff := types.ReleaseSchedule{
+		TotalAmount: total, StartTime: start, EndTime: end,
+		Curve: types.ReleaseCurveCliff, CliffTime: start.Add(25 * time.Hour),
+	}
+	step := types.ReleaseSchedule{
+		TotalAmount: total, StartTime: start, EndTime: end,
+		Curve: types.ReleaseCurveStep, StepDuration: uint64((10 * time.Hour).Seconds()),
+	}
+	exponential := types.ReleaseSchedule{
+		TotalAmount: total, StartTime: start, EndTime: end,
+		Curve: types.ReleaseCurveExponential, HalfLife: uint64((10 * time.Hour).Seconds()),
+	}
+
+	tests := []struct {
+		name     string
+		schedule types.ReleaseSchedule
+		time     time.Time
+		expected math.Int
+	}{
+		{"linear - before start", linear, start.Add(-time.Hour), math.ZeroInt()},
+		{"linear - halfway", linear, start.Add(50 * time.Hour), math.NewInt(500_000)},
+		{"linear - after end", linear, end.Add(time.Hour), math.NewInt(1_000_000)},
+		{"cliff - before cliff", cliff, start.Add(24 * time.Hour), math.ZeroInt()},
+		{"cliff - on cliff", cliff, start.Add(25 * time.Hour), math.NewInt(250_000)},
+		{"cliff - after cliff", cliff, start.Add(50 * time.Hour), math.NewInt(500_000)},
+		{"cliff - at end", cliff, end, math.NewInt(1_000_000)},
+		{"step - first step", step, start.Add(9 * time.Hour), math.ZeroInt()},
+		{"step - second step", step, start.Add(19 * time.Hour), math.NewInt(100_000)},
+		{"step - on a step", step, start.Add(50 * time.Hour), math.NewInt(500_000)},
+		{"step - at end", step, end, math.NewInt(1_000_000)},
+		{"exponential - before start", exponential, start, math.ZeroInt()},
+		{"exponential - one half life", exponential, start.Add(10 * time.Hour), math.NewInt(500_000)},
+		{"exponential - two half lives", exponential, start.Add(20 * time.Hour), math.NewInt(750_000)},
+		{"exponential - half of a half life", exponential, start.Add(5 * time.Hour), math.NewInt(292_893)},
+		{"exponential - at end", exponential, end, math.NewInt(1_000_000)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected.String(), types.CumulativeRelease(tc.schedule, tc.time).String())
+		})
+	}
+}
+
+// TestCumulativeReleaseBounds tests that no curve decreases over time or goes above the total amount
+func TestCumulativeReleaseBounds(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(1000 * time.Second)
+	total := sdk.NewCoin("akii", math.NewInt(999_999_937))
+
+	schedules := []types.ReleaseSchedule{
+		{TotalAmount: total, StartTime: start, EndTime: end},
+		{TotalAmount: total, StartTime: start, EndTime: end, Curve: types.ReleaseCurveCliff, CliffTime: start.Add(333 * time.Second)},
+		{TotalAmount: total, StartTime: start, EndTime: end, Curve: types.ReleaseCurveStep, StepDuration: 77},
+		{TotalAmount: total, StartTime: start, EndTime: end, Curve: types.ReleaseCurveExponential, HalfLife: 7},
+		{TotalAmount: total, StartTime: start, EndTime: end, Curve: types.ReleaseCurveExponential, HalfLife: 100_000},
+	}
+
+	for _, schedule := range schedules {
+		previous := math.ZeroInt()
+		for s := -10; s <= 1010; s++ {
+			released := types.CumulativeRelease(schedule, start.Add(time.Duration(s)*time.Second))
+			require.True(t, released.GTE(previous), "%s decreased at %d seconds", schedule.Curve, s)
+			require.True(t, released.LTE(total.Amount), "%s exceeded the total at %d seconds", schedule.Curve, s)
+			previous = released
+		}
+		require.Equal(t, total.Amount, previous, "%s did not release the total", schedule.Curve)
+	}
+}
+
+// TestValidateCurve tests the validation of the curve parameters
+func TestValidateCurve(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(100 * time.Second)
+
+	tests := []struct {
+		name        string
+		schedule    types.ReleaseSchedule
+		errContains string
+	}{
+		{
+			name:     "valid - linear without start time",
+			schedule: types.ReleaseSchedule{EndTime: end},
+		},
+		{
+			name:     "valid - cliff",
+			schedule: types.ReleaseSchedule{StartTime: start, EndTime: end, Curve: types.ReleaseCurveCliff, CliffTime: start.Add(time.Second)},
+		},
+		{
+			name:     "valid - step",
+			schedule: types.ReleaseSchedule{StartTime: start, EndTime: end, Curve: types.ReleaseCurveStep, StepDuration: 100},
+		},
+		{
+			name:     "valid - exponential",
+			schedule: types.ReleaseSchedule{StartTime: start, EndTime: end, Curve: types.ReleaseCurveExponential, HalfLife: 1},
+		},
+		{
+			name:        "invalid - linear with cliff time",
+			schedule:    types.ReleaseSchedule{StartTime: start, EndTime: end, CliffTime: start.Add(time.Second)},
+			errContains: "cliff time is only used by the cliff curve",
+		},
+		{
+			name:        "invalid - cliff with half life",
+			schedule:    types.ReleaseSchedule{StartTime: start, EndTime: end, Curve: types.ReleaseCurveCliff, CliffTime: start.Add(time.Second), HalfLife: 1},
+			errContains: "half life is only used by the exponential curve",
+		},
+		{
+			name:        "invalid - exponential with step duration",
+			schedule:    types.ReleaseSchedule{StartTime: start, EndTime: end, Curve: types.ReleaseCurveExponential, HalfLife: 1, StepDuration: 1},
+			errContains: "step duration is only used by the step curve",
+		},
+		{
+			name:        "invalid - unknown curve",
+			schedule:    types.ReleaseSchedule{StartTime: start, EndTime: end, Curve: types.ReleaseCurve(99)},
+			errContains: "unknown release curve",
+		},
+		{
+			name:        "invalid - cliff without start time",
+			schedule:    types.ReleaseSchedule{EndTime: end, Curve: types.ReleaseCurveCliff, CliffTime: end.Add(-time.Second)},
+			errContains: "requires a start time",
+		},
+		{
+			name:        "invalid - cliff on start time",
+			schedule:    types.ReleaseSchedule{StartTime: start, EndTime: end, Curve: types.ReleaseCurveCliff, CliffTime: start},
+			errContains: "must be between start time",
+		},
+		{
+			name:        "invalid - cliff on end time",
+			schedule:    types.ReleaseSchedule{StartTime: start, EndTime: end, Curve: types.ReleaseCurveCliff, CliffTime: end},
+			errContains: "must be between start time",
+		},
+		{
+			name:        "invalid - zero step duration",
+			schedule:    types.ReleaseSchedule{StartTime: start, EndTime: end, Curve: types.ReleaseCurveStep},
+			errContains: "step duration must be positive",
+		},
+		{
+			name:        "invalid - step longer than the schedule",
+			schedule:    types.ReleaseSchedule{StartTime: start, EndTime: end, Curve: types.ReleaseCurveStep, StepDuration: 101},
+			errContains: "cannot be longer than the schedule",
+		},
+		{
+			name:        "invalid - zero half life",
+			schedule:    types.ReleaseSchedule{StartTime: start, EndTime: end, Curve: types.ReleaseCurveExponential},
+			errContains: "half life must be positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schedule.ValidateCurve()
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
+// TestProjectRelease tests the projection of the cumulative release at a future time
+func TestProjectRelease(t *testing.T) {
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	total := sdk.NewCoin("akii", math.NewInt(1000))
+
+	tests := []struct {
+		name     string
+		schedule types.ReleaseSchedule
+		time     time.Time
+		expected math.Int
+	}{
+		{
+			name: "linear - from the last release",
+			schedule: types.ReleaseSchedule{
+				TotalAmount: total, ReleasedAmount: sdk.NewCoin("akii", math.NewInt(200)),
+				LastReleaseTime: blockTime, EndTime: blockTime.Add(100 * time.Second), Active: true,
+			},
+			time:     blockTime.Add(50 * time.Second),
+			expected: math.NewInt(600),
+		},
+		{
+			name: "linear - not started yet",
+			schedule: types.ReleaseSchedule{
+				TotalAmount: total, ReleasedAmount: sdk.NewCoin("akii", math.ZeroInt()),
+				StartTime: blockTime.Add(100 * time.Second), EndTime: blockTime.Add(200 * time.Second), Active: true,
+			},
+			time:     blockTime.Add(150 * time.Second),
+			expected: math.NewInt(500),
+		},
+		{
+			name: "linear - after end",
+			schedule: types.ReleaseSchedule{
+				TotalAmount: total, ReleasedAmount: sdk.NewCoin("akii", math.ZeroInt()),
+				EndTime: blockTime.Add(100 * time.Second), Active: true,
+			},
+			time:     blockTime.Add(time.Hour),
+			expected: math.NewInt(1000),
+		},
+		{
+			name: "step - follows the curve",
+			schedule: types.ReleaseSchedule{
+				TotalAmount: total, ReleasedAmount: sdk.NewCoin("akii", math.ZeroInt()),
+				StartTime: blockTime, EndTime: blockTime.Add(100 * time.Second), Active: true,
+				Curve: types.ReleaseCurveStep, StepDuration: 25,
+			},
+			time:     blockTime.Add(60 * time.Second),
+			expected: math.NewInt(500),
+		},
+		{
+			name: "inactive - keeps the released amount",
+			schedule: types.ReleaseSchedule{
+				TotalAmount: total, ReleasedAmount: sdk.NewCoin("akii", math.NewInt(300)),
+				EndTime: blockTime.Add(100 * time.Second), Active: false,
+			},
+			time:     blockTime.Add(time.Hour),
+			expected: math.NewInt(300),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected.String(), types.ProjectRelease(tc.schedule, blockTime, tc.time).String())
+		})
+	}
+}
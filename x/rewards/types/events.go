@@ -0,0 +1,27 @@
+package types
+
+// Rewards module event types
+const (
+	EventTypeRelease        = "release"
+	EventTypeReleaseFailed  = "release_failed"
+	EventTypeClawback       = "clawback"
+	EventTypeFeeShare       = "fee_share"
+	EventTypeCreateSchedule = "create_schedule"
+	EventTypeAmendSchedule  = "amend_schedule"
+	EventTypeCancelSchedule = "cancel_schedule"
+	EventTypePauseSchedule  = "pause_schedule"
+	EventTypeResumeSchedule = "resume_schedule"
+)
+
+// Rewards module attribute keys
+const (
+	AttributeKeyScheduleID      = "schedule_id"
+	AttributeKeyDestinationType = "destination_type"
+	AttributeKeyDestination     = "destination"
+	AttributeKeyAmount          = "amount"
+	AttributeKeyFeeShare        = "fee_share"
+	AttributeKeyUnreleased      = "unreleased_amount"
+	AttributeKeyError           = "error"
+
+	AttributeValueCategory = ModuleName
+)
@@ -1,22 +1,29 @@
 package types
 
+import "fmt"
+
+// DefaultNextScheduleID is the identifier given to the first created schedule
+const DefaultNextScheduleID uint64 = 1
+
 // NewGenesisState constructs a genesis state
 func NewGenesisState(
-	params Params, rp RewardPool, release ReleaseSchedule,
+	params Params, rp RewardPool, schedules []ReleaseSchedule, nextScheduleID uint64,
 ) *GenesisState {
 	return &GenesisState{
-		Params:          params,
-		RewardPool:      rp,
-		ReleaseSchedule: release,
+		Params:           params,
+		RewardPool:       rp,
+		ReleaseSchedules: schedules,
+		NextScheduleId:   nextScheduleID,
 	}
 }
 
 // DefaultGenesisState returns the default genesis state of rewards.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		RewardPool:      InitialRewardPool(),
-		Params:          DefaultParams(),
-		ReleaseSchedule: InitialReleaseSchedule(),
+		RewardPool:       InitialRewardPool(),
+		Params:           DefaultParams(),
+		ReleaseSchedules: []ReleaseSchedule{},
+		NextScheduleId:   DefaultNextScheduleID,
 	}
 }
 
@@ -29,5 +36,28 @@ func (gs *GenesisState) Validate() error {
 	if err := gs.RewardPool.ValidateGenesis(); err != nil {
 		return err
 	}
-	return gs.ReleaseSchedule.ValidateGenesis()
+
+	// The schedule ids must be unique and below the next id
+	if gs.NextScheduleId == 0 {
+		return fmt.Errorf("next schedule id cannot be zero")
+	}
+	ids := make(map[uint64]struct{}, len(gs.ReleaseSchedules))
+	for _, schedule := range gs.ReleaseSchedules {
+		if schedule.Id == 0 {
+			return fmt.Errorf("schedule id cannot be zero")
+		}
+		if schedule.Id >= gs.NextScheduleId {
+			return fmt.Errorf("schedule id %d must be lower than the next schedule id %d", schedule.Id, gs.NextScheduleId)
+		}
+		if _, exists := ids[schedule.Id]; exists {
+			return fmt.Errorf("duplicate schedule id %d", schedule.Id)
+		}
+		ids[schedule.Id] = struct{}{}
+
+		if err := schedule.ValidateGenesis(); err != nil {
+			return fmt.Errorf("invalid schedule %d: %w", schedule.Id, err)
+		}
+	}
+
+	return nil
 }
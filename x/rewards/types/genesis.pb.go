@@ -28,10 +28,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	// release_schedule has information of how the reward is being released
-	ReleaseSchedule ReleaseSchedule `protobuf:"bytes,2,opt,name=release_schedule,json=releaseSchedule,proto3" json:"release_schedule"`
 	// reward_pool has information on the community pool
 	RewardPool RewardPool `protobuf:"bytes,3,opt,name=reward_pool,json=rewardPool,proto3" json:"reward_pool"`
+	// release_schedules has information of how each reward is being released
+	ReleaseSchedules []ReleaseSchedule `protobuf:"bytes,4,rep,name=release_schedules,json=releaseSchedules,proto3" json:"release_schedules"`
+	// next_schedule_id is the identifier given to the next created schedule
+	NextScheduleId uint64 `protobuf:"varint,5,opt,name=next_schedule_id,json=nextScheduleId,proto3" json:"next_schedule_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -74,18 +76,25 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
-func (m *GenesisState) GetReleaseSchedule() ReleaseSchedule {
+func (m *GenesisState) GetRewardPool() RewardPool {
 	if m != nil {
-		return m.ReleaseSchedule
+		return m.RewardPool
 	}
-	return ReleaseSchedule{}
+	return RewardPool{}
 }
 
-func (m *GenesisState) GetRewardPool() RewardPool {
+func (m *GenesisState) GetReleaseSchedules() []ReleaseSchedule {
 	if m != nil {
-		return m.RewardPool
+		return m.ReleaseSchedules
 	}
-	return RewardPool{}
+	return nil
+}
+
+func (m *GenesisState) GetNextScheduleId() uint64 {
+	if m != nil {
+		return m.NextScheduleId
+	}
+	return 0
 }
 
 func init() {
@@ -97,26 +106,28 @@ func init() {
 }
 
 var fileDescriptor_96ab53dc25b7c542 = []byte{
-	// 296 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xbf, 0x4e, 0xc3, 0x30,
-	0x10, 0xc6, 0x63, 0x40, 0x1d, 0x5c, 0x24, 0x50, 0xc4, 0x10, 0x75, 0x30, 0x15, 0x2a, 0x08, 0x24,
-	0x64, 0xab, 0xb0, 0x33, 0x30, 0xc0, 0xc0, 0x52, 0xb5, 0x5b, 0x97, 0xca, 0x49, 0x4f, 0xa9, 0x45,
-	0x9a, 0x8b, 0x6c, 0x97, 0x3f, 0x6f, 0xc1, 0x63, 0x75, 0xec, 0xc8, 0x84, 0x50, 0xf2, 0x10, 0xac,
-	0xa8, 0x8e, 0x09, 0x62, 0x08, 0x9b, 0x73, 0xf9, 0x7d, 0xbf, 0x3b, 0x7d, 0xf4, 0xec, 0x51, 0xa9,
-	0x64, 0x21, 0x55, 0x2e, 0x34, 0x3c, 0x4b, 0x3d, 0x37, 0xe2, 0x69, 0x18, 0x83, 0x95, 0x43, 0x91,
-	0x42, 0x0e, 0x46, 0x19, 0x5e, 0x68, 0xb4, 0x18, 0x46, 0x3f, 0x1c, 0xf7, 0x1c, 0xf7, 0x5c, 0xef,
-	0x28, 0xc5, 0x14, 0x1d, 0x24, 0xb6, 0xaf, 0x9a, 0xef, 0xb1, 0x04, 0xcd, 0x12, 0x8d, 0x88, 0xa5,
-	0x81, 0x46, 0x99, 0xa0, 0xca, 0xfd, 0xff, 0xd3, 0xd6, 0xbd, 0x85, 0xd4, 0x72, 0xe9, 0xd7, 0xf6,
-	0x06, 0xad, 0x98, 0x7d, 0x2d, 0xc0, 0x53, 0x27, 0x5f, 0x84, 0xee, 0xdf, 0xd7, 0xe7, 0x4e, 0xac,
-	0xb4, 0x10, 0xde, 0xd0, 0x4e, 0xad, 0x89, 0x48, 0x9f, 0x9c, 0x77, 0xaf, 0xfa, 0xbc, 0xed, 0x7c,
-	0x3e, 0x72, 0xdc, 0xed, 0xde, 0xfa, 0xe3, 0x38, 0x18, 0xfb, 0x54, 0x38, 0xa5, 0x87, 0x1a, 0x32,
-	0x90, 0x06, 0x66, 0x26, 0x59, 0xc0, 0x7c, 0x95, 0x41, 0xb4, 0xe3, 0x4c, 0x17, 0xed, 0xa6, 0x71,
-	0x9d, 0x98, 0xf8, 0x80, 0x57, 0x1e, 0xe8, 0xbf, 0xe3, 0xf0, 0x81, 0x76, 0xeb, 0xe4, 0xac, 0x40,
-	0xcc, 0xa2, 0x5d, 0xa7, 0x1d, 0xfc, 0xa7, 0xdd, 0x7e, 0x8f, 0x10, 0x33, 0x6f, 0xa4, 0xfa, 0x77,
-	0x72, 0xb7, 0x2e, 0x19, 0xd9, 0x94, 0x8c, 0x7c, 0x96, 0x8c, 0xbc, 0x55, 0x2c, 0xd8, 0x54, 0x2c,
-	0x78, 0xaf, 0x58, 0x30, 0xbd, 0x4c, 0x95, 0x5d, 0xac, 0x62, 0x9e, 0xe0, 0x52, 0x34, 0x25, 0x36,
-	0x8f, 0x97, 0xa6, 0x4f, 0xd7, 0x63, 0xdc, 0x71, 0x45, 0x5e, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff,
-	0x22, 0xbd, 0xae, 0x98, 0x0f, 0x02, 0x00, 0x00,
+	// 333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
+	0x10, 0xc7, 0x93, 0x36, 0x5f, 0xf9, 0xd8, 0x8a, 0xd4, 0xe0, 0x21, 0xf4, 0xb0, 0x06, 0xa9, 0x12,
+	0x41, 0x12, 0x5a, 0xef, 0x1e, 0x7a, 0x50, 0xd4, 0x4b, 0x69, 0x6f, 0x22, 0x94, 0x4d, 0x32, 0xa4,
+	0x8b, 0x69, 0x36, 0xec, 0x6e, 0xb5, 0xbe, 0x85, 0xaf, 0xe1, 0x9b, 0xf4, 0xd8, 0xa3, 0x27, 0x91,
+	0xf6, 0x45, 0xa4, 0x9b, 0xed, 0x82, 0x87, 0x78, 0x9b, 0x4c, 0x7e, 0xf3, 0x9b, 0xe1, 0xbf, 0xe8,
+	0xfc, 0x99, 0xd2, 0x64, 0x46, 0x68, 0x11, 0x71, 0x78, 0x25, 0x3c, 0x15, 0xd1, 0x4b, 0x3f, 0x06,
+	0x49, 0xfa, 0x51, 0x06, 0x05, 0x08, 0x2a, 0xc2, 0x92, 0x33, 0xc9, 0x5c, 0x6f, 0xcf, 0x85, 0x9a,
+	0x0b, 0x35, 0xd7, 0x3d, 0xce, 0x58, 0xc6, 0x14, 0x14, 0xed, 0xaa, 0x8a, 0xef, 0xe2, 0x84, 0x89,
+	0x39, 0x13, 0x51, 0x4c, 0x04, 0x18, 0x65, 0xc2, 0x68, 0xa1, 0xff, 0x9f, 0xd5, 0xee, 0x2d, 0x09,
+	0x27, 0x73, 0xbd, 0xb6, 0xdb, 0xab, 0xc5, 0xe4, 0x5b, 0x09, 0x9a, 0x3a, 0xfd, 0x68, 0xa0, 0x83,
+	0xdb, 0xea, 0xdc, 0x89, 0x24, 0x12, 0xdc, 0x6b, 0xd4, 0xaa, 0x34, 0x9e, 0xed, 0xdb, 0x41, 0x7b,
+	0xe0, 0x87, 0x75, 0xe7, 0x87, 0x23, 0xc5, 0x0d, 0x9d, 0xd5, 0xd7, 0x89, 0x35, 0xd6, 0x53, 0xee,
+	0x03, 0x6a, 0x57, 0xdc, 0xb4, 0x64, 0x2c, 0xf7, 0x9a, 0x4a, 0xd2, 0xab, 0x97, 0x8c, 0xd5, 0xf7,
+	0x88, 0xb1, 0x5c, 0x8b, 0x10, 0x37, 0x1d, 0xf7, 0x09, 0x1d, 0x71, 0xc8, 0x81, 0x08, 0x98, 0x8a,
+	0x64, 0x06, 0xe9, 0x22, 0x07, 0xe1, 0x39, 0x7e, 0x33, 0x68, 0x0f, 0x2e, 0xfe, 0x52, 0xaa, 0x91,
+	0x89, 0x9e, 0xd0, 0xde, 0x0e, 0xff, 0xdd, 0x16, 0x6e, 0x80, 0x3a, 0x05, 0x2c, 0xa5, 0x51, 0x4f,
+	0x69, 0xea, 0xfd, 0xf3, 0xed, 0xc0, 0x19, 0x1f, 0xee, 0xfa, 0x7b, 0xf0, 0x2e, 0xbd, 0x77, 0xfe,
+	0x37, 0x3a, 0xcd, 0xe1, 0xcd, 0x6a, 0x83, 0xed, 0xf5, 0x06, 0xdb, 0xdf, 0x1b, 0x6c, 0xbf, 0x6f,
+	0xb1, 0xb5, 0xde, 0x62, 0xeb, 0x73, 0x8b, 0xad, 0xc7, 0xcb, 0x8c, 0xca, 0xd9, 0x22, 0x0e, 0x13,
+	0x36, 0x8f, 0x4c, 0xec, 0xa6, 0x58, 0x9a, 0x17, 0x50, 0xc9, 0xc7, 0x2d, 0x15, 0xfd, 0xd5, 0x4f,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0x50, 0xd6, 0xee, 0x94, 0x41, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -139,18 +150,27 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.RewardPool.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.NextScheduleId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextScheduleId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ReleaseSchedules) > 0 {
+		for iNdEx := len(m.ReleaseSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReleaseSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
 	{
-		size, err := m.ReleaseSchedule.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.RewardPool.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -158,7 +178,7 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenesis(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -191,10 +211,17 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
-	l = m.ReleaseSchedule.Size()
-	n += 1 + l + sovGenesis(uint64(l))
 	l = m.RewardPool.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ReleaseSchedules) > 0 {
+		for _, e := range m.ReleaseSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextScheduleId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextScheduleId))
+	}
 	return n
 }
 
@@ -266,9 +293,9 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseSchedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -295,13 +322,13 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReleaseSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RewardPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseSchedules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -328,10 +355,30 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReleaseSchedules = append(m.ReleaseSchedules, ReleaseSchedule{})
+			if err := m.ReleaseSchedules[len(m.ReleaseSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduleId", wireType)
+			}
+			m.NextScheduleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextScheduleId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
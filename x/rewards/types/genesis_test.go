@@ -25,14 +25,15 @@ func (suite *GenesisTestSuite) TestNewGenesisState() {
 	// Create test data
 	params := types.DefaultParams()
 	pool := types.InitialRewardPool()
-	schedule := types.InitialReleaseSchedule()
+	schedules := []types.ReleaseSchedule{{Id: 1}}
 
 	// Test creation
-	genesis := types.NewGenesisState(params, pool, schedule)
+	genesis := types.NewGenesisState(params, pool, schedules, 2)
 
 	suite.Require().Equal(params, genesis.Params)
 	suite.Require().Equal(pool, genesis.RewardPool)
-	suite.Require().Equal(schedule, genesis.ReleaseSchedule)
+	suite.Require().Equal(schedules, genesis.ReleaseSchedules)
+	suite.Require().Equal(uint64(2), genesis.NextScheduleId)
 }
 
 func (suite *GenesisTestSuite) TestDefaultGenesisState() {
@@ -40,13 +41,15 @@ func (suite *GenesisTestSuite) TestDefaultGenesisState() {
 
 	suite.Require().Equal(types.DefaultParams(), defaultGenesis.Params)
 	suite.Require().Equal(types.InitialRewardPool(), defaultGenesis.RewardPool)
-	suite.Require().Equal(types.InitialReleaseSchedule(), defaultGenesis.ReleaseSchedule)
+	suite.Require().Empty(defaultGenesis.ReleaseSchedules)
+	suite.Require().Equal(types.DefaultNextScheduleID, defaultGenesis.NextScheduleId)
 }
 
 func (suite *GenesisTestSuite) TestValidateGenesis() {
 	validParams := types.DefaultParams()
 	validPool := types.InitialRewardPool()
 	validSchedule := types.ReleaseSchedule{
+		Id:              1,
 		TotalAmount:     sdk.NewCoin("akii", math.NewInt(1000)),
 		ReleasedAmount:  sdk.NewCoin("akii", math.NewInt(0)),
 		EndTime:         time.Now().Add(time.Hour * 24),
@@ -69,10 +72,53 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			modifyFn: func(gs *types.GenesisState) {
 				gs.Params = validParams
 				gs.RewardPool = validPool
-				gs.ReleaseSchedule = validSchedule
+				gs.ReleaseSchedules = []types.ReleaseSchedule{validSchedule}
+				gs.NextScheduleId = 2
 			},
 			expectedPass: true,
 		},
+		{
+			name: "multiple schedules",
+			modifyFn: func(gs *types.GenesisState) {
+				second := validSchedule
+				second.Id = 3
+				gs.ReleaseSchedules = []types.ReleaseSchedule{validSchedule, second}
+				gs.NextScheduleId = 4
+			},
+			expectedPass: true,
+		},
+		{
+			name: "invalid next schedule id - zero",
+			modifyFn: func(gs *types.GenesisState) {
+				gs.NextScheduleId = 0
+			},
+			expectedPass: false,
+		},
+		{
+			name: "invalid schedule id - zero",
+			modifyFn: func(gs *types.GenesisState) {
+				schedule := validSchedule
+				schedule.Id = 0
+				gs.ReleaseSchedules = []types.ReleaseSchedule{schedule}
+			},
+			expectedPass: false,
+		},
+		{
+			name: "invalid schedule id - not below the next id",
+			modifyFn: func(gs *types.GenesisState) {
+				gs.ReleaseSchedules = []types.ReleaseSchedule{validSchedule}
+				gs.NextScheduleId = 1
+			},
+			expectedPass: false,
+		},
+		{
+			name: "invalid schedule id - duplicate",
+			modifyFn: func(gs *types.GenesisState) {
+				gs.ReleaseSchedules = []types.ReleaseSchedule{validSchedule, validSchedule}
+				gs.NextScheduleId = 2
+			},
+			expectedPass: false,
+		},
 		{
 			name: "invalid params",
 			modifyFn: func(gs *types.GenesisState) {
@@ -81,9 +127,22 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			expectedPass: false,
 		},
 		{
-			name: "invalid release schedule - past end time",
+			name: "valid release schedule - past end time",
+			modifyFn: func(gs *types.GenesisState) {
+				schedule := validSchedule
+				schedule.EndTime = time.Now().Add(-time.Hour)
+				gs.ReleaseSchedules = []types.ReleaseSchedule{schedule}
+				gs.NextScheduleId = 2
+			},
+			expectedPass: true,
+		},
+		{
+			name: "invalid release schedule - start time after end time",
 			modifyFn: func(gs *types.GenesisState) {
-				gs.ReleaseSchedule.EndTime = time.Now().Add(-time.Hour)
+				schedule := validSchedule
+				schedule.StartTime = schedule.EndTime.Add(time.Hour)
+				gs.ReleaseSchedules = []types.ReleaseSchedule{schedule}
+				gs.NextScheduleId = 2
 			},
 			expectedPass: false,
 		},
@@ -3,9 +3,12 @@ package types
 import "cosmossdk.io/collections"
 
 var (
-	ParamsKey          = collections.NewPrefix(0)
-	RewardPoolKey      = collections.NewPrefix(1)
-	ReleaseScheduleKey = collections.NewPrefix(2)
+	ParamsKey     = collections.NewPrefix(0)
+	RewardPoolKey = collections.NewPrefix(1)
+	// ReleaseScheduleKey holds the single legacy schedule, kept for the v2 migration
+	ReleaseScheduleKey  = collections.NewPrefix(2)
+	ReleaseSchedulesKey = collections.NewPrefix(3)
+	NextScheduleIDKey   = collections.NewPrefix(4)
 )
 
 const (
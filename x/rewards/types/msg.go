@@ -8,7 +8,12 @@ import (
 var (
 	_ sdk.Msg = (*MsgUpdateParams)(nil)
 	_ sdk.Msg = (*MsgFundPool)(nil)
-	_ sdk.Msg = (*MsgChangeSchedule)(nil)
+	_ sdk.Msg = (*MsgCreateSchedule)(nil)
+	_ sdk.Msg = (*MsgAmendSchedule)(nil)
+	_ sdk.Msg = (*MsgCancelSchedule)(nil)
+	_ sdk.Msg = (*MsgPauseSchedule)(nil)
+	_ sdk.Msg = (*MsgResumeSchedule)(nil)
+	_ sdk.Msg = (*MsgClawback)(nil)
 )
 
 // NewMsgUpdateParams returns a new MsgUpdateParams with the authority
@@ -29,11 +34,57 @@ func NewMsgFundPool(sender sdk.AccAddress, amount sdk.Coin) *MsgFundPool {
 	}
 }
 
-// NewMsgChangeSchedule returns a new MsgChangeSchedule with the authority,
+// NewMsgCreateSchedule returns a new MsgCreateSchedule with the authority,
 // and a new schedule.
-func NewMsgChangeSchedule(authority string, schedule ReleaseSchedule) *MsgChangeSchedule {
-	return &MsgChangeSchedule{
+func NewMsgCreateSchedule(authority string, schedule ReleaseSchedule) *MsgCreateSchedule {
+	return &MsgCreateSchedule{
 		Authority: authority,
 		Schedule:  schedule,
 	}
 }
+
+// NewMsgAmendSchedule returns a new MsgAmendSchedule with the authority,
+// and the amended schedule.
+func NewMsgAmendSchedule(authority string, schedule ReleaseSchedule) *MsgAmendSchedule {
+	return &MsgAmendSchedule{
+		Authority: authority,
+		Schedule:  schedule,
+	}
+}
+
+// NewMsgCancelSchedule returns a new MsgCancelSchedule with the authority,
+// and the schedule id.
+func NewMsgCancelSchedule(authority string, id uint64) *MsgCancelSchedule {
+	return &MsgCancelSchedule{
+		Authority: authority,
+		Id:        id,
+	}
+}
+
+// NewMsgPauseSchedule returns a new MsgPauseSchedule with the authority,
+// and the schedule id.
+func NewMsgPauseSchedule(authority string, id uint64) *MsgPauseSchedule {
+	return &MsgPauseSchedule{
+		Authority: authority,
+		Id:        id,
+	}
+}
+
+// NewMsgResumeSchedule returns a new MsgResumeSchedule with the authority,
+// and the schedule id.
+func NewMsgResumeSchedule(authority string, id uint64) *MsgResumeSchedule {
+	return &MsgResumeSchedule{
+		Authority: authority,
+		Id:        id,
+	}
+}
+
+// NewMsgClawback returns a new MsgClawback with the authority, the amount
+// and the recipient, an empty recipient sends to the community pool.
+func NewMsgClawback(authority string, amount sdk.Coin, recipient string) *MsgClawback {
+	return &MsgClawback{
+		Authority: authority,
+		Amount:    amount,
+		Recipient: recipient,
+	}
+}
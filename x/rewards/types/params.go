@@ -3,6 +3,10 @@ package types
 import (
 	"fmt"
 
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/kiichain/kiichain/v4/app/params"
 )
 
@@ -10,6 +14,7 @@ import (
 func DefaultParams() Params {
 	return Params{
 		TokenDenom: params.BaseDenom, // akii base denom
+		FeeShare:   math.LegacyZeroDec(),
 	}
 }
 
@@ -19,5 +24,43 @@ func (p Params) ValidateBasic() error {
 	if denom == "" {
 		return fmt.Errorf("invalid denom, empty: %s", denom)
 	}
+
+	// Validate the allowed denoms
+	seen := make(map[string]bool, len(p.AllowedDenoms))
+	for _, allowed := range p.AllowedDenoms {
+		if err := sdk.ValidateDenom(allowed); err != nil {
+			return fmt.Errorf("invalid allowed denom %s: %w", allowed, err)
+		}
+		if allowed == p.TokenDenom {
+			return fmt.Errorf("allowed denom %s is already the token denom", allowed)
+		}
+		if seen[allowed] {
+			return fmt.Errorf("duplicated allowed denom %s", allowed)
+		}
+		seen[allowed] = true
+	}
+
+	// Validate the fee share, an unset fee share is disabled
+	if !p.FeeShare.IsNil() && (p.FeeShare.IsNegative() || p.FeeShare.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("fee share must be between 0 and 1: %s", p.FeeShare)
+	}
 	return nil
 }
+
+// IsAllowedDenom returns true if the denom can be used by the pool and the schedules
+func (p Params) IsAllowedDenom(denom string) bool {
+	if denom == p.TokenDenom {
+		return true
+	}
+	for _, allowed := range p.AllowedDenoms {
+		if allowed == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFeeShareEnabled returns true if a share of the fees is redirected to the pool
+func (p Params) IsFeeShareEnabled() bool {
+	return !p.FeeShare.IsNil() && p.FeeShare.IsPositive()
+}
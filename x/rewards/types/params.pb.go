@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -27,6 +28,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// Denom used
 	TokenDenom string `protobuf:"bytes,1,opt,name=token_denom,json=tokenDenom,proto3" json:"token_denom,omitempty"`
+	// Extra denoms accepted by the pool and the schedules, next to the token
+	// denom, such as tokenfactory denoms or IBC assets
+	AllowedDenoms []string `protobuf:"bytes,2,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// Share of the fee collector balance on the token denom redirected to the
+	// pool on each block, before the distribution module allocates it
+	FeeShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=fee_share,json=feeShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_share"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -69,6 +76,13 @@ func (m *Params) GetTokenDenom() string {
 	return ""
 }
 
+func (m *Params) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kiichain.rewards.v1beta1.Params")
 }
@@ -78,19 +92,25 @@ func init() {
 }
 
 var fileDescriptor_54abd846c753e163 = []byte{
-	// 186 bytes of a gzipped FileDescriptorProto
+	// 277 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0xce, 0xcc, 0x4c,
 	0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0x4a, 0x2d, 0x4f, 0x2c, 0x4a, 0x29, 0xd6, 0x2f, 0x33, 0x4c,
 	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
 	0xc9, 0x17, 0x92, 0x80, 0x29, 0xd3, 0x83, 0x2a, 0xd3, 0x83, 0x2a, 0x93, 0x12, 0x49, 0xcf, 0x4f,
 	0xcf, 0x07, 0x2b, 0xd2, 0x07, 0xb1, 0x20, 0xea, 0xa5, 0x54, 0x70, 0x1a, 0x5b, 0x52, 0x59, 0x90,
-	0x0a, 0x35, 0x55, 0x49, 0x93, 0x8b, 0x2d, 0x00, 0x6c, 0x8b, 0x90, 0x3c, 0x17, 0x77, 0x49, 0x7e,
-	0x76, 0x6a, 0x5e, 0x7c, 0x4a, 0x6a, 0x5e, 0x7e, 0xae, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10,
-	0x17, 0x58, 0xc8, 0x05, 0x24, 0xe2, 0xe4, 0x76, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c,
-	0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72,
-	0x0c, 0x51, 0x3a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x70, 0x5b,
-	0xe1, 0x8c, 0x0a, 0xb8, 0x03, 0xc0, 0x16, 0x27, 0xb1, 0x81, 0x6d, 0x36, 0x06, 0x04, 0x00, 0x00,
-	0xff, 0xff, 0x46, 0xe3, 0xb3, 0x7a, 0xf8, 0x00, 0x00, 0x00,
+	0x0a, 0x35, 0x55, 0x69, 0x12, 0x23, 0x17, 0x5b, 0x00, 0xd8, 0x1a, 0x21, 0x79, 0x2e, 0xee, 0x92,
+	0xfc, 0xec, 0xd4, 0xbc, 0xf8, 0x94, 0xd4, 0xbc, 0xfc, 0x5c, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce,
+	0x20, 0x2e, 0xb0, 0x90, 0x0b, 0x48, 0x44, 0x48, 0x95, 0x8b, 0x2f, 0x31, 0x27, 0x27, 0xbf, 0x3c,
+	0x35, 0x05, 0xa2, 0xa4, 0x58, 0x82, 0x49, 0x81, 0x59, 0x83, 0x33, 0x88, 0x17, 0x2a, 0x0a, 0x56,
+	0x55, 0x2c, 0xe4, 0xc0, 0xc5, 0x99, 0x96, 0x9a, 0x1a, 0x5f, 0x9c, 0x91, 0x58, 0x94, 0x2a, 0xc1,
+	0x0c, 0x32, 0xc5, 0x49, 0xf9, 0xc4, 0x3d, 0x79, 0x86, 0x5b, 0xf7, 0xe4, 0xa5, 0x93, 0xf3, 0x8b,
+	0x73, 0xf3, 0x8b, 0x8b, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x73, 0x13, 0x4b, 0x32, 0xf4, 0x7c,
+	0x52, 0xd3, 0x13, 0x93, 0x2b, 0x5d, 0x52, 0x93, 0x83, 0x38, 0xd2, 0x52, 0x53, 0x83, 0x41, 0x9a,
+	0x9c, 0xdc, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09,
+	0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x27, 0x3d, 0xb3,
+	0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xee, 0x3f, 0x38, 0xa3, 0x02, 0xee, 0x55,
+	0xb0, 0x17, 0x93, 0xd8, 0xc0, 0x7e, 0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x9d, 0x6f, 0xf7,
+	0x7f, 0x62, 0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -113,6 +133,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.FeeShare.Size()
+		i -= size
+		if _, err := m.FeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.TokenDenom) > 0 {
 		i -= len(m.TokenDenom)
 		copy(dAtA[i:], m.TokenDenom)
@@ -144,6 +183,14 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.FeeShare.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -214,6 +261,72 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.TokenDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
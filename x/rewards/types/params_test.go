@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"cosmossdk.io/math"
+
 	"github.com/kiichain/kiichain/v4/x/rewards/types"
 )
 
@@ -12,6 +14,8 @@ func TestParamsValidateBasic(t *testing.T) {
 	type fields struct {
 		GovernanceMinDeposit string
 		TokenDenom           string
+		AllowedDenoms        []string
+		FeeShare             math.LegacyDec
 	}
 	tests := []struct {
 		name    string
@@ -32,11 +36,77 @@ func TestParamsValidateBasic(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "success - allowed denoms",
+			fields: fields{
+				TokenDenom:    "akii",
+				AllowedDenoms: []string{"uusdc", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid - allowed denom format",
+			fields: fields{
+				TokenDenom:    "akii",
+				AllowedDenoms: []string{"!invalid"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid - allowed denom is the token denom",
+			fields: fields{
+				TokenDenom:    "akii",
+				AllowedDenoms: []string{"akii"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid - duplicated allowed denom",
+			fields: fields{
+				TokenDenom:    "akii",
+				AllowedDenoms: []string{"uusdc", "uusdc"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "success - fee share",
+			fields: fields{
+				TokenDenom: "akii",
+				FeeShare:   math.LegacyNewDecWithPrec(1, 1),
+			},
+			wantErr: false,
+		},
+		{
+			name: "success - all the fees",
+			fields: fields{
+				TokenDenom: "akii",
+				FeeShare:   math.LegacyOneDec(),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid - negative fee share",
+			fields: fields{
+				TokenDenom: "akii",
+				FeeShare:   math.LegacyNewDecWithPrec(-1, 1),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid - fee share above one",
+			fields: fields{
+				TokenDenom: "akii",
+				FeeShare:   math.LegacyNewDecWithPrec(11, 1),
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			p := types.Params{
-				TokenDenom: tt.fields.TokenDenom,
+				TokenDenom:    tt.fields.TokenDenom,
+				AllowedDenoms: tt.fields.AllowedDenoms,
+				FeeShare:      tt.fields.FeeShare,
 			}
 			if err := p.ValidateBasic(); (err != nil) != tt.wantErr {
 				t.Errorf("ValidateBasic() error = %v, wantErr %v", err, tt.wantErr)
@@ -52,4 +122,15 @@ func TestDefaultParams(t *testing.T) {
 
 	// Verify specific default values
 	require.Equal(t, "akii", defaultParams.TokenDenom)
+	require.Empty(t, defaultParams.AllowedDenoms)
+}
+
+// TestIsAllowedDenom tests the denoms accepted by the pool
+func TestIsAllowedDenom(t *testing.T) {
+	p := types.Params{TokenDenom: "akii", AllowedDenoms: []string{"uusdc"}}
+
+	require.True(t, p.IsAllowedDenom("akii"))
+	require.True(t, p.IsAllowedDenom("uusdc"))
+	require.False(t, p.IsAllowedDenom("uatom"))
+	require.False(t, p.IsAllowedDenom(""))
 }
@@ -5,24 +5,30 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -116,6 +122,8 @@ func (m *QueryParamsResponse) GetParams() Params {
 // QueryReleaseScheduleRequest defines the request structure for the
 // ReleaseSchedule gRPC query.
 type QueryReleaseScheduleRequest struct {
+	// id is the identifier of the schedule
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
 func (m *QueryReleaseScheduleRequest) Reset()         { *m = QueryReleaseScheduleRequest{} }
@@ -151,6 +159,13 @@ func (m *QueryReleaseScheduleRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryReleaseScheduleRequest proto.InternalMessageInfo
 
+func (m *QueryReleaseScheduleRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
 // QueryReleaseScheduleResponse defines the response structure for the
 // ReleaseSchedule gRPC query.
 type QueryReleaseScheduleResponse struct {
@@ -197,6 +212,221 @@ func (m *QueryReleaseScheduleResponse) GetReleaseSchedule() ReleaseSchedule {
 	return ReleaseSchedule{}
 }
 
+// QueryReleaseSchedulesRequest defines the request structure for the
+// ReleaseSchedules gRPC query.
+type QueryReleaseSchedulesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReleaseSchedulesRequest) Reset()         { *m = QueryReleaseSchedulesRequest{} }
+func (m *QueryReleaseSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReleaseSchedulesRequest) ProtoMessage()    {}
+func (*QueryReleaseSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{4}
+}
+func (m *QueryReleaseSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReleaseSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReleaseSchedulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReleaseSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReleaseSchedulesRequest.Merge(m, src)
+}
+func (m *QueryReleaseSchedulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReleaseSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReleaseSchedulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReleaseSchedulesRequest proto.InternalMessageInfo
+
+func (m *QueryReleaseSchedulesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryReleaseSchedulesResponse defines the response structure for the
+// ReleaseSchedules gRPC query.
+type QueryReleaseSchedulesResponse struct {
+	ReleaseSchedules []ReleaseSchedule `protobuf:"bytes,1,rep,name=release_schedules,json=releaseSchedules,proto3" json:"release_schedules" yaml:"release_schedules"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryReleaseSchedulesResponse) Reset()         { *m = QueryReleaseSchedulesResponse{} }
+func (m *QueryReleaseSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReleaseSchedulesResponse) ProtoMessage()    {}
+func (*QueryReleaseSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{5}
+}
+func (m *QueryReleaseSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReleaseSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReleaseSchedulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReleaseSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReleaseSchedulesResponse.Merge(m, src)
+}
+func (m *QueryReleaseSchedulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReleaseSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReleaseSchedulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReleaseSchedulesResponse proto.InternalMessageInfo
+
+func (m *QueryReleaseSchedulesResponse) GetReleaseSchedules() []ReleaseSchedule {
+	if m != nil {
+		return m.ReleaseSchedules
+	}
+	return nil
+}
+
+func (m *QueryReleaseSchedulesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryReleaseProjectionRequest defines the request structure for the
+// ReleaseProjection gRPC query.
+type QueryReleaseProjectionRequest struct {
+	// id is the identifier of the schedule
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// time is the timestamp of the projection, it can't be before the block
+	// time
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *QueryReleaseProjectionRequest) Reset()         { *m = QueryReleaseProjectionRequest{} }
+func (m *QueryReleaseProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReleaseProjectionRequest) ProtoMessage()    {}
+func (*QueryReleaseProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{6}
+}
+func (m *QueryReleaseProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReleaseProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReleaseProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReleaseProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReleaseProjectionRequest.Merge(m, src)
+}
+func (m *QueryReleaseProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReleaseProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReleaseProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReleaseProjectionRequest proto.InternalMessageInfo
+
+func (m *QueryReleaseProjectionRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueryReleaseProjectionRequest) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// QueryReleaseProjectionResponse defines the response structure for the
+// ReleaseProjection gRPC query.
+type QueryReleaseProjectionResponse struct {
+	// released_amount is the expected cumulative amount released at the time
+	ReleasedAmount types.Coin `protobuf:"bytes,1,opt,name=released_amount,json=releasedAmount,proto3" json:"released_amount" yaml:"released_amount"`
+	// remaining_amount is the expected amount still to be released at the time
+	RemainingAmount types.Coin `protobuf:"bytes,2,opt,name=remaining_amount,json=remainingAmount,proto3" json:"remaining_amount" yaml:"remaining_amount"`
+}
+
+func (m *QueryReleaseProjectionResponse) Reset()         { *m = QueryReleaseProjectionResponse{} }
+func (m *QueryReleaseProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReleaseProjectionResponse) ProtoMessage()    {}
+func (*QueryReleaseProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{7}
+}
+func (m *QueryReleaseProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryReleaseProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryReleaseProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryReleaseProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReleaseProjectionResponse.Merge(m, src)
+}
+func (m *QueryReleaseProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryReleaseProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReleaseProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryReleaseProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryReleaseProjectionResponse) GetReleasedAmount() types.Coin {
+	if m != nil {
+		return m.ReleasedAmount
+	}
+	return types.Coin{}
+}
+
+func (m *QueryReleaseProjectionResponse) GetRemainingAmount() types.Coin {
+	if m != nil {
+		return m.RemainingAmount
+	}
+	return types.Coin{}
+}
+
 // QueryRewardPoolRequest defines the request structure for the
 // RewardPool gRPC query.
 type QueryRewardPoolRequest struct {
@@ -206,7 +436,7 @@ func (m *QueryRewardPoolRequest) Reset()         { *m = QueryRewardPoolRequest{}
 func (m *QueryRewardPoolRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRewardPoolRequest) ProtoMessage()    {}
 func (*QueryRewardPoolRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_12435df56ac62847, []int{4}
+	return fileDescriptor_12435df56ac62847, []int{8}
 }
 func (m *QueryRewardPoolRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -239,13 +469,16 @@ var xxx_messageInfo_QueryRewardPoolRequest proto.InternalMessageInfo
 // RewardPool gRPC query.
 type QueryRewardPoolResponse struct {
 	RewardPool RewardPool `protobuf:"bytes,1,opt,name=reward_pool,json=rewardPool,proto3" json:"reward_pool" yaml:"reward_pool"`
+	// balances are the pool funds per denom, split between the amount committed
+	// to the schedules and the free amount
+	Balances []PoolDenomBalance `protobuf:"bytes,2,rep,name=balances,proto3" json:"balances" yaml:"balances"`
 }
 
 func (m *QueryRewardPoolResponse) Reset()         { *m = QueryRewardPoolResponse{} }
 func (m *QueryRewardPoolResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRewardPoolResponse) ProtoMessage()    {}
 func (*QueryRewardPoolResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_12435df56ac62847, []int{5}
+	return fileDescriptor_12435df56ac62847, []int{9}
 }
 func (m *QueryRewardPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -281,465 +514,3126 @@ func (m *QueryRewardPoolResponse) GetRewardPool() RewardPool {
 	return RewardPool{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "kiichain.rewards.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "kiichain.rewards.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryReleaseScheduleRequest)(nil), "kiichain.rewards.v1beta1.QueryReleaseScheduleRequest")
-	proto.RegisterType((*QueryReleaseScheduleResponse)(nil), "kiichain.rewards.v1beta1.QueryReleaseScheduleResponse")
-	proto.RegisterType((*QueryRewardPoolRequest)(nil), "kiichain.rewards.v1beta1.QueryRewardPoolRequest")
-	proto.RegisterType((*QueryRewardPoolResponse)(nil), "kiichain.rewards.v1beta1.QueryRewardPoolResponse")
+func (m *QueryRewardPoolResponse) GetBalances() []PoolDenomBalance {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("kiichain/rewards/v1beta1/query.proto", fileDescriptor_12435df56ac62847)
+// PoolDenomBalance defines the funds of the reward pool on a single denom
+type PoolDenomBalance struct {
+	// denom of the funds
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// pool_amount is the amount held by the pool
+	PoolAmount cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=pool_amount,json=poolAmount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"pool_amount"`
+	// committed_amount is the unreleased amount of the schedules on the denom
+	CommittedAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=committed_amount,json=committedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"committed_amount"`
+	// free_amount is the amount not committed to schedules, it can be used by
+	// new schedules or clawed back
+	FreeAmount cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=free_amount,json=freeAmount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"free_amount"`
 }
 
-var fileDescriptor_12435df56ac62847 = []byte{
-	// 500 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xb3, 0xfc, 0xc9, 0x61, 0x7b, 0x28, 0x5a, 0x2a, 0x1a, 0x99, 0xe2, 0x44, 0xab, 0x56,
-	0x94, 0x2a, 0xb1, 0x49, 0x10, 0x1c, 0x38, 0x70, 0xc8, 0x81, 0x73, 0x31, 0xe2, 0xc2, 0xa5, 0xda,
-	0xa4, 0x2b, 0xc7, 0xc2, 0xf1, 0xb8, 0x5e, 0x1b, 0x88, 0xc4, 0x89, 0x17, 0x00, 0x09, 0x71, 0xe7,
-	0x21, 0x78, 0x88, 0x1e, 0x2b, 0x71, 0xe1, 0x54, 0xa1, 0x84, 0x27, 0x80, 0x17, 0x40, 0xde, 0x1d,
-	0xbb, 0xaa, 0x5b, 0x37, 0xe4, 0xe6, 0xec, 0x7c, 0xf3, 0x7d, 0xbf, 0xec, 0x8c, 0x96, 0x6e, 0xbf,
-	0x09, 0x82, 0xf1, 0x44, 0x04, 0x91, 0x9b, 0xc8, 0x77, 0x22, 0x39, 0x54, 0xee, 0xdb, 0xfe, 0x48,
-	0xa6, 0xa2, 0xef, 0x1e, 0x65, 0x32, 0x99, 0x39, 0x71, 0x02, 0x29, 0xb0, 0x56, 0xa1, 0x72, 0x50,
-	0xe5, 0xa0, 0xca, 0xda, 0xf0, 0xc1, 0x07, 0x2d, 0x72, 0xf3, 0x2f, 0xa3, 0xb7, 0xb6, 0x7c, 0x00,
-	0x3f, 0x94, 0xae, 0x88, 0x03, 0x57, 0x44, 0x11, 0xa4, 0x22, 0x0d, 0x20, 0x52, 0x58, 0xdd, 0x1b,
-	0x83, 0x9a, 0x82, 0x72, 0x47, 0x42, 0x49, 0x13, 0x53, 0x86, 0xc6, 0xc2, 0x0f, 0x22, 0x2d, 0x46,
-	0x6d, 0x3d, 0x5f, 0x3a, 0x8b, 0x65, 0xe1, 0xb8, 0x53, 0xab, 0x8a, 0x45, 0x22, 0xa6, 0x28, 0xe3,
-	0x1b, 0x94, 0xbd, 0xc8, 0xe3, 0xf6, 0xf5, 0xa1, 0x27, 0x8f, 0x32, 0xa9, 0x52, 0xfe, 0x8a, 0xde,
-	0x3e, 0x77, 0xaa, 0x62, 0x88, 0x94, 0x64, 0xcf, 0x68, 0xd3, 0x34, 0xb7, 0x48, 0x87, 0xec, 0xae,
-	0x0d, 0x3a, 0x4e, 0xdd, 0x25, 0x38, 0xa6, 0x73, 0x78, 0xe3, 0xf8, 0xb4, 0xdd, 0xf0, 0xb0, 0x8b,
-	0xdf, 0xa3, 0x77, 0xb5, 0xad, 0x27, 0x43, 0x29, 0x94, 0x7c, 0x39, 0x9e, 0xc8, 0xc3, 0x2c, 0x94,
-	0x45, 0xea, 0x57, 0x42, 0xb7, 0x2e, 0xaf, 0x63, 0x7e, 0x46, 0x6f, 0x25, 0xa6, 0x74, 0xa0, 0xb0,
-	0x86, 0x24, 0x0f, 0xea, 0x49, 0x2a, 0x66, 0xc3, 0x76, 0x8e, 0xf4, 0xe7, 0xb4, 0xbd, 0x39, 0x13,
-	0xd3, 0xf0, 0x29, 0xaf, 0x1a, 0x72, 0x6f, 0x3d, 0x39, 0xdf, 0xc1, 0x5b, 0xf4, 0x0e, 0x62, 0xe5,
-	0xce, 0xfb, 0x00, 0x61, 0x41, 0xfc, 0x81, 0x6e, 0x5e, 0xa8, 0x20, 0xab, 0xa0, 0x6b, 0x86, 0xe4,
-	0x20, 0x06, 0x08, 0x11, 0x73, 0xfb, 0x2a, 0xcc, 0xc2, 0x62, 0x68, 0x21, 0x21, 0x2b, 0x08, 0x4b,
-	0x1b, 0xee, 0xd1, 0xa4, 0xd4, 0x0d, 0xfe, 0x5e, 0xa7, 0x37, 0x75, 0x3c, 0xfb, 0x44, 0x68, 0xd3,
-	0xdc, 0x38, 0xeb, 0xd6, 0x47, 0x5c, 0x1c, 0xb4, 0xd5, 0xfb, 0x4f, 0xb5, 0xf9, 0x53, 0x7c, 0xf7,
-	0xe3, 0x8f, 0xdf, 0x5f, 0xae, 0x71, 0xd6, 0x71, 0x97, 0x6c, 0x17, 0xfb, 0x4e, 0xe8, 0x7a, 0xe5,
-	0xe6, 0xd9, 0xe3, 0x25, 0x61, 0x97, 0xaf, 0x85, 0xf5, 0x64, 0xd5, 0x36, 0x84, 0x1d, 0x68, 0xd8,
-	0x2e, 0xdb, 0xab, 0x87, 0xc5, 0x49, 0xf7, 0x8a, 0xe1, 0xb3, 0x6f, 0x84, 0xd2, 0xb3, 0x49, 0xb0,
-	0x87, 0x4b, 0xa3, 0x2b, 0x1b, 0x61, 0xf5, 0x57, 0xe8, 0x40, 0xce, 0x9e, 0xe6, 0xbc, 0xcf, 0x76,
-	0xae, 0xe2, 0xcc, 0x7f, 0xf7, 0xf2, 0x15, 0x18, 0x3e, 0x3f, 0x9e, 0xdb, 0xe4, 0x64, 0x6e, 0x93,
-	0x5f, 0x73, 0x9b, 0x7c, 0x5e, 0xd8, 0x8d, 0x93, 0x85, 0xdd, 0xf8, 0xb9, 0xb0, 0x1b, 0xaf, 0xbb,
-	0x7e, 0x90, 0x4e, 0xb2, 0x91, 0x33, 0x86, 0xe9, 0x99, 0x55, 0xf9, 0xf1, 0xbe, 0x74, 0xd5, 0xcf,
-	0xc4, 0xa8, 0xa9, 0x1f, 0x80, 0x47, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x94, 0x24, 0x7b,
-	0xef, 0x04, 0x00, 0x00,
+func (m *PoolDenomBalance) Reset()         { *m = PoolDenomBalance{} }
+func (m *PoolDenomBalance) String() string { return proto.CompactTextString(m) }
+func (*PoolDenomBalance) ProtoMessage()    {}
+func (*PoolDenomBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{10}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params defines a gRPC query method that returns the reward module's
-	// parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ReleaseSchedule defines a gRPC query method for fetching
-	// ReleaseSchedule data.
-	ReleaseSchedule(ctx context.Context, in *QueryReleaseScheduleRequest, opts ...grpc.CallOption) (*QueryReleaseScheduleResponse, error)
-	// RewardPool defines a gRPC query method for fetching
-	// RewardPool data.
-	RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error)
+func (m *PoolDenomBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *PoolDenomBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolDenomBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *PoolDenomBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolDenomBalance.Merge(m, src)
+}
+func (m *PoolDenomBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolDenomBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolDenomBalance.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PoolDenomBalance proto.InternalMessageInfo
+
+func (m *PoolDenomBalance) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ReleaseSchedule(ctx context.Context, in *QueryReleaseScheduleRequest, opts ...grpc.CallOption) (*QueryReleaseScheduleResponse, error) {
-	out := new(QueryReleaseScheduleResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/ReleaseSchedule", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryPoolHealthRequest defines the request structure for the
+// PoolHealth gRPC query.
+type QueryPoolHealthRequest struct {
 }
 
-func (c *queryClient) RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error) {
-	out := new(QueryRewardPoolResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/RewardPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPoolHealthRequest) Reset()         { *m = QueryPoolHealthRequest{} }
+func (m *QueryPoolHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolHealthRequest) ProtoMessage()    {}
+func (*QueryPoolHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{11}
+}
+func (m *QueryPoolHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params defines a gRPC query method that returns the reward module's
-	// parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ReleaseSchedule defines a gRPC query method for fetching
-	// ReleaseSchedule data.
-	ReleaseSchedule(context.Context, *QueryReleaseScheduleRequest) (*QueryReleaseScheduleResponse, error)
-	// RewardPool defines a gRPC query method for fetching
-	// RewardPool data.
-	RewardPool(context.Context, *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error)
+func (m *QueryPoolHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolHealthRequest.Merge(m, src)
+}
+func (m *QueryPoolHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolHealthRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryPoolHealthRequest proto.InternalMessageInfo
+
+// QueryPoolHealthResponse defines the response structure for the
+// PoolHealth gRPC query.
+type QueryPoolHealthResponse struct {
+	// healthy is true if none of the checks is broken
+	Healthy bool `protobuf:"varint,1,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// checks are the results of each accounting check
+	Checks []HealthCheck `protobuf:"bytes,2,rep,name=checks,proto3" json:"checks"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryPoolHealthResponse) Reset()         { *m = QueryPoolHealthResponse{} }
+func (m *QueryPoolHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolHealthResponse) ProtoMessage()    {}
+func (*QueryPoolHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{12}
 }
-func (*UnimplementedQueryServer) ReleaseSchedule(ctx context.Context, req *QueryReleaseScheduleRequest) (*QueryReleaseScheduleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReleaseSchedule not implemented")
+func (m *QueryPoolHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) RewardPool(ctx context.Context, req *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardPool not implemented")
+func (m *QueryPoolHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolHealthResponse.Merge(m, src)
+}
+func (m *QueryPoolHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolHealthResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryPoolHealthResponse proto.InternalMessageInfo
+
+func (m *QueryPoolHealthResponse) GetHealthy() bool {
+	if m != nil {
+		return m.Healthy
+	}
+	return false
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryPoolHealthResponse) GetChecks() []HealthCheck {
+	if m != nil {
+		return m.Checks
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.rewards.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ReleaseSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryReleaseScheduleRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ReleaseSchedule(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.rewards.v1beta1.Query/ReleaseSchedule",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ReleaseSchedule(ctx, req.(*QueryReleaseScheduleRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// HealthCheck defines the result of a single accounting check
+type HealthCheck struct {
+	// name of the check, the same as the invariant route
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// broken is true if the check failed
+	Broken bool `protobuf:"varint,2,opt,name=broken,proto3" json:"broken,omitempty"`
+	// message describes the failures of the check
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func _Query_RewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRewardPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RewardPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.rewards.v1beta1.Query/RewardPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RewardPool(ctx, req.(*QueryRewardPoolRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *HealthCheck) Reset()         { *m = HealthCheck{} }
+func (m *HealthCheck) String() string { return proto.CompactTextString(m) }
+func (*HealthCheck) ProtoMessage()    {}
+func (*HealthCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{13}
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kiichain.rewards.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ReleaseSchedule",
-			Handler:    _Query_ReleaseSchedule_Handler,
-		},
-		{
-			MethodName: "RewardPool",
-			Handler:    _Query_RewardPool_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kiichain/rewards/v1beta1/query.proto",
+func (m *HealthCheck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *HealthCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HealthCheck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *HealthCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthCheck.Merge(m, src)
+}
+func (m *HealthCheck) XXX_Size() int {
+	return m.Size()
+}
+func (m *HealthCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthCheck.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_HealthCheck proto.InternalMessageInfo
+
+func (m *HealthCheck) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *HealthCheck) GetBroken() bool {
+	if m != nil {
+		return m.Broken
+	}
+	return false
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *HealthCheck) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRunwayRequest defines the request structure for the Runway gRPC query.
+type QueryRunwayRequest struct {
+	// denom of the schedules, the token denom is used if empty
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// block_time_ms is the block time used by the per block emission, in
+	// milliseconds, the default block time is used if zero
+	BlockTimeMs uint64 `protobuf:"varint,2,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRunwayRequest) Reset()         { *m = QueryRunwayRequest{} }
+func (m *QueryRunwayRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRunwayRequest) ProtoMessage()    {}
+func (*QueryRunwayRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{14}
+}
+func (m *QueryRunwayRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRunwayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRunwayRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryReleaseScheduleRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRunwayRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRunwayRequest.Merge(m, src)
 }
-
-func (m *QueryReleaseScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRunwayRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRunwayRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRunwayRequest.DiscardUnknown(m)
 }
 
-func (m *QueryReleaseScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryRunwayRequest proto.InternalMessageInfo
+
+func (m *QueryRunwayRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *QueryReleaseScheduleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRunwayRequest) GetBlockTimeMs() uint64 {
+	if m != nil {
+		return m.BlockTimeMs
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryReleaseScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRunwayResponse defines the response structure for the Runway gRPC
+// query.
+type QueryRunwayResponse struct {
+	// denom of the schedules
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// emission_per_second is the amount released per second by the active
+	// schedules, averaged over the next day
+	EmissionPerSecond cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=emission_per_second,json=emissionPerSecond,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"emission_per_second"`
+	// emission_per_block is the emission per second times the block time
+	EmissionPerBlock cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=emission_per_block,json=emissionPerBlock,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"emission_per_block"`
+	// projected_end_time is when the releases are expected to stop with the
+	// current funding, zero if no schedule is active
+	ProjectedEndTime time.Time `protobuf:"bytes,4,opt,name=projected_end_time,json=projectedEndTime,proto3,stdtime" json:"projected_end_time"`
+	// fully_funded is true if the pool covers the schedules until their end
+	FullyFunded bool `protobuf:"varint,5,opt,name=fully_funded,json=fullyFunded,proto3" json:"fully_funded,omitempty"`
+	// unallocated_amount is the amount of the pool not committed to schedules
+	UnallocatedAmount cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=unallocated_amount,json=unallocatedAmount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"unallocated_amount"`
 }
 
-func (m *QueryReleaseScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ReleaseSchedule.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRunwayResponse) Reset()         { *m = QueryRunwayResponse{} }
+func (m *QueryRunwayResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRunwayResponse) ProtoMessage()    {}
+func (*QueryRunwayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{15}
+}
+func (m *QueryRunwayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRunwayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRunwayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryRewardPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRunwayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRunwayResponse.Merge(m, src)
+}
+func (m *QueryRunwayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRunwayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRunwayResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRewardPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryRunwayResponse proto.InternalMessageInfo
+
+func (m *QueryRunwayResponse) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *QueryRewardPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryRunwayResponse) GetProjectedEndTime() time.Time {
+	if m != nil {
+		return m.ProjectedEndTime
+	}
+	return time.Time{}
 }
 
-func (m *QueryRewardPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRunwayResponse) GetFullyFunded() bool {
+	if m != nil {
+		return m.FullyFunded
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryStakingAPRRequest defines the request structure for the StakingAPR
+// gRPC query.
+type QueryStakingAPRRequest struct {
 }
 
-func (m *QueryRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.RewardPool.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryStakingAPRRequest) Reset()         { *m = QueryStakingAPRRequest{} }
+func (m *QueryStakingAPRRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingAPRRequest) ProtoMessage()    {}
+func (*QueryStakingAPRRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{16}
+}
+func (m *QueryStakingAPRRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingAPRRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingAPRRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryStakingAPRRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingAPRRequest.Merge(m, src)
+}
+func (m *QueryStakingAPRRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingAPRRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingAPRRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
+var xxx_messageInfo_QueryStakingAPRRequest proto.InternalMessageInfo
+
+// QueryStakingAPRResponse defines the response structure for the StakingAPR
+// gRPC query.
+type QueryStakingAPRResponse struct {
+	// apr is the yearly emission to the fee collector over the bonded tokens,
+	// before the community tax and the validator commissions
+	Apr cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=apr,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"apr"`
+	// annual_emission is the amount released to the fee collector in a year at
+	// the current emission rate, on the bond denom
+	AnnualEmission types.Coin `protobuf:"bytes,2,opt,name=annual_emission,json=annualEmission,proto3" json:"annual_emission" yaml:"annual_emission"`
+	// bonded_tokens is the amount of tokens bonded on the staking module
+	BondedTokens cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=bonded_tokens,json=bondedTokens,proto3,customtype=cosmossdk.io/math.Int" json:"bonded_tokens"`
+}
+
+func (m *QueryStakingAPRResponse) Reset()         { *m = QueryStakingAPRResponse{} }
+func (m *QueryStakingAPRResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStakingAPRResponse) ProtoMessage()    {}
+func (*QueryStakingAPRResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_12435df56ac62847, []int{17}
+}
+func (m *QueryStakingAPRResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStakingAPRResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStakingAPRResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStakingAPRResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStakingAPRResponse.Merge(m, src)
+}
+func (m *QueryStakingAPRResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStakingAPRResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStakingAPRResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStakingAPRResponse proto.InternalMessageInfo
+
+func (m *QueryStakingAPRResponse) GetAnnualEmission() types.Coin {
+	if m != nil {
+		return m.AnnualEmission
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "kiichain.rewards.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "kiichain.rewards.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryReleaseScheduleRequest)(nil), "kiichain.rewards.v1beta1.QueryReleaseScheduleRequest")
+	proto.RegisterType((*QueryReleaseScheduleResponse)(nil), "kiichain.rewards.v1beta1.QueryReleaseScheduleResponse")
+	proto.RegisterType((*QueryReleaseSchedulesRequest)(nil), "kiichain.rewards.v1beta1.QueryReleaseSchedulesRequest")
+	proto.RegisterType((*QueryReleaseSchedulesResponse)(nil), "kiichain.rewards.v1beta1.QueryReleaseSchedulesResponse")
+	proto.RegisterType((*QueryReleaseProjectionRequest)(nil), "kiichain.rewards.v1beta1.QueryReleaseProjectionRequest")
+	proto.RegisterType((*QueryReleaseProjectionResponse)(nil), "kiichain.rewards.v1beta1.QueryReleaseProjectionResponse")
+	proto.RegisterType((*QueryRewardPoolRequest)(nil), "kiichain.rewards.v1beta1.QueryRewardPoolRequest")
+	proto.RegisterType((*QueryRewardPoolResponse)(nil), "kiichain.rewards.v1beta1.QueryRewardPoolResponse")
+	proto.RegisterType((*PoolDenomBalance)(nil), "kiichain.rewards.v1beta1.PoolDenomBalance")
+	proto.RegisterType((*QueryPoolHealthRequest)(nil), "kiichain.rewards.v1beta1.QueryPoolHealthRequest")
+	proto.RegisterType((*QueryPoolHealthResponse)(nil), "kiichain.rewards.v1beta1.QueryPoolHealthResponse")
+	proto.RegisterType((*HealthCheck)(nil), "kiichain.rewards.v1beta1.HealthCheck")
+	proto.RegisterType((*QueryRunwayRequest)(nil), "kiichain.rewards.v1beta1.QueryRunwayRequest")
+	proto.RegisterType((*QueryRunwayResponse)(nil), "kiichain.rewards.v1beta1.QueryRunwayResponse")
+	proto.RegisterType((*QueryStakingAPRRequest)(nil), "kiichain.rewards.v1beta1.QueryStakingAPRRequest")
+	proto.RegisterType((*QueryStakingAPRResponse)(nil), "kiichain.rewards.v1beta1.QueryStakingAPRResponse")
+}
+
+func init() {
+	proto.RegisterFile("kiichain/rewards/v1beta1/query.proto", fileDescriptor_12435df56ac62847)
+}
+
+var fileDescriptor_12435df56ac62847 = []byte{
+	// 1321 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0x5f, 0x67, 0xd3, 0xb0, 0x9d, 0xb4, 0xdd, 0x74, 0x5a, 0xda, 0x90, 0xb6, 0xc9, 0x62, 0x5a,
+	0x5a, 0x4a, 0x63, 0xb7, 0x5b, 0x15, 0x56, 0x20, 0x90, 0xc8, 0x6e, 0x97, 0x22, 0x01, 0xda, 0x7a,
+	0xcb, 0x85, 0x8b, 0x35, 0xb1, 0x67, 0x13, 0x13, 0xdb, 0xe3, 0x7a, 0x6c, 0xba, 0x11, 0xe2, 0xc2,
+	0x17, 0xa0, 0x12, 0xe2, 0x8a, 0xf8, 0x18, 0x88, 0x4f, 0x50, 0x89, 0x4b, 0x25, 0x2e, 0xa8, 0x12,
+	0x0b, 0xda, 0xe5, 0xc6, 0x8d, 0x13, 0x27, 0x84, 0xe6, 0x5f, 0xfe, 0x38, 0xc9, 0x3a, 0xb9, 0xc5,
+	0x33, 0xef, 0xf7, 0xde, 0x6f, 0x7e, 0xef, 0xcd, 0x7b, 0x13, 0x70, 0xb5, 0xe7, 0x79, 0x4e, 0x17,
+	0x79, 0xa1, 0x19, 0xe3, 0x27, 0x28, 0x76, 0xa9, 0xf9, 0xe5, 0x9d, 0x36, 0x4e, 0xd0, 0x1d, 0xf3,
+	0x71, 0x8a, 0xe3, 0xbe, 0x11, 0xc5, 0x24, 0x21, 0xb0, 0xaa, 0xac, 0x0c, 0x69, 0x65, 0x48, 0xab,
+	0xda, 0xf9, 0x0e, 0xe9, 0x10, 0x6e, 0x64, 0xb2, 0x5f, 0xc2, 0xbe, 0x76, 0xb9, 0x43, 0x48, 0xc7,
+	0xc7, 0x26, 0x8a, 0x3c, 0x13, 0x85, 0x21, 0x49, 0x50, 0xe2, 0x91, 0x90, 0xca, 0xdd, 0x9b, 0x0e,
+	0xa1, 0x01, 0xa1, 0x66, 0x1b, 0x51, 0x2c, 0xc2, 0x0c, 0x82, 0x46, 0xa8, 0xe3, 0x85, 0xdc, 0x58,
+	0xda, 0xd6, 0x47, 0x6d, 0x95, 0x95, 0x43, 0x3c, 0xb5, 0xdf, 0x90, 0x91, 0xf8, 0x57, 0x3b, 0xdd,
+	0x33, 0x13, 0x2f, 0xc0, 0x34, 0x41, 0x41, 0x24, 0x0d, 0x66, 0x1f, 0x30, 0xe9, 0x47, 0x58, 0x51,
+	0xba, 0x36, 0xd3, 0x2a, 0x42, 0x31, 0x0a, 0xa4, 0x99, 0x7e, 0x1e, 0xc0, 0x87, 0x8c, 0xef, 0x0e,
+	0x5f, 0xb4, 0xf0, 0xe3, 0x14, 0xd3, 0x44, 0xff, 0x0c, 0x9c, 0x1b, 0x5b, 0xa5, 0x11, 0x09, 0x29,
+	0x86, 0xef, 0x83, 0x92, 0x00, 0x57, 0xb5, 0x35, 0xed, 0x46, 0x79, 0x7d, 0xcd, 0x98, 0xa5, 0xa2,
+	0x21, 0x90, 0xad, 0xe2, 0xb3, 0x83, 0xc6, 0x92, 0x25, 0x51, 0x7a, 0x13, 0x5c, 0xe2, 0x6e, 0x2d,
+	0xec, 0x63, 0x44, 0xf1, 0xae, 0xd3, 0xc5, 0x6e, 0xea, 0x63, 0x19, 0x15, 0x9e, 0x01, 0x05, 0xcf,
+	0xe5, 0xae, 0x8b, 0x56, 0xc1, 0x73, 0xf5, 0xef, 0x35, 0x70, 0x79, 0xba, 0xbd, 0xe4, 0x93, 0x82,
+	0x4a, 0x2c, 0xb6, 0x6c, 0x2a, 0xf7, 0x24, 0xb3, 0x37, 0x66, 0x33, 0xcb, 0x38, 0x6b, 0x35, 0x18,
+	0xc5, 0x7f, 0x0e, 0x1a, 0x17, 0xfb, 0x28, 0xf0, 0xdf, 0xd1, 0xb3, 0x0e, 0x75, 0x6b, 0x35, 0x1e,
+	0x47, 0xe8, 0x7b, 0xd3, 0x69, 0x29, 0xf5, 0xe0, 0x36, 0x00, 0xc3, 0xac, 0x4b, 0x42, 0xaf, 0x1b,
+	0x22, 0xed, 0x06, 0x4b, 0xbb, 0x21, 0x2a, 0x71, 0xa8, 0x55, 0x47, 0x69, 0x60, 0x8d, 0x20, 0xf5,
+	0x43, 0x0d, 0x5c, 0x99, 0x11, 0x48, 0x0a, 0xb0, 0x0f, 0xce, 0x66, 0xf9, 0xb2, 0xdc, 0x2c, 0x2f,
+	0xa6, 0xc0, 0x9a, 0x54, 0xa0, 0x3a, 0x5d, 0x01, 0xaa, 0x5b, 0x95, 0x8c, 0x04, 0x14, 0x7e, 0x38,
+	0x76, 0xc6, 0x02, 0x3f, 0xe3, 0xf5, 0xdc, 0x33, 0x0a, 0xda, 0x63, 0x87, 0xf4, 0xc6, 0xcf, 0xb8,
+	0x13, 0x93, 0x2f, 0xb0, 0xc3, 0x76, 0x66, 0x54, 0x05, 0xdc, 0x00, 0x45, 0x76, 0x23, 0x64, 0xcc,
+	0x9a, 0x21, 0xae, 0x8b, 0xa1, 0xae, 0x8b, 0xf1, 0x48, 0x5d, 0x97, 0xd6, 0x0a, 0x3b, 0xd7, 0xd3,
+	0x3f, 0x1a, 0x9a, 0xc5, 0x11, 0xfa, 0xdf, 0x1a, 0xa8, 0xcf, 0x8a, 0x25, 0x05, 0x6d, 0x03, 0x95,
+	0x6d, 0xd7, 0x46, 0x01, 0x49, 0xc3, 0x44, 0xe6, 0xef, 0x95, 0xb1, 0xb3, 0xa9, 0x53, 0x6d, 0x12,
+	0x2f, 0x6c, 0xd5, 0xa5, 0x7c, 0x17, 0xc6, 0xe4, 0x53, 0x78, 0xdd, 0x3a, 0xa3, 0x56, 0x3e, 0xe0,
+	0x0b, 0x10, 0xb3, 0xaa, 0x0d, 0x90, 0x17, 0x7a, 0x61, 0x47, 0x05, 0x29, 0xe4, 0x05, 0x99, 0xa8,
+	0xd2, 0x71, 0x07, 0xbc, 0x4a, 0xe5, 0x92, 0x08, 0xa3, 0x57, 0xc1, 0x05, 0x79, 0x58, 0x96, 0xfd,
+	0x1d, 0x42, 0x7c, 0x75, 0xbb, 0x7f, 0xd7, 0xc0, 0xc5, 0x89, 0x2d, 0x29, 0x00, 0x02, 0x65, 0x51,
+	0x2e, 0x76, 0x44, 0x88, 0x2f, 0x0f, 0x7f, 0xf5, 0xb8, 0x5a, 0x52, 0x2e, 0x5a, 0x35, 0x49, 0x11,
+	0x2a, 0x8a, 0x03, 0x37, 0xba, 0x05, 0xe2, 0x81, 0x1d, 0xb4, 0xc1, 0x4a, 0x1b, 0xf9, 0x28, 0x74,
+	0x30, 0xad, 0x16, 0x78, 0xad, 0xde, 0x3c, 0xa6, 0x8f, 0x10, 0xe2, 0x6f, 0xe1, 0x90, 0x04, 0x2d,
+	0x01, 0x69, 0x5d, 0x94, 0x51, 0x56, 0x45, 0x14, 0xe5, 0x49, 0xb7, 0x06, 0x4e, 0xf5, 0xff, 0x34,
+	0x50, 0xc9, 0xe2, 0xe0, 0x79, 0x70, 0xc2, 0x65, 0xdf, 0xfc, 0x48, 0x27, 0x2d, 0xf1, 0x01, 0xb7,
+	0x40, 0x99, 0x11, 0x1c, 0x4d, 0xc3, 0xc9, 0xd6, 0x6b, 0x2c, 0xc4, 0x8b, 0x83, 0xc6, 0x25, 0x91,
+	0x0d, 0xea, 0xf6, 0x0c, 0x8f, 0x98, 0x01, 0x4a, 0xba, 0xc6, 0xc7, 0xb8, 0x83, 0x9c, 0xfe, 0x16,
+	0x76, 0x2c, 0xc0, 0x70, 0x32, 0xa3, 0x0f, 0x40, 0xc5, 0x21, 0x41, 0xe0, 0x25, 0xc9, 0xb0, 0x6c,
+	0x96, 0xb9, 0xab, 0x2b, 0xd2, 0xd5, 0xcb, 0x93, 0xae, 0x3e, 0x0a, 0x13, 0x6b, 0x75, 0x00, 0x93,
+	0x9e, 0xb6, 0x40, 0x79, 0x2f, 0xc6, 0x58, 0x39, 0x29, 0x2e, 0xc0, 0x87, 0xe1, 0x32, 0xa9, 0x67,
+	0x22, 0x3c, 0xc0, 0xc8, 0x4f, 0xba, 0x2a, 0xf5, 0xfb, 0x32, 0xf3, 0xa3, 0x3b, 0x32, 0xf3, 0x55,
+	0xf0, 0x52, 0x97, 0xaf, 0xf4, 0xb9, 0x44, 0x2b, 0x96, 0xfa, 0x84, 0x9b, 0xa0, 0xe4, 0x74, 0xb1,
+	0xd3, 0x53, 0xe9, 0xba, 0x36, 0x3b, 0x5d, 0xc2, 0xe7, 0x26, 0xb3, 0x56, 0xbd, 0x5f, 0x40, 0xf5,
+	0x5d, 0x50, 0x1e, 0xd9, 0x84, 0x10, 0x14, 0x43, 0x14, 0x60, 0x99, 0x0d, 0xfe, 0x1b, 0x5e, 0x00,
+	0xa5, 0x76, 0x4c, 0x7a, 0x58, 0xf4, 0x93, 0x15, 0x4b, 0x7e, 0x31, 0x66, 0x01, 0xa6, 0x14, 0x75,
+	0xb0, 0x50, 0xd5, 0x52, 0x9f, 0xfa, 0xa7, 0x72, 0x7a, 0x59, 0x69, 0xf8, 0x04, 0xf5, 0x55, 0xc7,
+	0x98, 0x9e, 0x6a, 0x1d, 0x9c, 0x6e, 0xfb, 0xc4, 0xe9, 0xd9, 0xac, 0x17, 0xd8, 0x01, 0xe5, 0x41,
+	0x8a, 0x56, 0x99, 0x2f, 0xb2, 0xa6, 0xf1, 0x09, 0xd5, 0x7f, 0x58, 0x96, 0x83, 0x4f, 0x39, 0x94,
+	0xda, 0x4c, 0xf7, 0xb8, 0x0b, 0xce, 0xe1, 0xc0, 0xa3, 0xd4, 0x23, 0xa1, 0x1d, 0xe1, 0xd8, 0xa6,
+	0xd8, 0x21, 0xa1, 0xbb, 0x48, 0x11, 0x9d, 0x55, 0xf8, 0x1d, 0x1c, 0xef, 0x72, 0x34, 0x7c, 0x08,
+	0xe0, 0x98, 0x53, 0x4e, 0x4f, 0x56, 0xd3, 0x5c, 0x3e, 0x2b, 0x23, 0x3e, 0x5b, 0x0c, 0x0c, 0x2d,
+	0x00, 0x23, 0xd1, 0xea, 0xb0, 0x6b, 0xe3, 0xd0, 0xe5, 0x0a, 0xf0, 0xda, 0x9a, 0xb7, 0x7f, 0x56,
+	0x06, 0xf8, 0xfb, 0xa1, 0xcb, 0x0c, 0xe0, 0xab, 0xe0, 0xd4, 0x5e, 0xea, 0xfb, 0x7d, 0x7b, 0x2f,
+	0x0d, 0x5d, 0xec, 0x56, 0x4f, 0xf0, 0x8c, 0x95, 0xf9, 0xda, 0x36, 0x5f, 0x62, 0x61, 0xd3, 0x10,
+	0xf9, 0x3e, 0x71, 0xd0, 0xc8, 0xbd, 0x28, 0x2d, 0xa0, 0xce, 0x08, 0x3c, 0x53, 0xd9, 0xbb, 0x09,
+	0xea, 0xb1, 0x56, 0xb7, 0x63, 0xa9, 0xca, 0xfe, 0x57, 0x35, 0xb5, 0xd1, 0x2d, 0x99, 0xbe, 0x7b,
+	0x60, 0x19, 0x45, 0xb1, 0x48, 0xde, 0x7c, 0xa1, 0x99, 0x3d, 0x1b, 0x06, 0x28, 0x0c, 0x53, 0xe4,
+	0xdb, 0x4a, 0xd2, 0xfc, 0x3e, 0x9d, 0x19, 0x06, 0x19, 0xbc, 0x6e, 0x9d, 0x11, 0x2b, 0xf7, 0xe5,
+	0x02, 0x6c, 0x81, 0xd3, 0x6d, 0xc2, 0xe4, 0xb2, 0x13, 0x56, 0xeb, 0x74, 0xbe, 0xbe, 0x71, 0x4a,
+	0x60, 0x1e, 0x71, 0xc8, 0xfa, 0x0b, 0x00, 0x4e, 0xf0, 0xa3, 0xc3, 0x6f, 0x35, 0x50, 0x12, 0x2f,
+	0x2f, 0x78, 0x6b, 0xf6, 0x25, 0x9d, 0x7c, 0xf0, 0xd5, 0x9a, 0x73, 0x5a, 0x0b, 0x41, 0xf5, 0x1b,
+	0xdf, 0xfc, 0xfa, 0xd7, 0x77, 0x05, 0x1d, 0xae, 0x99, 0x39, 0xaf, 0x4c, 0xf8, 0xb3, 0x06, 0x56,
+	0x33, 0xef, 0x0d, 0x78, 0x2f, 0x27, 0xd8, 0xf4, 0xe7, 0x61, 0xed, 0xad, 0x45, 0x61, 0x92, 0xec,
+	0x06, 0x27, 0xbb, 0x0e, 0x6f, 0xcf, 0x26, 0x2b, 0x27, 0x74, 0x73, 0xf0, 0xe4, 0x31, 0xbf, 0xf2,
+	0xdc, 0xaf, 0xe1, 0x4f, 0x1a, 0xa8, 0x64, 0xdf, 0x5e, 0x70, 0x41, 0x1a, 0x03, 0x89, 0xdf, 0x5e,
+	0x18, 0x27, 0xf9, 0xdf, 0xe5, 0xfc, 0x9b, 0xf0, 0xcd, 0x05, 0xf8, 0xc3, 0x5f, 0x34, 0x70, 0x76,
+	0xe2, 0x99, 0x03, 0xe7, 0xe4, 0x30, 0xf1, 0x08, 0xab, 0x6d, 0x2c, 0x0e, 0x94, 0xec, 0x37, 0x39,
+	0xfb, 0xf7, 0xe0, 0xbb, 0x8b, 0xaa, 0x6f, 0x46, 0x43, 0xde, 0x3f, 0x6a, 0x00, 0x0c, 0x5f, 0x1a,
+	0xf0, 0x76, 0x2e, 0x9b, 0xcc, 0x93, 0xa7, 0x76, 0x67, 0x01, 0x84, 0x24, 0xde, 0xe4, 0xc4, 0xaf,
+	0xc3, 0x6b, 0xc7, 0x11, 0x67, 0xdf, 0x4d, 0xf6, 0x12, 0xe0, 0x14, 0x87, 0x53, 0x35, 0x97, 0xe2,
+	0xc4, 0x68, 0xce, 0xa5, 0x38, 0x39, 0xb2, 0xe7, 0xa1, 0xc8, 0xb8, 0x35, 0xc5, 0x20, 0xe7, 0xdd,
+	0x41, 0x0c, 0xb6, 0xdc, 0xee, 0x30, 0x36, 0x50, 0x73, 0xbb, 0xc3, 0xf8, 0xb4, 0x9c, 0xa7, 0x3b,
+	0xc4, 0x82, 0x06, 0x13, 0x6d, 0xd8, 0xaf, 0x73, 0x45, 0x9b, 0xe8, 0xfa, 0xb9, 0xa2, 0x4d, 0x0e,
+	0x83, 0x79, 0x44, 0xa3, 0x02, 0xd5, 0x44, 0x51, 0xdc, 0xda, 0x7e, 0x76, 0x58, 0xd7, 0x9e, 0x1f,
+	0xd6, 0xb5, 0x3f, 0x0f, 0xeb, 0xda, 0xd3, 0xa3, 0xfa, 0xd2, 0xf3, 0xa3, 0xfa, 0xd2, 0x6f, 0x47,
+	0xf5, 0xa5, 0xcf, 0x6f, 0x75, 0xbc, 0xa4, 0x9b, 0xb6, 0x0d, 0x87, 0x04, 0x43, 0x57, 0x83, 0x1f,
+	0xfb, 0x03, 0xaf, 0xfc, 0x5f, 0x79, 0xbb, 0xc4, 0x07, 0xec, 0xdd, 0xff, 0x03, 0x00, 0x00, 0xff,
+	0xff, 0xaf, 0x46, 0xce, 0xeb, 0x9f, 0x10, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params defines a gRPC query method that returns the reward module's
+	// parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ReleaseSchedule defines a gRPC query method for fetching
+	// a single ReleaseSchedule by its identifier.
+	ReleaseSchedule(ctx context.Context, in *QueryReleaseScheduleRequest, opts ...grpc.CallOption) (*QueryReleaseScheduleResponse, error)
+	// ReleaseSchedules defines a gRPC query method for fetching
+	// all the ReleaseSchedule data.
+	ReleaseSchedules(ctx context.Context, in *QueryReleaseSchedulesRequest, opts ...grpc.CallOption) (*QueryReleaseSchedulesResponse, error)
+	// ReleaseProjection defines a gRPC query method for fetching the expected
+	// cumulative release of a schedule at a future time.
+	ReleaseProjection(ctx context.Context, in *QueryReleaseProjectionRequest, opts ...grpc.CallOption) (*QueryReleaseProjectionResponse, error)
+	// RewardPool defines a gRPC query method for fetching
+	// RewardPool data.
+	RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error)
+	// PoolHealth defines a gRPC query method for running the reward pool
+	// accounting checks, the same ones registered as invariants.
+	PoolHealth(ctx context.Context, in *QueryPoolHealthRequest, opts ...grpc.CallOption) (*QueryPoolHealthResponse, error)
+	// Runway defines a gRPC query method for fetching the emission rate, the
+	// projected end time and the unallocated funds of a denom.
+	Runway(ctx context.Context, in *QueryRunwayRequest, opts ...grpc.CallOption) (*QueryRunwayResponse, error)
+	// StakingAPR defines a gRPC query method for estimating the APR added to
+	// the stakers by the schedules releasing to the fee collector.
+	StakingAPR(ctx context.Context, in *QueryStakingAPRRequest, opts ...grpc.CallOption) (*QueryStakingAPRResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReleaseSchedule(ctx context.Context, in *QueryReleaseScheduleRequest, opts ...grpc.CallOption) (*QueryReleaseScheduleResponse, error) {
+	out := new(QueryReleaseScheduleResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/ReleaseSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReleaseSchedules(ctx context.Context, in *QueryReleaseSchedulesRequest, opts ...grpc.CallOption) (*QueryReleaseSchedulesResponse, error) {
+	out := new(QueryReleaseSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/ReleaseSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReleaseProjection(ctx context.Context, in *QueryReleaseProjectionRequest, opts ...grpc.CallOption) (*QueryReleaseProjectionResponse, error) {
+	out := new(QueryReleaseProjectionResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/ReleaseProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardPool(ctx context.Context, in *QueryRewardPoolRequest, opts ...grpc.CallOption) (*QueryRewardPoolResponse, error) {
+	out := new(QueryRewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/RewardPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolHealth(ctx context.Context, in *QueryPoolHealthRequest, opts ...grpc.CallOption) (*QueryPoolHealthResponse, error) {
+	out := new(QueryPoolHealthResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/PoolHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Runway(ctx context.Context, in *QueryRunwayRequest, opts ...grpc.CallOption) (*QueryRunwayResponse, error) {
+	out := new(QueryRunwayResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/Runway", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StakingAPR(ctx context.Context, in *QueryStakingAPRRequest, opts ...grpc.CallOption) (*QueryStakingAPRResponse, error) {
+	out := new(QueryStakingAPRResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Query/StakingAPR", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params defines a gRPC query method that returns the reward module's
+	// parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ReleaseSchedule defines a gRPC query method for fetching
+	// a single ReleaseSchedule by its identifier.
+	ReleaseSchedule(context.Context, *QueryReleaseScheduleRequest) (*QueryReleaseScheduleResponse, error)
+	// ReleaseSchedules defines a gRPC query method for fetching
+	// all the ReleaseSchedule data.
+	ReleaseSchedules(context.Context, *QueryReleaseSchedulesRequest) (*QueryReleaseSchedulesResponse, error)
+	// ReleaseProjection defines a gRPC query method for fetching the expected
+	// cumulative release of a schedule at a future time.
+	ReleaseProjection(context.Context, *QueryReleaseProjectionRequest) (*QueryReleaseProjectionResponse, error)
+	// RewardPool defines a gRPC query method for fetching
+	// RewardPool data.
+	RewardPool(context.Context, *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error)
+	// PoolHealth defines a gRPC query method for running the reward pool
+	// accounting checks, the same ones registered as invariants.
+	PoolHealth(context.Context, *QueryPoolHealthRequest) (*QueryPoolHealthResponse, error)
+	// Runway defines a gRPC query method for fetching the emission rate, the
+	// projected end time and the unallocated funds of a denom.
+	Runway(context.Context, *QueryRunwayRequest) (*QueryRunwayResponse, error)
+	// StakingAPR defines a gRPC query method for estimating the APR added to
+	// the stakers by the schedules releasing to the fee collector.
+	StakingAPR(context.Context, *QueryStakingAPRRequest) (*QueryStakingAPRResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ReleaseSchedule(ctx context.Context, req *QueryReleaseScheduleRequest) (*QueryReleaseScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseSchedule not implemented")
+}
+func (*UnimplementedQueryServer) ReleaseSchedules(ctx context.Context, req *QueryReleaseSchedulesRequest) (*QueryReleaseSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseSchedules not implemented")
+}
+func (*UnimplementedQueryServer) ReleaseProjection(ctx context.Context, req *QueryReleaseProjectionRequest) (*QueryReleaseProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseProjection not implemented")
+}
+func (*UnimplementedQueryServer) RewardPool(ctx context.Context, req *QueryRewardPoolRequest) (*QueryRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardPool not implemented")
+}
+func (*UnimplementedQueryServer) PoolHealth(ctx context.Context, req *QueryPoolHealthRequest) (*QueryPoolHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolHealth not implemented")
+}
+func (*UnimplementedQueryServer) Runway(ctx context.Context, req *QueryRunwayRequest) (*QueryRunwayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Runway not implemented")
+}
+func (*UnimplementedQueryServer) StakingAPR(ctx context.Context, req *QueryStakingAPRRequest) (*QueryStakingAPRResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StakingAPR not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReleaseSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReleaseScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReleaseSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Query/ReleaseSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReleaseSchedule(ctx, req.(*QueryReleaseScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReleaseSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReleaseSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReleaseSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Query/ReleaseSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReleaseSchedules(ctx, req.(*QueryReleaseSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReleaseProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReleaseProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReleaseProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Query/ReleaseProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReleaseProjection(ctx, req.(*QueryReleaseProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Query/RewardPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardPool(ctx, req.(*QueryRewardPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Query/PoolHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolHealth(ctx, req.(*QueryPoolHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Runway_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRunwayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Runway(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Query/Runway",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Runway(ctx, req.(*QueryRunwayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StakingAPR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStakingAPRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StakingAPR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Query/StakingAPR",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StakingAPR(ctx, req.(*QueryStakingAPRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kiichain.rewards.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ReleaseSchedule",
+			Handler:    _Query_ReleaseSchedule_Handler,
+		},
+		{
+			MethodName: "ReleaseSchedules",
+			Handler:    _Query_ReleaseSchedules_Handler,
+		},
+		{
+			MethodName: "ReleaseProjection",
+			Handler:    _Query_ReleaseProjection_Handler,
+		},
+		{
+			MethodName: "RewardPool",
+			Handler:    _Query_RewardPool_Handler,
+		},
+		{
+			MethodName: "PoolHealth",
+			Handler:    _Query_PoolHealth_Handler,
+		},
+		{
+			MethodName: "Runway",
+			Handler:    _Query_Runway_Handler,
+		},
+		{
+			MethodName: "StakingAPR",
+			Handler:    _Query_StakingAPR_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kiichain/rewards/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReleaseScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReleaseScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReleaseScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReleaseScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReleaseScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReleaseScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ReleaseSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReleaseSchedulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReleaseSchedulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReleaseSchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReleaseSchedulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReleaseSchedulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReleaseSchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ReleaseSchedules) > 0 {
+		for iNdEx := len(m.ReleaseSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReleaseSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReleaseProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReleaseProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReleaseProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryReleaseProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReleaseProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReleaseProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RemainingAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ReleasedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.RewardPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolDenomBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolDenomBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolDenomBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FreeAmount.Size()
+		i -= size
+		if _, err := m.FreeAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.CommittedAmount.Size()
+		i -= size
+		if _, err := m.CommittedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PoolAmount.Size()
+		i -= size
+		if _, err := m.PoolAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checks) > 0 {
+		for iNdEx := len(m.Checks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Healthy {
+		i--
+		if m.Healthy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HealthCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HealthCheck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HealthCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Broken {
+		i--
+		if m.Broken {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRunwayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRunwayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRunwayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRunwayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRunwayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRunwayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UnallocatedAmount.Size()
+		i -= size
+		if _, err := m.UnallocatedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.FullyFunded {
+		i--
+		if m.FullyFunded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ProjectedEndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ProjectedEndTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.EmissionPerBlock.Size()
+		i -= size
+		if _, err := m.EmissionPerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.EmissionPerSecond.Size()
+		i -= size
+		if _, err := m.EmissionPerSecond.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingAPRRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingAPRRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingAPRRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStakingAPRResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStakingAPRResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStakingAPRResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BondedTokens.Size()
+		i -= size
+		if _, err := m.BondedTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.AnnualEmission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Apr.Size()
+		i -= size
+		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReleaseScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryReleaseScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReleaseSchedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReleaseSchedulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReleaseSchedulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ReleaseSchedules) > 0 {
+		for _, e := range m.ReleaseSchedules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReleaseProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryReleaseProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ReleasedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RewardPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolDenomBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.PoolAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CommittedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FreeAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPoolHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Healthy {
+		n += 2
+	}
+	if len(m.Checks) > 0 {
+		for _, e := range m.Checks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HealthCheck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Broken {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRunwayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovQuery(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *QueryRunwayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.EmissionPerSecond.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EmissionPerBlock.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ProjectedEndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.FullyFunded {
+		n += 2
+	}
+	l = m.UnallocatedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStakingAPRRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStakingAPRResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Apr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AnnualEmission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BondedTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReleaseScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReleaseScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReleaseScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReleaseScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReleaseScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReleaseScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReleaseSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReleaseSchedulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReleaseSchedulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReleaseSchedulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReleaseSchedulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReleaseSchedulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReleaseSchedulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReleaseSchedules = append(m.ReleaseSchedules, ReleaseSchedule{})
+			if err := m.ReleaseSchedules[len(m.ReleaseSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReleaseProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReleaseProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReleaseProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryReleaseProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryReleaseProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryReleaseProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReleasedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRewardPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, PoolDenomBalance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolDenomBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolDenomBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolDenomBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommittedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommittedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FreeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *QueryReleaseScheduleRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolHealthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolHealthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryReleaseScheduleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.ReleaseSchedule.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *QueryRewardPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPoolHealthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolHealthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Healthy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Healthy = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checks = append(m.Checks, HealthCheck{})
+			if err := m.Checks[len(m.Checks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryRewardPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.RewardPool.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *HealthCheck) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -762,12 +3656,96 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthCheck: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthCheck: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Broken", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Broken = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -789,7 +3767,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRunwayRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -812,17 +3790,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRunwayRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRunwayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -832,25 +3810,43 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -872,7 +3868,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryReleaseScheduleRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRunwayResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -895,65 +3891,115 @@ func (m *QueryReleaseScheduleRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReleaseScheduleRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRunwayResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReleaseScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRunwayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryReleaseScheduleResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionPerSecond", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.EmissionPerSecond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryReleaseScheduleResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryReleaseScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseSchedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedEndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -980,7 +4026,61 @@ func (m *QueryReleaseScheduleResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReleaseSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ProjectedEndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullyFunded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FullyFunded = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnallocatedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnallocatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1005,7 +4105,7 @@ func (m *QueryReleaseScheduleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStakingAPRRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1028,10 +4128,10 @@ func (m *QueryRewardPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakingAPRRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakingAPRRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1055,7 +4155,7 @@ func (m *QueryRewardPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStakingAPRResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1078,15 +4178,49 @@ func (m *QueryRewardPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStakingAPRResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStakingAPRResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualEmission", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1113,7 +4247,41 @@ func (m *QueryRewardPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AnnualEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
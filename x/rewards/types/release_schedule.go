@@ -2,36 +2,47 @@ package types
 
 import (
 	fmt "fmt"
-	time "time"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// InitialReleaseSchedule returns an empty release schedule
-func InitialReleaseSchedule() ReleaseSchedule {
-	return ReleaseSchedule{
-		TotalAmount:     sdk.Coin{},
-		ReleasedAmount:  sdk.Coin{},
-		EndTime:         time.Time{},
-		LastReleaseTime: time.Time{},
-		Active:          false,
-	}
-}
-
 // ValidateGenesis validates the release schedule for a genesis state
+// There is no block time on genesis, so the times are only checked against each other,
+// exported schedules can have an end time in the past
 func (rr ReleaseSchedule) ValidateGenesis() error {
-	// Validate EndTime (zero time is allowed for genesis)
-	if !rr.EndTime.IsZero() && rr.EndTime.Before(time.Now()) {
-		return fmt.Errorf("end time %s cannot be in the past", rr.EndTime.String())
+	// Validate StartTime (zero time is allowed for genesis)
+	if !rr.StartTime.IsZero() && !rr.EndTime.IsZero() && !rr.StartTime.Before(rr.EndTime) {
+		return fmt.Errorf("start time %s must be before end time %s", rr.StartTime, rr.EndTime)
+	}
+
+	// Validate LastReleaseTime, nothing is released before the start
+	if !rr.LastReleaseTime.IsZero() && rr.LastReleaseTime.Before(rr.StartTime) {
+		return fmt.Errorf("last release time %s cannot be before start time %s", rr.LastReleaseTime, rr.StartTime)
 	}
 
-	// Validate LastReleaseTime
-	if rr.LastReleaseTime.After(time.Now()) {
-		return fmt.Errorf("last release time %s cannot be in the future", rr.EndTime.String())
+	// Validate the release curve
+	if err := rr.ValidateCurve(); err != nil {
+		return err
+	}
+
+	// Validate Destination
+	if rr.Destination != "" {
+		if _, err := sdk.AccAddressFromBech32(rr.Destination); err != nil {
+			return fmt.Errorf("invalid destination address: %w", err)
+		}
+	}
+
+	// Validate the splits
+	if err := rr.ValidateSplits(); err != nil {
+		return err
 	}
 
 	// Some validations just make sense if active
 	if rr.Active {
+		if !rr.PausedTime.IsZero() {
+			return fmt.Errorf("active reward releaser cannot have a paused time")
+		}
 		// Validate TotalAmount
 		if err := rr.TotalAmount.Validate(); err != nil {
 			return fmt.Errorf("invalid total amount: %w", err)
@@ -62,3 +73,21 @@ func (rr ReleaseSchedule) ValidateGenesis() error {
 	}
 	return nil
 }
+
+// ShiftTimes moves the times of the schedule by the duration, keeping the shape of its curve
+// Unset times are kept as zero
+func (rr ReleaseSchedule) ShiftTimes(d time.Duration) ReleaseSchedule {
+	shift := func(t time.Time) time.Time {
+		if t.IsZero() {
+			return t
+		}
+		return t.Add(d)
+	}
+
+	rr.StartTime = shift(rr.StartTime)
+	rr.CliffTime = shift(rr.CliffTime)
+	rr.LastReleaseTime = shift(rr.LastReleaseTime)
+	rr.EndTime = shift(rr.EndTime)
+
+	return rr
+}
@@ -26,7 +26,7 @@ func TestRewardReleaserValidateGenesis(t *testing.T) {
 	}{
 		{
 			name:     "valid initial state",
-			schedule: types.InitialReleaseSchedule(),
+			schedule: types.ReleaseSchedule{},
 			wantErr:  false,
 		},
 		{
@@ -89,28 +89,28 @@ func TestRewardReleaserValidateGenesis(t *testing.T) {
 			errMsg:  "cannot be greater than total amount",
 		},
 		{
-			name: "end time in past",
+			name: "end time in past - exported finished schedule",
 			schedule: types.ReleaseSchedule{
 				TotalAmount:     validCoin,
-				ReleasedAmount:  sdk.Coin{},
+				ReleasedAmount:  validCoin,
 				EndTime:         now.Add(-time.Hour * 24),
-				LastReleaseTime: time.Time{},
+				LastReleaseTime: now.Add(-time.Hour * 23),
 				Active:          false,
 			},
-			wantErr: true,
-			errMsg:  "cannot be in the past",
+			wantErr: false,
 		},
 		{
-			name: "last release in future",
+			name: "last release before start time",
 			schedule: types.ReleaseSchedule{
 				TotalAmount:     validCoin,
 				ReleasedAmount:  sdk.Coin{},
-				EndTime:         time.Time{},
-				LastReleaseTime: now.Add(time.Hour * 24),
+				StartTime:       now.Add(time.Hour),
+				EndTime:         now.Add(time.Hour * 24),
+				LastReleaseTime: now,
 				Active:          false,
 			},
 			wantErr: true,
-			errMsg:  "cannot be in the future",
+			errMsg:  "cannot be before start time",
 		},
 		{
 			name: "active with zero total",
@@ -124,6 +124,82 @@ func TestRewardReleaserValidateGenesis(t *testing.T) {
 			wantErr: true,
 			errMsg:  "cannot have zero total amount",
 		},
+		{
+			name: "valid start time and destination",
+			schedule: types.ReleaseSchedule{
+				TotalAmount:    validCoin,
+				ReleasedAmount: sdk.NewCoin("akii", math.ZeroInt()),
+				StartTime:      now.Add(time.Hour),
+				EndTime:        now.Add(time.Hour * 24),
+				Destination:    sdk.AccAddress("destination").String(),
+				Active:         true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "start time after end time",
+			schedule: types.ReleaseSchedule{
+				TotalAmount:    validCoin,
+				ReleasedAmount: sdk.NewCoin("akii", math.ZeroInt()),
+				StartTime:      now.Add(time.Hour * 48),
+				EndTime:        now.Add(time.Hour * 24),
+				Active:         true,
+			},
+			wantErr: true,
+			errMsg:  "must be before end time",
+		},
+		{
+			name: "invalid destination",
+			schedule: types.ReleaseSchedule{
+				TotalAmount:    validCoin,
+				ReleasedAmount: sdk.NewCoin("akii", math.ZeroInt()),
+				EndTime:        now.Add(time.Hour * 24),
+				Destination:    "invalid",
+				Active:         true,
+			},
+			wantErr: true,
+			errMsg:  "invalid destination address",
+		},
+		{
+			name: "valid cliff curve",
+			schedule: types.ReleaseSchedule{
+				TotalAmount:    validCoin,
+				ReleasedAmount: sdk.NewCoin("akii", math.ZeroInt()),
+				StartTime:      now,
+				EndTime:        now.Add(time.Hour * 24),
+				Curve:          types.ReleaseCurveCliff,
+				CliffTime:      now.Add(time.Hour * 6),
+				Active:         true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "exponential curve without half life",
+			schedule: types.ReleaseSchedule{
+				TotalAmount:    validCoin,
+				ReleasedAmount: sdk.NewCoin("akii", math.ZeroInt()),
+				StartTime:      now,
+				EndTime:        now.Add(time.Hour * 24),
+				Curve:          types.ReleaseCurveExponential,
+				Active:         true,
+			},
+			wantErr: true,
+			errMsg:  "half life must be positive",
+		},
+		{
+			name: "splits not adding up to one",
+			schedule: types.ReleaseSchedule{
+				TotalAmount:    validCoin,
+				ReleasedAmount: sdk.NewCoin("akii", math.ZeroInt()),
+				EndTime:        now.Add(time.Hour * 24),
+				Splits: []types.ReleaseSplit{
+					{Type: types.DestinationCommunityPool, Weight: math.LegacyNewDecWithPrec(9, 1)},
+				},
+				Active: true,
+			},
+			wantErr: true,
+			errMsg:  "must add up to one",
+		},
 		{
 			name: "active with zero end time",
 			schedule: types.ReleaseSchedule{
@@ -136,6 +212,19 @@ func TestRewardReleaserValidateGenesis(t *testing.T) {
 			wantErr: true,
 			errMsg:  "must have an end time",
 		},
+		{
+			name: "active with a paused time",
+			schedule: types.ReleaseSchedule{
+				TotalAmount:     validCoin,
+				ReleasedAmount:  sdk.NewCoin("akii", math.NewInt(500)),
+				EndTime:         now.Add(time.Hour * 24),
+				LastReleaseTime: now,
+				PausedTime:      now,
+				Active:          true,
+			},
+			wantErr: true,
+			errMsg:  "cannot have a paused time",
+		},
 	}
 
 	for _, tt := range tests {
@@ -152,3 +241,27 @@ func TestRewardReleaserValidateGenesis(t *testing.T) {
 		})
 	}
 }
+
+func TestReleaseScheduleShiftTimes(t *testing.T) {
+	now := time.Now()
+	schedule := types.ReleaseSchedule{
+		StartTime:       now,
+		CliffTime:       now.Add(time.Hour),
+		LastReleaseTime: now.Add(time.Hour * 2),
+		EndTime:         now.Add(time.Hour * 4),
+	}
+
+	// Every time is shifted
+	shifted := schedule.ShiftTimes(time.Hour)
+	require.True(t, now.Add(time.Hour).Equal(shifted.StartTime))
+	require.True(t, now.Add(time.Hour*2).Equal(shifted.CliffTime))
+	require.True(t, now.Add(time.Hour*3).Equal(shifted.LastReleaseTime))
+	require.True(t, now.Add(time.Hour*5).Equal(shifted.EndTime))
+
+	// Unset times are kept as zero
+	schedule.CliffTime = time.Time{}
+	schedule.LastReleaseTime = time.Time{}
+	shifted = schedule.ShiftTimes(time.Hour)
+	require.True(t, shifted.CliffTime.IsZero())
+	require.True(t, shifted.LastReleaseTime.IsZero())
+}
@@ -18,6 +18,11 @@ func CalculateReward(blockTime time.Time, schedule ReleaseSchedule) (sdk.Coin, e
 		return remaining, nil
 	}
 
+	// Non-linear curves release what the curve owes at the block time
+	if schedule.Curve != ReleaseCurveLinear {
+		return calculateCurveReward(blockTime, schedule, remaining), nil
+	}
+
 	// If total duration would be 0, there would be a div by 0
 	if schedule.EndTime.Equal(schedule.LastReleaseTime) {
 		return sdk.Coin{}, fmt.Errorf("end time is equal to last release and would do a division by 0. EndTime: %s", schedule.EndTime)
@@ -49,3 +54,42 @@ func CalculateReward(blockTime time.Time, schedule ReleaseSchedule) (sdk.Coin, e
 
 	return sdk.NewCoin(schedule.TotalAmount.Denom, amountToRelease), nil
 }
+
+// calculateCurveReward returns the difference between the curve amount at the block time and the released amount
+// The result can be zero while the curve is flat, for example before a cliff or between steps
+func calculateCurveReward(blockTime time.Time, schedule ReleaseSchedule, remaining sdk.Coin) sdk.Coin {
+	due := CumulativeRelease(schedule, blockTime).Sub(schedule.ReleasedAmount.Amount)
+	due = math.MinInt(math.MaxInt(due, math.ZeroInt()), remaining.Amount)
+
+	return sdk.NewCoin(schedule.TotalAmount.Denom, due)
+}
+
+// ProjectRelease returns the cumulative released amount expected at a future time
+// The linear curve is projected from the last release, or from the later of the start and block time if none happened
+func ProjectRelease(schedule ReleaseSchedule, blockTime, t time.Time) math.Int {
+	released := schedule.ReleasedAmount.Amount
+	if released.IsNil() {
+		released = math.ZeroInt()
+	}
+	total := schedule.TotalAmount.Amount
+
+	// Inactive schedules and times before the start don't release anything else
+	if !schedule.Active || total.IsNil() || !t.After(schedule.StartTime) {
+		return released
+	}
+
+	if schedule.Curve != ReleaseCurveLinear {
+		return math.MaxInt(released, CumulativeRelease(schedule, t))
+	}
+
+	// The linear curve releases the remaining amount between the last release and the end time
+	from := schedule.LastReleaseTime
+	if from.IsZero() {
+		from = blockTime
+		if schedule.StartTime.After(from) {
+			from = schedule.StartTime
+		}
+	}
+
+	return released.Add(LinearRelease(total.Sub(released), from, schedule.EndTime, t))
+}
@@ -116,6 +116,86 @@ func TestCalculateReward(t *testing.T) {
 			expectedCoin:  sdk.Coin{},
 			expectedError: true,
 		},
+		{
+			name:      "cliff - nothing before the cliff",
+			blockTime: now.Add(20 * time.Minute),
+			schedule: types.ReleaseSchedule{
+				TotalAmount:     sdk.NewCoin(denom, math.NewInt(1000)),
+				ReleasedAmount:  sdk.NewCoin(denom, math.ZeroInt()),
+				StartTime:       now,
+				LastReleaseTime: now,
+				EndTime:         now.Add(time.Hour),
+				Curve:           types.ReleaseCurveCliff,
+				CliffTime:       now.Add(30 * time.Minute),
+				Active:          true,
+			},
+			expectedCoin:  sdk.NewCoin(denom, math.ZeroInt()),
+			expectedError: false,
+		},
+		{
+			name:      "cliff - accrued amount on the cliff",
+			blockTime: now.Add(30 * time.Minute),
+			schedule: types.ReleaseSchedule{
+				TotalAmount:     sdk.NewCoin(denom, math.NewInt(1000)),
+				ReleasedAmount:  sdk.NewCoin(denom, math.ZeroInt()),
+				StartTime:       now,
+				LastReleaseTime: now,
+				EndTime:         now.Add(time.Hour),
+				Curve:           types.ReleaseCurveCliff,
+				CliffTime:       now.Add(30 * time.Minute),
+				Active:          true,
+			},
+			expectedCoin:  sdk.NewCoin(denom, math.NewInt(500)),
+			expectedError: false,
+		},
+		{
+			name:      "step - difference to the last step",
+			blockTime: now.Add(35 * time.Minute),
+			schedule: types.ReleaseSchedule{
+				TotalAmount:     sdk.NewCoin(denom, math.NewInt(1000)),
+				ReleasedAmount:  sdk.NewCoin(denom, math.NewInt(250)),
+				StartTime:       now,
+				LastReleaseTime: now.Add(15 * time.Minute),
+				EndTime:         now.Add(time.Hour),
+				Curve:           types.ReleaseCurveStep,
+				StepDuration:    uint64((15 * time.Minute).Seconds()),
+				Active:          true,
+			},
+			expectedCoin:  sdk.NewCoin(denom, math.NewInt(250)),
+			expectedError: false,
+		},
+		{
+			name:      "exponential - one half life",
+			blockTime: now.Add(10 * time.Minute),
+			schedule: types.ReleaseSchedule{
+				TotalAmount:     sdk.NewCoin(denom, math.NewInt(1000)),
+				ReleasedAmount:  sdk.NewCoin(denom, math.NewInt(100)),
+				StartTime:       now,
+				LastReleaseTime: now.Add(time.Minute),
+				EndTime:         now.Add(time.Hour),
+				Curve:           types.ReleaseCurveExponential,
+				HalfLife:        uint64((10 * time.Minute).Seconds()),
+				Active:          true,
+			},
+			expectedCoin:  sdk.NewCoin(denom, math.NewInt(400)),
+			expectedError: false,
+		},
+		{
+			name:      "exponential - remaining amount past end time",
+			blockTime: now.Add(2 * time.Hour),
+			schedule: types.ReleaseSchedule{
+				TotalAmount:     sdk.NewCoin(denom, math.NewInt(1000)),
+				ReleasedAmount:  sdk.NewCoin(denom, math.NewInt(990)),
+				StartTime:       now,
+				LastReleaseTime: now.Add(50 * time.Minute),
+				EndTime:         now.Add(time.Hour),
+				Curve:           types.ReleaseCurveExponential,
+				HalfLife:        uint64((10 * time.Minute).Seconds()),
+				Active:          true,
+			},
+			expectedCoin:  sdk.NewCoin(denom, math.NewInt(10)),
+			expectedError: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"sort"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+const (
+	// EmissionRateWindow is the period used to average the emission rate of the schedules
+	EmissionRateWindow = 24 * time.Hour
+	// DefaultBlockTime is the block time used by the per block emission when none is given
+	DefaultBlockTime = 2 * time.Second
+	// SecondsPerYear is the number of seconds used to annualize the emission rate
+	SecondsPerYear = 365 * 24 * 60 * 60
+)
+
+// EmissionRate returns the amount a schedule is expected to release per second
+// The rate is averaged over the next EmissionRateWindow, or until the end time if it's sooner
+func EmissionRate(schedule ReleaseSchedule, blockTime time.Time) math.LegacyDec {
+	if !schedule.Active || !schedule.EndTime.After(blockTime) {
+		return math.LegacyZeroDec()
+	}
+
+	window := EmissionRateWindow
+	if remaining := schedule.EndTime.Sub(blockTime); remaining < window {
+		window = remaining
+	}
+	seconds := int64(window.Seconds())
+	if seconds == 0 {
+		return math.LegacyZeroDec()
+	}
+
+	released := ProjectRelease(schedule, blockTime, blockTime.Add(window)).Sub(ProjectRelease(schedule, blockTime, blockTime))
+	return math.LegacyNewDecFromInt(released).QuoInt64(seconds)
+}
+
+// ProjectedEndTime returns when the schedules are expected to stop releasing with the available funds
+// It's the last end time of the active schedules, or the time the releases use all the funds if it's sooner,
+// in which case the schedules are not fully funded. The time is zero if no schedule is active
+func ProjectedEndTime(schedules []ReleaseSchedule, available math.Int, blockTime time.Time) (time.Time, bool) {
+	var end time.Time
+	for _, schedule := range schedules {
+		if schedule.Active && schedule.EndTime.After(end) {
+			end = schedule.EndTime
+		}
+	}
+	if end.IsZero() {
+		return time.Time{}, true
+	}
+
+	// pending returns the amount the schedules release from now to a time
+	pending := func(t time.Time) math.Int {
+		total := math.ZeroInt()
+		for _, schedule := range schedules {
+			released := schedule.ReleasedAmount.Amount
+			if released.IsNil() {
+				released = math.ZeroInt()
+			}
+			total = total.Add(ProjectRelease(schedule, blockTime, t).Sub(released))
+		}
+		return total
+	}
+
+	if pending(end).LTE(available) {
+		return end, true
+	}
+
+	// The releases only grow over time, so search the first second they go above the funds
+	seconds := int(end.Sub(blockTime).Seconds())
+	if seconds < 0 {
+		seconds = 0
+	}
+	i := sort.Search(seconds, func(i int) bool {
+		return pending(blockTime.Add(time.Duration(i) * time.Second)).GT(available)
+	})
+	return blockTime.Add(time.Duration(i) * time.Second), false
+}
@@ -0,0 +1,138 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v4/x/rewards/types"
+)
+
+// TestEmissionRate tests the amount released per second by a schedule
+func TestEmissionRate(t *testing.T) {
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	zero := sdk.NewCoin("akii", math.ZeroInt())
+
+	tests := []struct {
+		name     string
+		schedule types.ReleaseSchedule
+		expected math.LegacyDec
+	}{
+		{
+			name: "linear - ends inside the window",
+			schedule: types.ReleaseSchedule{
+				TotalAmount: sdk.NewCoin("akii", math.NewInt(1000)), ReleasedAmount: zero,
+				EndTime: blockTime.Add(100 * time.Second), Active: true,
+			},
+			expected: math.LegacyNewDec(10),
+		},
+		{
+			name: "linear - longer than the window",
+			schedule: types.ReleaseSchedule{
+				TotalAmount: sdk.NewCoin("akii", math.NewInt(2*86400*10)), ReleasedAmount: zero,
+				EndTime: blockTime.Add(2 * types.EmissionRateWindow), Active: true,
+			},
+			expected: math.LegacyNewDec(10),
+		},
+		{
+			name: "cliff - averaged over the window",
+			schedule: types.ReleaseSchedule{
+				TotalAmount: sdk.NewCoin("akii", math.NewInt(1000)), ReleasedAmount: zero,
+				StartTime: blockTime, EndTime: blockTime.Add(100 * time.Second), Active: true,
+				Curve: types.ReleaseCurveCliff, CliffTime: blockTime.Add(50 * time.Second),
+			},
+			expected: math.LegacyNewDec(10),
+		},
+		{
+			name: "inactive",
+			schedule: types.ReleaseSchedule{
+				TotalAmount: sdk.NewCoin("akii", math.NewInt(1000)), ReleasedAmount: zero,
+				EndTime: blockTime.Add(100 * time.Second), Active: false,
+			},
+			expected: math.LegacyZeroDec(),
+		},
+		{
+			name: "ended",
+			schedule: types.ReleaseSchedule{
+				TotalAmount: sdk.NewCoin("akii", math.NewInt(1000)), ReleasedAmount: zero,
+				EndTime: blockTime, Active: true,
+			},
+			expected: math.LegacyZeroDec(),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected.String(), types.EmissionRate(tc.schedule, blockTime).String())
+		})
+	}
+}
+
+// TestProjectedEndTime tests when the schedules stop releasing with the available funds
+func TestProjectedEndTime(t *testing.T) {
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	zero := sdk.NewCoin("akii", math.ZeroInt())
+	first := types.ReleaseSchedule{
+		TotalAmount: sdk.NewCoin("akii", math.NewInt(1000)), ReleasedAmount: zero,
+		EndTime: blockTime.Add(100 * time.Second), Active: true,
+	}
+	second := types.ReleaseSchedule{
+		TotalAmount: sdk.NewCoin("akii", math.NewInt(1000)), ReleasedAmount: zero,
+		EndTime: blockTime.Add(200 * time.Second), Active: true,
+	}
+	paused := second
+	paused.Active = false
+
+	tests := []struct {
+		name           string
+		schedules      []types.ReleaseSchedule
+		available      int64
+		expectedEnd    time.Time
+		expectedFunded bool
+	}{
+		{
+			name:           "no schedules",
+			available:      1000,
+			expectedFunded: true,
+		},
+		{
+			name:           "fully funded",
+			schedules:      []types.ReleaseSchedule{first, second},
+			available:      2000,
+			expectedEnd:    blockTime.Add(200 * time.Second),
+			expectedFunded: true,
+		},
+		{
+			name:           "paused schedules don't count",
+			schedules:      []types.ReleaseSchedule{first, paused},
+			available:      1000,
+			expectedEnd:    blockTime.Add(100 * time.Second),
+			expectedFunded: true,
+		},
+		{
+			name:        "funds run out",
+			schedules:   []types.ReleaseSchedule{first},
+			available:   500,
+			expectedEnd: blockTime.Add(51 * time.Second),
+		},
+		{
+			name:        "funds run out with overlapping schedules",
+			schedules:   []types.ReleaseSchedule{first, second},
+			available:   1500,
+			expectedEnd: blockTime.Add(101 * time.Second),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			end, funded := types.ProjectedEndTime(tc.schedules, math.NewInt(tc.available), blockTime)
+			require.Equal(t, tc.expectedEnd, end)
+			require.Equal(t, tc.expectedFunded, funded)
+		})
+	}
+}
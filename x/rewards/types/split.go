@@ -0,0 +1,123 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxReleaseSplits is the maximum number of destinations of a schedule
+const MaxReleaseSplits = 10
+
+// ValidateSplits checks if the splits of the schedule are well formed
+// The checks that need the chain state, like the module accounts and contracts existing, are done by the keeper
+func (rr ReleaseSchedule) ValidateSplits() error {
+	if len(rr.Splits) == 0 {
+		return nil
+	}
+
+	// The splits replace the single destination
+	if rr.Destination != "" {
+		return fmt.Errorf("destination and splits cannot be set at the same time")
+	}
+	if len(rr.Splits) > MaxReleaseSplits {
+		return fmt.Errorf("schedule has %d splits, the maximum is %d", len(rr.Splits), MaxReleaseSplits)
+	}
+
+	totalWeight := math.LegacyZeroDec()
+	seen := make(map[string]bool, len(rr.Splits))
+	for _, split := range rr.Splits {
+		if err := split.Validate(); err != nil {
+			return err
+		}
+
+		// The same destination can't be repeated
+		key := fmt.Sprintf("%s/%s", split.Type, split.Target)
+		if seen[key] {
+			return fmt.Errorf("duplicated split destination %s", key)
+		}
+		seen[key] = true
+
+		totalWeight = totalWeight.Add(split.Weight)
+	}
+
+	if !totalWeight.Equal(math.LegacyOneDec()) {
+		return fmt.Errorf("split weights must add up to one, got %s", totalWeight)
+	}
+
+	return nil
+}
+
+// Validate checks if a single split is well formed
+func (s ReleaseSplit) Validate() error {
+	if s.Weight.IsNil() || !s.Weight.IsPositive() || s.Weight.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("split weight must be between zero and one, got %s", s.Weight)
+	}
+
+	switch s.Type {
+	case DestinationFeeCollector, DestinationCommunityPool:
+		if s.Target != "" {
+			return fmt.Errorf("%s split cannot have a target", s.Type)
+		}
+	case DestinationModuleAccount:
+		if s.Target == "" {
+			return fmt.Errorf("module account split must have a module name")
+		}
+		if s.Target == ModuleName {
+			return fmt.Errorf("split cannot send to the %s module itself", ModuleName)
+		}
+	case DestinationAddress, DestinationContract:
+		if _, err := sdk.AccAddressFromBech32(s.Target); err != nil {
+			return fmt.Errorf("invalid %s split target: %w", s.Type, err)
+		}
+	default:
+		return fmt.Errorf("unknown destination type %d", s.Type)
+	}
+
+	return nil
+}
+
+// EffectiveSplits returns the splits used on the releases of the schedule
+// Schedules without splits send everything to the destination, or to the fee collector if there is no destination
+func (rr ReleaseSchedule) EffectiveSplits() []ReleaseSplit {
+	if len(rr.Splits) > 0 {
+		return rr.Splits
+	}
+
+	if rr.Destination != "" {
+		return []ReleaseSplit{{Type: DestinationAddress, Target: rr.Destination, Weight: math.LegacyOneDec()}}
+	}
+	return []ReleaseSplit{{Type: DestinationFeeCollector, Weight: math.LegacyOneDec()}}
+}
+
+// FeeCollectorWeight returns the share of the releases of the schedule sent to the fee collector
+func (rr ReleaseSchedule) FeeCollectorWeight() math.LegacyDec {
+	weight := math.LegacyZeroDec()
+	for _, split := range rr.EffectiveSplits() {
+		if split.Type == DestinationFeeCollector {
+			weight = weight.Add(split.Weight)
+		}
+	}
+	return weight
+}
+
+// SplitAmounts divides an amount by the weights of the splits
+// Each share is truncated and the rounding left is added to the first split, so the shares always add up to the amount
+func SplitAmounts(amount sdk.Coin, splits []ReleaseSplit) []sdk.Coin {
+	shares := make([]sdk.Coin, len(splits))
+	if len(splits) == 0 {
+		return shares
+	}
+
+	distributed := math.ZeroInt()
+	for i, split := range splits {
+		share := math.LegacyNewDecFromInt(amount.Amount).Mul(split.Weight).TruncateInt()
+		shares[i] = sdk.NewCoin(amount.Denom, share)
+		distributed = distributed.Add(share)
+	}
+	shares[0].Amount = shares[0].Amount.Add(amount.Amount.Sub(distributed))
+
+	return shares
+}
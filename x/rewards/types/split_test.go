@@ -0,0 +1,224 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kiichain/kiichain/v4/x/rewards/types"
+)
+
+// TestValidateSplits tests the validation of the schedule splits
+func TestValidateSplits(t *testing.T) {
+	address := sdk.AccAddress("destination").String()
+	half := math.LegacyNewDecWithPrec(5, 1)
+
+	tests := []struct {
+		name        string
+		schedule    types.ReleaseSchedule
+		errContains string
+	}{
+		{
+			name:     "valid - no splits",
+			schedule: types.ReleaseSchedule{Destination: address},
+		},
+		{
+			name: "valid - all destination types",
+			schedule: types.ReleaseSchedule{Splits: []types.ReleaseSplit{
+				{Type: types.DestinationFeeCollector, Weight: math.LegacyNewDecWithPrec(4, 1)},
+				{Type: types.DestinationCommunityPool, Weight: math.LegacyNewDecWithPrec(3, 1)},
+				{Type: types.DestinationModuleAccount, Target: "oracle", Weight: math.LegacyNewDecWithPrec(1, 1)},
+				{Type: types.DestinationAddress, Target: address, Weight: math.LegacyNewDecWithPrec(1, 1)},
+				{Type: types.DestinationContract, Target: sdk.AccAddress("contract").String(), Weight: math.LegacyNewDecWithPrec(1, 1)},
+			}},
+		},
+		{
+			name: "invalid - destination and splits",
+			schedule: types.ReleaseSchedule{Destination: address, Splits: []types.ReleaseSplit{
+				{Type: types.DestinationFeeCollector, Weight: math.LegacyOneDec()},
+			}},
+			errContains: "cannot be set at the same time",
+		},
+		{
+			name: "invalid - weights below one",
+			schedule: types.ReleaseSchedule{Splits: []types.ReleaseSplit{
+				{Type: types.DestinationFeeCollector, Weight: half},
+				{Type: types.DestinationCommunityPool, Weight: math.LegacyNewDecWithPrec(4, 1)},
+			}},
+			errContains: "must add up to one",
+		},
+		{
+			name: "invalid - weights above one",
+			schedule: types.ReleaseSchedule{Splits: []types.ReleaseSplit{
+				{Type: types.DestinationFeeCollector, Weight: half},
+				{Type: types.DestinationCommunityPool, Weight: math.LegacyNewDecWithPrec(6, 1)},
+			}},
+			errContains: "must add up to one",
+		},
+		{
+			name: "invalid - zero weight",
+			schedule: types.ReleaseSchedule{Splits: []types.ReleaseSplit{
+				{Type: types.DestinationFeeCollector, Weight: math.LegacyOneDec()},
+				{Type: types.DestinationCommunityPool, Weight: math.LegacyZeroDec()},
+			}},
+			errContains: "weight must be between zero and one",
+		},
+		{
+			name: "invalid - nil weight",
+			schedule: types.ReleaseSchedule{Splits: []types.ReleaseSplit{
+				{Type: types.DestinationFeeCollector},
+			}},
+			errContains: "weight must be between zero and one",
+		},
+		{
+			name: "invalid - duplicated destination",
+			schedule: types.ReleaseSchedule{Splits: []types.ReleaseSplit{
+				{Type: types.DestinationAddress, Target: address, Weight: half},
+				{Type: types.DestinationAddress, Target: address, Weight: half},
+			}},
+			errContains: "duplicated split destination",
+		},
+		{
+			name: "invalid - fee collector with a target",
+			schedule: types.ReleaseSchedule{Splits: []types.ReleaseSplit{
+				{Type: types.DestinationFeeCollector, Target: address, Weight: math.LegacyOneDec()},
+			}},
+			errContains: "cannot have a target",
+		},
+		{
+			name: "invalid - module account without a name",
+			schedule: types.ReleaseSchedule{Splits: []types.ReleaseSplit{
+				{Type: types.DestinationModuleAccount, Weight: math.LegacyOneDec()},
+			}},
+			errContains: "must have a module name",
+		},
+		{
+			name: "invalid - rewards module account",
+			schedule: types.ReleaseSchedule{Splits: []types.ReleaseSplit{
+				{Type: types.DestinationModuleAccount, Target: types.ModuleName, Weight: math.LegacyOneDec()},
+			}},
+			errContains: "cannot send to the rewards module itself",
+		},
+		{
+			name: "invalid - contract address",
+			schedule: types.ReleaseSchedule{Splits: []types.ReleaseSplit{
+				{Type: types.DestinationContract, Target: "invalid", Weight: math.LegacyOneDec()},
+			}},
+			errContains: "invalid DESTINATION_TYPE_CONTRACT split target",
+		},
+		{
+			name: "invalid - unknown destination type",
+			schedule: types.ReleaseSchedule{Splits: []types.ReleaseSplit{
+				{Type: types.DestinationType(99), Weight: math.LegacyOneDec()},
+			}},
+			errContains: "unknown destination type",
+		},
+		{
+			name: "invalid - too many splits",
+			schedule: func() types.ReleaseSchedule {
+				splits := make([]types.ReleaseSplit, types.MaxReleaseSplits+1)
+				for i := range splits {
+					splits[i] = types.ReleaseSplit{
+						Type:   types.DestinationAddress,
+						Target: sdk.AccAddress([]byte{byte(i)}).String(),
+						Weight: math.LegacyOneDec().QuoInt64(int64(len(splits))),
+					}
+				}
+				return types.ReleaseSchedule{Splits: splits}
+			}(),
+			errContains: "the maximum is",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schedule.ValidateSplits()
+			if tc.errContains == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.errContains)
+			}
+		})
+	}
+}
+
+// TestSplitAmounts tests that the split shares always add up to the released amount
+func TestSplitAmounts(t *testing.T) {
+	thirds := []types.ReleaseSplit{
+		{Type: types.DestinationFeeCollector, Weight: math.LegacyMustNewDecFromStr("0.333333333333333334")},
+		{Type: types.DestinationCommunityPool, Weight: math.LegacyMustNewDecFromStr("0.333333333333333333")},
+		{Type: types.DestinationModuleAccount, Target: "oracle", Weight: math.LegacyMustNewDecFromStr("0.333333333333333333")},
+	}
+
+	tests := []struct {
+		name     string
+		amount   sdk.Coin
+		splits   []types.ReleaseSplit
+		expected []int64
+	}{
+		{
+			name:     "single split",
+			amount:   sdk.NewCoin("akii", math.NewInt(1000)),
+			splits:   types.ReleaseSchedule{}.EffectiveSplits(),
+			expected: []int64{1000},
+		},
+		{
+			name:     "rounding goes to the first split",
+			amount:   sdk.NewCoin("akii", math.NewInt(1000)),
+			splits:   thirds,
+			expected: []int64{334, 333, 333},
+		},
+		{
+			name:     "small amount",
+			amount:   sdk.NewCoin("akii", math.NewInt(2)),
+			splits:   thirds,
+			expected: []int64{2, 0, 0},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			shares := types.SplitAmounts(tc.amount, tc.splits)
+			require.Len(t, shares, len(tc.expected))
+
+			total := math.ZeroInt()
+			for i, expected := range tc.expected {
+				require.Equal(t, tc.amount.Denom, shares[i].Denom)
+				require.Equal(t, expected, shares[i].Amount.Int64())
+				total = total.Add(shares[i].Amount)
+			}
+			require.Equal(t, tc.amount.Amount, total)
+		})
+	}
+}
+
+// TestEffectiveSplits tests the splits used by schedules without splits
+func TestEffectiveSplits(t *testing.T) {
+	address := sdk.AccAddress("destination").String()
+
+	// No destination sends everything to the fee collector
+	splits := types.ReleaseSchedule{}.EffectiveSplits()
+	require.Equal(t, []types.ReleaseSplit{{Type: types.DestinationFeeCollector, Weight: math.LegacyOneDec()}}, splits)
+
+	// A destination sends everything to the address
+	splits = types.ReleaseSchedule{Destination: address}.EffectiveSplits()
+	require.Equal(t, []types.ReleaseSplit{{Type: types.DestinationAddress, Target: address, Weight: math.LegacyOneDec()}}, splits)
+}
+
+// TestFeeCollectorWeight tests the share of the releases sent to the fee collector
+func TestFeeCollectorWeight(t *testing.T) {
+	address := sdk.AccAddress("destination").String()
+
+	require.Equal(t, math.LegacyOneDec(), types.ReleaseSchedule{}.FeeCollectorWeight())
+	require.Equal(t, math.LegacyZeroDec(), types.ReleaseSchedule{Destination: address}.FeeCollectorWeight())
+
+	schedule := types.ReleaseSchedule{Splits: []types.ReleaseSplit{
+		{Type: types.DestinationFeeCollector, Weight: math.LegacyNewDecWithPrec(7, 1)},
+		{Type: types.DestinationAddress, Target: address, Weight: math.LegacyNewDecWithPrec(3, 1)},
+	}}
+	require.Equal(t, math.LegacyNewDecWithPrec(7, 1), schedule.FeeCollectorWeight())
+}
@@ -222,26 +222,27 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-// MsgChangeSchedule is the Msg/ChangeSchedule request type.
-type MsgChangeSchedule struct {
+// MsgCreateSchedule is the Msg/CreateSchedule request type.
+type MsgCreateSchedule struct {
 	// authority is the address of the governance account.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// New information for the schedule
+	// Information for the new schedule, the id is set by the module and the
+	// schedule must not have releases
 	Schedule ReleaseSchedule `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule"`
 }
 
-func (m *MsgChangeSchedule) Reset()         { *m = MsgChangeSchedule{} }
-func (m *MsgChangeSchedule) String() string { return proto.CompactTextString(m) }
-func (*MsgChangeSchedule) ProtoMessage()    {}
-func (*MsgChangeSchedule) Descriptor() ([]byte, []int) {
+func (m *MsgCreateSchedule) Reset()         { *m = MsgCreateSchedule{} }
+func (m *MsgCreateSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSchedule) ProtoMessage()    {}
+func (*MsgCreateSchedule) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8e1e54764dba96cb, []int{4}
 }
-func (m *MsgChangeSchedule) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgChangeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgChangeSchedule.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateSchedule.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -251,49 +252,51 @@ func (m *MsgChangeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgChangeSchedule) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgChangeSchedule.Merge(m, src)
+func (m *MsgCreateSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSchedule.Merge(m, src)
 }
-func (m *MsgChangeSchedule) XXX_Size() int {
+func (m *MsgCreateSchedule) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgChangeSchedule) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgChangeSchedule.DiscardUnknown(m)
+func (m *MsgCreateSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSchedule.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgChangeSchedule proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateSchedule proto.InternalMessageInfo
 
-func (m *MsgChangeSchedule) GetAuthority() string {
+func (m *MsgCreateSchedule) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgChangeSchedule) GetSchedule() ReleaseSchedule {
+func (m *MsgCreateSchedule) GetSchedule() ReleaseSchedule {
 	if m != nil {
 		return m.Schedule
 	}
 	return ReleaseSchedule{}
 }
 
-// MsgChangeScheduleResponse defines the response structure for executing a
-// MsgChangeSchedule message.
-type MsgChangeScheduleResponse struct {
+// MsgCreateScheduleResponse defines the response structure for executing a
+// MsgCreateSchedule message.
+type MsgCreateScheduleResponse struct {
+	// id is the identifier given to the new schedule
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgChangeScheduleResponse) Reset()         { *m = MsgChangeScheduleResponse{} }
-func (m *MsgChangeScheduleResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgChangeScheduleResponse) ProtoMessage()    {}
-func (*MsgChangeScheduleResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCreateScheduleResponse) Reset()         { *m = MsgCreateScheduleResponse{} }
+func (m *MsgCreateScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateScheduleResponse) ProtoMessage()    {}
+func (*MsgCreateScheduleResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_8e1e54764dba96cb, []int{5}
 }
-func (m *MsgChangeScheduleResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateScheduleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgChangeScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgChangeScheduleResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateScheduleResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -303,517 +306,2346 @@ func (m *MsgChangeScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgChangeScheduleResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgChangeScheduleResponse.Merge(m, src)
+func (m *MsgCreateScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateScheduleResponse.Merge(m, src)
 }
-func (m *MsgChangeScheduleResponse) XXX_Size() int {
+func (m *MsgCreateScheduleResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgChangeScheduleResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgChangeScheduleResponse.DiscardUnknown(m)
+func (m *MsgCreateScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateScheduleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgChangeScheduleResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateScheduleResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgFundPool)(nil), "kiichain.rewards.v1beta1.MsgFundPool")
-	proto.RegisterType((*MsgFundPoolResponse)(nil), "kiichain.rewards.v1beta1.MsgFundPoolResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "kiichain.rewards.v1beta1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "kiichain.rewards.v1beta1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgChangeSchedule)(nil), "kiichain.rewards.v1beta1.MsgChangeSchedule")
-	proto.RegisterType((*MsgChangeScheduleResponse)(nil), "kiichain.rewards.v1beta1.MsgChangeScheduleResponse")
+func (m *MsgCreateScheduleResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("kiichain/rewards/v1beta1/tx.proto", fileDescriptor_8e1e54764dba96cb) }
-
-var fileDescriptor_8e1e54764dba96cb = []byte{
-	// 610 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0xe3, 0x02, 0x51, 0x7b, 0xe1, 0x8f, 0x62, 0x5a, 0x25, 0x31, 0x92, 0x93, 0x5a, 0x44,
-	0x4a, 0x02, 0xb1, 0x95, 0x56, 0x62, 0xc8, 0x80, 0x44, 0x2a, 0x75, 0x41, 0x91, 0x2a, 0x57, 0x48,
-	0x88, 0xa5, 0x5c, 0xec, 0xeb, 0xc5, 0xc2, 0xbe, 0xb3, 0x7c, 0xe7, 0xd2, 0x6c, 0x88, 0x91, 0x89,
-	0x99, 0x4f, 0x11, 0x09, 0xf8, 0x06, 0x0c, 0x1d, 0x23, 0x26, 0xa6, 0x0a, 0x25, 0x43, 0x76, 0x3e,
-	0x01, 0xb2, 0x7d, 0x76, 0xd2, 0x56, 0xa9, 0x0a, 0x4b, 0x62, 0xfb, 0x7e, 0xf7, 0x3e, 0xcf, 0xf3,
-	0xde, 0xe9, 0x05, 0xdb, 0xef, 0x1c, 0xc7, 0x1a, 0x42, 0x87, 0x18, 0x01, 0x7a, 0x0f, 0x03, 0x9b,
-	0x19, 0x27, 0x9d, 0x01, 0xe2, 0xb0, 0x63, 0xf0, 0x53, 0xdd, 0x0f, 0x28, 0xa7, 0x72, 0x39, 0x45,
-	0x74, 0x81, 0xe8, 0x02, 0x51, 0x36, 0x31, 0xc5, 0x34, 0x86, 0x8c, 0xe8, 0x29, 0xe1, 0x15, 0xd5,
-	0xa2, 0xcc, 0xa3, 0xcc, 0x18, 0x40, 0x86, 0xb2, 0x6a, 0x16, 0x75, 0x88, 0x58, 0xaf, 0xaf, 0x94,
-	0xf4, 0x61, 0x00, 0x3d, 0x26, 0xb0, 0xc7, 0xab, 0x9d, 0x8d, 0x7c, 0x94, 0x52, 0x55, 0x4c, 0x29,
-	0x76, 0x91, 0x11, 0xbf, 0x0d, 0xc2, 0x63, 0x83, 0x3b, 0x1e, 0x62, 0x1c, 0x7a, 0xbe, 0x00, 0x4a,
-	0xc2, 0x8d, 0xc7, 0xb0, 0x71, 0xd2, 0x89, 0xfe, 0xc4, 0x42, 0x25, 0x59, 0x38, 0x4a, 0xfc, 0x27,
-	0x2f, 0x62, 0xa9, 0x08, 0x3d, 0x87, 0x50, 0x23, 0xfe, 0x4d, 0x3e, 0x69, 0xdf, 0x25, 0x50, 0xe8,
-	0x33, 0xbc, 0x1f, 0x12, 0xfb, 0x80, 0x52, 0x57, 0x6e, 0x82, 0x3c, 0x43, 0xc4, 0x46, 0x41, 0x59,
-	0xaa, 0x49, 0x8d, 0x8d, 0x5e, 0xf1, 0xcf, 0x79, 0xf5, 0xde, 0x08, 0x7a, 0x6e, 0x57, 0x4b, 0xbe,
-	0x6b, 0xa6, 0x00, 0xe4, 0xd7, 0x20, 0x0f, 0x3d, 0x1a, 0x12, 0x5e, 0x5e, 0xab, 0x49, 0x8d, 0xc2,
-	0x4e, 0x45, 0x17, 0x62, 0x51, 0x83, 0xd2, 0x5e, 0xea, 0x7b, 0xd4, 0x21, 0xbd, 0xfa, 0xd9, 0x79,
-	0x35, 0xb7, 0xa8, 0x94, 0x6c, 0xd3, 0xbe, 0xcc, 0xc7, 0xad, 0x82, 0x8b, 0x30, 0xb4, 0x46, 0x47,
-	0x51, 0x1f, 0x4d, 0x51, 0xaf, 0xbb, 0xfd, 0x71, 0x3e, 0x6e, 0x09, 0x99, 0x4f, 0xf3, 0x71, 0xab,
-	0x98, 0x76, 0xea, 0x38, 0x24, 0x76, 0xdb, 0xa7, 0xd4, 0xd5, 0xb6, 0xc0, 0xc3, 0x25, 0xdb, 0x26,
-	0x62, 0x3e, 0x25, 0x0c, 0x69, 0x5f, 0x25, 0xf0, 0xa0, 0xcf, 0xf0, 0x2b, 0xdf, 0x86, 0x1c, 0x1d,
-	0xc4, 0x6d, 0x97, 0x9f, 0x81, 0x0d, 0x18, 0xf2, 0x21, 0x0d, 0x1c, 0x3e, 0x12, 0xa9, 0xca, 0x3f,
-	0xbf, 0xb5, 0x37, 0x85, 0xdb, 0x17, 0xb6, 0x1d, 0x20, 0xc6, 0x0e, 0x79, 0xe0, 0x10, 0x6c, 0x2e,
-	0x50, 0xf9, 0x39, 0xc8, 0x27, 0x07, 0x27, 0xf2, 0xd5, 0xf4, 0x55, 0x17, 0x46, 0x4f, 0x94, 0x7a,
-	0xb7, 0xa3, 0x98, 0xa6, 0xd8, 0xd5, 0x6d, 0x44, 0x29, 0x16, 0xf5, 0xa2, 0x20, 0x5b, 0x69, 0x90,
-	0x30, 0x36, 0xd8, 0x4e, 0x48, 0xad, 0x02, 0x4a, 0x97, 0x4c, 0x67, 0x81, 0x7e, 0x48, 0xa0, 0xd8,
-	0x67, 0x78, 0x6f, 0x08, 0x09, 0x46, 0x87, 0xd6, 0x10, 0xd9, 0xa1, 0x8b, 0xfe, 0x3b, 0xd2, 0x4b,
-	0xb0, 0xce, 0x44, 0x0d, 0x11, 0xaa, 0xb9, 0x3a, 0x94, 0x89, 0x5c, 0x04, 0x59, 0x26, 0x2a, 0xd2,
-	0x65, 0x05, 0xba, 0xad, 0xab, 0xf9, 0x4a, 0x69, 0x3e, 0x2b, 0xf6, 0xdb, 0x4e, 0x59, 0xed, 0x11,
-	0xa8, 0x5c, 0x49, 0x91, 0x66, 0xdc, 0x99, 0xac, 0x81, 0x5b, 0x7d, 0x86, 0xe5, 0xb7, 0x60, 0x3d,
-	0xbb, 0x87, 0xf5, 0xd5, 0xbe, 0x96, 0xce, 0x5d, 0x69, 0xdf, 0x08, 0x4b, 0x95, 0x64, 0x17, 0xdc,
-	0xbd, 0x70, 0x35, 0x9a, 0xd7, 0x6e, 0x5f, 0x46, 0x95, 0xce, 0x8d, 0xd1, 0x4c, 0x2d, 0x00, 0xf7,
-	0x2f, 0x9d, 0xdb, 0x93, 0x6b, 0x8b, 0x5c, 0x84, 0x95, 0xdd, 0x7f, 0x80, 0x53, 0x4d, 0xe5, 0xce,
-	0x87, 0xf9, 0xb8, 0x25, 0xf5, 0xf6, 0xcf, 0xa6, 0xaa, 0x34, 0x99, 0xaa, 0xd2, 0xef, 0xa9, 0x2a,
-	0x7d, 0x9e, 0xa9, 0xb9, 0xc9, 0x4c, 0xcd, 0xfd, 0x9a, 0xa9, 0xb9, 0x37, 0x4f, 0xb1, 0xc3, 0x87,
-	0xe1, 0x40, 0xb7, 0xa8, 0x67, 0x64, 0x93, 0x28, 0x7b, 0x38, 0xcd, 0x86, 0x52, 0x3c, 0x8c, 0x06,
-	0xf9, 0x78, 0x4a, 0xec, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x64, 0xcc, 0x15, 0x84, 0x4f, 0x05,
-	0x00, 0x00,
+// MsgAmendSchedule is the Msg/AmendSchedule request type.
+type MsgAmendSchedule struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// New information for the schedule with the same id, the released values
+	// are kept
+	Schedule ReleaseSchedule `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgAmendSchedule) Reset()         { *m = MsgAmendSchedule{} }
+func (m *MsgAmendSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendSchedule) ProtoMessage()    {}
+func (*MsgAmendSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e1e54764dba96cb, []int{6}
+}
+func (m *MsgAmendSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAmendSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendSchedule.Merge(m, src)
+}
+func (m *MsgAmendSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendSchedule.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgAmendSchedule proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// FundPool adds funds to the community pool that can be used on a extension
-	FundPool(ctx context.Context, in *MsgFundPool, opts ...grpc.CallOption) (*MsgFundPoolResponse, error)
-	// UpdateParams defines a governance operation for updating the x/mint module
-	// parameters. The authority is hard-coded to the x/gov module account.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// ChangeSchedule defines a governance operation for changing the reward and
-	// its schedule
-	ChangeSchedule(ctx context.Context, in *MsgChangeSchedule, opts ...grpc.CallOption) (*MsgChangeScheduleResponse, error)
+func (m *MsgAmendSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgAmendSchedule) GetSchedule() ReleaseSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return ReleaseSchedule{}
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgAmendScheduleResponse defines the response structure for executing a
+// MsgAmendSchedule message.
+type MsgAmendScheduleResponse struct {
 }
 
-func (c *msgClient) FundPool(ctx context.Context, in *MsgFundPool, opts ...grpc.CallOption) (*MsgFundPoolResponse, error) {
-	out := new(MsgFundPoolResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Msg/FundPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgAmendScheduleResponse) Reset()         { *m = MsgAmendScheduleResponse{} }
+func (m *MsgAmendScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendScheduleResponse) ProtoMessage()    {}
+func (*MsgAmendScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e1e54764dba96cb, []int{7}
+}
+func (m *MsgAmendScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgAmendScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendScheduleResponse.Merge(m, src)
+}
+func (m *MsgAmendScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendScheduleResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAmendScheduleResponse proto.InternalMessageInfo
+
+// MsgCancelSchedule is the Msg/CancelSchedule request type.
+type MsgCancelSchedule struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// id is the identifier of the schedule to be removed
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelSchedule) Reset()         { *m = MsgCancelSchedule{} }
+func (m *MsgCancelSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelSchedule) ProtoMessage()    {}
+func (*MsgCancelSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e1e54764dba96cb, []int{8}
+}
+func (m *MsgCancelSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCancelSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelSchedule.Merge(m, src)
+}
+func (m *MsgCancelSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelSchedule.DiscardUnknown(m)
 }
 
-func (c *msgClient) ChangeSchedule(ctx context.Context, in *MsgChangeSchedule, opts ...grpc.CallOption) (*MsgChangeScheduleResponse, error) {
-	out := new(MsgChangeScheduleResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Msg/ChangeSchedule", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelSchedule proto.InternalMessageInfo
+
+func (m *MsgCancelSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// FundPool adds funds to the community pool that can be used on a extension
-	FundPool(context.Context, *MsgFundPool) (*MsgFundPoolResponse, error)
-	// UpdateParams defines a governance operation for updating the x/mint module
-	// parameters. The authority is hard-coded to the x/gov module account.
-	//
-	// Since: cosmos-sdk 0.47
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// ChangeSchedule defines a governance operation for changing the reward and
-	// its schedule
-	ChangeSchedule(context.Context, *MsgChangeSchedule) (*MsgChangeScheduleResponse, error)
+func (m *MsgCancelSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgCancelScheduleResponse defines the response structure for executing a
+// MsgCancelSchedule message.
+type MsgCancelScheduleResponse struct {
 }
 
-func (*UnimplementedMsgServer) FundPool(ctx context.Context, req *MsgFundPool) (*MsgFundPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FundPool not implemented")
+func (m *MsgCancelScheduleResponse) Reset()         { *m = MsgCancelScheduleResponse{} }
+func (m *MsgCancelScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelScheduleResponse) ProtoMessage()    {}
+func (*MsgCancelScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e1e54764dba96cb, []int{9}
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgCancelScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelScheduleResponse.Merge(m, src)
+}
+func (m *MsgCancelScheduleResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) ChangeSchedule(ctx context.Context, req *MsgChangeSchedule) (*MsgChangeScheduleResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeSchedule not implemented")
+func (m *MsgCancelScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelScheduleResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgCancelScheduleResponse proto.InternalMessageInfo
+
+// MsgPauseSchedule is the Msg/PauseSchedule request type.
+type MsgPauseSchedule struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// id is the identifier of the schedule to be paused
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Msg_FundPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFundPool)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FundPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.rewards.v1beta1.Msg/FundPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FundPool(ctx, req.(*MsgFundPool))
+func (m *MsgPauseSchedule) Reset()         { *m = MsgPauseSchedule{} }
+func (m *MsgPauseSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseSchedule) ProtoMessage()    {}
+func (*MsgPauseSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e1e54764dba96cb, []int{10}
+}
+func (m *MsgPauseSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgPauseSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseSchedule.Merge(m, src)
+}
+func (m *MsgPauseSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseSchedule.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.rewards.v1beta1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+var xxx_messageInfo_MsgPauseSchedule proto.InternalMessageInfo
+
+func (m *MsgPauseSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ChangeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgChangeSchedule)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ChangeSchedule(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.rewards.v1beta1.Msg/ChangeSchedule",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ChangeSchedule(ctx, req.(*MsgChangeSchedule))
+func (m *MsgPauseSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kiichain.rewards.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "FundPool",
-			Handler:    _Msg_FundPool_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "ChangeSchedule",
-			Handler:    _Msg_ChangeSchedule_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kiichain/rewards/v1beta1/tx.proto",
+// MsgPauseScheduleResponse defines the response structure for executing a
+// MsgPauseSchedule message.
+type MsgPauseScheduleResponse struct {
 }
 
-func (m *MsgFundPool) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgPauseScheduleResponse) Reset()         { *m = MsgPauseScheduleResponse{} }
+func (m *MsgPauseScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseScheduleResponse) ProtoMessage()    {}
+func (*MsgPauseScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e1e54764dba96cb, []int{11}
 }
-
-func (m *MsgFundPool) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgPauseScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgFundPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgPauseScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgFundPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgPauseScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseScheduleResponse.Merge(m, src)
 }
-
-func (m *MsgFundPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgPauseScheduleResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgFundPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgPauseScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgPauseScheduleResponse proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgResumeSchedule is the Msg/ResumeSchedule request type.
+type MsgResumeSchedule struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// id is the identifier of the schedule to be resumed
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgResumeSchedule) Reset()         { *m = MsgResumeSchedule{} }
+func (m *MsgResumeSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeSchedule) ProtoMessage()    {}
+func (*MsgResumeSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e1e54764dba96cb, []int{12}
+}
+func (m *MsgResumeSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgResumeSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeSchedule.Merge(m, src)
+}
+func (m *MsgResumeSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeSchedule.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgResumeSchedule proto.InternalMessageInfo
+
+func (m *MsgResumeSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgResumeSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgResumeScheduleResponse defines the response structure for executing a
+// MsgResumeSchedule message.
+type MsgResumeScheduleResponse struct {
 }
 
-func (m *MsgChangeSchedule) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgResumeScheduleResponse) Reset()         { *m = MsgResumeScheduleResponse{} }
+func (m *MsgResumeScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeScheduleResponse) ProtoMessage()    {}
+func (*MsgResumeScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e1e54764dba96cb, []int{13}
+}
+func (m *MsgResumeScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgResumeScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeScheduleResponse.Merge(m, src)
+}
+func (m *MsgResumeScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *MsgChangeSchedule) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgResumeScheduleResponse proto.InternalMessageInfo
+
+// MsgClawback is the Msg/Clawback request type.
+type MsgClawback struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// amount to be moved out of the reward pool, it can't use the funds
+	// committed to the schedules
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// recipient of the funds, empty sends to the distribution community pool
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func (m *MsgChangeSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgClawback) Reset()         { *m = MsgClawback{} }
+func (m *MsgClawback) String() string { return proto.CompactTextString(m) }
+func (*MsgClawback) ProtoMessage()    {}
+func (*MsgClawback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e1e54764dba96cb, []int{14}
+}
+func (m *MsgClawback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClawback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgClawback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawback.Merge(m, src)
+}
+func (m *MsgClawback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClawback proto.InternalMessageInfo
+
+func (m *MsgClawback) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgChangeScheduleResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClawback) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgChangeScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClawback) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
 }
 
-func (m *MsgChangeScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgClawbackResponse defines the response structure for executing a
+// MsgClawback message.
+type MsgClawbackResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgClawbackResponse) Reset()         { *m = MsgClawbackResponse{} }
+func (m *MsgClawbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClawbackResponse) ProtoMessage()    {}
+func (*MsgClawbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8e1e54764dba96cb, []int{15}
 }
-func (m *MsgFundPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgClawbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgFundPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgClawbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClawbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgClawbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClawbackResponse.Merge(m, src)
+}
+func (m *MsgClawbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClawbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClawbackResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+var xxx_messageInfo_MsgClawbackResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgFundPool)(nil), "kiichain.rewards.v1beta1.MsgFundPool")
+	proto.RegisterType((*MsgFundPoolResponse)(nil), "kiichain.rewards.v1beta1.MsgFundPoolResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "kiichain.rewards.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "kiichain.rewards.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateSchedule)(nil), "kiichain.rewards.v1beta1.MsgCreateSchedule")
+	proto.RegisterType((*MsgCreateScheduleResponse)(nil), "kiichain.rewards.v1beta1.MsgCreateScheduleResponse")
+	proto.RegisterType((*MsgAmendSchedule)(nil), "kiichain.rewards.v1beta1.MsgAmendSchedule")
+	proto.RegisterType((*MsgAmendScheduleResponse)(nil), "kiichain.rewards.v1beta1.MsgAmendScheduleResponse")
+	proto.RegisterType((*MsgCancelSchedule)(nil), "kiichain.rewards.v1beta1.MsgCancelSchedule")
+	proto.RegisterType((*MsgCancelScheduleResponse)(nil), "kiichain.rewards.v1beta1.MsgCancelScheduleResponse")
+	proto.RegisterType((*MsgPauseSchedule)(nil), "kiichain.rewards.v1beta1.MsgPauseSchedule")
+	proto.RegisterType((*MsgPauseScheduleResponse)(nil), "kiichain.rewards.v1beta1.MsgPauseScheduleResponse")
+	proto.RegisterType((*MsgResumeSchedule)(nil), "kiichain.rewards.v1beta1.MsgResumeSchedule")
+	proto.RegisterType((*MsgResumeScheduleResponse)(nil), "kiichain.rewards.v1beta1.MsgResumeScheduleResponse")
+	proto.RegisterType((*MsgClawback)(nil), "kiichain.rewards.v1beta1.MsgClawback")
+	proto.RegisterType((*MsgClawbackResponse)(nil), "kiichain.rewards.v1beta1.MsgClawbackResponse")
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func init() { proto.RegisterFile("kiichain/rewards/v1beta1/tx.proto", fileDescriptor_8e1e54764dba96cb) }
+
+var fileDescriptor_8e1e54764dba96cb = []byte{
+	// 858 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xc1, 0x4f, 0xdb, 0x56,
+	0x1c, 0xc7, 0xe3, 0xc0, 0x22, 0x78, 0x0c, 0x46, 0x32, 0x18, 0x89, 0x27, 0x05, 0xf0, 0x86, 0x44,
+	0xc2, 0x62, 0x2b, 0x20, 0xed, 0x10, 0x4d, 0x93, 0x08, 0x12, 0x97, 0x29, 0x12, 0x32, 0x9a, 0x34,
+	0xed, 0xc2, 0x5e, 0xec, 0x87, 0x63, 0x61, 0xfb, 0x59, 0x7e, 0x36, 0x90, 0xdb, 0x34, 0x69, 0xaa,
+	0xd4, 0x53, 0xcf, 0xfd, 0x0b, 0x7a, 0x8c, 0xd4, 0xf6, 0x1f, 0xa8, 0x7a, 0xe0, 0x88, 0x7a, 0xea,
+	0x09, 0x21, 0x38, 0xe4, 0xde, 0x73, 0x0f, 0x95, 0xed, 0xe7, 0x17, 0xec, 0x10, 0x27, 0xa5, 0x55,
+	0xd5, 0x0b, 0x24, 0xf6, 0xd7, 0xbf, 0xdf, 0xf7, 0xf3, 0x7b, 0x7e, 0xdf, 0x3c, 0xb0, 0x7e, 0xa2,
+	0xeb, 0x4a, 0x07, 0xea, 0x96, 0xe4, 0xa0, 0x33, 0xe8, 0xa8, 0x44, 0x3a, 0xad, 0xb7, 0x91, 0x0b,
+	0xeb, 0x92, 0x7b, 0x2e, 0xda, 0x0e, 0x76, 0x71, 0xa1, 0x18, 0x49, 0x44, 0x2a, 0x11, 0xa9, 0x84,
+	0x5f, 0xd2, 0xb0, 0x86, 0x03, 0x91, 0xe4, 0x7f, 0x0a, 0xf5, 0x7c, 0x59, 0xc1, 0xc4, 0xc4, 0x44,
+	0x6a, 0x43, 0x82, 0x58, 0x35, 0x05, 0xeb, 0x16, 0xbd, 0xbf, 0x31, 0xb2, 0xa5, 0x0d, 0x1d, 0x68,
+	0x12, 0x2a, 0xfb, 0x79, 0xb4, 0xb3, 0xae, 0x8d, 0x22, 0xd5, 0xaa, 0x86, 0xb1, 0x66, 0x20, 0x29,
+	0xf8, 0xd6, 0xf6, 0x8e, 0x25, 0x57, 0x37, 0x11, 0x71, 0xa1, 0x69, 0x53, 0xc1, 0x0a, 0x75, 0x63,
+	0x12, 0x4d, 0x3a, 0xad, 0xfb, 0xff, 0xe8, 0x8d, 0x52, 0x78, 0xe3, 0x28, 0xf4, 0x1f, 0x7e, 0xa1,
+	0xb7, 0xf2, 0xd0, 0xd4, 0x2d, 0x2c, 0x05, 0x7f, 0xc3, 0x4b, 0xc2, 0x4b, 0x0e, 0xcc, 0xb5, 0x88,
+	0xb6, 0xef, 0x59, 0xea, 0x01, 0xc6, 0x46, 0xa1, 0x02, 0x72, 0x04, 0x59, 0x2a, 0x72, 0x8a, 0xdc,
+	0x1a, 0xb7, 0x39, 0xdb, 0xcc, 0xbf, 0xbb, 0x5a, 0x9d, 0xef, 0x42, 0xd3, 0x68, 0x08, 0xe1, 0x75,
+	0x41, 0xa6, 0x82, 0xc2, 0x5f, 0x20, 0x07, 0x4d, 0xec, 0x59, 0x6e, 0x31, 0xbb, 0xc6, 0x6d, 0xce,
+	0x6d, 0x97, 0x44, 0xda, 0xcc, 0x1f, 0x50, 0x34, 0x4b, 0x71, 0x0f, 0xeb, 0x56, 0x73, 0xe3, 0xe2,
+	0x6a, 0x35, 0x33, 0xa8, 0x14, 0x3e, 0x26, 0x3c, 0xed, 0xf7, 0xaa, 0x73, 0x06, 0xd2, 0xa0, 0xd2,
+	0x3d, 0xf2, 0xe7, 0x28, 0xd3, 0x7a, 0x8d, 0xf5, 0xff, 0xfa, 0xbd, 0x2a, 0x6d, 0xf3, 0xb8, 0xdf,
+	0xab, 0xe6, 0xa3, 0x49, 0x1d, 0x7b, 0x96, 0x5a, 0xb3, 0x31, 0x36, 0x84, 0x65, 0xf0, 0xfd, 0x1d,
+	0xdb, 0x32, 0x22, 0x36, 0xb6, 0x08, 0x12, 0x9e, 0x73, 0xe0, 0xbb, 0x16, 0xd1, 0xfe, 0xb4, 0x55,
+	0xe8, 0xa2, 0x83, 0x60, 0xec, 0x85, 0x5f, 0xc1, 0x2c, 0xf4, 0xdc, 0x0e, 0x76, 0x74, 0xb7, 0x4b,
+	0xa9, 0x8a, 0x6f, 0x5e, 0xd4, 0x96, 0xa8, 0xdb, 0x5d, 0x55, 0x75, 0x10, 0x21, 0x87, 0xae, 0xa3,
+	0x5b, 0x9a, 0x3c, 0x90, 0x16, 0x7e, 0x07, 0xb9, 0x70, 0xe1, 0x28, 0xdf, 0x9a, 0x38, 0xea, 0x85,
+	0x11, 0xc3, 0x4e, 0xcd, 0x69, 0x1f, 0x53, 0xa6, 0x4f, 0x35, 0x36, 0x7d, 0x8a, 0x41, 0x3d, 0x1f,
+	0x64, 0x39, 0x02, 0xf1, 0x02, 0x83, 0xb5, 0x50, 0x29, 0x94, 0xc0, 0x4a, 0xc2, 0x34, 0x03, 0x7a,
+	0xcd, 0x81, 0x7c, 0x8b, 0x68, 0x7b, 0x0e, 0x82, 0x2e, 0x3a, 0x54, 0x3a, 0x48, 0xf5, 0x0c, 0xf4,
+	0x60, 0xa4, 0x3f, 0xc0, 0x0c, 0xa1, 0x35, 0x28, 0x54, 0x65, 0x34, 0x94, 0x8c, 0x0c, 0x04, 0x09,
+	0x6b, 0x4a, 0xe9, 0x58, 0x81, 0x46, 0x75, 0x98, 0x6f, 0x25, 0xe2, 0x53, 0x02, 0xbf, 0xb5, 0x48,
+	0x2b, 0x6c, 0x81, 0xd2, 0x10, 0x45, 0xc4, 0x58, 0x58, 0x00, 0x59, 0x5d, 0x0d, 0x30, 0xa6, 0xe5,
+	0xac, 0xae, 0x0a, 0xaf, 0x38, 0xb0, 0xd8, 0x22, 0xda, 0xae, 0x89, 0x2c, 0xf5, 0xeb, 0x42, 0xae,
+	0x0c, 0x23, 0xff, 0x10, 0x21, 0x43, 0xdf, 0xee, 0x80, 0x98, 0x07, 0xc5, 0x24, 0x03, 0x5b, 0xd4,
+	0x47, 0x74, 0x51, 0xa1, 0xa5, 0x20, 0xe3, 0x93, 0x09, 0xc3, 0xf1, 0x65, 0xa3, 0xf1, 0xa5, 0xaf,
+	0x4b, 0xd0, 0x72, 0xe0, 0xf2, 0xc7, 0x70, 0x5d, 0x62, 0x46, 0x98, 0xcd, 0xff, 0xc3, 0x75, 0x38,
+	0x80, 0x1e, 0x41, 0x9f, 0xdd, 0x65, 0xda, 0x28, 0x6d, 0xbf, 0x63, 0x72, 0x94, 0x31, 0x1b, 0xc9,
+	0x51, 0xca, 0x88, 0x78, 0x26, 0xfa, 0xa2, 0xa3, 0x74, 0x82, 0x96, 0xc9, 0x51, 0xc6, 0x8d, 0x30,
+	0x9b, 0xd7, 0x61, 0xcc, 0xee, 0x19, 0xf0, 0xac, 0x0d, 0x95, 0x93, 0x07, 0x1b, 0xfc, 0x6d, 0xf2,
+	0xcc, 0x9d, 0xf5, 0xdf, 0xdd, 0x67, 0xfd, 0x5e, 0x95, 0x8b, 0x72, 0xd5, 0xef, 0xea, 0x20, 0x45,
+	0xb7, 0x75, 0x64, 0xb9, 0xc5, 0xa9, 0x71, 0x5d, 0x99, 0xb4, 0xf1, 0xd3, 0xf0, 0x18, 0x16, 0xd9,
+	0x1b, 0x45, 0x91, 0x68, 0x22, 0x47, 0x84, 0x11, 0xf9, 0xf6, 0xfb, 0x1c, 0x98, 0x6a, 0x11, 0xad,
+	0xf0, 0x0f, 0x98, 0x61, 0x3f, 0x32, 0x1b, 0xa3, 0x77, 0xe0, 0x9d, 0x50, 0xe7, 0x6b, 0x13, 0xc9,
+	0x58, 0x8c, 0x18, 0xe0, 0xdb, 0x58, 0xee, 0x57, 0x52, 0x1f, 0xbf, 0x2b, 0xe5, 0xeb, 0x13, 0x4b,
+	0x59, 0x37, 0x07, 0x2c, 0x24, 0x42, 0x79, 0x2b, 0xb5, 0x48, 0x5c, 0xcc, 0xef, 0x7c, 0x84, 0x98,
+	0xf5, 0xc4, 0x60, 0x3e, 0x1e, 0x8a, 0xd5, 0xd4, 0x2a, 0x31, 0x2d, 0xbf, 0x3d, 0xb9, 0x36, 0x06,
+	0x19, 0x0f, 0xa9, 0x31, 0x90, 0x31, 0xf1, 0x38, 0xc8, 0x7b, 0x53, 0xc7, 0x87, 0x8c, 0x27, 0x4e,
+	0x3a, 0x64, 0x4c, 0x3b, 0x06, 0xf2, 0xde, 0x08, 0xf1, 0x21, 0x13, 0xf1, 0x91, 0x0e, 0x19, 0x17,
+	0x8f, 0x81, 0xbc, 0x3f, 0x0f, 0xfc, 0xdd, 0xc0, 0xb2, 0x20, 0x7d, 0x37, 0x44, 0xb2, 0x31, 0xbb,
+	0x21, 0xb9, 0xef, 0xf8, 0x6f, 0xfe, 0xf5, 0xb7, 0x7e, 0x73, 0xff, 0xe2, 0xa6, 0xcc, 0x5d, 0xde,
+	0x94, 0xb9, 0xeb, 0x9b, 0x32, 0xf7, 0xe4, 0xb6, 0x9c, 0xb9, 0xbc, 0x2d, 0x67, 0xde, 0xde, 0x96,
+	0x33, 0x7f, 0xff, 0xa2, 0xe9, 0x6e, 0xc7, 0x6b, 0x8b, 0x0a, 0x36, 0x25, 0x76, 0x24, 0x65, 0x1f,
+	0xce, 0xd9, 0xe9, 0x34, 0x38, 0x95, 0xb6, 0x73, 0xc1, 0x71, 0x71, 0xe7, 0x43, 0x00, 0x00, 0x00,
+	0xff, 0xff, 0x03, 0xf7, 0x66, 0x39, 0x58, 0x0b, 0x00, 0x00,
 }
 
-func (m *MsgChangeSchedule) Size() (n int) {
-	if m == nil {
-		return 0
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// FundPool adds funds to the community pool that can be used on a extension
+	FundPool(ctx context.Context, in *MsgFundPool, opts ...grpc.CallOption) (*MsgFundPoolResponse, error)
+	// UpdateParams defines a governance operation for updating the x/mint module
+	// parameters. The authority is hard-coded to the x/gov module account.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateSchedule defines a governance operation for creating a new reward
+	// release schedule
+	CreateSchedule(ctx context.Context, in *MsgCreateSchedule, opts ...grpc.CallOption) (*MsgCreateScheduleResponse, error)
+	// AmendSchedule defines a governance operation for changing an existing
+	// reward release schedule
+	AmendSchedule(ctx context.Context, in *MsgAmendSchedule, opts ...grpc.CallOption) (*MsgAmendScheduleResponse, error)
+	// CancelSchedule defines a governance operation for removing a reward
+	// release schedule
+	CancelSchedule(ctx context.Context, in *MsgCancelSchedule, opts ...grpc.CallOption) (*MsgCancelScheduleResponse, error)
+	// PauseSchedule defines a governance operation for stopping the releases of
+	// a schedule
+	PauseSchedule(ctx context.Context, in *MsgPauseSchedule, opts ...grpc.CallOption) (*MsgPauseScheduleResponse, error)
+	// ResumeSchedule defines a governance operation for restarting the releases
+	// of a paused schedule
+	ResumeSchedule(ctx context.Context, in *MsgResumeSchedule, opts ...grpc.CallOption) (*MsgResumeScheduleResponse, error)
+	// Clawback defines a governance operation for moving funds not committed to
+	// any schedule out of the reward pool
+	Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) FundPool(ctx context.Context, in *MsgFundPool, opts ...grpc.CallOption) (*MsgFundPoolResponse, error) {
+	out := new(MsgFundPoolResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Msg/FundPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateSchedule(ctx context.Context, in *MsgCreateSchedule, opts ...grpc.CallOption) (*MsgCreateScheduleResponse, error) {
+	out := new(MsgCreateScheduleResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Msg/CreateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AmendSchedule(ctx context.Context, in *MsgAmendSchedule, opts ...grpc.CallOption) (*MsgAmendScheduleResponse, error) {
+	out := new(MsgAmendScheduleResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Msg/AmendSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelSchedule(ctx context.Context, in *MsgCancelSchedule, opts ...grpc.CallOption) (*MsgCancelScheduleResponse, error) {
+	out := new(MsgCancelScheduleResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Msg/CancelSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseSchedule(ctx context.Context, in *MsgPauseSchedule, opts ...grpc.CallOption) (*MsgPauseScheduleResponse, error) {
+	out := new(MsgPauseScheduleResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Msg/PauseSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeSchedule(ctx context.Context, in *MsgResumeSchedule, opts ...grpc.CallOption) (*MsgResumeScheduleResponse, error) {
+	out := new(MsgResumeScheduleResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Msg/ResumeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Clawback(ctx context.Context, in *MsgClawback, opts ...grpc.CallOption) (*MsgClawbackResponse, error) {
+	out := new(MsgClawbackResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.rewards.v1beta1.Msg/Clawback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// FundPool adds funds to the community pool that can be used on a extension
+	FundPool(context.Context, *MsgFundPool) (*MsgFundPoolResponse, error)
+	// UpdateParams defines a governance operation for updating the x/mint module
+	// parameters. The authority is hard-coded to the x/gov module account.
+	//
+	// Since: cosmos-sdk 0.47
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CreateSchedule defines a governance operation for creating a new reward
+	// release schedule
+	CreateSchedule(context.Context, *MsgCreateSchedule) (*MsgCreateScheduleResponse, error)
+	// AmendSchedule defines a governance operation for changing an existing
+	// reward release schedule
+	AmendSchedule(context.Context, *MsgAmendSchedule) (*MsgAmendScheduleResponse, error)
+	// CancelSchedule defines a governance operation for removing a reward
+	// release schedule
+	CancelSchedule(context.Context, *MsgCancelSchedule) (*MsgCancelScheduleResponse, error)
+	// PauseSchedule defines a governance operation for stopping the releases of
+	// a schedule
+	PauseSchedule(context.Context, *MsgPauseSchedule) (*MsgPauseScheduleResponse, error)
+	// ResumeSchedule defines a governance operation for restarting the releases
+	// of a paused schedule
+	ResumeSchedule(context.Context, *MsgResumeSchedule) (*MsgResumeScheduleResponse, error)
+	// Clawback defines a governance operation for moving funds not committed to
+	// any schedule out of the reward pool
+	Clawback(context.Context, *MsgClawback) (*MsgClawbackResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) FundPool(ctx context.Context, req *MsgFundPool) (*MsgFundPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundPool not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreateSchedule(ctx context.Context, req *MsgCreateSchedule) (*MsgCreateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (*UnimplementedMsgServer) AmendSchedule(ctx context.Context, req *MsgAmendSchedule) (*MsgAmendScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendSchedule not implemented")
+}
+func (*UnimplementedMsgServer) CancelSchedule(ctx context.Context, req *MsgCancelSchedule) (*MsgCancelScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSchedule not implemented")
+}
+func (*UnimplementedMsgServer) PauseSchedule(ctx context.Context, req *MsgPauseSchedule) (*MsgPauseScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSchedule not implemented")
+}
+func (*UnimplementedMsgServer) ResumeSchedule(ctx context.Context, req *MsgResumeSchedule) (*MsgResumeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSchedule not implemented")
+}
+func (*UnimplementedMsgServer) Clawback(ctx context.Context, req *MsgClawback) (*MsgClawbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Clawback not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_FundPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Msg/FundPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundPool(ctx, req.(*MsgFundPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Msg/CreateSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSchedule(ctx, req.(*MsgCreateSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AmendSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AmendSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Msg/AmendSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendSchedule(ctx, req.(*MsgAmendSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Msg/CancelSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSchedule(ctx, req.(*MsgCancelSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Msg/PauseSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseSchedule(ctx, req.(*MsgPauseSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Msg/ResumeSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeSchedule(ctx, req.(*MsgResumeSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Clawback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClawback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Clawback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.rewards.v1beta1.Msg/Clawback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Clawback(ctx, req.(*MsgClawback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kiichain.rewards.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FundPool",
+			Handler:    _Msg_FundPool_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _Msg_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "AmendSchedule",
+			Handler:    _Msg_AmendSchedule_Handler,
+		},
+		{
+			MethodName: "CancelSchedule",
+			Handler:    _Msg_CancelSchedule_Handler,
+		},
+		{
+			MethodName: "PauseSchedule",
+			Handler:    _Msg_PauseSchedule_Handler,
+		},
+		{
+			MethodName: "ResumeSchedule",
+			Handler:    _Msg_ResumeSchedule_Handler,
+		},
+		{
+			MethodName: "Clawback",
+			Handler:    _Msg_Clawback_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kiichain/rewards/v1beta1/tx.proto",
+}
+
+func (m *MsgFundPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClawbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClawbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClawbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgFundPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFundPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Schedule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgAmendSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Schedule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAmendScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPauseSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgPauseScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumeSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgResumeScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClawback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClawbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgFundPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAmendSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAmendSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAmendSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAmendScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAmendScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAmendScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Schedule.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgChangeScheduleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgFundPool) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -836,15 +2668,15 @@ func (m *MsgFundPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -872,13 +2704,13 @@ func (m *MsgFundPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -888,25 +2720,11 @@ func (m *MsgFundPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -928,7 +2746,7 @@ func (m *MsgFundPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -951,10 +2769,10 @@ func (m *MsgFundPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -978,7 +2796,7 @@ func (m *MsgFundPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1001,10 +2819,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1040,10 +2858,129 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1053,25 +2990,43 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1093,7 +3048,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1116,10 +3071,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1143,7 +3098,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeSchedule) Unmarshal(dAtA []byte) error {
+func (m *MsgClawback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1166,10 +3121,10 @@ func (m *MsgChangeSchedule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeSchedule: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1206,7 +3161,7 @@ func (m *MsgChangeSchedule) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1233,10 +3188,42 @@ func (m *MsgChangeSchedule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1258,7 +3245,7 @@ func (m *MsgChangeSchedule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeScheduleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClawbackResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1281,10 +3268,10 @@ func (m *MsgChangeScheduleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeScheduleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClawbackResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClawbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -30,6 +32,142 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ReleaseCurve defines how the total amount of a schedule is released over
+// time
+type ReleaseCurve int32
+
+const (
+	// Releases linearly between the last release and the end time
+	ReleaseCurveLinear ReleaseCurve = 0
+	// Releases nothing until the cliff time, then linearly from the start time
+	ReleaseCurveCliff ReleaseCurve = 1
+	// Releases the linear amount at the end of each step
+	ReleaseCurveStep ReleaseCurve = 2
+	// Releases half of the amount left on each half life, the rest is released
+	// at the end time
+	ReleaseCurveExponential ReleaseCurve = 3
+)
+
+var ReleaseCurve_name = map[int32]string{
+	0: "RELEASE_CURVE_LINEAR",
+	1: "RELEASE_CURVE_CLIFF",
+	2: "RELEASE_CURVE_STEP",
+	3: "RELEASE_CURVE_EXPONENTIAL",
+}
+
+var ReleaseCurve_value = map[string]int32{
+	"RELEASE_CURVE_LINEAR":      0,
+	"RELEASE_CURVE_CLIFF":       1,
+	"RELEASE_CURVE_STEP":        2,
+	"RELEASE_CURVE_EXPONENTIAL": 3,
+}
+
+func (x ReleaseCurve) String() string {
+	return proto.EnumName(ReleaseCurve_name, int32(x))
+}
+
+func (ReleaseCurve) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_890c6773eb163743, []int{0}
+}
+
+// DestinationType defines the kind of account receiving a release split
+type DestinationType int32
+
+const (
+	// Sends to the fee collector, distributed to all the stakers
+	DestinationFeeCollector DestinationType = 0
+	// Sends to the distribution community pool
+	DestinationCommunityPool DestinationType = 1
+	// Sends to a module account, the target is the module name
+	DestinationModuleAccount DestinationType = 2
+	// Sends to an address, the target is the bech32 address
+	DestinationAddress DestinationType = 3
+	// Sends to a CosmWasm contract, the target is the contract address
+	DestinationContract DestinationType = 4
+)
+
+var DestinationType_name = map[int32]string{
+	0: "DESTINATION_TYPE_FEE_COLLECTOR",
+	1: "DESTINATION_TYPE_COMMUNITY_POOL",
+	2: "DESTINATION_TYPE_MODULE_ACCOUNT",
+	3: "DESTINATION_TYPE_ADDRESS",
+	4: "DESTINATION_TYPE_CONTRACT",
+}
+
+var DestinationType_value = map[string]int32{
+	"DESTINATION_TYPE_FEE_COLLECTOR":  0,
+	"DESTINATION_TYPE_COMMUNITY_POOL": 1,
+	"DESTINATION_TYPE_MODULE_ACCOUNT": 2,
+	"DESTINATION_TYPE_ADDRESS":        3,
+	"DESTINATION_TYPE_CONTRACT":       4,
+}
+
+func (x DestinationType) String() string {
+	return proto.EnumName(DestinationType_name, int32(x))
+}
+
+func (DestinationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_890c6773eb163743, []int{1}
+}
+
+// ReleaseSplit defines the share of each release sent to a destination
+type ReleaseSplit struct {
+	// Kind of destination
+	Type DestinationType `protobuf:"varint,1,opt,name=type,proto3,enum=kiichain.rewards.v1beta1.DestinationType" json:"type,omitempty" yaml:"type"`
+	// Module name or address of the destination, empty for the fee collector
+	// and the community pool
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty" yaml:"target"`
+	// Share of each release, the weights of a schedule add up to one
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight" yaml:"weight"`
+}
+
+func (m *ReleaseSplit) Reset()         { *m = ReleaseSplit{} }
+func (m *ReleaseSplit) String() string { return proto.CompactTextString(m) }
+func (*ReleaseSplit) ProtoMessage()    {}
+func (*ReleaseSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_890c6773eb163743, []int{0}
+}
+func (m *ReleaseSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseSplit.Merge(m, src)
+}
+func (m *ReleaseSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseSplit proto.InternalMessageInfo
+
+func (m *ReleaseSplit) GetType() DestinationType {
+	if m != nil {
+		return m.Type
+	}
+	return DestinationFeeCollector
+}
+
+func (m *ReleaseSplit) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
 // ReleaseSchedule defines information related to reward distribution
 type ReleaseSchedule struct {
 	// Total amount to be rewarded
@@ -42,13 +180,31 @@ type ReleaseSchedule struct {
 	LastReleaseTime time.Time `protobuf:"bytes,5,opt,name=last_release_time,json=lastReleaseTime,proto3,stdtime" json:"last_release_time" yaml:"last_release_time"`
 	// If reward pool is active
 	Active bool `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty" yaml:"active"`
+	// Unique identifier of the schedule
+	Id uint64 `protobuf:"varint,7,opt,name=id,proto3" json:"id,omitempty" yaml:"id"`
+	// Timestamp of start of release, zero starts right away
+	StartTime time.Time `protobuf:"bytes,8,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// Address receiving the released amount, empty sends to the fee collector
+	Destination string `protobuf:"bytes,9,opt,name=destination,proto3" json:"destination,omitempty" yaml:"destination"`
+	// Curve used to release the total amount
+	Curve ReleaseCurve `protobuf:"varint,10,opt,name=curve,proto3,enum=kiichain.rewards.v1beta1.ReleaseCurve" json:"curve,omitempty" yaml:"curve"`
+	// Timestamp where the cliff curve starts releasing
+	CliffTime time.Time `protobuf:"bytes,11,opt,name=cliff_time,json=cliffTime,proto3,stdtime" json:"cliff_time" yaml:"cliff_time"`
+	// Length in seconds of each step of the step curve
+	StepDuration uint64 `protobuf:"varint,12,opt,name=step_duration,json=stepDuration,proto3" json:"step_duration,omitempty" yaml:"step_duration"`
+	// Length in seconds of the half life of the exponential curve
+	HalfLife uint64 `protobuf:"varint,13,opt,name=half_life,json=halfLife,proto3" json:"half_life,omitempty" yaml:"half_life"`
+	// Weighted destinations of each release, replaces the destination when set
+	Splits []ReleaseSplit `protobuf:"bytes,14,rep,name=splits,proto3" json:"splits" yaml:"splits"`
+	// Timestamp where the schedule was paused, zero if it's not paused
+	PausedTime time.Time `protobuf:"bytes,15,opt,name=paused_time,json=pausedTime,proto3,stdtime" json:"paused_time" yaml:"paused_time"`
 }
 
 func (m *ReleaseSchedule) Reset()         { *m = ReleaseSchedule{} }
 func (m *ReleaseSchedule) String() string { return proto.CompactTextString(m) }
 func (*ReleaseSchedule) ProtoMessage()    {}
 func (*ReleaseSchedule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_890c6773eb163743, []int{0}
+	return fileDescriptor_890c6773eb163743, []int{1}
 }
 func (m *ReleaseSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -112,6 +268,69 @@ func (m *ReleaseSchedule) GetActive() bool {
 	return false
 }
 
+func (m *ReleaseSchedule) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ReleaseSchedule) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *ReleaseSchedule) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *ReleaseSchedule) GetCurve() ReleaseCurve {
+	if m != nil {
+		return m.Curve
+	}
+	return ReleaseCurveLinear
+}
+
+func (m *ReleaseSchedule) GetCliffTime() time.Time {
+	if m != nil {
+		return m.CliffTime
+	}
+	return time.Time{}
+}
+
+func (m *ReleaseSchedule) GetStepDuration() uint64 {
+	if m != nil {
+		return m.StepDuration
+	}
+	return 0
+}
+
+func (m *ReleaseSchedule) GetHalfLife() uint64 {
+	if m != nil {
+		return m.HalfLife
+	}
+	return 0
+}
+
+func (m *ReleaseSchedule) GetSplits() []ReleaseSplit {
+	if m != nil {
+		return m.Splits
+	}
+	return nil
+}
+
+func (m *ReleaseSchedule) GetPausedTime() time.Time {
+	if m != nil {
+		return m.PausedTime
+	}
+	return time.Time{}
+}
+
 // RewardPool is the global fee pool for distribution.
 type RewardPool struct {
 	CommunityPool github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=community_pool,json=communityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"community_pool"`
@@ -121,7 +340,7 @@ func (m *RewardPool) Reset()         { *m = RewardPool{} }
 func (m *RewardPool) String() string { return proto.CompactTextString(m) }
 func (*RewardPool) ProtoMessage()    {}
 func (*RewardPool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_890c6773eb163743, []int{1}
+	return fileDescriptor_890c6773eb163743, []int{2}
 }
 func (m *RewardPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -158,6 +377,9 @@ func (m *RewardPool) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.DecCo
 }
 
 func init() {
+	proto.RegisterEnum("kiichain.rewards.v1beta1.ReleaseCurve", ReleaseCurve_name, ReleaseCurve_value)
+	proto.RegisterEnum("kiichain.rewards.v1beta1.DestinationType", DestinationType_name, DestinationType_value)
+	proto.RegisterType((*ReleaseSplit)(nil), "kiichain.rewards.v1beta1.ReleaseSplit")
 	proto.RegisterType((*ReleaseSchedule)(nil), "kiichain.rewards.v1beta1.ReleaseSchedule")
 	proto.RegisterType((*RewardPool)(nil), "kiichain.rewards.v1beta1.RewardPool")
 }
@@ -167,38 +389,125 @@ func init() {
 }
 
 var fileDescriptor_890c6773eb163743 = []byte{
-	// 483 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x3f, 0x8f, 0xd3, 0x3e,
-	0x18, 0xc7, 0xeb, 0x5f, 0xf5, 0x2b, 0x27, 0x97, 0xbb, 0xaa, 0x01, 0xa1, 0x50, 0x50, 0x52, 0x45,
-	0x37, 0x94, 0x7f, 0x8e, 0xee, 0x58, 0x10, 0x1b, 0x05, 0x31, 0xa3, 0xc0, 0x02, 0x4b, 0xe5, 0x24,
-	0x26, 0xb5, 0xce, 0xce, 0x53, 0xd5, 0xce, 0x41, 0x07, 0x5e, 0x01, 0xcb, 0xbd, 0x0c, 0xc4, 0xc4,
-	0x1b, 0x60, 0xbf, 0xf1, 0x46, 0xa6, 0x1e, 0x6a, 0x07, 0xf6, 0x7b, 0x05, 0x28, 0xb6, 0x13, 0x9d,
-	0x00, 0xe9, 0x96, 0xc4, 0x7e, 0xf4, 0x7c, 0x3f, 0xdf, 0x27, 0xdf, 0xd8, 0x78, 0xff, 0x88, 0xf3,
-	0x6c, 0x4e, 0x79, 0x19, 0x2f, 0xd9, 0x07, 0xba, 0xcc, 0x55, 0x7c, 0x7c, 0x90, 0x32, 0x4d, 0x0f,
-	0x62, 0xbd, 0x5a, 0x30, 0x45, 0x16, 0x4b, 0xd0, 0xe0, 0xf9, 0x4d, 0x17, 0x71, 0x5d, 0xc4, 0x75,
-	0x8d, 0x82, 0x0c, 0x94, 0x04, 0x15, 0xa7, 0x54, 0xb1, 0x56, 0x9a, 0x01, 0x2f, 0xad, 0x72, 0x74,
-	0xb3, 0x80, 0x02, 0xcc, 0x32, 0xae, 0x57, 0xae, 0x1a, 0x16, 0x00, 0x85, 0x60, 0xb1, 0xd9, 0xa5,
-	0xd5, 0xfb, 0x58, 0x73, 0xc9, 0x94, 0xa6, 0x72, 0xe1, 0x1a, 0x86, 0x54, 0xf2, 0x12, 0x62, 0xf3,
-	0xb4, 0xa5, 0xe8, 0x7b, 0x17, 0x0f, 0x12, 0x26, 0x18, 0x55, 0xec, 0x75, 0x36, 0x67, 0x79, 0x25,
-	0x98, 0xf7, 0x16, 0x5f, 0xd7, 0xa0, 0xa9, 0x98, 0x51, 0x09, 0x55, 0xa9, 0x7d, 0x34, 0x46, 0x93,
-	0xfe, 0xe1, 0x6d, 0x62, 0x87, 0x22, 0xf5, 0x50, 0xcd, 0xa4, 0xe4, 0x39, 0xf0, 0x72, 0x7a, 0xe7,
-	0x74, 0x1d, 0x76, 0x2e, 0xd6, 0xe1, 0x8d, 0x15, 0x95, 0xe2, 0x69, 0x74, 0x59, 0x1c, 0x25, 0x7d,
-	0xb3, 0x7d, 0x66, 0x76, 0x5e, 0x8a, 0x07, 0x4b, 0xeb, 0x96, 0x37, 0xf4, 0xff, 0xae, 0xa2, 0x07,
-	0x8e, 0x7e, 0xcb, 0xd2, 0xff, 0xd0, 0x47, 0xc9, 0x5e, 0x53, 0x71, 0x1e, 0x09, 0xde, 0x61, 0x65,
-	0x3e, 0xab, 0x3f, 0xde, 0xef, 0x1a, 0xf8, 0x88, 0xd8, 0x64, 0x48, 0x93, 0x0c, 0x79, 0xd3, 0x24,
-	0xd3, 0xce, 0x3e, 0xb0, 0xf4, 0x46, 0x19, 0x9d, 0x9c, 0x87, 0x28, 0xb9, 0xc6, 0xca, 0xbc, 0x6e,
-	0xf5, 0x04, 0x1e, 0x0a, 0xaa, 0xf4, 0xcc, 0x59, 0x59, 0xf8, 0xff, 0x57, 0xc2, 0xf7, 0x1d, 0xdc,
-	0xb7, 0xf0, 0xbf, 0x10, 0xd6, 0x65, 0x50, 0xd7, 0xdd, 0x4f, 0x30, 0x6e, 0xf7, 0x70, 0x8f, 0x66,
-	0x9a, 0x1f, 0x33, 0xbf, 0x37, 0x46, 0x93, 0x9d, 0xe9, 0xf0, 0x62, 0x1d, 0xee, 0x5a, 0x84, 0xad,
-	0x47, 0x89, 0x6b, 0x88, 0x3e, 0x23, 0x8c, 0x13, 0x73, 0x7a, 0x5e, 0x01, 0x08, 0xef, 0x13, 0xde,
-	0xcb, 0x40, 0xca, 0xaa, 0xe4, 0x7a, 0x35, 0x5b, 0x00, 0x08, 0x1f, 0x8d, 0xbb, 0x93, 0xfe, 0xe1,
-	0xdd, 0x7f, 0xc6, 0xfb, 0x82, 0x65, 0x26, 0xe1, 0x27, 0xf5, 0x98, 0x5f, 0xcf, 0xc3, 0x07, 0x05,
-	0xd7, 0xf3, 0x2a, 0x25, 0x19, 0xc8, 0xd8, 0x9d, 0x40, 0xfb, 0x7a, 0xa4, 0xf2, 0x23, 0x77, 0x74,
-	0x9d, 0x46, 0x7d, 0xf9, 0xf5, 0xed, 0x3e, 0x4a, 0x76, 0x5b, 0xb7, 0xda, 0x7e, 0xfa, 0xf2, 0x74,
-	0x13, 0xa0, 0xb3, 0x4d, 0x80, 0x7e, 0x6e, 0x02, 0x74, 0xb2, 0x0d, 0x3a, 0x67, 0xdb, 0xa0, 0xf3,
-	0x63, 0x1b, 0x74, 0xde, 0x3d, 0xbc, 0x84, 0x6e, 0x2f, 0x47, 0xbb, 0xf8, 0xd8, 0xde, 0x13, 0x63,
-	0x92, 0xf6, 0x4c, 0x96, 0x8f, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x75, 0x10, 0x1e, 0x59, 0x48,
-	0x03, 0x00, 0x00,
+	// 1154 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x3f, 0x6f, 0xdb, 0xc6,
+	0x1b, 0x36, 0x65, 0xc7, 0xb1, 0x4f, 0xb6, 0x25, 0x5f, 0x94, 0x84, 0x56, 0x12, 0x51, 0x20, 0x82,
+	0x1f, 0x9c, 0xfc, 0x12, 0xaa, 0x49, 0x83, 0xa2, 0x08, 0x50, 0x14, 0x12, 0x45, 0x03, 0x02, 0x68,
+	0x49, 0xa0, 0xe8, 0x22, 0x69, 0x07, 0x82, 0x22, 0x4f, 0xd2, 0x21, 0x14, 0x4f, 0x20, 0x4f, 0x49,
+	0x3c, 0x74, 0x2f, 0xd4, 0x25, 0x5f, 0x40, 0x53, 0x97, 0xa2, 0x53, 0x87, 0x7e, 0x88, 0x0c, 0x1d,
+	0x82, 0x2e, 0x0d, 0x3a, 0x28, 0x41, 0x32, 0x74, 0xd7, 0x27, 0x28, 0x78, 0x77, 0x92, 0xe9, 0x38,
+	0x81, 0xdb, 0xc5, 0xbe, 0x7b, 0xef, 0x79, 0x9e, 0xf7, 0xcf, 0xbd, 0xef, 0x51, 0xe0, 0xe6, 0x13,
+	0x8c, 0xbd, 0x81, 0x8b, 0xc3, 0x4a, 0x84, 0x9e, 0xb9, 0x91, 0x1f, 0x57, 0x9e, 0xde, 0xeb, 0x22,
+	0xea, 0xde, 0xab, 0xd0, 0xe3, 0x11, 0x8a, 0xb5, 0x51, 0x44, 0x28, 0x81, 0xf2, 0x02, 0xa5, 0x09,
+	0x94, 0x26, 0x50, 0xc5, 0x92, 0x47, 0xe2, 0x21, 0x89, 0x2b, 0x5d, 0x37, 0x46, 0x4b, 0xaa, 0x47,
+	0x70, 0xc8, 0x99, 0xc5, 0x42, 0x9f, 0xf4, 0x09, 0x5b, 0x56, 0x92, 0x95, 0xb0, 0x2a, 0x7d, 0x42,
+	0xfa, 0x01, 0xaa, 0xb0, 0x5d, 0x77, 0xdc, 0xab, 0x50, 0x3c, 0x44, 0x31, 0x75, 0x87, 0x23, 0x01,
+	0xd8, 0x75, 0x87, 0x38, 0x24, 0x15, 0xf6, 0x57, 0x98, 0xf6, 0xb8, 0x27, 0x87, 0x8b, 0xf1, 0x0d,
+	0x3f, 0x52, 0xff, 0x94, 0xc0, 0x96, 0x85, 0x02, 0xe4, 0xc6, 0xa8, 0x33, 0x0a, 0x30, 0x85, 0x4d,
+	0xb0, 0x96, 0x84, 0x2f, 0x4b, 0x65, 0x69, 0x7f, 0xe7, 0xfe, 0x2d, 0xed, 0x53, 0xe1, 0x6b, 0x75,
+	0x14, 0x53, 0x1c, 0xba, 0x14, 0x93, 0xd0, 0x3e, 0x1e, 0xa1, 0x5a, 0x6e, 0x3e, 0x53, 0xb2, 0xc7,
+	0xee, 0x30, 0x78, 0xa8, 0x26, 0x02, 0xaa, 0xc5, 0x74, 0xe0, 0x2d, 0xb0, 0x4e, 0xdd, 0xa8, 0x8f,
+	0xa8, 0x9c, 0x29, 0x4b, 0xfb, 0x9b, 0xb5, 0xdd, 0xf9, 0x4c, 0xd9, 0x16, 0x30, 0x66, 0x57, 0x2d,
+	0x01, 0x80, 0x26, 0x58, 0x7f, 0x86, 0x70, 0x7f, 0x40, 0xe5, 0x55, 0x06, 0x7d, 0xf0, 0x72, 0xa6,
+	0xac, 0xfc, 0x35, 0x53, 0xae, 0xf1, 0x88, 0x63, 0xff, 0x89, 0x86, 0x49, 0x65, 0xe8, 0xd2, 0x81,
+	0x66, 0xa2, 0xbe, 0xeb, 0x1d, 0xd7, 0x91, 0x77, 0xa2, 0xc6, 0xa9, 0xaa, 0x25, 0x34, 0xd4, 0xd7,
+	0x1b, 0x20, 0xb7, 0xc8, 0xcc, 0x1b, 0x20, 0x7f, 0x1c, 0x20, 0xf8, 0x18, 0x6c, 0x51, 0x42, 0xdd,
+	0xc0, 0x71, 0x87, 0x64, 0x1c, 0x52, 0x96, 0x64, 0xf6, 0xfe, 0x9e, 0x26, 0x4a, 0x92, 0xdc, 0xc4,
+	0x32, 0x3f, 0x9d, 0xe0, 0xb0, 0x76, 0x2d, 0x09, 0x61, 0x3e, 0x53, 0x2e, 0x89, 0x88, 0x53, 0x64,
+	0xd5, 0xca, 0xb2, 0x6d, 0x95, 0xed, 0x60, 0x17, 0xe4, 0x22, 0xee, 0xcd, 0x5f, 0xa8, 0x67, 0xce,
+	0x53, 0x2f, 0x09, 0xf5, 0x2b, 0x5c, 0xfd, 0x03, 0xbe, 0x6a, 0xed, 0x2c, 0x2c, 0xc2, 0x87, 0x05,
+	0x36, 0x50, 0xe8, 0x3b, 0xc9, 0x8d, 0xb3, 0x12, 0x65, 0xef, 0x17, 0x35, 0xde, 0x0e, 0xda, 0xa2,
+	0x1d, 0x34, 0x7b, 0xd1, 0x0e, 0xcb, 0xd8, 0x73, 0x5c, 0x7d, 0xc1, 0x54, 0x5f, 0xbc, 0x51, 0x24,
+	0xeb, 0x22, 0x0a, 0xfd, 0x04, 0x0a, 0x03, 0xb0, 0x1b, 0xb8, 0x31, 0x75, 0x84, 0x2b, 0x2e, 0x7e,
+	0xe1, 0x5c, 0xf1, 0x9b, 0x42, 0x5c, 0xe6, 0xe2, 0x67, 0x24, 0xb8, 0x97, 0x5c, 0x62, 0x17, 0x97,
+	0xc0, 0xbc, 0xdd, 0x02, 0xeb, 0xae, 0x47, 0xf1, 0x53, 0x24, 0xaf, 0x97, 0xa5, 0xfd, 0x8d, 0x74,
+	0x37, 0x70, 0xbb, 0x6a, 0x09, 0x00, 0xbc, 0x01, 0x32, 0xd8, 0x97, 0x2f, 0x96, 0xa5, 0xfd, 0xb5,
+	0xda, 0xf6, 0x7c, 0xa6, 0x6c, 0x72, 0x18, 0xf6, 0x55, 0x2b, 0x83, 0x7d, 0xf8, 0x08, 0x80, 0x98,
+	0xba, 0x11, 0xe5, 0x01, 0x6f, 0x9c, 0x1b, 0xf0, 0x0d, 0x11, 0xf0, 0x2e, 0x97, 0x39, 0xe1, 0xf2,
+	0x48, 0x37, 0x99, 0x81, 0xc5, 0xd8, 0x06, 0x59, 0xff, 0xa4, 0xb7, 0xe5, 0x4d, 0xd6, 0x8b, 0xda,
+	0x7c, 0xa6, 0x40, 0x4e, 0x4d, 0x1d, 0xaa, 0x7f, 0xfc, 0x76, 0xb7, 0x20, 0xae, 0xb7, 0xea, 0xfb,
+	0x11, 0x8a, 0xe3, 0x0e, 0x8d, 0x70, 0xd8, 0xb7, 0xd2, 0x12, 0xb0, 0x09, 0x2e, 0x78, 0xe3, 0xe8,
+	0x29, 0x92, 0x01, 0x1b, 0xaa, 0xff, 0x7d, 0x7a, 0xa8, 0x44, 0xad, 0xf4, 0x04, 0x5d, 0xcb, 0xcf,
+	0x67, 0xca, 0x16, 0xf7, 0xc9, 0xe8, 0xaa, 0xc5, 0x65, 0x92, 0xdc, 0xbd, 0x00, 0xf7, 0x7a, 0x3c,
+	0xf7, 0xec, 0x7f, 0xcd, 0xfd, 0x84, 0x2b, 0x72, 0x67, 0x06, 0x96, 0xfb, 0x57, 0x60, 0x3b, 0xa6,
+	0x68, 0xe4, 0xf8, 0xe3, 0x88, 0x67, 0xbf, 0xc5, 0xea, 0x2f, 0xcf, 0x67, 0x4a, 0x61, 0x51, 0xb8,
+	0xd4, 0xb1, 0x6a, 0x6d, 0x25, 0xfb, 0xba, 0xd8, 0xc2, 0x7b, 0x60, 0x73, 0xe0, 0x06, 0x3d, 0x27,
+	0xc0, 0x3d, 0x24, 0x6f, 0x33, 0x6a, 0x61, 0x3e, 0x53, 0xf2, 0x9c, 0xba, 0x3c, 0x52, 0xad, 0x8d,
+	0x64, 0x6d, 0xe2, 0x1e, 0x82, 0x47, 0x60, 0x3d, 0x4e, 0x1e, 0x9e, 0x58, 0xde, 0x29, 0xaf, 0xee,
+	0x67, 0xff, 0x45, 0x71, 0xd8, 0x3b, 0x55, 0xbb, 0x2c, 0x72, 0x12, 0xdd, 0xc3, 0x35, 0x54, 0x4b,
+	0x88, 0xc1, 0xef, 0x40, 0x76, 0xe4, 0x8e, 0x93, 0x61, 0x62, 0x35, 0xca, 0x9d, 0x5b, 0xa3, 0xc5,
+	0x2c, 0x8a, 0x4b, 0x4e, 0x91, 0x79, 0x91, 0x00, 0xb7, 0x24, 0x04, 0xf5, 0x47, 0x09, 0x00, 0x8b,
+	0x05, 0xd7, 0x26, 0x24, 0x80, 0xdf, 0x83, 0x1d, 0x8f, 0x0c, 0x87, 0xe3, 0x10, 0xd3, 0x63, 0x67,
+	0x44, 0x48, 0x20, 0x4b, 0x2c, 0x95, 0xeb, 0x1f, 0x9d, 0xfc, 0x3a, 0xf2, 0xd8, 0xf0, 0x7f, 0x99,
+	0x38, 0xfc, 0xe5, 0x8d, 0xf2, 0xff, 0x3e, 0xa6, 0x83, 0x71, 0x57, 0xf3, 0xc8, 0x50, 0x3c, 0xcd,
+	0xe2, 0xdf, 0xdd, 0xd8, 0x7f, 0x22, 0x3e, 0x25, 0x82, 0x13, 0xff, 0xfc, 0xf7, 0xaf, 0xb7, 0x25,
+	0x6b, 0x7b, 0xe9, 0x2d, 0x71, 0x7f, 0xfb, 0xed, 0xc9, 0x13, 0xce, 0xfa, 0x06, 0x7e, 0x06, 0x0a,
+	0x96, 0x61, 0x1a, 0xd5, 0x8e, 0xe1, 0xe8, 0x47, 0xd6, 0x37, 0x86, 0x63, 0x36, 0x9a, 0x46, 0xd5,
+	0xca, 0xaf, 0x14, 0xaf, 0x4c, 0xa6, 0x65, 0x98, 0xc6, 0x9a, 0x38, 0x44, 0x6e, 0x04, 0x35, 0x70,
+	0xe9, 0x34, 0x43, 0x37, 0x1b, 0x07, 0x07, 0x79, 0xa9, 0x78, 0x79, 0x32, 0x2d, 0xef, 0xa6, 0x09,
+	0x7a, 0xd2, 0x2a, 0xf0, 0x0e, 0x80, 0xa7, 0xf1, 0x1d, 0xdb, 0x68, 0xe7, 0x33, 0xc5, 0xc2, 0x64,
+	0x5a, 0xce, 0xa7, 0xe1, 0x1d, 0x8a, 0x46, 0xf0, 0x21, 0xd8, 0x3b, 0x8d, 0x36, 0x1e, 0xb5, 0x5b,
+	0x4d, 0xa3, 0x69, 0x37, 0xaa, 0x66, 0x7e, 0xb5, 0x78, 0x6d, 0x32, 0x2d, 0x5f, 0x4d, 0x93, 0x8c,
+	0xe7, 0x23, 0x12, 0xa2, 0x90, 0x62, 0x37, 0x28, 0xae, 0xfd, 0xf0, 0x53, 0x69, 0xe5, 0xf6, 0xef,
+	0x19, 0x90, 0xfb, 0xe0, 0x7b, 0x03, 0xbf, 0x06, 0xa5, 0xba, 0xd1, 0xb1, 0x1b, 0xcd, 0xaa, 0xdd,
+	0x68, 0x35, 0x1d, 0xfb, 0x71, 0xdb, 0x70, 0x0e, 0x0c, 0xc3, 0xd1, 0x5b, 0xa6, 0x69, 0xe8, 0x76,
+	0x2b, 0xc9, 0x97, 0x49, 0xa7, 0x88, 0x07, 0x08, 0xe9, 0x24, 0x08, 0x90, 0x47, 0x49, 0x04, 0xab,
+	0x40, 0x39, 0x23, 0xa0, 0xb7, 0x0e, 0x0f, 0x8f, 0x9a, 0x0d, 0xfb, 0xb1, 0xd3, 0x6e, 0xb5, 0xcc,
+	0xbc, 0x54, 0xbc, 0x3e, 0x99, 0x96, 0xe5, 0x94, 0x82, 0x9e, 0x2e, 0xfd, 0x47, 0x25, 0x0e, 0x5b,
+	0xf5, 0x23, 0xd3, 0x70, 0xaa, 0xba, 0xde, 0x3a, 0x6a, 0xda, 0xf9, 0xcc, 0x19, 0x89, 0x43, 0x92,
+	0x7c, 0x8b, 0xaa, 0x9e, 0xc7, 0xde, 0xf4, 0x07, 0x40, 0x3e, 0x23, 0x51, 0xad, 0xd7, 0x2d, 0xa3,
+	0xd3, 0xc9, 0xaf, 0xf2, 0x0b, 0x4b, 0x71, 0xc5, 0x23, 0x03, 0xbf, 0x00, 0x7b, 0x1f, 0x89, 0xbd,
+	0x69, 0x5b, 0x55, 0xdd, 0xce, 0xaf, 0x15, 0xaf, 0x4e, 0xa6, 0xe5, 0x4b, 0xa7, 0xa2, 0x0e, 0x69,
+	0xe4, 0x7a, 0x94, 0x97, 0xb3, 0x76, 0xf0, 0xf2, 0x5d, 0x49, 0x7a, 0xf5, 0xae, 0x24, 0xbd, 0x7d,
+	0x57, 0x92, 0x5e, 0xbc, 0x2f, 0xad, 0xbc, 0x7a, 0x5f, 0x5a, 0x79, 0xfd, 0xbe, 0xb4, 0xf2, 0xed,
+	0x9d, 0x54, 0x33, 0x2e, 0x7f, 0xde, 0x2c, 0x17, 0xcf, 0x97, 0xbf, 0x74, 0x58, 0x5b, 0x76, 0xd7,
+	0xd9, 0x1c, 0x7d, 0xfe, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe6, 0xdd, 0x4e, 0x7f, 0x0a, 0x09,
+	0x00, 0x00,
+}
+
+func (m *ReleaseSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *ReleaseSchedule) Marshal() (dAtA []byte, err error) {
@@ -221,6 +530,71 @@ func (m *ReleaseSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PausedTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PausedTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTypes(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x7a
+	if len(m.Splits) > 0 {
+		for iNdEx := len(m.Splits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Splits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.HalfLife != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.HalfLife))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.StepDuration != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StepDuration))
+		i--
+		dAtA[i] = 0x60
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CliffTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CliffTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTypes(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x5a
+	if m.Curve != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Curve))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTypes(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.Active {
 		i--
 		if m.Active {
@@ -231,20 +605,20 @@ func (m *ReleaseSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastReleaseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastReleaseTime):])
-	if err1 != nil {
-		return 0, err1
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastReleaseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastReleaseTime):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n1
-	i = encodeVarintTypes(dAtA, i, uint64(n1))
+	i -= n4
+	i = encodeVarintTypes(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x2a
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
-	if err2 != nil {
-		return 0, err2
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n2
-	i = encodeVarintTypes(dAtA, i, uint64(n2))
+	i -= n5
+	i = encodeVarintTypes(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x1a
 	{
@@ -318,6 +692,24 @@ func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *ReleaseSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTypes(uint64(m.Type))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
 func (m *ReleaseSchedule) Size() (n int) {
 	if m == nil {
 		return 0
@@ -335,6 +727,34 @@ func (m *ReleaseSchedule) Size() (n int) {
 	if m.Active {
 		n += 2
 	}
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Curve != 0 {
+		n += 1 + sovTypes(uint64(m.Curve))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CliffTime)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.StepDuration != 0 {
+		n += 1 + sovTypes(uint64(m.StepDuration))
+	}
+	if m.HalfLife != 0 {
+		n += 1 + sovTypes(uint64(m.HalfLife))
+	}
+	if len(m.Splits) > 0 {
+		for _, e := range m.Splits {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PausedTime)
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -359,7 +779,7 @@ func sovTypes(x uint64) (n int) {
 func sozTypes(x uint64) (n int) {
 	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *ReleaseSchedule) Unmarshal(dAtA []byte) error {
+func (m *ReleaseSplit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -382,17 +802,17 @@ func (m *ReleaseSchedule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReleaseSchedule: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReleaseSplit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReleaseSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReleaseSplit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			m.Type = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -402,30 +822,16 @@ func (m *ReleaseSchedule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Type |= DestinationType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -435,30 +841,29 @@ func (m *ReleaseSchedule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ReleasedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Target = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -468,48 +873,198 @@ func (m *ReleaseSchedule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastReleaseTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReleasedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReleaseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
@@ -540,6 +1095,247 @@ func (m *ReleaseSchedule) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Active = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Curve", wireType)
+			}
+			m.Curve = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Curve |= ReleaseCurve(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CliffTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepDuration", wireType)
+			}
+			m.StepDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StepDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalfLife", wireType)
+			}
+			m.HalfLife = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalfLife |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Splits = append(m.Splits, ReleaseSplit{})
+			if err := m.Splits[len(m.Splits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PausedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
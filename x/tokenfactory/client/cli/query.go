@@ -27,6 +27,11 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdDenomAuthorityMetadata(),
 		GetCmdDenomsFromCreator(),
 		GetCmdDenomsFromAdmin(),
+		GetCmdBeforeSendHook(),
+		GetCmdDenomPaused(),
+		GetCmdAccountFrozen(),
+		GetCmdFrozenAccounts(),
+		GetCmdDenomERC20Address(),
 	)
 
 	return cmd
@@ -144,3 +149,156 @@ func GetCmdDenomsFromAdmin() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdBeforeSendHook returns the before send hook of a denom
+func GetCmdBeforeSendHook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "before-send-hook [denom] [flags]",
+		Short: "Get the CosmWasm contract called before the transfers of a denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BeforeSendHookAddress(cmd.Context(), &types.QueryBeforeSendHookAddressRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdDenomPaused returns if the transfers of a denom are paused
+func GetCmdDenomPaused() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-paused [denom] [flags]",
+		Short: "Get if the transfers of a denom are paused",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomPaused(cmd.Context(), &types.QueryDenomPausedRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdAccountFrozen returns if an address is frozen for a denom
+func GetCmdAccountFrozen() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-frozen [denom] [address] [flags]",
+		Short: "Get if an address is frozen for a denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AccountFrozen(cmd.Context(), &types.QueryAccountFrozenRequest{
+				Denom:   args[0],
+				Address: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdFrozenAccounts returns the addresses frozen for a denom
+func GetCmdFrozenAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen-accounts [denom] [flags]",
+		Short: "Get all the addresses frozen for a denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FrozenAccounts(cmd.Context(), &types.QueryFrozenAccountsRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "frozen-accounts")
+
+	return cmd
+}
+
+// GetCmdDenomERC20Address returns the ERC20 address registered for a denom
+func GetCmdDenomERC20Address() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "erc20-address [denom] [flags]",
+		Short: "Get the ERC20 address registered for a denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DenomERC20Address(cmd.Context(), &types.QueryDenomERC20AddressRequest{
+				Denom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
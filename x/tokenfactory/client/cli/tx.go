@@ -7,6 +7,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -35,11 +37,24 @@ func GetTxCmd() *cobra.Command {
 		NewForceTransferCmd(),
 		NewChangeAdminCmd(),
 		NewModifyDenomMetadataCmd(),
+		NewSetBeforeSendHookCmd(),
+		NewSetMaxSupplyCmd(),
+		NewGrantRoleCmd(),
+		NewRevokeRoleCmd(),
+		NewSetDenomPausedCmd(),
+		NewSetAccountFrozenCmd(),
 	)
 
 	return cmd
 }
 
+const (
+	// FlagMaxSupply is the flag for the max supply of a new denom
+	FlagMaxSupply = "max-supply"
+	// FlagRegisterERC20 is the flag to register a new denom as an ERC20
+	FlagRegisterERC20 = "register-erc20"
+)
+
 // NewCreateDenomCmd broadcast MsgCreateDenom
 func NewCreateDenomCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -64,10 +79,30 @@ func NewCreateDenomCmd() *cobra.Command {
 				args[0],
 			)
 
+			// The max supply is optional
+			maxSupplyStr, err := cmd.Flags().GetString(FlagMaxSupply)
+			if err != nil {
+				return err
+			}
+			if maxSupplyStr != "" {
+				maxSupply, ok := sdkmath.NewIntFromString(maxSupplyStr)
+				if !ok {
+					return fmt.Errorf("invalid max supply: %s", maxSupplyStr)
+				}
+				msg.MaxSupply = &maxSupply
+			}
+
+			msg.RegisterErc20, err = cmd.Flags().GetBool(FlagRegisterERC20)
+			if err != nil {
+				return err
+			}
+
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
 
+	cmd.Flags().String(FlagMaxSupply, "", "Optional max supply of the denom, it can only be lowered later")
+	cmd.Flags().Bool(FlagRegisterERC20, false, "Register the denom as a native coin ERC20, usable from the EVM")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -365,3 +400,232 @@ func NewModifyDenomMetadataCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewSetBeforeSendHookCmd broadcast MsgSetBeforeSendHook
+func NewSetBeforeSendHookCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-before-send-hook [denom] [cosmwasm-address] [flags]",
+		Short: "Sets the CosmWasm contract called before the transfers of a factory-created denom. Use an empty address to remove it. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgSetBeforeSendHook(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetMaxSupplyCmd broadcast MsgSetMaxSupply
+func NewSetMaxSupplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-max-supply [denom] [max-supply] [flags]",
+		Short: "Sets the max supply of a factory-created denom. Once set, it can only be lowered. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			maxSupply, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid max supply: %s", args[1])
+			}
+
+			msg := types.NewMsgSetMaxSupply(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				maxSupply,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// FlagMintAllowance is the flag for the mint allowance of a minter
+const FlagMintAllowance = "mint-allowance"
+
+// NewGrantRoleCmd broadcast MsgGrantRole
+func NewGrantRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-role [denom] [role] [address] [flags]",
+		Short: fmt.Sprintf("Grants a role over a factory-created denom, one of %s. Must have admin authority to do so.", strings.Join(types.Roles, ", ")),
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			// The mint allowance is optional
+			var mintAllowance *sdkmath.Int
+			mintAllowanceStr, err := cmd.Flags().GetString(FlagMintAllowance)
+			if err != nil {
+				return err
+			}
+			if mintAllowanceStr != "" {
+				allowance, ok := sdkmath.NewIntFromString(mintAllowanceStr)
+				if !ok {
+					return fmt.Errorf("invalid mint allowance: %s", mintAllowanceStr)
+				}
+				mintAllowance = &allowance
+			}
+
+			msg := types.NewMsgGrantRole(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				args[2],
+				mintAllowance,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMintAllowance, "", "Optional amount the minter can mint, only used by the minter role")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRevokeRoleCmd broadcast MsgRevokeRole
+func NewRevokeRoleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-role [denom] [role] [address] [flags]",
+		Short: "Revokes a role over a factory-created denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := types.NewMsgRevokeRole(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				args[2],
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetDenomPausedCmd broadcast MsgSetDenomPaused
+func NewSetDenomPausedCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-paused [denom] [paused] [flags]",
+		Short: "Pauses or resumes every transfer of a factory-created denom. Must have admin or pauser authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			paused, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid paused value %s: %w", args[1], err)
+			}
+
+			msg := types.NewMsgSetDenomPaused(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				paused,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetAccountFrozenCmd broadcast MsgSetAccountFrozen
+func NewSetAccountFrozenCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-account-frozen [denom] [address] [frozen] [flags]",
+		Short: "Freezes or unfreezes the transfers of a factory-created denom from and to an address. Must have admin or pauser authority to do so.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			frozen, err := strconv.ParseBool(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid frozen value %s: %w", args[2], err)
+			}
+
+			msg := types.NewMsgSetAccountFrozen(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				args[1],
+				frozen,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -5,6 +5,9 @@ import (
 
 	"github.com/cosmos/gogoproto/proto"
 
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kiichain/kiichain/v4/x/tokenfactory/types"
@@ -40,12 +43,43 @@ func (k Keeper) setAuthorityMetadata(ctx context.Context, denom string, metadata
 	return nil
 }
 
+// setAdmin sets the admin of a denom
+// The roles granted by the previous admin are cleared, including when the admin is renounced
+// The admin can't be renounced while the denom is paused or has frozen accounts, as nobody could lift them
 func (k Keeper) setAdmin(ctx context.Context, metadata types.DenomAuthorityMetadata, denom string, admin string) error {
+	if admin == "" && (k.IsDenomPaused(ctx, denom) || k.hasFrozenAccounts(ctx, denom)) {
+		return errorsmod.Wrapf(types.ErrRenounceRestricted, "denom %s", denom)
+	}
+
+	if metadata.Admin != admin {
+		metadata.Roles = nil
+	}
 	metadata.Admin = admin
 
 	return k.setAuthorityMetadata(ctx, denom, metadata)
 }
 
+// setMaxSupply sets the max supply of a denom
+// Once set, the max supply can only be lowered, and it can't be lower than the current supply
+func (k Keeper) setMaxSupply(ctx context.Context, metadata types.DenomAuthorityMetadata, denom string, maxSupply math.Int) error {
+	if maxSupply.IsNil() || !maxSupply.IsPositive() {
+		return errorsmod.Wrapf(types.ErrInvalidMaxSupply, "max supply must be positive, got %s", maxSupply)
+	}
+
+	if metadata.MaxSupply != nil && maxSupply.GT(*metadata.MaxSupply) {
+		return errorsmod.Wrapf(types.ErrInvalidMaxSupply, "max supply can only be lowered, current max supply is %s", metadata.MaxSupply)
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, denom)
+	if maxSupply.LT(supply.Amount) {
+		return errorsmod.Wrapf(types.ErrInvalidMaxSupply, "max supply can't be lower than the current supply %s", supply.Amount)
+	}
+
+	metadata.MaxSupply = &maxSupply
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
 // GetDenomsFromAdmin returns all denoms for which the provided address is the admin
 func (k Keeper) GetDenomsFromAdmin(ctx context.Context, admin string) ([]string, error) {
 	iterator := k.GetAllDenomsIterator(ctx)
@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"fmt"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -584,3 +586,102 @@ func (suite *KeeperTestSuite) TestSetDenomMetaData() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMaxSupply() {
+	// Create a denom with a max supply
+	maxSupply := sdkmath.NewInt(100)
+	createMsg := types.NewMsgCreateDenom(suite.TestAccs[0].String(), "capped")
+	createMsg.MaxSupply = &maxSupply
+	res, err := suite.msgServer.CreateDenom(suite.Ctx, createMsg)
+	suite.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+
+	// The max supply is returned with the authority metadata
+	queryRes, err := suite.queryClient.DenomAuthorityMetadata(suite.Ctx.Context(), &types.QueryDenomAuthorityMetadataRequest{
+		Denom: denom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(queryRes.AuthorityMetadata.MaxSupply)
+	suite.Require().Equal("100", queryRes.AuthorityMetadata.MaxSupply.String())
+
+	// Mints are allowed up to the max supply
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(denom, 60)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(denom, 50)))
+	suite.Require().ErrorIs(err, types.ErrMaxSupplyExceeded)
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(denom, 40)))
+	suite.Require().NoError(err)
+
+	// Burning frees room under the max supply
+	_, err = suite.msgServer.Burn(suite.Ctx, types.NewMsgBurn(suite.TestAccs[0].String(), sdk.NewInt64Coin(denom, 30)))
+	suite.Require().NoError(err)
+
+	for _, tc := range []struct {
+		desc      string
+		sender    string
+		maxSupply sdkmath.Int
+		expErr    error
+	}{
+		{
+			desc:      "only the admin can set the max supply",
+			sender:    suite.TestAccs[1].String(),
+			maxSupply: sdkmath.NewInt(80),
+			expErr:    types.ErrUnauthorized,
+		},
+		{
+			desc:      "max supply can't be raised",
+			sender:    suite.TestAccs[0].String(),
+			maxSupply: sdkmath.NewInt(200),
+			expErr:    types.ErrInvalidMaxSupply,
+		},
+		{
+			desc:      "max supply can't be lower than the supply",
+			sender:    suite.TestAccs[0].String(),
+			maxSupply: sdkmath.NewInt(69),
+			expErr:    types.ErrInvalidMaxSupply,
+		},
+		{
+			desc:      "max supply can be lowered",
+			sender:    suite.TestAccs[0].String(),
+			maxSupply: sdkmath.NewInt(80),
+		},
+		{
+			desc:      "max supply can be lowered to the supply",
+			sender:    suite.TestAccs[0].String(),
+			maxSupply: sdkmath.NewInt(70),
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			_, err := suite.msgServer.SetMaxSupply(suite.Ctx, types.NewMsgSetMaxSupply(tc.sender, denom, tc.maxSupply))
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			metadata, err := suite.App.TokenFactoryKeeper.GetAuthorityMetadata(suite.Ctx, denom)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.maxSupply.String(), metadata.MaxSupply.String())
+		})
+	}
+
+	// The max supply is reached, so no more tokens can be minted
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(denom, 1)))
+	suite.Require().ErrorIs(err, types.ErrMaxSupplyExceeded)
+
+	// Changing the admin keeps the max supply
+	_, err = suite.msgServer.ChangeAdmin(suite.Ctx, types.NewMsgChangeAdmin(suite.TestAccs[0].String(), denom, suite.TestAccs[1].String()))
+	suite.Require().NoError(err)
+	metadata, err := suite.App.TokenFactoryKeeper.GetAuthorityMetadata(suite.Ctx, denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal("70", metadata.MaxSupply.String())
+
+	// A denom created without a max supply can get one later
+	suite.CreateDefaultDenom()
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 1000)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetMaxSupply(suite.Ctx, types.NewMsgSetMaxSupply(suite.TestAccs[0].String(), suite.defaultDenom, sdkmath.NewInt(1000)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 1)))
+	suite.Require().ErrorIs(err, types.ErrMaxSupplyExceeded)
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/gogo/status"
 	"google.golang.org/grpc/codes"
 
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kiichain/kiichain/v4/x/tokenfactory/types"
@@ -19,6 +21,19 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		return err
 	}
 
+	// verify that the mint doesn't exceed the max supply
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, amount.Denom)
+	if err != nil {
+		return err
+	}
+	if authorityMetadata.MaxSupply != nil {
+		supply := k.bankKeeper.GetSupply(ctx, amount.Denom)
+		if supply.Amount.Add(amount.Amount).GT(*authorityMetadata.MaxSupply) {
+			return errorsmod.Wrapf(types.ErrMaxSupplyExceeded, "minting %s would exceed the max supply %s, current supply is %s",
+				amount, authorityMetadata.MaxSupply, supply.Amount)
+		}
+	}
+
 	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
 	if err != nil {
 		return err
@@ -33,7 +48,8 @@ func (k Keeper) mintTo(ctx sdk.Context, amount sdk.Coin, mintTo string) error {
 		return fmt.Errorf("failed to mint to blocked address: %s", addr)
 	}
 
-	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName,
+	// The mint is made by the issuer, so it is not blocked by a pause or a freeze
+	return k.bankKeeper.SendCoinsFromModuleToAccount(withIssuerAction(ctx), types.ModuleName,
 		addr,
 		sdk.NewCoins(amount))
 }
@@ -54,7 +70,8 @@ func (k Keeper) burnFrom(ctx sdk.Context, amount sdk.Coin, burnFrom string) erro
 		return fmt.Errorf("failed to burn from blocked address: %s", addr)
 	}
 
-	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx,
+	// The burn is made by the issuer, so it is not blocked by a pause or a freeze
+	err = k.bankKeeper.SendCoinsFromAccountToModule(withIssuerAction(ctx),
 		addr,
 		types.ModuleName,
 		sdk.NewCoins(amount))
@@ -103,5 +120,6 @@ func (k Keeper) forceTransfer(ctx sdk.Context, amount sdk.Coin, fromAddr string,
 		return fmt.Errorf("failed to force transfer to blocked address: %s", toSdkAddr)
 	}
 
-	return k.bankKeeper.SendCoins(ctx, fromSdkAddr, toSdkAddr, sdk.NewCoins(amount))
+	// The force transfer is made by the issuer, so it is not blocked by a pause or a freeze
+	return k.bankKeeper.SendCoins(withIssuerAction(ctx), fromSdkAddr, toSdkAddr, sdk.NewCoins(amount))
 }
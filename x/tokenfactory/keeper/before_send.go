@@ -0,0 +1,151 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/kiichain/kiichain/v4/x/tokenfactory/types"
+)
+
+// Ensure the restriction matches the bank send restriction signature
+var _ banktypes.SendRestrictionFn = Keeper{}.BeforeSendRestriction
+
+// setBeforeSendHook sets the CosmWasm contract called before the transfers of a denom
+// An empty address removes the hook
+func (k Keeper) setBeforeSendHook(ctx context.Context, denom string, cosmwasmAddress string) error {
+	// Verify that the denom is a tokenfactory denom
+	if _, _, err := types.DeconstructDenom(denom); err != nil {
+		return err
+	}
+
+	store := k.GetDenomPrefixStore(sdk.UnwrapSDKContext(ctx), denom)
+
+	// Delete the hook when the address is empty
+	if cosmwasmAddress == "" {
+		store.Delete([]byte(types.BeforeSendHookAddressKey))
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(cosmwasmAddress); err != nil {
+		return err
+	}
+
+	store.Set([]byte(types.BeforeSendHookAddressKey), []byte(cosmwasmAddress))
+	return nil
+}
+
+// GetBeforeSendHook returns the CosmWasm contract called before the transfers of a denom
+// The address is empty if no hook is set
+func (k Keeper) GetBeforeSendHook(ctx context.Context, denom string) string {
+	bz := k.GetDenomPrefixStore(sdk.UnwrapSDKContext(ctx), denom).Get([]byte(types.BeforeSendHookAddressKey))
+	return string(bz)
+}
+
+// BeforeSendRestriction is the bank send restriction calling the before send hooks of the sent denoms
+// Every hook is called with track_before_send, its errors are ignored and its state changes reverted. Transfers sent by users also call
+// block_before_send, its errors block the transfer. Transfers sent by module accounts are never blocked,
+// so a misbehaving hook can't stop the module flows, such as fee distribution, for the other denoms
+func (k Keeper) BeforeSendRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	// Hooks can't be called before the wasm keeper is set
+	if k.contractKeeper == nil {
+		return toAddr, nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// The sender account is only read once a hook is found, so the transfers without hooks don't pay for it
+	senderResolved, blockBeforeSend := false, false
+	for _, coin := range amt {
+		// Only the tokenfactory denoms can have a hook
+		if _, _, err := types.DeconstructDenom(coin.Denom); err != nil {
+			continue
+		}
+		cosmwasmAddress := k.GetBeforeSendHook(sdkCtx, coin.Denom)
+		if cosmwasmAddress == "" {
+			continue
+		}
+		contractAddr, err := sdk.AccAddressFromBech32(cosmwasmAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		// Track the transfer on a cached context, the state changes of a failed call are dropped
+		// and the errors are only logged
+		trackCtx, write := sdkCtx.CacheContext()
+		if err := k.callBeforeSendHook(trackCtx, contractAddr, fromAddr, toAddr, coin, false); err != nil {
+			k.Logger(sdkCtx).Debug("track before send hook failed", "denom", coin.Denom, "error", err)
+		} else {
+			write()
+		}
+
+		// Let the contract block the transfer
+		if !senderResolved {
+			blockBeforeSend = !k.isModuleAccount(sdkCtx, fromAddr)
+			senderResolved = true
+		}
+		if blockBeforeSend {
+			if err := k.callBeforeSendHook(sdkCtx, contractAddr, fromAddr, toAddr, coin, true); err != nil {
+				return nil, errorsmod.Wrapf(err, "failed to call before send hook for denom %s", coin.Denom)
+			}
+		}
+	}
+
+	return toAddr, nil
+}
+
+// callBeforeSendHook calls the sudo entry point of a hook contract with a gas limit
+// The gas used is charged on the parent context, running out of gas on the hook returns an error
+func (k Keeper) callBeforeSendHook(
+	ctx sdk.Context,
+	contractAddr, fromAddr, toAddr sdk.AccAddress,
+	coin sdk.Coin,
+	blockBeforeSend bool,
+) (err error) {
+	// Build the sudo message
+	beforeSendMsg := types.BeforeSendMsg{
+		From:   fromAddr.String(),
+		To:     toAddr.String(),
+		Amount: wasmvmtypes.Coin{Denom: coin.Denom, Amount: coin.Amount.String()},
+	}
+	var msgBz []byte
+	if blockBeforeSend {
+		msgBz, err = json.Marshal(types.BlockBeforeSendSudoMsg{BlockBeforeSend: beforeSendMsg})
+	} else {
+		msgBz, err = json.Marshal(types.TrackBeforeSendSudoMsg{TrackBeforeSend: beforeSendMsg})
+	}
+	if err != nil {
+		return err
+	}
+
+	// Call the contract on a child context with its own gas meter and events
+	childCtx := ctx.WithGasMeter(storetypes.NewGasMeter(types.BeforeSendHookGasLimit)).WithEventManager(sdk.NewEventManager())
+	defer func() {
+		// Charge the gas used on the parent context
+		ctx.GasMeter().ConsumeGas(childCtx.GasMeter().GasConsumedToLimit(), "before send hook")
+
+		// Recover from running out of gas, other panics are not expected
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = types.ErrBeforeSendHookOutOfGas
+		}
+	}()
+
+	_, err = k.contractKeeper.Sudo(childCtx, contractAddr, msgBz)
+	return err
+}
+
+// isModuleAccount returns true if the address is a module account
+func (k Keeper) isModuleAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	_, ok := k.accountKeeper.GetAccount(ctx, addr).(sdk.ModuleAccountI)
+	return ok
+}
@@ -0,0 +1,286 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/kiichain/kiichain/v4/x/tokenfactory/keeper"
+	"github.com/kiichain/kiichain/v4/x/tokenfactory/types"
+)
+
+// mockContractKeeper is a contract keeper recording the before send hook calls
+type mockContractKeeper struct {
+	// calls are the sudo messages received
+	calls []map[string]types.BeforeSendMsg
+	// blockAmount is the amount blocked by block_before_send
+	blockAmount string
+	// outOfGas makes every call run out of gas
+	outOfGas bool
+	// trackFails makes track_before_send fail after onCall
+	trackFails bool
+	// onCall is run on every call, to change the state as a contract would
+	onCall func(ctx sdk.Context)
+}
+
+func (m *mockContractKeeper) Sudo(ctx context.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	if m.outOfGas {
+		sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(types.BeforeSendHookGasLimit+1, "mock hook")
+	}
+
+	var call map[string]types.BeforeSendMsg
+	if err := json.Unmarshal(msg, &call); err != nil {
+		return nil, err
+	}
+	m.calls = append(m.calls, call)
+	if m.onCall != nil {
+		m.onCall(sdk.UnwrapSDKContext(ctx))
+	}
+
+	if _, ok := call["track_before_send"]; ok && m.trackFails {
+		return nil, errors.New("track failed")
+	}
+	if blockMsg, ok := call["block_before_send"]; ok && blockMsg.Amount.Amount == m.blockAmount {
+		return nil, errors.New("transfer blocked")
+	}
+	return nil, nil
+}
+
+func (m *mockContractKeeper) HasContractInfo(_ context.Context, _ sdk.AccAddress) bool {
+	return true
+}
+
+// setupBeforeSendHook creates the default denom and sets a hook using a mock contract keeper
+func (suite *KeeperTestSuite) setupBeforeSendHook() (keeper.Keeper, *mockContractKeeper) {
+	suite.CreateDefaultDenom()
+
+	contractKeeper := &mockContractKeeper{}
+	tokenFactoryKeeper := suite.App.TokenFactoryKeeper
+	tokenFactoryKeeper.SetContractKeeper(contractKeeper)
+	suite.OverrideMsgServer(tokenFactoryKeeper)
+
+	_, err := suite.msgServer.SetBeforeSendHook(suite.Ctx, types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), suite.defaultDenom, suite.TestAccs[2].String()))
+	suite.Require().NoError(err)
+
+	return tokenFactoryKeeper, contractKeeper
+}
+
+func (suite *KeeperTestSuite) TestMsgSetBeforeSendHook() {
+	suite.CreateDefaultDenom()
+
+	// A hook must be a contract
+	_, err := suite.msgServer.SetBeforeSendHook(suite.Ctx, types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), suite.defaultDenom, suite.TestAccs[2].String()))
+	suite.Require().ErrorIs(err, types.ErrInvalidBeforeSendHook)
+
+	// Use a contract keeper accepting every address
+	tokenFactoryKeeper := suite.App.TokenFactoryKeeper
+	tokenFactoryKeeper.SetContractKeeper(&mockContractKeeper{})
+	suite.OverrideMsgServer(tokenFactoryKeeper)
+
+	// Only the admin can set the hook
+	_, err = suite.msgServer.SetBeforeSendHook(suite.Ctx, types.NewMsgSetBeforeSendHook(suite.TestAccs[1].String(), suite.defaultDenom, suite.TestAccs[2].String()))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// Set the hook
+	_, err = suite.msgServer.SetBeforeSendHook(suite.Ctx, types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), suite.defaultDenom, suite.TestAccs[2].String()))
+	suite.Require().NoError(err)
+
+	queryRes, err := suite.queryClient.BeforeSendHookAddress(suite.Ctx.Context(), &types.QueryBeforeSendHookAddressRequest{
+		Denom: suite.defaultDenom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.TestAccs[2].String(), queryRes.CosmwasmAddress)
+
+	// Remove the hook
+	_, err = suite.msgServer.SetBeforeSendHook(suite.Ctx, types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), suite.defaultDenom, ""))
+	suite.Require().NoError(err)
+
+	queryRes, err = suite.queryClient.BeforeSendHookAddress(suite.Ctx.Context(), &types.QueryBeforeSendHookAddressRequest{
+		Denom: suite.defaultDenom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(queryRes.CosmwasmAddress)
+
+	// The hook can't be set without the capability
+	tokenFactoryKeeper.SetEnabledCapabilities(suite.Ctx, []string{})
+	suite.OverrideMsgServer(tokenFactoryKeeper)
+	_, err = suite.msgServer.SetBeforeSendHook(suite.Ctx, types.NewMsgSetBeforeSendHook(suite.TestAccs[0].String(), suite.defaultDenom, suite.TestAccs[2].String()))
+	suite.Require().ErrorIs(err, types.ErrCapabilityNotEnabled)
+}
+
+func (suite *KeeperTestSuite) TestBeforeSendRestriction() {
+	tokenFactoryKeeper, contractKeeper := suite.setupBeforeSendHook()
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	suite.App.AccountKeeper.GetModuleAccount(suite.Ctx, types.ModuleName)
+
+	for _, tc := range []struct {
+		desc        string
+		from        sdk.AccAddress
+		amount      sdk.Coins
+		blockAmount string
+		outOfGas    bool
+		expCalls    []string
+		expErr      error
+	}{
+		{
+			desc:     "user transfer calls track and block",
+			from:     suite.TestAccs[0],
+			amount:   sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10)),
+			expCalls: []string{"track_before_send", "block_before_send"},
+		},
+		{
+			desc:        "user transfer blocked by the hook",
+			from:        suite.TestAccs[0],
+			amount:      sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10)),
+			blockAmount: "10",
+			expCalls:    []string{"track_before_send", "block_before_send"},
+			expErr:      errors.New("transfer blocked"),
+		},
+		{
+			desc:        "module transfer is only tracked",
+			from:        moduleAddr,
+			amount:      sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10)),
+			blockAmount: "10",
+			expCalls:    []string{"track_before_send"},
+		},
+		{
+			desc:        "other denoms don't call the hook",
+			from:        suite.TestAccs[0],
+			amount:      sdk.NewCoins(sdk.NewInt64Coin("utwo", 10)),
+			blockAmount: "10",
+		},
+		{
+			desc:     "hook out of gas",
+			from:     suite.TestAccs[0],
+			amount:   sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10)),
+			outOfGas: true,
+			expErr:   types.ErrBeforeSendHookOutOfGas,
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			contractKeeper.calls = nil
+			contractKeeper.blockAmount = tc.blockAmount
+			contractKeeper.outOfGas = tc.outOfGas
+
+			ctx := suite.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			toAddr, err := tokenFactoryKeeper.BeforeSendRestriction(ctx, tc.from, suite.TestAccs[1], tc.amount)
+			if tc.expErr != nil {
+				suite.Require().ErrorContains(err, tc.expErr.Error())
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Equal(suite.TestAccs[1], toAddr)
+			}
+
+			// Check the calls made to the hook
+			suite.Require().Len(contractKeeper.calls, len(tc.expCalls))
+			for i, expCall := range tc.expCalls {
+				msg, ok := contractKeeper.calls[i][expCall]
+				suite.Require().True(ok, "expected %s call", expCall)
+				suite.Require().Equal(tc.from.String(), msg.From)
+				suite.Require().Equal(suite.TestAccs[1].String(), msg.To)
+				suite.Require().Equal(tc.amount[0].Amount.String(), msg.Amount.Amount)
+			}
+
+			// The gas used by the hook is charged, up to its limit
+			if tc.outOfGas {
+				suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), uint64(types.BeforeSendHookGasLimit))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestBeforeSendRestrictionGas() {
+	tokenFactoryKeeper, contractKeeper := suite.setupBeforeSendHook()
+	contractKeeper.calls = nil
+
+	// The transfers of the other denoms don't read the store
+	ctx := suite.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	_, err := tokenFactoryKeeper.BeforeSendRestriction(ctx, suite.TestAccs[0], suite.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin("utwo", 10)))
+	suite.Require().NoError(err)
+	suite.Require().Zero(ctx.GasMeter().GasConsumed())
+
+	// The denoms without a hook only read the hook address
+	moonDenom, err := types.GetTokenDenom(suite.TestAccs[0].String(), "moon")
+	suite.Require().NoError(err)
+	ctx = suite.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	_, err = tokenFactoryKeeper.BeforeSendRestriction(ctx, suite.TestAccs[0], suite.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin(moonDenom, 10)))
+	suite.Require().NoError(err)
+	hookReadGas := ctx.GasMeter().GasConsumed()
+	ctx = suite.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	tokenFactoryKeeper.GetBeforeSendHook(ctx, moonDenom)
+	suite.Require().Equal(ctx.GasMeter().GasConsumed(), hookReadGas)
+	suite.Require().Empty(contractKeeper.calls)
+}
+
+func (suite *KeeperTestSuite) TestBeforeSendTrackHookFailure() {
+	tokenFactoryKeeper, contractKeeper := suite.setupBeforeSendHook()
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	suite.App.AccountKeeper.GetModuleAccount(suite.Ctx, types.ModuleName)
+
+	for _, tc := range []struct {
+		desc       string
+		trackFails bool
+	}{
+		{
+			desc: "track state changes are kept",
+		},
+		{
+			desc:       "failed track state changes are reverted",
+			trackFails: true,
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			ctx, _ := suite.Ctx.CacheContext()
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+			// The track call creates an account before returning
+			// Transfers sent by module accounts only call the track hook
+			tracked := sdk.AccAddress("tracked_account_____")
+			contractKeeper.calls = nil
+			contractKeeper.trackFails = tc.trackFails
+			contractKeeper.onCall = func(ctx sdk.Context) {
+				suite.App.AccountKeeper.SetAccount(ctx, suite.App.AccountKeeper.NewAccountWithAddress(ctx, tracked))
+			}
+			defer func() {
+				contractKeeper.trackFails = false
+				contractKeeper.onCall = nil
+			}()
+
+			// A failing track hook doesn't block the transfer
+			_, err := tokenFactoryKeeper.BeforeSendRestriction(ctx, moduleAddr, suite.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10)))
+			suite.Require().NoError(err)
+			suite.Require().Len(contractKeeper.calls, 1)
+
+			// The account is only kept if the track call succeeded
+			suite.Require().Equal(!tc.trackFails, suite.App.AccountKeeper.HasAccount(ctx, tracked))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestBeforeSendHookOnBankSend() {
+	suite.setupBeforeSendHook()
+
+	// Minting sends the tokens from the module account, so it is not blocked by the hook
+	_, err := suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 100)))
+	suite.Require().NoError(err)
+
+	// The hook is not a contract, so every user transfer of the denom fails on the app keepers
+	// The send runs on a cached context, as a failed transaction would be reverted
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	err = suite.App.BankKeeper.SendCoins(cacheCtx, suite.TestAccs[0], suite.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().Error(err)
+
+	// Transfers of other denoms are not affected
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, suite.TestAccs[0], suite.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin("utwo", 10)))
+	suite.Require().NoError(err)
+
+	// Minting to another account still works
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMintTo(suite.TestAccs[0].String(), sdk.NewInt64Coin(suite.defaultDenom, 10), suite.TestAccs[1].String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(10), suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], suite.defaultDenom).Amount.Int64())
+}
@@ -79,26 +79,9 @@ func (k Keeper) chargeForCreateDenom(ctx sdk.Context, creatorAddr string, _ stri
 	// if DenomCreationFee is non-zero, transfer the tokens from the creator
 	// account to community pool
 	if params.DenomCreationFee != nil {
-		accAddr, err := sdk.AccAddressFromBech32(creatorAddr)
-		if err != nil {
+		if err := k.chargeFee(ctx, creatorAddr, params.DenomCreationFee); err != nil {
 			return err
 		}
-
-		if types.IsCapabilityEnabled(k.enabledCapabilities, types.EnableCommunityPoolFeeFunding) {
-			if err := k.communityPoolKeeper.FundCommunityPool(ctx, params.DenomCreationFee, accAddr); err != nil {
-				return err
-			}
-		} else {
-			err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddr, types.ModuleName, params.DenomCreationFee)
-			if err != nil {
-				return err
-			}
-
-			err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, params.DenomCreationFee)
-			if err != nil {
-				return err
-			}
-		}
 	}
 
 	// if DenomCreationGasConsume is non-zero, consume the gas
@@ -108,3 +91,23 @@ func (k Keeper) chargeForCreateDenom(ctx sdk.Context, creatorAddr string, _ stri
 
 	return nil
 }
+
+// chargeFee transfers a fee from the creator account to the community pool,
+// or burns it if the community pool funding capability is disabled
+func (k Keeper) chargeFee(ctx sdk.Context, creatorAddr string, fee sdk.Coins) error {
+	accAddr, err := sdk.AccAddressFromBech32(creatorAddr)
+	if err != nil {
+		return err
+	}
+
+	if types.IsCapabilityEnabled(k.enabledCapabilities, types.EnableCommunityPoolFeeFunding) {
+		return k.communityPoolKeeper.FundCommunityPool(ctx, fee, accAddr)
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, accAddr, types.ModuleName, fee)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, fee)
+}
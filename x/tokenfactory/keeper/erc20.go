@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+
+	"github.com/kiichain/kiichain/v4/x/tokenfactory/types"
+)
+
+// registerERC20 registers a denom as a native coin ERC20 with the erc20 module
+// The ERC20 is a dynamic precompile owned by the erc20 module, its name, symbol and decimals
+// are read from the bank metadata of the denom, so they follow the SetDenomMetadata updates
+// The registration must be enabled by the module params and the erc20 module must be enabled
+// Each registration adds a dynamic precompile to the erc20 params that is never removed, this
+// growth is priced by the ERC20 registration fee charged to the creator
+func (k Keeper) registerERC20(ctx sdk.Context, creatorAddr string, denom string) (common.Address, error) {
+	params := k.GetParams(ctx)
+	if !params.EnableErc20Registration {
+		return common.Address{}, errorsmod.Wrap(types.ErrERC20Registration, "ERC20 registration is disabled")
+	}
+	if !k.erc20Keeper.IsERC20Enabled(ctx) {
+		return common.Address{}, errorsmod.Wrap(types.ErrERC20Registration, "erc20 module is disabled")
+	}
+
+	if k.erc20Keeper.IsDenomRegistered(ctx, denom) {
+		return common.Address{}, errorsmod.Wrapf(types.ErrERC20Registration, "denom %s is already registered", denom)
+	}
+
+	erc20Address := types.GetERC20Address(denom)
+	if k.erc20Keeper.IsERC20Registered(ctx, erc20Address) {
+		return common.Address{}, errorsmod.Wrapf(types.ErrERC20Registration, "ERC20 %s is already registered", erc20Address)
+	}
+
+	// Charge the registration fee
+	if !params.Erc20RegistrationFee.IsZero() {
+		if err := k.chargeFee(ctx, creatorAddr, params.Erc20RegistrationFee); err != nil {
+			return common.Address{}, err
+		}
+	}
+
+	// Store the token pair, as the erc20 module does for the IBC coins
+	pair := erc20types.NewTokenPair(erc20Address, denom, erc20types.OWNER_MODULE)
+	k.erc20Keeper.SetTokenPair(ctx, pair)
+	k.erc20Keeper.SetDenomMap(ctx, pair.Denom, pair.GetID())
+	k.erc20Keeper.SetERC20Map(ctx, erc20Address, pair.GetID())
+
+	// Enable the ERC20 precompile for the pair
+	if err := k.erc20Keeper.EnableDynamicPrecompiles(ctx, erc20Address); err != nil {
+		return common.Address{}, errorsmod.Wrap(types.ErrERC20Registration, err.Error())
+	}
+
+	return erc20Address, nil
+}
+
+// GetDenomERC20Address returns the ERC20 address registered for a denom
+// The address is empty if the denom is not registered as an ERC20
+func (k Keeper) GetDenomERC20Address(ctx sdk.Context, denom string) string {
+	id := k.erc20Keeper.GetTokenPairID(ctx, denom)
+	if len(id) == 0 {
+		return ""
+	}
+
+	pair, found := k.erc20Keeper.GetTokenPair(ctx, id)
+	if !found {
+		return ""
+	}
+
+	return pair.Erc20Address
+}
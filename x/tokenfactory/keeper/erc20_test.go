@@ -0,0 +1,178 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/evm/contracts"
+
+	"github.com/kiichain/kiichain/v4/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestCreateDenomRegisterERC20() {
+	suite.SetupTest()
+	admin := suite.TestAccs[0]
+
+	// Enable the ERC20 registration
+	params := suite.App.TokenFactoryKeeper.GetParams(suite.Ctx)
+	params.EnableErc20Registration = true
+	err := suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params)
+	suite.Require().NoError(err)
+
+	// Denoms are not registered by default
+	res, err := suite.msgServer.CreateDenom(suite.Ctx, types.NewMsgCreateDenom(admin.String(), "moon"))
+	suite.Require().NoError(err)
+	queryRes, err := suite.queryClient.DenomERC20Address(suite.Ctx.Context(), &types.QueryDenomERC20AddressRequest{
+		Denom: res.GetNewTokenDenom(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Empty(queryRes.Erc20Address)
+
+	// Create a denom registered as an ERC20
+	msg := types.NewMsgCreateDenom(admin.String(), "sun")
+	msg.RegisterErc20 = true
+	res, err = suite.msgServer.CreateDenom(suite.Ctx, msg)
+	suite.Require().NoError(err)
+	denom := res.GetNewTokenDenom()
+	erc20Address := types.GetERC20Address(denom)
+
+	// The token pair is registered on the erc20 module and returned by the query
+	queryRes, err = suite.queryClient.DenomERC20Address(suite.Ctx.Context(), &types.QueryDenomERC20AddressRequest{
+		Denom: denom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(erc20Address.Hex(), queryRes.Erc20Address)
+	suite.Require().True(suite.App.Erc20Keeper.IsDenomRegistered(suite.Ctx, denom))
+	suite.Require().True(suite.App.Erc20Keeper.GetParams(suite.Ctx).IsDynamicPrecompile(erc20Address))
+
+	// Set the metadata and mint some tokens
+	_, err = suite.msgServer.SetDenomMetadata(suite.Ctx, types.NewMsgSetDenomMetadata(admin.String(), banktypes.Metadata{
+		Description: "the sun token",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: denom, Exponent: 0},
+			{Denom: "sun", Exponent: 6},
+		},
+		Base:    denom,
+		Display: "sun",
+		Name:    "Sun",
+		Symbol:  "SUN",
+	}))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(denom, 1000)))
+	suite.Require().NoError(err)
+
+	// The EVM calls need the block proposer
+	validators, err := suite.App.StakingKeeper.GetAllValidators(suite.Ctx)
+	suite.Require().NoError(err)
+	valConsAddr, err := validators[0].GetConsAddr()
+	suite.Require().NoError(err)
+	ctx := suite.Ctx.WithProposer(valConsAddr)
+
+	// The ERC20 follows the denom metadata and the bank balances
+	from := common.BytesToAddress(admin)
+	erc20ABI := contracts.ERC20MinterBurnerDecimalsContract.ABI
+	for _, tc := range []struct {
+		method   string
+		args     []interface{}
+		expValue interface{}
+	}{
+		{method: "symbol", expValue: "SUN"},
+		{method: "decimals", expValue: uint8(6)},
+		{method: "balanceOf", args: []interface{}{from}, expValue: big.NewInt(1000)},
+	} {
+		evmRes, err := suite.App.EVMKeeper.CallEVM(ctx, erc20ABI, from, erc20Address, false, tc.method, tc.args...)
+		suite.Require().NoError(err, tc.method)
+		values, err := erc20ABI.Unpack(tc.method, evmRes.Ret)
+		suite.Require().NoError(err, tc.method)
+		suite.Require().Equal(tc.expValue, values[0], tc.method)
+	}
+
+	// A denom can't be registered twice
+	_, err = suite.msgServer.CreateDenom(suite.Ctx, msg)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestCreateDenomRegisterERC20Disabled() {
+	for _, tc := range []struct {
+		desc               string
+		enableRegistration bool
+		disableErc20       bool
+	}{
+		{
+			desc: "registration disabled by default",
+		},
+		{
+			desc:               "erc20 module disabled",
+			enableRegistration: true,
+			disableErc20:       true,
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			suite.SetupTest()
+			admin := suite.TestAccs[0]
+
+			params := suite.App.TokenFactoryKeeper.GetParams(suite.Ctx)
+			params.EnableErc20Registration = tc.enableRegistration
+			err := suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params)
+			suite.Require().NoError(err)
+			if tc.disableErc20 {
+				erc20Params := suite.App.Erc20Keeper.GetParams(suite.Ctx)
+				erc20Params.EnableErc20 = false
+				err = suite.App.Erc20Keeper.SetParams(suite.Ctx, erc20Params)
+				suite.Require().NoError(err)
+			}
+
+			// The registration fails, the message runs on a cached context as a failed transaction would be reverted
+			msg := types.NewMsgCreateDenom(admin.String(), "sun")
+			msg.RegisterErc20 = true
+			cacheCtx, _ := suite.Ctx.CacheContext()
+			_, err = suite.msgServer.CreateDenom(cacheCtx, msg)
+			suite.Require().ErrorIs(err, types.ErrERC20Registration)
+
+			// The denom can still be created without the registration
+			res, err := suite.msgServer.CreateDenom(suite.Ctx, types.NewMsgCreateDenom(admin.String(), "sun"))
+			suite.Require().NoError(err)
+			suite.Require().False(suite.App.Erc20Keeper.IsDenomRegistered(suite.Ctx, res.GetNewTokenDenom()))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestCreateDenomRegisterERC20Fee() {
+	suite.SetupTest()
+	admin := suite.TestAccs[0]
+
+	// Enable the ERC20 registration with a fee
+	registrationFee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5_000_000))
+	params := suite.App.TokenFactoryKeeper.GetParams(suite.Ctx)
+	params.EnableErc20Registration = true
+	params.Erc20RegistrationFee = registrationFee
+	err := suite.App.TokenFactoryKeeper.SetParams(suite.Ctx, params)
+	suite.Require().NoError(err)
+
+	// The creator pays both the denom creation fee and the registration fee
+	balanceBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, admin, sdk.DefaultBondDenom)
+	msg := types.NewMsgCreateDenom(admin.String(), "sun")
+	msg.RegisterErc20 = true
+	_, err = suite.msgServer.CreateDenom(suite.Ctx, msg)
+	suite.Require().NoError(err)
+	balanceAfter := suite.App.BankKeeper.GetBalance(suite.Ctx, admin, sdk.DefaultBondDenom)
+	expectedFee := params.DenomCreationFee.Add(registrationFee...).AmountOf(sdk.DefaultBondDenom)
+	suite.Require().Equal(expectedFee, balanceBefore.Amount.Sub(balanceAfter.Amount))
+
+	// A creator that can only pay the denom creation fee can't register the ERC20
+	poorAccount := sdk.AccAddress([]byte("poor_account________"))
+	suite.FundAcc(poorAccount, params.DenomCreationFee)
+	msg = types.NewMsgCreateDenom(poorAccount.String(), "sun")
+	msg.RegisterErc20 = true
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	_, err = suite.msgServer.CreateDenom(cacheCtx, msg)
+	suite.Require().Error(err)
+
+	// The denom can still be created without the registration
+	_, err = suite.msgServer.CreateDenom(suite.Ctx, types.NewMsgCreateDenom(poorAccount.String(), "sun"))
+	suite.Require().NoError(err)
+}
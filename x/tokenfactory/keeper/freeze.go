@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/kiichain/kiichain/v4/x/tokenfactory/types"
+)
+
+// Ensure the restriction matches the bank send restriction signature
+var _ banktypes.SendRestrictionFn = Keeper{}.FreezeSendRestriction
+
+// issuerActionKey is the context key marking the transfers made by the denom issuer
+type issuerActionKey struct{}
+
+// withIssuerAction marks the context transfers as made by the denom issuer, such as
+// mints, burns and force transfers, so they are not blocked by a pause or a freeze
+func withIssuerAction(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(issuerActionKey{}, true)
+}
+
+// isIssuerAction returns true if the context transfers are made by the denom issuer
+func isIssuerAction(ctx sdk.Context) bool {
+	issuerAction, _ := ctx.Value(issuerActionKey{}).(bool)
+	return issuerAction
+}
+
+// setDenomPaused pauses or resumes the transfers of a denom
+func (k Keeper) setDenomPaused(ctx context.Context, denom string, paused bool) error {
+	// Verify that the denom is a tokenfactory denom
+	if _, _, err := types.DeconstructDenom(denom); err != nil {
+		return err
+	}
+
+	store := k.GetDenomPrefixStore(sdk.UnwrapSDKContext(ctx), denom)
+	if !paused {
+		store.Delete([]byte(types.DenomPausedKey))
+		return nil
+	}
+
+	store.Set([]byte(types.DenomPausedKey), []byte{1})
+	return nil
+}
+
+// IsDenomPaused returns true if the transfers of a denom are paused
+func (k Keeper) IsDenomPaused(ctx context.Context, denom string) bool {
+	return k.GetDenomPrefixStore(sdk.UnwrapSDKContext(ctx), denom).Has([]byte(types.DenomPausedKey))
+}
+
+// getFrozenAccountsStore returns the substore with the frozen addresses of a denom
+func (k Keeper) getFrozenAccountsStore(ctx sdk.Context, denom string) prefix.Store {
+	return prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.GetFrozenAccountsPrefix())
+}
+
+// setAccountFrozen freezes or unfreezes an address for a denom
+func (k Keeper) setAccountFrozen(ctx context.Context, denom string, address sdk.AccAddress, frozen bool) error {
+	// Verify that the denom is a tokenfactory denom
+	if _, _, err := types.DeconstructDenom(denom); err != nil {
+		return err
+	}
+
+	store := k.getFrozenAccountsStore(sdk.UnwrapSDKContext(ctx), denom)
+	if !frozen {
+		store.Delete(address)
+		return nil
+	}
+
+	store.Set(address, []byte{1})
+	return nil
+}
+
+// isFreezeExemptSender returns true if the address is a module account exempt from the pauses and freezes
+func (k Keeper) isFreezeExemptSender(addr sdk.AccAddress) bool {
+	for _, exemptAddr := range k.freezeExemptSenders {
+		if addr.Equals(exemptAddr) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAccountFrozen returns true if an address is frozen for a denom
+func (k Keeper) IsAccountFrozen(ctx context.Context, denom string, address sdk.AccAddress) bool {
+	return k.getFrozenAccountsStore(sdk.UnwrapSDKContext(ctx), denom).Has(address)
+}
+
+// GetFrozenAccounts returns all the addresses frozen for a denom
+func (k Keeper) GetFrozenAccounts(ctx context.Context, denom string) []string {
+	iterator := k.getFrozenAccountsStore(sdk.UnwrapSDKContext(ctx), denom).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var addresses []string
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, sdk.AccAddress(iterator.Key()).String())
+	}
+
+	return addresses
+}
+
+// hasFrozenAccounts returns true if any address is frozen for a denom
+func (k Keeper) hasFrozenAccounts(ctx context.Context, denom string) bool {
+	iterator := k.getFrozenAccountsStore(sdk.UnwrapSDKContext(ctx), denom).Iterator(nil, nil)
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
+// FreezeSendRestriction is the bank send restriction enforcing the paused denoms and the frozen accounts
+// Every transfer of a paused denom is blocked, as well as the transfers of a denom from or to an address
+// frozen for it. This covers the bank sends, the IBC transfers and the ERC20 conversions, since they all
+// move the coins through the bank keeper. The mints, burns and force transfers made by the issuer are
+// never blocked, so a frozen balance can still be recovered. The transfers sent by the modules listed
+// given to the keeper as freeze exempt are never blocked either, so a paused denom can't stop their payouts
+func (k Keeper) FreezeSendRestriction(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if isIssuerAction(sdkCtx) {
+		return toAddr, nil
+	}
+
+	for _, coin := range amt {
+		// Only the tokenfactory denoms can be paused or frozen
+		if _, _, err := types.DeconstructDenom(coin.Denom); err != nil {
+			continue
+		}
+
+		// The exempt module payouts are never blocked
+		if k.isFreezeExemptSender(fromAddr) {
+			return toAddr, nil
+		}
+
+		if k.IsDenomPaused(sdkCtx, coin.Denom) {
+			return nil, errorsmod.Wrapf(types.ErrDenomPaused, "denom %s", coin.Denom)
+		}
+		if k.IsAccountFrozen(sdkCtx, coin.Denom, fromAddr) {
+			return nil, errorsmod.Wrapf(types.ErrAccountFrozen, "%s is frozen for %s", fromAddr, coin.Denom)
+		}
+		if k.IsAccountFrozen(sdkCtx, coin.Denom, toAddr) {
+			return nil, errorsmod.Wrapf(types.ErrAccountFrozen, "%s is frozen for %s", toAddr, coin.Denom)
+		}
+	}
+
+	return toAddr, nil
+}
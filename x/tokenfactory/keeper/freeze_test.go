@@ -0,0 +1,256 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+
+	rewardstypes "github.com/kiichain/kiichain/v4/x/rewards/types"
+	"github.com/kiichain/kiichain/v4/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestMsgSetDenomPaused() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	pauser := suite.TestAccs[1].String()
+
+	// Only the admin or a pauser can pause the denom
+	_, err := suite.msgServer.SetDenomPaused(suite.Ctx, types.NewMsgSetDenomPaused(pauser, suite.defaultDenom, true))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.GrantRole(suite.Ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, types.RolePauser, pauser, nil))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetDenomPaused(suite.Ctx, types.NewMsgSetDenomPaused(pauser, suite.defaultDenom, true))
+	suite.Require().NoError(err)
+
+	queryRes, err := suite.queryClient.DenomPaused(suite.Ctx.Context(), &types.QueryDenomPausedRequest{
+		Denom: suite.defaultDenom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(queryRes.Paused)
+
+	// Resume the transfers
+	_, err = suite.msgServer.SetDenomPaused(suite.Ctx, types.NewMsgSetDenomPaused(admin, suite.defaultDenom, false))
+	suite.Require().NoError(err)
+
+	queryRes, err = suite.queryClient.DenomPaused(suite.Ctx.Context(), &types.QueryDenomPausedRequest{
+		Denom: suite.defaultDenom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(queryRes.Paused)
+}
+
+func (suite *KeeperTestSuite) TestMsgSetAccountFrozen() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	pauser := suite.TestAccs[1].String()
+
+	// Only the admin or a pauser can freeze an address
+	_, err := suite.msgServer.SetAccountFrozen(suite.Ctx, types.NewMsgSetAccountFrozen(pauser, suite.defaultDenom, suite.TestAccs[2].String(), true))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.msgServer.GrantRole(suite.Ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, types.RolePauser, pauser, nil))
+	suite.Require().NoError(err)
+	for _, addr := range suite.TestAccs {
+		_, err = suite.msgServer.SetAccountFrozen(suite.Ctx, types.NewMsgSetAccountFrozen(pauser, suite.defaultDenom, addr.String(), true))
+		suite.Require().NoError(err)
+	}
+
+	accountRes, err := suite.queryClient.AccountFrozen(suite.Ctx.Context(), &types.QueryAccountFrozenRequest{
+		Denom:   suite.defaultDenom,
+		Address: suite.TestAccs[2].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().True(accountRes.Frozen)
+
+	// The frozen accounts are paginated
+	accountsRes, err := suite.queryClient.FrozenAccounts(suite.Ctx.Context(), &types.QueryFrozenAccountsRequest{
+		Denom:      suite.defaultDenom,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(accountsRes.Addresses, 1)
+	suite.Require().Equal(uint64(len(suite.TestAccs)), accountsRes.Pagination.Total)
+
+	// Unfreeze an address
+	_, err = suite.msgServer.SetAccountFrozen(suite.Ctx, types.NewMsgSetAccountFrozen(admin, suite.defaultDenom, suite.TestAccs[2].String(), false))
+	suite.Require().NoError(err)
+
+	accountRes, err = suite.queryClient.AccountFrozen(suite.Ctx.Context(), &types.QueryAccountFrozenRequest{
+		Denom:   suite.defaultDenom,
+		Address: suite.TestAccs[2].String(),
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(accountRes.Frozen)
+	suite.Require().Len(suite.App.TokenFactoryKeeper.GetFrozenAccounts(suite.Ctx, suite.defaultDenom), len(suite.TestAccs)-1)
+}
+
+func (suite *KeeperTestSuite) TestRenounceAdminWithRestrictions() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	frozen := suite.TestAccs[1].String()
+
+	// The admin can't be renounced while the denom is paused
+	_, err := suite.msgServer.SetDenomPaused(suite.Ctx, types.NewMsgSetDenomPaused(admin, suite.defaultDenom, true))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ChangeAdmin(suite.Ctx, types.NewMsgChangeAdmin(admin, suite.defaultDenom, ""))
+	suite.Require().ErrorIs(err, types.ErrRenounceRestricted)
+
+	// Nor while an account is frozen
+	_, err = suite.msgServer.SetDenomPaused(suite.Ctx, types.NewMsgSetDenomPaused(admin, suite.defaultDenom, false))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetAccountFrozen(suite.Ctx, types.NewMsgSetAccountFrozen(admin, suite.defaultDenom, frozen, true))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ChangeAdmin(suite.Ctx, types.NewMsgChangeAdmin(admin, suite.defaultDenom, ""))
+	suite.Require().ErrorIs(err, types.ErrRenounceRestricted)
+
+	// The admin can still be transferred, the new admin can lift the restrictions
+	_, err = suite.msgServer.ChangeAdmin(suite.Ctx, types.NewMsgChangeAdmin(admin, suite.defaultDenom, frozen))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetAccountFrozen(suite.Ctx, types.NewMsgSetAccountFrozen(frozen, suite.defaultDenom, frozen, false))
+	suite.Require().NoError(err)
+
+	// Once lifted the admin can be renounced
+	_, err = suite.msgServer.ChangeAdmin(suite.Ctx, types.NewMsgChangeAdmin(frozen, suite.defaultDenom, ""))
+	suite.Require().NoError(err)
+	authorityMetadata, err := suite.App.TokenFactoryKeeper.GetAuthorityMetadata(suite.Ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	suite.Require().Empty(authorityMetadata.Admin)
+}
+
+func (suite *KeeperTestSuite) TestFreezeOnBankSend() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	user := suite.TestAccs[1]
+	frozen := suite.TestAccs[2]
+
+	// Enable the issuer actions
+	tokenFactoryKeeper := suite.App.TokenFactoryKeeper
+	tokenFactoryKeeper.SetEnabledCapabilities(suite.Ctx, []string{
+		types.EnableForceTransfer,
+		types.EnableBurnFrom,
+	})
+	suite.OverrideMsgServer(tokenFactoryKeeper)
+
+	_, err := suite.msgServer.Mint(suite.Ctx, types.NewMsgMintTo(admin, sdk.NewInt64Coin(suite.defaultDenom, 100), user.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetAccountFrozen(suite.Ctx, types.NewMsgSetAccountFrozen(admin, suite.defaultDenom, frozen.String(), true))
+	suite.Require().NoError(err)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+
+	// The transfers to and from a frozen address are blocked
+	// The sends run on a cached context, as a failed transaction would be reverted
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	err = suite.App.BankKeeper.SendCoins(cacheCtx, user, frozen, coins)
+	suite.Require().ErrorIs(err, types.ErrAccountFrozen)
+
+	// The issuer can still mint to, force transfer from and burn from the frozen address
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMintTo(admin, sdk.NewInt64Coin(suite.defaultDenom, 30), frozen.String()))
+	suite.Require().NoError(err)
+	cacheCtx, _ = suite.Ctx.CacheContext()
+	err = suite.App.BankKeeper.SendCoins(cacheCtx, frozen, user, coins)
+	suite.Require().ErrorIs(err, types.ErrAccountFrozen)
+	_, err = suite.msgServer.ForceTransfer(suite.Ctx, types.NewMsgForceTransfer(admin, sdk.NewInt64Coin(suite.defaultDenom, 10), frozen.String(), user.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(suite.Ctx, types.NewMsgBurnFrom(admin, sdk.NewInt64Coin(suite.defaultDenom, 10), frozen.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(10), suite.App.BankKeeper.GetBalance(suite.Ctx, frozen, suite.defaultDenom).Amount.Int64())
+
+	// The other addresses and denoms are not affected
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, user, suite.TestAccs[0], coins)
+	suite.Require().NoError(err)
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, frozen, user, sdk.NewCoins(sdk.NewInt64Coin("utwo", 10)))
+	suite.Require().NoError(err)
+
+	// Pausing the denom blocks every transfer
+	_, err = suite.msgServer.SetDenomPaused(suite.Ctx, types.NewMsgSetDenomPaused(admin, suite.defaultDenom, true))
+	suite.Require().NoError(err)
+	cacheCtx, _ = suite.Ctx.CacheContext()
+	err = suite.App.BankKeeper.SendCoins(cacheCtx, user, suite.TestAccs[0], coins)
+	suite.Require().ErrorIs(err, types.ErrDenomPaused)
+
+	// The issuer actions are not paused
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMintTo(admin, sdk.NewInt64Coin(suite.defaultDenom, 10), user.String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ForceTransfer(suite.Ctx, types.NewMsgForceTransfer(admin, sdk.NewInt64Coin(suite.defaultDenom, 10), user.String(), suite.TestAccs[0].String()))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(suite.Ctx, types.NewMsgBurnFrom(admin, sdk.NewInt64Coin(suite.defaultDenom, 10), user.String()))
+	suite.Require().NoError(err)
+
+	// Resuming the denom allows the transfers again
+	_, err = suite.msgServer.SetDenomPaused(suite.Ctx, types.NewMsgSetDenomPaused(admin, suite.defaultDenom, false))
+	suite.Require().NoError(err)
+	err = suite.App.BankKeeper.SendCoins(suite.Ctx, user, suite.TestAccs[0], coins)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestFreezeExemptSenders() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0]
+
+	// Fund the rewards pool with the denom
+	_, err := suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(admin.String(), sdk.NewInt64Coin(suite.defaultDenom, 1000)))
+	suite.Require().NoError(err)
+	err = suite.App.RewardsKeeper.FundCommunityPool(suite.Ctx, sdk.NewInt64Coin(suite.defaultDenom, 1000), admin)
+	suite.Require().NoError(err)
+
+	// Release the pool to the fee collector over two hours
+	now := time.Now()
+	schedule := rewardstypes.ReleaseSchedule{
+		Id:              1,
+		TotalAmount:     sdk.NewInt64Coin(suite.defaultDenom, 1000),
+		ReleasedAmount:  sdk.NewInt64Coin(suite.defaultDenom, 0),
+		LastReleaseTime: now,
+		EndTime:         now.Add(time.Hour * 2),
+		Active:          true,
+	}
+	err = suite.App.RewardsKeeper.ReleaseSchedules.Set(suite.Ctx, schedule.Id, schedule)
+	suite.Require().NoError(err)
+
+	// Pause the denom and freeze the rewards module account
+	_, err = suite.msgServer.SetDenomPaused(suite.Ctx, types.NewMsgSetDenomPaused(admin.String(), suite.defaultDenom, true))
+	suite.Require().NoError(err)
+	rewardsAddr := authtypes.NewModuleAddress(rewardstypes.ModuleName)
+	_, err = suite.msgServer.SetAccountFrozen(suite.Ctx, types.NewMsgSetAccountFrozen(admin.String(), suite.defaultDenom, rewardsAddr.String(), true))
+	suite.Require().NoError(err)
+
+	// The release is not blocked
+	ctx := suite.Ctx.WithBlockTime(now.Add(time.Hour))
+	err = suite.App.RewardsKeeper.BeginBlocker(ctx)
+	suite.Require().NoError(err)
+
+	schedule, err = suite.App.RewardsKeeper.ReleaseSchedules.Get(ctx, schedule.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdkmath.NewInt(500), schedule.ReleasedAmount.Amount)
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	suite.Require().Equal(int64(500), suite.App.BankKeeper.GetBalance(ctx, feeCollector, suite.defaultDenom).Amount.Int64())
+
+	// The sends run on a cached context, as a failed transaction would be reverted
+	coins := sdk.NewCoins(sdk.NewInt64Coin(suite.defaultDenom, 10))
+	send := func(fromAddr, toAddr sdk.AccAddress) error {
+		cacheCtx, _ := ctx.CacheContext()
+		return suite.App.BankKeeper.SendCoins(cacheCtx, fromAddr, toAddr, coins)
+	}
+	erc20Addr := authtypes.NewModuleAddress(erc20types.ModuleName)
+
+	// The exempt modules can still pay the users, but the transfers between users are blocked
+	err = suite.App.BankKeeper.SendCoins(ctx, feeCollector, admin, coins)
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(send(admin, suite.TestAccs[1]), types.ErrDenomPaused)
+
+	// The transfers to the module accounts are blocked
+	suite.Require().ErrorIs(send(admin, feeCollector), types.ErrDenomPaused)
+	suite.Require().ErrorIs(send(admin, erc20Addr), types.ErrDenomPaused)
+
+	// The transfers sent by the other module accounts are blocked
+	err = suite.App.BankKeeper.SendCoins(ctx, feeCollector, erc20Addr, coins)
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(send(erc20Addr, admin), types.ErrDenomPaused)
+}
@@ -29,6 +29,20 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		err = k.setBeforeSendHook(ctx, genDenom.GetDenom(), genDenom.GetBeforeSendHookAddress())
+		if err != nil {
+			panic(err)
+		}
+		err = k.setDenomPaused(ctx, genDenom.GetDenom(), genDenom.GetPaused())
+		if err != nil {
+			panic(err)
+		}
+		for _, address := range genDenom.GetFrozenAccounts() {
+			err = k.setAccountFrozen(ctx, genDenom.GetDenom(), sdk.MustAccAddressFromBech32(address), true)
+			if err != nil {
+				panic(err)
+			}
+		}
 	}
 }
 
@@ -46,8 +60,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		}
 
 		genDenoms = append(genDenoms, types.GenesisDenom{
-			Denom:             denom,
-			AuthorityMetadata: authorityMetadata,
+			Denom:                 denom,
+			AuthorityMetadata:     authorityMetadata,
+			BeforeSendHookAddress: k.GetBeforeSendHook(ctx, denom),
+			Paused:                k.IsDenomPaused(ctx, denom),
+			FrozenAccounts:        k.GetFrozenAccounts(ctx, denom),
 		})
 	}
 
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -8,6 +10,7 @@ import (
 )
 
 func (suite *KeeperTestSuite) TestGenesis() {
+	maxSupply := sdkmath.NewInt(1000000)
 	genesisState := types.GenesisState{
 		FactoryDenoms: []types.GenesisDenom{
 			{
@@ -19,7 +22,8 @@ func (suite *KeeperTestSuite) TestGenesis() {
 			{
 				Denom: "factory/kii1t7egva48prqmzl59x5ngv4zx0dtrwewc5thc4c/diff-admin",
 				AuthorityMetadata: types.DenomAuthorityMetadata{
-					Admin: "kii15czt5nhlnvayqq37xun9s9yus0d6y26dl40fz7",
+					Admin:     "kii15czt5nhlnvayqq37xun9s9yus0d6y26dl40fz7",
+					MaxSupply: &maxSupply,
 				},
 			},
 			{
@@ -27,6 +31,15 @@ func (suite *KeeperTestSuite) TestGenesis() {
 				AuthorityMetadata: types.DenomAuthorityMetadata{
 					Admin: "kii1t7egva48prqmzl59x5ngv4zx0dtrwewc5thc4c",
 				},
+				BeforeSendHookAddress: "kii15czt5nhlnvayqq37xun9s9yus0d6y26dl40fz7",
+			},
+			{
+				Denom: "factory/kii1t7egva48prqmzl59x5ngv4zx0dtrwewc5thc4c/paused",
+				AuthorityMetadata: types.DenomAuthorityMetadata{
+					Admin: "kii1t7egva48prqmzl59x5ngv4zx0dtrwewc5thc4c",
+				},
+				Paused:         true,
+				FrozenAccounts: []string{"kii15czt5nhlnvayqq37xun9s9yus0d6y26dl40fz7"},
 			},
 		},
 	}
@@ -3,6 +3,11 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kiichain/kiichain/v4/x/tokenfactory/types"
@@ -42,3 +47,45 @@ func (k Keeper) DenomsFromAdmin(ctx context.Context, req *types.QueryDenomsFromA
 	}
 	return &types.QueryDenomsFromAdminResponse{Denoms: denoms}, nil
 }
+
+func (k Keeper) BeforeSendHookAddress(ctx context.Context, req *types.QueryBeforeSendHookAddressRequest) (*types.QueryBeforeSendHookAddressResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cosmwasmAddress := k.GetBeforeSendHook(sdkCtx, req.GetDenom())
+	return &types.QueryBeforeSendHookAddressResponse{CosmwasmAddress: cosmwasmAddress}, nil
+}
+
+func (k Keeper) DenomPaused(ctx context.Context, req *types.QueryDenomPausedRequest) (*types.QueryDenomPausedResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.QueryDenomPausedResponse{Paused: k.IsDenomPaused(sdkCtx, req.GetDenom())}, nil
+}
+
+func (k Keeper) AccountFrozen(ctx context.Context, req *types.QueryAccountFrozenRequest) (*types.QueryAccountFrozenResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	address, err := sdk.AccAddressFromBech32(req.GetAddress())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryAccountFrozenResponse{Frozen: k.IsAccountFrozen(sdkCtx, req.GetDenom(), address)}, nil
+}
+
+func (k Keeper) FrozenAccounts(ctx context.Context, req *types.QueryFrozenAccountsRequest) (*types.QueryFrozenAccountsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	store := k.getFrozenAccountsStore(sdkCtx, req.GetDenom())
+
+	addresses := []string{}
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		addresses = append(addresses, sdk.AccAddress(key).String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryFrozenAccountsResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
+
+func (k Keeper) DenomERC20Address(ctx context.Context, req *types.QueryDenomERC20AddressRequest) (*types.QueryDenomERC20AddressResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	erc20Address := k.GetDenomERC20Address(sdkCtx, req.GetDenom())
+	return &types.QueryDenomERC20AddressResponse{Erc20Address: erc20Address}, nil
+}
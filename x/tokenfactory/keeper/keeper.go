@@ -23,8 +23,12 @@ type (
 		accountKeeper       types.AccountKeeper
 		bankKeeper          types.BankKeeper
 		communityPoolKeeper types.CommunityPoolKeeper
+		contractKeeper      types.ContractKeeper
+		erc20Keeper         types.Erc20Keeper
 
 		enabledCapabilities []string
+		// freezeExemptSenders are the module accounts whose payouts are not blocked by the pauses and freezes
+		freezeExemptSenders []sdk.AccAddress
 
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
@@ -40,7 +44,9 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	communityPoolKeeper types.CommunityPoolKeeper,
+	erc20Keeper types.Erc20Keeper,
 	enabledCapabilities []string,
+	freezeExemptModules []string,
 	authority string,
 ) Keeper {
 	permAddrs := make(map[string]authtypes.PermissionsForAddress)
@@ -48,6 +54,11 @@ func NewKeeper(
 		permAddrs[name] = authtypes.NewPermissionsForAddress(name, perms)
 	}
 
+	freezeExemptSenders := make([]sdk.AccAddress, 0, len(freezeExemptModules))
+	for _, name := range freezeExemptModules {
+		freezeExemptSenders = append(freezeExemptSenders, authtypes.NewModuleAddress(name))
+	}
+
 	return Keeper{
 		cdc:       cdc,
 		storeKey:  storeKey,
@@ -56,10 +67,12 @@ func NewKeeper(
 		accountKeeper:       accountKeeper,
 		bankKeeper:          bankKeeper,
 		communityPoolKeeper: communityPoolKeeper,
+		erc20Keeper:         erc20Keeper,
 
 		authority: authority,
 
 		enabledCapabilities: enabledCapabilities,
+		freezeExemptSenders: freezeExemptSenders,
 	}
 }
 
@@ -68,6 +81,12 @@ func (k Keeper) GetAuthority() string {
 	return k.authority
 }
 
+// SetContractKeeper sets the contract keeper used to call the before send hooks
+// The wasm keeper is created after this keeper, so it can't be given on the constructor
+func (k *Keeper) SetContractKeeper(contractKeeper types.ContractKeeper) {
+	k.contractKeeper = contractKeeper
+}
+
 func (k Keeper) GetEnabledCapabilities() []string {
 	return k.enabledCapabilities
 }
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"cosmossdk.io/errors"
 
@@ -35,11 +36,37 @@ func (server msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateD
 		return nil, err
 	}
 
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeCreator, msg.Sender),
+		sdk.NewAttribute(types.AttributeNewTokenDenom, denom),
+	}
+
+	// Set the optional max supply
+	if msg.MaxSupply != nil {
+		authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, denom)
+		if err != nil {
+			return nil, err
+		}
+		err = server.Keeper.setMaxSupply(ctx, authorityMetadata, denom, *msg.MaxSupply)
+		if err != nil {
+			return nil, err
+		}
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeMaxSupply, msg.MaxSupply.String()))
+	}
+
+	// Register the optional ERC20 representation
+	if msg.RegisterErc20 {
+		erc20Address, err := server.Keeper.registerERC20(ctx, msg.Sender, denom)
+		if err != nil {
+			return nil, err
+		}
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeERC20Address, erc20Address.Hex()))
+	}
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.TypeMsgCreateDenom,
-			sdk.NewAttribute(types.AttributeCreator, msg.Sender),
-			sdk.NewAttribute(types.AttributeNewTokenDenom, denom),
+			attributes...,
 		),
 	})
 
@@ -63,10 +90,15 @@ func (server msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(types.RoleMinter, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
+	err = server.Keeper.useMintAllowance(ctx, authorityMetadata, msg.Amount.Denom, msg.Sender, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
 	if msg.MintToAddress == "" {
 		msg.MintToAddress = msg.Sender
 	}
@@ -95,7 +127,7 @@ func (server msgServer) Burn(goCtx context.Context, msg *types.MsgBurn) (*types.
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(types.RoleBurner, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
@@ -133,7 +165,7 @@ func (server msgServer) ForceTransfer(goCtx context.Context, msg *types.MsgForce
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(types.RoleForceTransferrer, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
@@ -199,7 +231,7 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSe
 		return nil, err
 	}
 
-	if msg.Sender != authorityMetadata.GetAdmin() {
+	if !authorityMetadata.HasRole(types.RoleMetadata, msg.Sender) {
 		return nil, types.ErrUnauthorized
 	}
 
@@ -216,6 +248,211 @@ func (server msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSe
 	return &types.MsgSetDenomMetadataResponse{}, nil
 }
 
+func (server msgServer) SetBeforeSendHook(goCtx context.Context, msg *types.MsgSetBeforeSendHook) (*types.MsgSetBeforeSendHookResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !types.IsCapabilityEnabled(server.Keeper.enabledCapabilities, types.EnableBeforeSendHook) {
+		return nil, types.ErrCapabilityNotEnabled
+	}
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	// The hook must be an existing contract, an empty address removes it
+	if msg.CosmwasmAddress != "" {
+		contractAddr, err := sdk.AccAddressFromBech32(msg.CosmwasmAddress)
+		if err != nil {
+			return nil, err
+		}
+		if server.Keeper.contractKeeper == nil || !server.Keeper.contractKeeper.HasContractInfo(ctx, contractAddr) {
+			return nil, types.ErrInvalidBeforeSendHook.Wrapf("%s is not a contract", msg.CosmwasmAddress)
+		}
+	}
+
+	err = server.Keeper.setBeforeSendHook(ctx, msg.Denom, msg.CosmwasmAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetBeforeSendHook,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeBeforeSendHook, msg.CosmwasmAddress),
+		),
+	})
+
+	return &types.MsgSetBeforeSendHookResponse{}, nil
+}
+
+func (server msgServer) SetMaxSupply(goCtx context.Context, msg *types.MsgSetMaxSupply) (*types.MsgSetMaxSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setMaxSupply(ctx, authorityMetadata, msg.Denom, msg.MaxSupply)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetMaxSupply,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeMaxSupply, msg.MaxSupply.String()),
+		),
+	})
+
+	return &types.MsgSetMaxSupplyResponse{}, nil
+}
+
+func (server msgServer) GrantRole(goCtx context.Context, msg *types.MsgGrantRole) (*types.MsgGrantRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the admin can manage the roles
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	role := types.DenomRole{
+		Role:          msg.Role,
+		Address:       msg.Address,
+		MintAllowance: msg.MintAllowance,
+	}
+	err = server.Keeper.grantRole(ctx, authorityMetadata, msg.Denom, role)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+		sdk.NewAttribute(types.AttributeRole, msg.Role),
+		sdk.NewAttribute(types.AttributeAddress, msg.Address),
+	}
+	if msg.MintAllowance != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeMintAllowance, msg.MintAllowance.String()))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgGrantRole,
+			attributes...,
+		),
+	})
+
+	return &types.MsgGrantRoleResponse{}, nil
+}
+
+func (server msgServer) RevokeRole(goCtx context.Context, msg *types.MsgRevokeRole) (*types.MsgRevokeRoleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the admin can manage the roles
+	if msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.revokeRole(ctx, authorityMetadata, msg.Denom, msg.Role, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgRevokeRole,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeRole, msg.Role),
+			sdk.NewAttribute(types.AttributeAddress, msg.Address),
+		),
+	})
+
+	return &types.MsgRevokeRoleResponse{}, nil
+}
+
+func (server msgServer) SetDenomPaused(goCtx context.Context, msg *types.MsgSetDenomPaused) (*types.MsgSetDenomPausedResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !authorityMetadata.HasRole(types.RolePauser, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = server.Keeper.setDenomPaused(ctx, msg.Denom, msg.Paused)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetDenomPaused,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributePaused, strconv.FormatBool(msg.Paused)),
+		),
+	})
+
+	return &types.MsgSetDenomPausedResponse{}, nil
+}
+
+func (server msgServer) SetAccountFrozen(goCtx context.Context, msg *types.MsgSetAccountFrozen) (*types.MsgSetAccountFrozenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := server.Keeper.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if !authorityMetadata.HasRole(types.RolePauser, msg.Sender) {
+		return nil, types.ErrUnauthorized
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.Keeper.setAccountFrozen(ctx, msg.Denom, address, msg.Frozen)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeMsgSetAccountFrozen,
+			sdk.NewAttribute(types.AttributeDenom, msg.GetDenom()),
+			sdk.NewAttribute(types.AttributeAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeFrozen, strconv.FormatBool(msg.Frozen)),
+		),
+	})
+
+	return &types.MsgSetAccountFrozenResponse{}, nil
+}
+
 func (server msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if server.authority != req.Authority {
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", server.authority, req.Authority)
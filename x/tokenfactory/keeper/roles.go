@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"github.com/kiichain/kiichain/v4/x/tokenfactory/types"
+)
+
+// grantRole grants a role over a denom to an address
+// Granting a role again replaces its mint allowance
+func (k Keeper) grantRole(ctx context.Context, metadata types.DenomAuthorityMetadata, denom string, role types.DenomRole) error {
+	if err := role.Validate(); err != nil {
+		return err
+	}
+
+	if i, found := metadata.FindRole(role.Role, role.Address); found {
+		metadata.Roles[i] = role
+	} else {
+		metadata.Roles = append(metadata.Roles, role)
+	}
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// revokeRole revokes a role over a denom from an address
+func (k Keeper) revokeRole(ctx context.Context, metadata types.DenomAuthorityMetadata, denom string, role, address string) error {
+	i, found := metadata.FindRole(role, address)
+	if !found {
+		return errorsmod.Wrapf(types.ErrRoleNotFound, "%s has no %s role over %s", address, role, denom)
+	}
+
+	metadata.Roles = append(metadata.Roles[:i], metadata.Roles[i+1:]...)
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
+
+// useMintAllowance consumes the mint allowance of a minter
+// The admin and the minters without allowance can mint any amount
+func (k Keeper) useMintAllowance(ctx context.Context, metadata types.DenomAuthorityMetadata, denom string, minter string, amount math.Int) error {
+	if minter == metadata.Admin {
+		return nil
+	}
+
+	i, found := metadata.FindRole(types.RoleMinter, minter)
+	if !found || metadata.Roles[i].MintAllowance == nil {
+		return nil
+	}
+
+	allowance := *metadata.Roles[i].MintAllowance
+	if allowance.LT(amount) {
+		return errorsmod.Wrapf(types.ErrMintAllowanceExceeded, "minting %s%s, the allowance is %s", amount, denom, allowance)
+	}
+
+	remaining := allowance.Sub(amount)
+	metadata.Roles[i].MintAllowance = &remaining
+
+	return k.setAuthorityMetadata(ctx, denom, metadata)
+}
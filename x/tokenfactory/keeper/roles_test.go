@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/kiichain/kiichain/v4/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestGrantRevokeRole() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	minter := suite.TestAccs[1].String()
+
+	// Only the admin can grant roles
+	_, err := suite.msgServer.GrantRole(suite.Ctx, types.NewMsgGrantRole(minter, suite.defaultDenom, types.RoleMinter, minter, nil))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// Unknown roles are rejected
+	_, err = suite.msgServer.GrantRole(suite.Ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, "owner", minter, nil))
+	suite.Require().ErrorIs(err, types.ErrInvalidRole)
+
+	// Only the minters have an allowance
+	allowance := sdkmath.NewInt(100)
+	_, err = suite.msgServer.GrantRole(suite.Ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, types.RoleBurner, minter, &allowance))
+	suite.Require().ErrorIs(err, types.ErrInvalidRole)
+
+	// Grant the role
+	_, err = suite.msgServer.GrantRole(suite.Ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, types.RoleMinter, minter, &allowance))
+	suite.Require().NoError(err)
+
+	// The role is returned with the authority metadata
+	queryRes, err := suite.queryClient.DenomAuthorityMetadata(suite.Ctx.Context(), &types.QueryDenomAuthorityMetadataRequest{
+		Denom: suite.defaultDenom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(queryRes.AuthorityMetadata.Roles, 1)
+	suite.Require().Equal(types.RoleMinter, queryRes.AuthorityMetadata.Roles[0].Role)
+	suite.Require().Equal(minter, queryRes.AuthorityMetadata.Roles[0].Address)
+	suite.Require().Equal("100", queryRes.AuthorityMetadata.Roles[0].MintAllowance.String())
+
+	// Granting the role again replaces the allowance
+	_, err = suite.msgServer.GrantRole(suite.Ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, types.RoleMinter, minter, nil))
+	suite.Require().NoError(err)
+	metadata, err := suite.App.TokenFactoryKeeper.GetAuthorityMetadata(suite.Ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	suite.Require().Len(metadata.Roles, 1)
+	suite.Require().Nil(metadata.Roles[0].MintAllowance)
+
+	// Only the admin can revoke the role
+	_, err = suite.msgServer.RevokeRole(suite.Ctx, types.NewMsgRevokeRole(minter, suite.defaultDenom, types.RoleMinter, minter))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.RevokeRole(suite.Ctx, types.NewMsgRevokeRole(admin, suite.defaultDenom, types.RoleMinter, minter))
+	suite.Require().NoError(err)
+
+	// The role can't be revoked twice
+	_, err = suite.msgServer.RevokeRole(suite.Ctx, types.NewMsgRevokeRole(admin, suite.defaultDenom, types.RoleMinter, minter))
+	suite.Require().ErrorIs(err, types.ErrRoleNotFound)
+
+	metadata, err = suite.App.TokenFactoryKeeper.GetAuthorityMetadata(suite.Ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	suite.Require().Empty(metadata.Roles)
+}
+
+func (suite *KeeperTestSuite) TestChangeAdminClearsRoles() {
+	for _, tc := range []struct {
+		desc     string
+		renounce bool
+	}{
+		{
+			desc: "admin changed",
+		},
+		{
+			desc:     "admin renounced",
+			renounce: true,
+		},
+	} {
+		suite.Run(tc.desc, func() {
+			suite.SetupTest()
+			suite.CreateDefaultDenom()
+			admin := suite.TestAccs[0].String()
+			minter := suite.TestAccs[1].String()
+			newAdmin := suite.TestAccs[2].String()
+			if tc.renounce {
+				newAdmin = ""
+			}
+
+			// Grant a role
+			_, err := suite.msgServer.GrantRole(suite.Ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, types.RoleMinter, minter, nil))
+			suite.Require().NoError(err)
+
+			// Setting the same admin keeps the roles
+			_, err = suite.msgServer.ChangeAdmin(suite.Ctx, types.NewMsgChangeAdmin(admin, suite.defaultDenom, admin))
+			suite.Require().NoError(err)
+			metadata, err := suite.App.TokenFactoryKeeper.GetAuthorityMetadata(suite.Ctx, suite.defaultDenom)
+			suite.Require().NoError(err)
+			suite.Require().True(metadata.HasRole(types.RoleMinter, minter))
+
+			// Changing the admin clears the roles
+			_, err = suite.msgServer.ChangeAdmin(suite.Ctx, types.NewMsgChangeAdmin(admin, suite.defaultDenom, newAdmin))
+			suite.Require().NoError(err)
+			metadata, err = suite.App.TokenFactoryKeeper.GetAuthorityMetadata(suite.Ctx, suite.defaultDenom)
+			suite.Require().NoError(err)
+			suite.Require().Equal(newAdmin, metadata.Admin)
+			suite.Require().Empty(metadata.Roles)
+
+			// The previous minter can't mint anymore
+			_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(minter, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+			suite.Require().ErrorIs(err, types.ErrUnauthorized)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRolePermissions() {
+	suite.CreateDefaultDenom()
+	admin := suite.TestAccs[0].String()
+	minter := suite.TestAccs[1].String()
+	operator := suite.TestAccs[2].String()
+
+	// Enable every capability used by the roles
+	tokenFactoryKeeper := suite.App.TokenFactoryKeeper
+	tokenFactoryKeeper.SetEnabledCapabilities(suite.Ctx, []string{
+		types.EnableSetMetadata,
+		types.EnableForceTransfer,
+		types.EnableBurnFrom,
+	})
+	suite.OverrideMsgServer(tokenFactoryKeeper)
+
+	// Grant a minter with an allowance, and every other role to the operator
+	allowance := sdkmath.NewInt(100)
+	_, err := suite.msgServer.GrantRole(suite.Ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, types.RoleMinter, minter, &allowance))
+	suite.Require().NoError(err)
+	for _, role := range []string{types.RoleBurner, types.RoleForceTransferrer, types.RoleMetadata} {
+		_, err = suite.msgServer.GrantRole(suite.Ctx, types.NewMsgGrantRole(admin, suite.defaultDenom, role, operator, nil))
+		suite.Require().NoError(err)
+	}
+
+	// The minter can mint up to its allowance
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMintTo(minter, sdk.NewInt64Coin(suite.defaultDenom, 60), operator))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMintTo(minter, sdk.NewInt64Coin(suite.defaultDenom, 50), operator))
+	suite.Require().ErrorIs(err, types.ErrMintAllowanceExceeded)
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMintTo(minter, sdk.NewInt64Coin(suite.defaultDenom, 40), operator))
+	suite.Require().NoError(err)
+
+	metadata, err := suite.App.TokenFactoryKeeper.GetAuthorityMetadata(suite.Ctx, suite.defaultDenom)
+	suite.Require().NoError(err)
+	i, found := metadata.FindRole(types.RoleMinter, minter)
+	suite.Require().True(found)
+	suite.Require().True(metadata.Roles[i].MintAllowance.IsZero())
+
+	// The admin has no allowance
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMintTo(admin, sdk.NewInt64Coin(suite.defaultDenom, 1000), operator))
+	suite.Require().NoError(err)
+
+	// The operator can't mint
+	_, err = suite.msgServer.Mint(suite.Ctx, types.NewMsgMint(operator, sdk.NewInt64Coin(suite.defaultDenom, 1)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// The minter can't use the other roles
+	_, err = suite.msgServer.Burn(suite.Ctx, types.NewMsgBurnFrom(minter, sdk.NewInt64Coin(suite.defaultDenom, 10), operator))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.ForceTransfer(suite.Ctx, types.NewMsgForceTransfer(minter, sdk.NewInt64Coin(suite.defaultDenom, 10), operator, minter))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// The operator can burn, force transfer and set the metadata
+	_, err = suite.msgServer.Burn(suite.Ctx, types.NewMsgBurn(operator, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ForceTransfer(suite.Ctx, types.NewMsgForceTransfer(operator, sdk.NewInt64Coin(suite.defaultDenom, 10), operator, minter))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SetDenomMetadata(suite.Ctx, types.NewMsgSetDenomMetadata(operator, banktypes.Metadata{
+		Description: "updated by the metadata role",
+		DenomUnits: []*banktypes.DenomUnit{{
+			Denom:    suite.defaultDenom,
+			Exponent: 0,
+		}},
+		Base:    suite.defaultDenom,
+		Display: suite.defaultDenom,
+		Name:    suite.defaultDenom,
+		Symbol:  "SUN",
+	}))
+	suite.Require().NoError(err)
+
+	// Only the admin can change the admin or grant roles
+	_, err = suite.msgServer.ChangeAdmin(suite.Ctx, types.NewMsgChangeAdmin(operator, suite.defaultDenom, operator))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = suite.msgServer.GrantRole(suite.Ctx, types.NewMsgGrantRole(operator, suite.defaultDenom, types.RoleMinter, operator, nil))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// Revoked roles can't be used
+	_, err = suite.msgServer.RevokeRole(suite.Ctx, types.NewMsgRevokeRole(admin, suite.defaultDenom, types.RoleBurner, operator))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.Burn(suite.Ctx, types.NewMsgBurn(operator, sdk.NewInt64Coin(suite.defaultDenom, 10)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+}
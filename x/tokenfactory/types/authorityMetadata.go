@@ -1,6 +1,8 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,5 +13,16 @@ func (metadata DenomAuthorityMetadata) Validate() error {
 			return err
 		}
 	}
+	if metadata.MaxSupply != nil && !metadata.MaxSupply.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidMaxSupply, "max supply must be positive, got %s", metadata.MaxSupply)
+	}
+	for i, role := range metadata.Roles {
+		if err := role.Validate(); err != nil {
+			return err
+		}
+		if j, _ := metadata.FindRole(role.Role, role.Address); j != i {
+			return errorsmod.Wrapf(ErrInvalidRole, "duplicated %s role for %s", role.Role, role.Address)
+		}
+	}
 	return nil
 }
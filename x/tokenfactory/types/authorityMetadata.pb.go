@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -25,11 +26,16 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // DenomAuthorityMetadata specifies metadata for addresses that have specific
-// capabilities over a token factory denom. Right now there is only one Admin
-// permission, but is planned to be extended to the future.
+// capabilities over a token factory denom. It holds the Admin permission, the
+// roles granted by the admin and the optional max supply of the denom.
 type DenomAuthorityMetadata struct {
 	// Can be empty for no admin, or a valid kii address
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// Max supply of the denom, mints can't exceed it. Empty for no max supply.
+	// Once set, it can only be lowered
+	MaxSupply *cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply,omitempty" yaml:"max_supply"`
+	// Roles granted by the admin. The admin holds every role
+	Roles []DenomRole `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles" yaml:"roles"`
 }
 
 func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
@@ -72,8 +78,74 @@ func (m *DenomAuthorityMetadata) GetAdmin() string {
 	return ""
 }
 
+func (m *DenomAuthorityMetadata) GetRoles() []DenomRole {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+// DenomRole grants a role over a token factory denom to an address
+type DenomRole struct {
+	// Role granted, one of minter, burner, force_transferrer, metadata or pauser
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty" yaml:"role"`
+	// Address holding the role
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// Amount the address can still mint, only used by the minter role. Empty for
+	// no allowance
+	MintAllowance *cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=mint_allowance,json=mintAllowance,proto3,customtype=cosmossdk.io/math.Int" json:"mint_allowance,omitempty" yaml:"mint_allowance"`
+}
+
+func (m *DenomRole) Reset()         { *m = DenomRole{} }
+func (m *DenomRole) String() string { return proto.CompactTextString(m) }
+func (*DenomRole) ProtoMessage()    {}
+func (*DenomRole) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f97282583f218d1c, []int{1}
+}
+func (m *DenomRole) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomRole.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomRole) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomRole.Merge(m, src)
+}
+func (m *DenomRole) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomRole) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomRole.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomRole proto.InternalMessageInfo
+
+func (m *DenomRole) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *DenomRole) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*DenomAuthorityMetadata)(nil), "kiichain.tokenfactory.v1beta1.DenomAuthorityMetadata")
+	proto.RegisterType((*DenomRole)(nil), "kiichain.tokenfactory.v1beta1.DenomRole")
 }
 
 func init() {
@@ -81,22 +153,34 @@ func init() {
 }
 
 var fileDescriptor_f97282583f218d1c = []byte{
-	// 233 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xcd, 0xce, 0xcc, 0x4c,
-	0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f,
-	0xaa, 0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f,
-	0xca, 0x2c, 0xa9, 0xf4, 0x4d, 0x2d, 0x49, 0x4c, 0x49, 0x2c, 0x49, 0xd4, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x85, 0x69, 0xd3, 0x43, 0xd6, 0xa6, 0x07, 0xd5, 0x26, 0x25, 0x92, 0x9e, 0x9f,
-	0x9e, 0x0f, 0x56, 0xa9, 0x0f, 0x62, 0x41, 0x34, 0x49, 0xc9, 0x25, 0xe7, 0x17, 0xe7, 0xe6, 0x17,
-	0xeb, 0x27, 0x25, 0x16, 0xa7, 0xc2, 0x6d, 0x48, 0xce, 0xcf, 0xcc, 0x83, 0xc8, 0x2b, 0xb9, 0x71,
-	0x89, 0xb9, 0xa4, 0xe6, 0xe5, 0xe7, 0x3a, 0xa2, 0x5b, 0x2a, 0xa4, 0xc6, 0xc5, 0x9a, 0x98, 0x92,
-	0x9b, 0x99, 0x27, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0xf0, 0xe9, 0x9e, 0x3c, 0x4f, 0x65,
-	0x62, 0x6e, 0x8e, 0x95, 0x12, 0x58, 0x58, 0x29, 0x08, 0x22, 0x6d, 0xc5, 0xf2, 0x62, 0x81, 0x3c,
-	0xa3, 0x93, 0xf7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa6, 0x67,
-	0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xc3, 0x3d, 0x0e, 0x67, 0x54, 0xa0, 0x86,
-	0x41, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x6d, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x67, 0x8b, 0x29, 0x04, 0x29, 0x01, 0x00, 0x00,
+	// 424 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x8a, 0x13, 0x41,
+	0x10, 0x86, 0xd3, 0x66, 0x57, 0x49, 0xaf, 0xae, 0x3a, 0xec, 0x4a, 0x58, 0x70, 0x7a, 0x69, 0x41,
+	0x72, 0x90, 0x1e, 0xb2, 0xa2, 0x87, 0xbd, 0x6d, 0xf0, 0x22, 0xe2, 0xc1, 0xd1, 0x93, 0x20, 0x4b,
+	0x65, 0xa6, 0x4d, 0x9a, 0x4c, 0x77, 0x85, 0xe9, 0x8e, 0x66, 0xde, 0xc2, 0x47, 0xf0, 0x5d, 0xbc,
+	0xe4, 0xb8, 0x47, 0xf1, 0x30, 0x48, 0x72, 0xf1, 0x26, 0xe4, 0x09, 0x24, 0x3d, 0xc9, 0xc4, 0x78,
+	0x70, 0x6f, 0xd5, 0xf5, 0xd7, 0x57, 0xd5, 0x7f, 0x51, 0xf4, 0xd9, 0x48, 0xa9, 0x64, 0x08, 0xca,
+	0x44, 0x0e, 0x47, 0xd2, 0x7c, 0x84, 0xc4, 0x61, 0x5e, 0x44, 0x9f, 0xba, 0x7d, 0xe9, 0xa0, 0x1b,
+	0xc1, 0xc4, 0x0d, 0x31, 0x57, 0xae, 0x78, 0x2d, 0x1d, 0xa4, 0xe0, 0x40, 0x8c, 0x73, 0x74, 0x18,
+	0x3c, 0xdc, 0x60, 0xe2, 0x6f, 0x4c, 0xac, 0xb1, 0x93, 0xa3, 0x01, 0x0e, 0xd0, 0x57, 0x46, 0xab,
+	0xa8, 0x82, 0x4e, 0xc2, 0x04, 0xad, 0x46, 0x1b, 0xf5, 0xc1, 0xca, 0x7a, 0x42, 0x82, 0xca, 0x54,
+	0x3a, 0xff, 0x4d, 0xe8, 0x83, 0x17, 0xd2, 0xa0, 0xbe, 0xf8, 0x77, 0x6a, 0xf0, 0x98, 0xee, 0x43,
+	0xaa, 0x95, 0x69, 0x93, 0x53, 0xd2, 0x69, 0xf5, 0xee, 0x2d, 0x4b, 0x76, 0xbb, 0x00, 0x9d, 0x9d,
+	0x73, 0x9f, 0xe6, 0x71, 0x25, 0x07, 0x6f, 0x28, 0xd5, 0x30, 0xbd, 0xb4, 0x93, 0xf1, 0x38, 0x2b,
+	0xda, 0x37, 0x7c, 0xf1, 0xd9, 0xac, 0x64, 0xe4, 0x47, 0xc9, 0x8e, 0xab, 0xf1, 0x36, 0x1d, 0x09,
+	0x85, 0x91, 0x06, 0x37, 0x14, 0x2f, 0x8d, 0x5b, 0x96, 0xec, 0x7e, 0xd5, 0x69, 0x0b, 0xf2, 0xb8,
+	0xa5, 0x61, 0xfa, 0xd6, 0xc7, 0xc1, 0x3b, 0xba, 0x9f, 0x63, 0x26, 0x6d, 0xbb, 0x79, 0xda, 0xec,
+	0x1c, 0x9c, 0x75, 0xc4, 0x7f, 0xad, 0x0b, 0x6f, 0x20, 0xc6, 0x4c, 0xf6, 0x8e, 0x66, 0x25, 0x6b,
+	0x6c, 0x3f, 0xea, 0x9b, 0xf0, 0xb8, 0x6a, 0x76, 0xbe, 0xf7, 0xeb, 0x2b, 0x23, 0xfc, 0x1b, 0xa1,
+	0xad, 0x1a, 0x08, 0x1e, 0xd1, 0xbd, 0x95, 0xb8, 0xf6, 0x78, 0x77, 0x59, 0xb2, 0x83, 0x2d, 0xca,
+	0x63, 0x2f, 0x06, 0x4f, 0xe8, 0x2d, 0x48, 0xd3, 0x5c, 0x5a, 0xbb, 0xb6, 0x17, 0x2c, 0x4b, 0x76,
+	0xb8, 0xd9, 0x85, 0x17, 0x78, 0xbc, 0x29, 0x09, 0x3e, 0xd0, 0x43, 0xad, 0x8c, 0xbb, 0x84, 0x2c,
+	0xc3, 0xcf, 0x60, 0x12, 0xd9, 0x6e, 0x7a, 0xe8, 0xf9, 0x75, 0x3b, 0x39, 0x5e, 0xef, 0x64, 0x07,
+	0xe6, 0xf1, 0x9d, 0x55, 0xe2, 0x62, 0xf3, 0xae, 0x5c, 0xf4, 0x5e, 0xcd, 0xe6, 0x21, 0xb9, 0x9a,
+	0x87, 0xe4, 0xe7, 0x3c, 0x24, 0x5f, 0x16, 0x61, 0xe3, 0x6a, 0x11, 0x36, 0xbe, 0x2f, 0xc2, 0xc6,
+	0xfb, 0xee, 0x40, 0xb9, 0xe1, 0xa4, 0x2f, 0x12, 0xd4, 0x51, 0x7d, 0x68, 0x75, 0x30, 0xdd, 0xbd,
+	0x39, 0x57, 0x8c, 0xa5, 0xed, 0xdf, 0xf4, 0xb7, 0xf0, 0xf4, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0xa3, 0xc8, 0x76, 0xf5, 0x99, 0x02, 0x00, 0x00,
 }
 
 func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
@@ -121,6 +205,55 @@ func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
 	if this.Admin != that1.Admin {
 		return false
 	}
+	if that1.MaxSupply == nil {
+		if this.MaxSupply != nil {
+			return false
+		}
+	} else if !this.MaxSupply.Equal(*that1.MaxSupply) {
+		return false
+	}
+	if len(this.Roles) != len(that1.Roles) {
+		return false
+	}
+	for i := range this.Roles {
+		if !this.Roles[i].Equal(&that1.Roles[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DenomRole) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DenomRole)
+	if !ok {
+		that2, ok := that.(DenomRole)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Role != that1.Role {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if that1.MintAllowance == nil {
+		if this.MintAllowance != nil {
+			return false
+		}
+	} else if !this.MintAllowance.Equal(*that1.MintAllowance) {
+		return false
+	}
 	return true
 }
 func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
@@ -143,6 +276,32 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Roles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxSupply != nil {
+		{
+			size := m.MaxSupply.Size()
+			i -= size
+			if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -153,6 +312,55 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomRole) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomRole) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MintAllowance != nil {
+		{
+			size := m.MintAllowance.Size()
+			i -= size
+			if _, err := m.MintAllowance.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuthorityMetadata(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuthorityMetadata(v)
 	base := offset
@@ -174,6 +382,37 @@ func (m *DenomAuthorityMetadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuthorityMetadata(uint64(l))
 	}
+	if m.MaxSupply != nil {
+		l = m.MaxSupply.Size()
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		for _, e := range m.Roles {
+			l = e.Size()
+			n += 1 + l + sovAuthorityMetadata(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomRole) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	if m.MintAllowance != nil {
+		l = m.MintAllowance.Size()
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
 	return n
 }
 
@@ -244,6 +483,226 @@ func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxSupply = &v
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, DenomRole{})
+			if err := m.Roles[len(m.Roles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomRole) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorityMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomRole: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomRole: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintAllowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MintAllowance = &v
+			if err := m.MintAllowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
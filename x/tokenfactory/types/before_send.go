@@ -0,0 +1,28 @@
+package types
+
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+)
+
+// BeforeSendHookGasLimit is the gas limit of each call to a before send hook contract
+// The gas used is charged on the transfer, but a single hook can't use more than this
+const BeforeSendHookGasLimit = 500_000
+
+// BeforeSendMsg is the content of the before send sudo calls
+type BeforeSendMsg struct {
+	From   string           `json:"from"`
+	To     string           `json:"to"`
+	Amount wasmvmtypes.Coin `json:"amount"`
+}
+
+// BlockBeforeSendSudoMsg is the sudo message sent to the hook contract before a transfer
+// An error returned by the contract blocks the transfer
+type BlockBeforeSendSudoMsg struct {
+	BlockBeforeSend BeforeSendMsg `json:"block_before_send"`
+}
+
+// TrackBeforeSendSudoMsg is the sudo message sent to the hook contract before every transfer
+// Errors returned by the contract are ignored, it can only be used to track the transfers
+type TrackBeforeSendSudoMsg struct {
+	TrackBeforeSend BeforeSendMsg `json:"track_before_send"`
+}
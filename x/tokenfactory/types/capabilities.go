@@ -4,6 +4,8 @@ const (
 	EnableSetMetadata   = "enable_metadata"
 	EnableForceTransfer = "enable_force_transfer"
 	EnableBurnFrom      = "enable_burn_from"
+	// EnableBeforeSendHook allows the denom admins to set a CosmWasm contract called before every transfer of the denom
+	EnableBeforeSendHook = "enable_before_send_hook"
 	// EnableCommunityPoolFeeFunding sends tokens to the community pool when a new fee is charged (if one is set in params).
 	// This is useful for ICS chains, or networks who wish to just have the fee tokens burned (not gas fees, just the extra on top).
 	EnableCommunityPoolFeeFunding = "enable_community_pool_fee_funding"
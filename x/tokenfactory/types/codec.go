@@ -29,6 +29,12 @@ const (
 	burnTFDenom          = "tokenfactory/burn"
 	forceTransferTFDenom = "tokenfactory/force-transfer"
 	changeAdminTFDenom   = "tokenfactory/change-admin"
+	setBeforeSendHook    = "tokenfactory/set-before-send-hook"
+	setMaxSupply         = "tokenfactory/set-max-supply"
+	grantRole            = "tokenfactory/grant-role"
+	revokeRole           = "tokenfactory/revoke-role"
+	setDenomPaused       = "tokenfactory/set-denom-paused"
+	setAccountFrozen     = "tokenfactory/set-account-frozen"
 	updateTFparams       = "tokenfactory/msg-update-params"
 )
 
@@ -56,6 +62,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgBurn{},
 		&MsgForceTransfer{},
 		&MsgChangeAdmin{},
+		&MsgSetBeforeSendHook{},
+		&MsgSetMaxSupply{},
+		&MsgGrantRole{},
+		&MsgRevokeRole{},
+		&MsgSetDenomPaused{},
+		&MsgSetAccountFrozen{},
 		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -67,5 +79,11 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgBurn{}, burnTFDenom, nil)
 	cdc.RegisterConcrete(&MsgForceTransfer{}, forceTransferTFDenom, nil)
 	cdc.RegisterConcrete(&MsgChangeAdmin{}, changeAdminTFDenom, nil)
+	cdc.RegisterConcrete(&MsgSetBeforeSendHook{}, setBeforeSendHook, nil)
+	cdc.RegisterConcrete(&MsgSetMaxSupply{}, setMaxSupply, nil)
+	cdc.RegisterConcrete(&MsgGrantRole{}, grantRole, nil)
+	cdc.RegisterConcrete(&MsgRevokeRole{}, revokeRole, nil)
+	cdc.RegisterConcrete(&MsgSetDenomPaused{}, setDenomPaused, nil)
+	cdc.RegisterConcrete(&MsgSetAccountFrozen{}, setAccountFrozen, nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateTFparams, nil)
 }
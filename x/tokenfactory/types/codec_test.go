@@ -23,7 +23,7 @@ func (suite *CodecTestSuite) TestRegisterInterfaces() {
 	RegisterInterfaces(registry)
 
 	impls := registry.ListImplementations(sdk.MsgInterfaceProtoName)
-	suite.Require().Equal(7, len(impls))
+	suite.Require().Equal(13, len(impls))
 	suite.Require().ElementsMatch([]string{
 		"/kiichain.tokenfactory.v1beta1.MsgCreateDenom",
 		"/kiichain.tokenfactory.v1beta1.MsgMint",
@@ -32,5 +32,11 @@ func (suite *CodecTestSuite) TestRegisterInterfaces() {
 		"/kiichain.tokenfactory.v1beta1.MsgSetDenomMetadata",
 		"/kiichain.tokenfactory.v1beta1.MsgForceTransfer",
 		"/kiichain.tokenfactory.v1beta1.MsgUpdateParams",
+		"/kiichain.tokenfactory.v1beta1.MsgSetBeforeSendHook",
+		"/kiichain.tokenfactory.v1beta1.MsgSetMaxSupply",
+		"/kiichain.tokenfactory.v1beta1.MsgGrantRole",
+		"/kiichain.tokenfactory.v1beta1.MsgRevokeRole",
+		"/kiichain.tokenfactory.v1beta1.MsgSetDenomPaused",
+		"/kiichain.tokenfactory.v1beta1.MsgSetAccountFrozen",
 	}, impls)
 }
@@ -0,0 +1,13 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+// GetERC20Address returns the address of the native coin ERC20 registered for a denom
+// The address is derived from the module address and the denom, so it is deterministic
+func GetERC20Address(denom string) common.Address {
+	return common.BytesToAddress(address.Module(ModuleName, []byte(denom)))
+}
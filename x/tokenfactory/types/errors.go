@@ -20,4 +20,15 @@ var (
 	ErrCreatorTooLong           = errorsmod.Register(ModuleName, 9, fmt.Sprintf("creator too long, max length is %d bytes", MaxCreatorLength))
 	ErrDenomDoesNotExist        = errorsmod.Register(ModuleName, 10, "denom does not exist")
 	ErrCapabilityNotEnabled     = errorsmod.Register(ModuleName, 11, "this capability is not enabled on chain")
+	ErrInvalidBeforeSendHook    = errorsmod.Register(ModuleName, 12, "invalid before send hook")
+	ErrBeforeSendHookOutOfGas   = errorsmod.Register(ModuleName, 13, fmt.Sprintf("before send hook ran out of gas, the limit is %d", BeforeSendHookGasLimit))
+	ErrInvalidMaxSupply         = errorsmod.Register(ModuleName, 14, "invalid max supply")
+	ErrMaxSupplyExceeded        = errorsmod.Register(ModuleName, 15, "max supply exceeded")
+	ErrInvalidRole              = errorsmod.Register(ModuleName, 16, "invalid role")
+	ErrRoleNotFound             = errorsmod.Register(ModuleName, 17, "role not found")
+	ErrMintAllowanceExceeded    = errorsmod.Register(ModuleName, 18, "mint allowance exceeded")
+	ErrDenomPaused              = errorsmod.Register(ModuleName, 19, "denom transfers are paused")
+	ErrAccountFrozen            = errorsmod.Register(ModuleName, 20, "account is frozen for the denom")
+	ErrERC20Registration        = errorsmod.Register(ModuleName, 21, "failed to register the denom as an ERC20")
+	ErrRenounceRestricted       = errorsmod.Register(ModuleName, 22, "can't renounce the admin of a denom with paused transfers or frozen accounts")
 )
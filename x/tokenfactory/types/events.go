@@ -15,4 +15,12 @@ const (
 	AttributeDenom               = "denom"
 	AttributeNewAdmin            = "new_admin"
 	AttributeDenomMetadata       = "denom_metadata"
+	AttributeBeforeSendHook      = "before_send_hook"
+	AttributeMaxSupply           = "max_supply"
+	AttributeRole                = "role"
+	AttributeAddress             = "address"
+	AttributeMintAllowance       = "mint_allowance"
+	AttributePaused              = "paused"
+	AttributeFrozen              = "frozen"
+	AttributeERC20Address        = "erc20_address"
 )
@@ -3,8 +3,12 @@ package types
 import (
 	context "context"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	erc20types "github.com/cosmos/evm/x/erc20/types"
 )
 
 type BankKeeper interface {
@@ -13,6 +17,7 @@ type BankKeeper interface {
 	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
 
 	HasSupply(ctx context.Context, denom string) bool
+	GetSupply(ctx context.Context, denom string) sdk.Coin
 	IterateTotalSupply(ctx context.Context, cb func(sdk.Coin) bool)
 
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
@@ -35,7 +40,26 @@ type AccountKeeper interface {
 	GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI
 }
 
+// ContractKeeper defines the contract needed to call the before send hooks on CosmWasm contracts
+type ContractKeeper interface {
+	Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+	HasContractInfo(ctx context.Context, contractAddress sdk.AccAddress) bool
+}
+
 // CommunityPoolKeeper defines the contract needed to be fulfilled for community pool interactions.
 type CommunityPoolKeeper interface {
 	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
+
+// Erc20Keeper defines the contract needed to register the denoms as native coin ERC20s
+type Erc20Keeper interface {
+	IsERC20Enabled(ctx sdk.Context) bool
+	IsDenomRegistered(ctx sdk.Context, denom string) bool
+	IsERC20Registered(ctx sdk.Context, erc20 common.Address) bool
+	GetTokenPairID(ctx sdk.Context, token string) []byte
+	GetTokenPair(ctx sdk.Context, id []byte) (erc20types.TokenPair, bool)
+	SetTokenPair(ctx sdk.Context, tokenPair erc20types.TokenPair)
+	SetDenomMap(ctx sdk.Context, denom string, id []byte)
+	SetERC20Map(ctx sdk.Context, erc20 common.Address, id []byte)
+	EnableDynamicPrecompiles(ctx sdk.Context, addresses ...common.Address) error
+}
@@ -46,6 +46,34 @@ func (gs GenesisState) Validate() error {
 				return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid admin address (%s)", err)
 			}
 		}
+
+		if denom.AuthorityMetadata.MaxSupply != nil && !denom.AuthorityMetadata.MaxSupply.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid max supply (%s)", denom.AuthorityMetadata.MaxSupply)
+		}
+
+		// Validate the granted roles
+		if err := denom.AuthorityMetadata.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAuthorityMetadata, "Invalid roles (%s)", err)
+		}
+
+		if denom.BeforeSendHookAddress != "" {
+			_, err = sdk.AccAddressFromBech32(denom.BeforeSendHookAddress)
+			if err != nil {
+				return errorsmod.Wrapf(ErrInvalidBeforeSendHook, "Invalid before send hook address (%s)", err)
+			}
+		}
+
+		seenFrozen := map[string]bool{}
+		for _, address := range denom.FrozenAccounts {
+			_, err = sdk.AccAddressFromBech32(address)
+			if err != nil {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "Invalid frozen address (%s)", err)
+			}
+			if seenFrozen[address] {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "duplicate frozen address: %s", address)
+			}
+			seenFrozen[address] = true
+		}
 	}
 
 	return nil
@@ -83,6 +83,13 @@ func (m *GenesisState) GetFactoryDenoms() []GenesisDenom {
 type GenesisDenom struct {
 	Denom             string                 `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 	AuthorityMetadata DenomAuthorityMetadata `protobuf:"bytes,2,opt,name=authority_metadata,json=authorityMetadata,proto3" json:"authority_metadata" yaml:"authority_metadata"`
+	// before_send_hook_address is the CosmWasm contract called before the
+	// transfers of the denom, empty if no hook is set
+	BeforeSendHookAddress string `protobuf:"bytes,3,opt,name=before_send_hook_address,json=beforeSendHookAddress,proto3" json:"before_send_hook_address,omitempty" yaml:"before_send_hook_address"`
+	// paused is true if the transfers of the denom are paused
+	Paused bool `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
+	// frozen_accounts are the addresses frozen for the denom
+	FrozenAccounts []string `protobuf:"bytes,5,rep,name=frozen_accounts,json=frozenAccounts,proto3" json:"frozen_accounts,omitempty" yaml:"frozen_accounts"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -132,6 +139,27 @@ func (m *GenesisDenom) GetAuthorityMetadata() DenomAuthorityMetadata {
 	return DenomAuthorityMetadata{}
 }
 
+func (m *GenesisDenom) GetBeforeSendHookAddress() string {
+	if m != nil {
+		return m.BeforeSendHookAddress
+	}
+	return ""
+}
+
+func (m *GenesisDenom) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *GenesisDenom) GetFrozenAccounts() []string {
+	if m != nil {
+		return m.FrozenAccounts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kiichain.tokenfactory.v1beta1.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "kiichain.tokenfactory.v1beta1.GenesisDenom")
@@ -142,30 +170,37 @@ func init() {
 }
 
 var fileDescriptor_10d9942a48aa4f88 = []byte{
-	// 355 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xce, 0xce, 0xcc, 0x4c,
-	0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0xc9, 0xcf, 0x4e, 0xcd, 0x4b, 0x4b, 0x4c, 0x2e, 0xc9, 0x2f,
-	0xaa, 0xd4, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce,
-	0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x85, 0x29, 0xd6, 0x43, 0x56, 0xac, 0x07,
-	0x55, 0x2c, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa9, 0x0f, 0x62, 0x41, 0x34, 0x49, 0x99,
-	0xe2, 0xb7, 0x21, 0xb1, 0xb4, 0x24, 0x23, 0xbf, 0x28, 0xb3, 0xa4, 0xd2, 0x37, 0xb5, 0x24, 0x31,
-	0x25, 0xb1, 0x24, 0x11, 0xaa, 0x4d, 0x0b, 0xbf, 0xb6, 0x82, 0xc4, 0xa2, 0xc4, 0x5c, 0xa8, 0xbb,
-	0x94, 0x8e, 0x31, 0x72, 0xf1, 0xb8, 0x43, 0x5c, 0x1a, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0xe4, 0xcc,
-	0xc5, 0x06, 0x51, 0x20, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0xa4, 0xaa, 0x87, 0xd7, 0xe5, 0x7a,
-	0x01, 0x60, 0xc5, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0xb5, 0x0a, 0x15, 0x72, 0xf1,
-	0x41, 0xd5, 0xc5, 0xa7, 0xa4, 0xe6, 0xe5, 0xe7, 0x16, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b,
-	0x69, 0x13, 0x30, 0x0c, 0xea, 0x12, 0x17, 0x90, 0x1e, 0x27, 0x59, 0x90, 0x91, 0x9f, 0xee, 0xc9,
-	0x8b, 0x56, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xa1, 0x1a, 0xa8, 0x14, 0xc4, 0x0b, 0x15, 0x70, 0x81,
-	0xf0, 0x91, 0x3c, 0x02, 0x16, 0x11, 0x52, 0xe3, 0x62, 0x05, 0x2b, 0x05, 0xfb, 0x83, 0xd3, 0x49,
-	0xe0, 0xd3, 0x3d, 0x79, 0x1e, 0x88, 0x49, 0x60, 0x61, 0xa5, 0x20, 0x88, 0xb4, 0x50, 0x3b, 0x23,
-	0x97, 0x10, 0x3c, 0x24, 0xe3, 0x73, 0xa1, 0x41, 0x29, 0xc1, 0x04, 0xf6, 0xbd, 0x29, 0x01, 0x07,
-	0x83, 0xad, 0x72, 0x44, 0x8f, 0x07, 0x27, 0x45, 0xa8, 0xd3, 0x25, 0x21, 0x16, 0x62, 0x1a, 0xaf,
-	0x14, 0x24, 0x88, 0x11, 0x7b, 0x56, 0x2c, 0x2f, 0x16, 0xc8, 0x33, 0x3a, 0x79, 0x9f, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x61, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x3e, 0x3c, 0x8a, 0xe1, 0x8c, 0x0a, 0xd4, 0xd8, 0x2e, 0xa9, 0x2c, 0x48, 0x2d,
-	0x4e, 0x62, 0x03, 0xc7, 0xb2, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x9b, 0xfd, 0x2b, 0xaf, 0xac,
-	0x02, 0x00, 0x00,
+	// 477 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0xeb, 0xb5, 0x9b, 0x58, 0xf6, 0x07, 0x66, 0x31, 0x14, 0x2a, 0x2d, 0x29, 0x46, 0xa0,
+	0xc2, 0xa4, 0x44, 0x1d, 0xda, 0x65, 0xb7, 0x66, 0x93, 0x40, 0x42, 0x48, 0x28, 0xbb, 0x21, 0xa4,
+	0xc8, 0x4d, 0xdc, 0x36, 0x2a, 0xf1, 0x5b, 0x62, 0x17, 0x51, 0xbe, 0x00, 0x57, 0x0e, 0x7c, 0x00,
+	0x3e, 0x0d, 0xda, 0x71, 0x47, 0x4e, 0x11, 0x6a, 0x2f, 0x9c, 0xf3, 0x09, 0x50, 0x6d, 0x13, 0x51,
+	0x26, 0xda, 0x5b, 0xf2, 0xf8, 0xf7, 0x3e, 0x7e, 0x5e, 0xbf, 0xaf, 0x75, 0x3c, 0x4a, 0xd3, 0x78,
+	0x48, 0x53, 0xee, 0x4b, 0x18, 0x31, 0xde, 0xa7, 0xb1, 0x84, 0x7c, 0xea, 0x7f, 0xe8, 0xf4, 0x98,
+	0xa4, 0x1d, 0x7f, 0xc0, 0x38, 0x13, 0xa9, 0xf0, 0xc6, 0x39, 0x48, 0xc0, 0x47, 0x7f, 0x60, 0xef,
+	0x6f, 0xd8, 0x33, 0x70, 0xf3, 0xee, 0x00, 0x06, 0xa0, 0x48, 0x7f, 0xf1, 0xa5, 0x8b, 0x9a, 0xa7,
+	0xab, 0x6f, 0xa0, 0x13, 0x39, 0x84, 0x3c, 0x95, 0xd3, 0x57, 0x4c, 0xd2, 0x84, 0x4a, 0x6a, 0xca,
+	0x9e, 0xae, 0x2e, 0x1b, 0xd3, 0x9c, 0x66, 0x26, 0x17, 0xf9, 0x8e, 0xac, 0xdd, 0xe7, 0x3a, 0xe9,
+	0xa5, 0xa4, 0x92, 0xe1, 0x73, 0x6b, 0x4b, 0x03, 0x36, 0x6a, 0xa1, 0xf6, 0xce, 0xc9, 0x23, 0x6f,
+	0x65, 0x72, 0xef, 0xb5, 0x82, 0x83, 0xc6, 0x55, 0xe1, 0xd6, 0x42, 0x53, 0x8a, 0xdf, 0x5b, 0xfb,
+	0x86, 0x8b, 0x12, 0xc6, 0x21, 0x13, 0xf6, 0x46, 0xab, 0xde, 0xde, 0x39, 0x39, 0x5e, 0x63, 0x66,
+	0x92, 0x5c, 0x2c, 0x6a, 0x82, 0xa3, 0x85, 0x65, 0x59, 0xb8, 0x87, 0x53, 0x9a, 0xbd, 0x3b, 0x23,
+	0xcb, 0x86, 0x24, 0xdc, 0x33, 0xc2, 0x85, 0xfe, 0xff, 0x5a, 0xaf, 0x1a, 0x51, 0x0a, 0x7e, 0x6c,
+	0x6d, 0x2a, 0x54, 0xf5, 0xb1, 0x1d, 0xdc, 0x29, 0x0b, 0x77, 0x57, 0x3b, 0x29, 0x99, 0x84, 0xfa,
+	0x18, 0x7f, 0x46, 0x16, 0xae, 0x5e, 0x32, 0xca, 0xcc, 0x53, 0xda, 0x1b, 0xaa, 0xfb, 0xd3, 0x35,
+	0x81, 0xd5, 0x55, 0xdd, 0x7f, 0xe7, 0x10, 0x3c, 0x30, 0xd1, 0xef, 0xeb, 0x0b, 0x6f, 0xda, 0x93,
+	0xf0, 0xe0, 0xc6, 0xf4, 0xf0, 0x5b, 0xcb, 0xee, 0xb1, 0x3e, 0xe4, 0x2c, 0x12, 0x8c, 0x27, 0xd1,
+	0x10, 0x60, 0x14, 0xd1, 0x24, 0xc9, 0x99, 0x10, 0x76, 0x5d, 0x35, 0xf1, 0xb0, 0x2c, 0x5c, 0x57,
+	0x7b, 0xfe, 0x8f, 0x24, 0xe1, 0xa1, 0x3e, 0xba, 0x64, 0x3c, 0x79, 0x01, 0x30, 0xea, 0x6a, 0x1d,
+	0x3f, 0x59, 0x0c, 0x76, 0x22, 0x58, 0x62, 0x37, 0x5a, 0xa8, 0x7d, 0x2b, 0x38, 0x28, 0x0b, 0x77,
+	0x4f, 0x7b, 0x69, 0x9d, 0x84, 0x06, 0xc0, 0xe7, 0xd6, 0xed, 0x7e, 0x0e, 0x9f, 0x18, 0x8f, 0x68,
+	0x1c, 0xc3, 0x84, 0x4b, 0x61, 0x6f, 0xb6, 0xea, 0xed, 0xed, 0xa0, 0x59, 0x16, 0xee, 0x3d, 0x33,
+	0x8e, 0x65, 0x80, 0x84, 0xfb, 0x5a, 0xe9, 0x1a, 0xe1, 0xac, 0xf1, 0xeb, 0x9b, 0x8b, 0x82, 0x97,
+	0x57, 0x33, 0x07, 0x5d, 0xcf, 0x1c, 0xf4, 0x73, 0xe6, 0xa0, 0x2f, 0x73, 0xa7, 0x76, 0x3d, 0x77,
+	0x6a, 0x3f, 0xe6, 0x4e, 0xed, 0x4d, 0x67, 0x90, 0xca, 0xe1, 0xa4, 0xe7, 0xc5, 0x90, 0xf9, 0xd5,
+	0xc2, 0x56, 0x1f, 0x1f, 0x97, 0x77, 0x57, 0x4e, 0xc7, 0x4c, 0xf4, 0xb6, 0xd4, 0xce, 0x3e, 0xfb,
+	0x1d, 0x00, 0x00, 0xff, 0xff, 0x07, 0x76, 0xf7, 0x53, 0x7a, 0x03, 0x00, 0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -193,6 +228,20 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if !this.AuthorityMetadata.Equal(&that1.AuthorityMetadata) {
 		return false
 	}
+	if this.BeforeSendHookAddress != that1.BeforeSendHookAddress {
+		return false
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if len(this.FrozenAccounts) != len(that1.FrozenAccounts) {
+		return false
+	}
+	for i := range this.FrozenAccounts {
+		if this.FrozenAccounts[i] != that1.FrozenAccounts[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -262,6 +311,32 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FrozenAccounts) > 0 {
+		for iNdEx := len(m.FrozenAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenAccounts[iNdEx])
+			copy(dAtA[i:], m.FrozenAccounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BeforeSendHookAddress) > 0 {
+		i -= len(m.BeforeSendHookAddress)
+		copy(dAtA[i:], m.BeforeSendHookAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BeforeSendHookAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -322,6 +397,19 @@ func (m *GenesisDenom) Size() (n int) {
 	}
 	l = m.AuthorityMetadata.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.BeforeSendHookAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if len(m.FrozenAccounts) > 0 {
+		for _, s := range m.FrozenAccounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -542,6 +630,90 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeforeSendHookAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BeforeSendHookAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAccounts = append(m.FrozenAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/kiichain/kiichain/v4/x/tokenfactory/types"
 )
 
@@ -72,6 +74,157 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "before send hook",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:                 "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						BeforeSendHookAddress: "cosmos1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jta8eyh",
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "max supply",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin:     "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+							MaxSupply: func() *sdkmath.Int { i := sdkmath.NewInt(1000); return &i }(),
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "zero max supply",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin:     "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+							MaxSupply: func() *sdkmath.Int { i := sdkmath.ZeroInt(); return &i }(),
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "roles",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+							Roles: []types.DenomRole{
+								{
+									Role:          types.RoleMinter,
+									Address:       "cosmos1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jta8eyh",
+									MintAllowance: func() *sdkmath.Int { i := sdkmath.NewInt(1000); return &i }(),
+								},
+								{Role: types.RoleBurner, Address: "cosmos1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jta8eyh"},
+							},
+						},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "unknown role",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+							Roles: []types.DenomRole{
+								{Role: "owner", Address: "cosmos1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jta8eyh"},
+							},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated role",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						AuthorityMetadata: types.DenomAuthorityMetadata{
+							Admin: "cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8",
+							Roles: []types.DenomRole{
+								{Role: types.RoleBurner, Address: "cosmos1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jta8eyh"},
+								{Role: types.RoleBurner, Address: "cosmos1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jta8eyh"},
+							},
+						},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid before send hook",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:                 "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						BeforeSendHookAddress: "moose",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "paused denom with frozen accounts",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:          "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						Paused:         true,
+						FrozenAccounts: []string{"cosmos1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jta8eyh"},
+					},
+				},
+			},
+			valid: true,
+		},
+		{
+			desc: "invalid frozen account",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom:          "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						FrozenAccounts: []string{"moose"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated frozen account",
+			genState: &types.GenesisState{
+				FactoryDenoms: []types.GenesisDenom{
+					{
+						Denom: "factory/cosmos1t7egva48prqmzl59x5ngv4zx0dtrwewcdqdjr8/bitcoin",
+						FrozenAccounts: []string{
+							"cosmos1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jta8eyh",
+							"cosmos1ft6e5esdtdegnvcr3djd3ftk4kwpcr6jta8eyh",
+						},
+					},
+				},
+			},
+			valid: false,
+		},
 		{
 			desc: "invalid admin",
 			genState: &types.GenesisState{
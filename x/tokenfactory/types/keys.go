@@ -31,6 +31,9 @@ var (
 	DenomsPrefixKey           = "denoms"
 	CreatorPrefixKey          = "creator"
 	AdminPrefixKey            = "admin"
+	BeforeSendHookAddressKey  = "beforesendhook"
+	DenomPausedKey            = "paused"
+	FrozenAccountsPrefixKey   = "frozen"
 )
 
 // GetDenomPrefixStore returns the store prefix where all the data associated with a specific denom
@@ -49,3 +52,9 @@ func GetCreatorPrefix(creator string) []byte {
 func GetCreatorsPrefix() []byte {
 	return []byte(strings.Join([]string{CreatorPrefixKey, ""}, KeySeparator))
 }
+
+// GetFrozenAccountsPrefix returns the prefix, inside the denom store, where the frozen
+// addresses of the denom are stored
+func GetFrozenAccountsPrefix() []byte {
+	return []byte(strings.Join([]string{FrozenAccountsPrefixKey, ""}, KeySeparator))
+}
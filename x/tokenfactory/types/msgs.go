@@ -10,12 +10,18 @@ import (
 )
 
 const (
-	TypeMsgCreateDenom      = "create_denom"
-	TypeMsgMint             = "tf_mint"
-	TypeMsgBurn             = "tf_burn"
-	TypeMsgForceTransfer    = "force_transfer"
-	TypeMsgChangeAdmin      = "change_admin"
-	TypeMsgSetDenomMetadata = "set_denom_metadata"
+	TypeMsgCreateDenom       = "create_denom"
+	TypeMsgMint              = "tf_mint"
+	TypeMsgBurn              = "tf_burn"
+	TypeMsgForceTransfer     = "force_transfer"
+	TypeMsgChangeAdmin       = "change_admin"
+	TypeMsgSetDenomMetadata  = "set_denom_metadata"
+	TypeMsgSetBeforeSendHook = "set_before_send_hook"
+	TypeMsgSetMaxSupply      = "set_max_supply"
+	TypeMsgGrantRole         = "grant_role"
+	TypeMsgRevokeRole        = "revoke_role"
+	TypeMsgSetDenomPaused    = "set_denom_paused"
+	TypeMsgSetAccountFrozen  = "set_account_frozen"
 )
 
 var _ sdk.Msg = &MsgCreateDenom{}
@@ -41,6 +47,11 @@ func (m MsgCreateDenom) ValidateBasic() error {
 		return errorsmod.Wrap(ErrInvalidDenom, err.Error())
 	}
 
+	// The max supply is optional
+	if m.MaxSupply != nil && !m.MaxSupply.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidMaxSupply, "max supply must be positive, got %s", m.MaxSupply)
+	}
+
 	return nil
 }
 
@@ -278,6 +289,245 @@ func (m MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+var _ sdk.Msg = &MsgSetBeforeSendHook{}
+
+// NewMsgSetBeforeSendHook creates a message to set the before send hook of a denom
+func NewMsgSetBeforeSendHook(sender, denom, cosmwasmAddress string) *MsgSetBeforeSendHook {
+	return &MsgSetBeforeSendHook{
+		Sender:          sender,
+		Denom:           denom,
+		CosmwasmAddress: cosmwasmAddress,
+	}
+}
+
+func (m MsgSetBeforeSendHook) Route() string { return RouterKey }
+func (m MsgSetBeforeSendHook) Type() string  { return TypeMsgSetBeforeSendHook }
+func (m MsgSetBeforeSendHook) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	// An empty address removes the hook
+	if m.CosmwasmAddress != "" {
+		_, err = sdk.AccAddressFromBech32(m.CosmwasmAddress)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid cosmwasm contract address (%s)", err)
+		}
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return ErrInvalidDenom
+	}
+
+	return nil
+}
+
+func (m MsgSetBeforeSendHook) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetBeforeSendHook) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetMaxSupply{}
+
+// NewMsgSetMaxSupply creates a message to set the max supply of a denom
+func NewMsgSetMaxSupply(sender, denom string, maxSupply sdkmath.Int) *MsgSetMaxSupply {
+	return &MsgSetMaxSupply{
+		Sender:    sender,
+		Denom:     denom,
+		MaxSupply: maxSupply,
+	}
+}
+
+func (m MsgSetMaxSupply) Route() string { return RouterKey }
+func (m MsgSetMaxSupply) Type() string  { return TypeMsgSetMaxSupply }
+func (m MsgSetMaxSupply) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return ErrInvalidDenom
+	}
+
+	if m.MaxSupply.IsNil() || !m.MaxSupply.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidMaxSupply, "max supply must be positive, got %s", m.MaxSupply)
+	}
+
+	return nil
+}
+
+func (m MsgSetMaxSupply) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetMaxSupply) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgGrantRole{}
+
+// NewMsgGrantRole creates a message to grant a role over a denom
+func NewMsgGrantRole(sender, denom, role, address string, mintAllowance *sdkmath.Int) *MsgGrantRole {
+	return &MsgGrantRole{
+		Sender:        sender,
+		Denom:         denom,
+		Role:          role,
+		Address:       address,
+		MintAllowance: mintAllowance,
+	}
+}
+
+func (m MsgGrantRole) Route() string { return RouterKey }
+func (m MsgGrantRole) Type() string  { return TypeMsgGrantRole }
+func (m MsgGrantRole) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return ErrInvalidDenom
+	}
+
+	role := DenomRole{Role: m.Role, Address: m.Address, MintAllowance: m.MintAllowance}
+	return role.Validate()
+}
+
+func (m MsgGrantRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgGrantRole) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgRevokeRole{}
+
+// NewMsgRevokeRole creates a message to revoke a role over a denom
+func NewMsgRevokeRole(sender, denom, role, address string) *MsgRevokeRole {
+	return &MsgRevokeRole{
+		Sender:  sender,
+		Denom:   denom,
+		Role:    role,
+		Address: address,
+	}
+}
+
+func (m MsgRevokeRole) Route() string { return RouterKey }
+func (m MsgRevokeRole) Type() string  { return TypeMsgRevokeRole }
+func (m MsgRevokeRole) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return ErrInvalidDenom
+	}
+
+	role := DenomRole{Role: m.Role, Address: m.Address}
+	return role.Validate()
+}
+
+func (m MsgRevokeRole) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRevokeRole) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetDenomPaused{}
+
+// NewMsgSetDenomPaused creates a message to pause or resume the transfers of a denom
+func NewMsgSetDenomPaused(sender, denom string, paused bool) *MsgSetDenomPaused {
+	return &MsgSetDenomPaused{
+		Sender: sender,
+		Denom:  denom,
+		Paused: paused,
+	}
+}
+
+func (m MsgSetDenomPaused) Route() string { return RouterKey }
+func (m MsgSetDenomPaused) Type() string  { return TypeMsgSetDenomPaused }
+func (m MsgSetDenomPaused) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return ErrInvalidDenom
+	}
+
+	return nil
+}
+
+func (m MsgSetDenomPaused) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetDenomPaused) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetAccountFrozen{}
+
+// NewMsgSetAccountFrozen creates a message to freeze or unfreeze an address for a denom
+func NewMsgSetAccountFrozen(sender, denom, address string, frozen bool) *MsgSetAccountFrozen {
+	return &MsgSetAccountFrozen{
+		Sender:  sender,
+		Denom:   denom,
+		Address: address,
+		Frozen:  frozen,
+	}
+}
+
+func (m MsgSetAccountFrozen) Route() string { return RouterKey }
+func (m MsgSetAccountFrozen) Type() string  { return TypeMsgSetAccountFrozen }
+func (m MsgSetAccountFrozen) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(m.Address)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return ErrInvalidDenom
+	}
+
+	return nil
+}
+
+func (m MsgSetAccountFrozen) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetAccountFrozen) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
+
 var _ sdk.Msg = &MsgUpdateParams{}
 
 // GetSignBytes implements the LegacyMsg interface.
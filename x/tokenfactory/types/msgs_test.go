@@ -119,6 +119,24 @@ func TestMsgCreateDenom(t *testing.T) {
 			}),
 			expectPass: false,
 		},
+		{
+			name: "with max supply",
+			msg: createMsg(func(msg types.MsgCreateDenom) types.MsgCreateDenom {
+				maxSupply := sdkmath.NewInt(1000)
+				msg.MaxSupply = &maxSupply
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "zero max supply",
+			msg: createMsg(func(msg types.MsgCreateDenom) types.MsgCreateDenom {
+				maxSupply := sdkmath.ZeroInt()
+				msg.MaxSupply = &maxSupply
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -452,3 +470,489 @@ func TestMsgSetDenomMetadata(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgSetBeforeSendHook(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setBeforeSendHook message
+	baseMsg := types.NewMsgSetBeforeSendHook(
+		addr1.String(),
+		tokenFactoryDenom,
+		addr2.String(),
+	)
+
+	// validate setBeforeSendHook message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_before_send_hook")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgSetBeforeSendHook
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := baseMsg
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty contract address removes the hook",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.CosmwasmAddress = ""
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid contract address",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.CosmwasmAddress = "moose"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgSetBeforeSendHook {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSetMaxSupply(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setMaxSupply message
+	baseMsg := types.NewMsgSetMaxSupply(
+		addr1.String(),
+		tokenFactoryDenom,
+		sdkmath.NewInt(1000),
+	)
+
+	// validate setMaxSupply message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_max_supply")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgSetMaxSupply
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := baseMsg
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero max supply",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := *baseMsg
+				msg.MaxSupply = sdkmath.ZeroInt()
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "nil max supply",
+			msg: func() *types.MsgSetMaxSupply {
+				msg := *baseMsg
+				msg.MaxSupply = sdkmath.Int{}
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgGrantRole(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+	allowance := sdkmath.NewInt(1000)
+
+	// make a proper grantRole message
+	baseMsg := types.NewMsgGrantRole(
+		addr1.String(),
+		tokenFactoryDenom,
+		types.RoleMinter,
+		addr2.String(),
+		&allowance,
+	)
+
+	// validate grantRole message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "grant_role")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgGrantRole
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgGrantRole {
+				msg := baseMsg
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "role without allowance",
+			msg: func() *types.MsgGrantRole {
+				msg := *baseMsg
+				msg.Role = types.RolePauser
+				msg.MintAllowance = nil
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "allowance on another role",
+			msg: func() *types.MsgGrantRole {
+				msg := *baseMsg
+				msg.Role = types.RoleBurner
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative allowance",
+			msg: func() *types.MsgGrantRole {
+				msg := *baseMsg
+				negative := sdkmath.NewInt(-1)
+				msg.MintAllowance = &negative
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "unknown role",
+			msg: func() *types.MsgGrantRole {
+				msg := *baseMsg
+				msg.Role = "owner"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid address",
+			msg: func() *types.MsgGrantRole {
+				msg := *baseMsg
+				msg.Address = "moose"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgGrantRole {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgRevokeRole(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper revokeRole message
+	baseMsg := types.NewMsgRevokeRole(
+		addr1.String(),
+		tokenFactoryDenom,
+		types.RoleBurner,
+		addr2.String(),
+	)
+
+	// validate revokeRole message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "revoke_role")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgRevokeRole
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgRevokeRole {
+				msg := baseMsg
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "unknown role",
+			msg: func() *types.MsgRevokeRole {
+				msg := *baseMsg
+				msg.Role = "owner"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgRevokeRole {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSetDenomPaused(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setDenomPaused message
+	baseMsg := types.NewMsgSetDenomPaused(
+		addr1.String(),
+		tokenFactoryDenom,
+		true,
+	)
+
+	// validate setDenomPaused message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_denom_paused")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgSetDenomPaused
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgSetDenomPaused {
+				msg := baseMsg
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "resume the transfers",
+			msg: func() *types.MsgSetDenomPaused {
+				msg := *baseMsg
+				msg.Paused = false
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgSetDenomPaused {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgSetDenomPaused {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSetAccountFrozen(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	pk2 := ed25519.GenPrivKey().PubKey()
+	addr2 := sdk.AccAddress(pk2.Address())
+	tokenFactoryDenom := fmt.Sprintf("factory/%s/bitcoin", addr1.String())
+
+	// make a proper setAccountFrozen message
+	baseMsg := types.NewMsgSetAccountFrozen(
+		addr1.String(),
+		tokenFactoryDenom,
+		addr2.String(),
+		true,
+	)
+
+	// validate setAccountFrozen message was created as intended
+	require.Equal(t, baseMsg.Route(), types.RouterKey)
+	require.Equal(t, baseMsg.Type(), "set_account_frozen")
+	signers := baseMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        func() *types.MsgSetAccountFrozen
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: func() *types.MsgSetAccountFrozen {
+				msg := baseMsg
+				return msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "unfreeze the address",
+			msg: func() *types.MsgSetAccountFrozen {
+				msg := *baseMsg
+				msg.Frozen = false
+				return &msg
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid address",
+			msg: func() *types.MsgSetAccountFrozen {
+				msg := *baseMsg
+				msg.Address = "moose"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: func() *types.MsgSetAccountFrozen {
+				msg := *baseMsg
+				msg.Denom = "bitcoin"
+				return &msg
+			},
+			expectPass: false,
+		},
+		{
+			name: "empty sender",
+			msg: func() *types.MsgSetAccountFrozen {
+				msg := *baseMsg
+				msg.Sender = ""
+				return &msg
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg().ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -17,14 +17,17 @@ func DefaultParams() Params {
 	return Params{
 		DenomCreationFee:        sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000)),
 		DenomCreationGasConsume: 2_000_000,
+		Erc20RegistrationFee:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10_000_000)),
 	}
 }
 
 // validate params.
 func (p Params) Validate() error {
-	err := validateDenomCreationFee(p.DenomCreationFee)
+	if err := validateDenomCreationFee(p.DenomCreationFee); err != nil {
+		return err
+	}
 
-	return err
+	return validateERC20RegistrationFee(p.Erc20RegistrationFee)
 }
 
 func validateDenomCreationFee(i interface{}) error {
@@ -40,6 +43,14 @@ func validateDenomCreationFee(i interface{}) error {
 	return nil
 }
 
+func validateERC20RegistrationFee(fee sdk.Coins) error {
+	if fee.Validate() != nil {
+		return fmt.Errorf("invalid erc20 registration fee: %+v", fee)
+	}
+
+	return nil
+}
+
 func validateDenomCreationFeeGasConsume(i interface{}) error {
 	_, ok := i.(uint64)
 	if !ok {
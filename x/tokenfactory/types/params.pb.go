@@ -33,6 +33,14 @@ type Params struct {
 	// more gas consumption to the base cost.
 	// https://github.com/CosmWasm/token-factory/issues/11
 	DenomCreationGasConsume uint64 `protobuf:"varint,2,opt,name=denom_creation_gas_consume,json=denomCreationGasConsume,proto3" json:"denom_creation_gas_consume,omitempty" yaml:"denom_creation_gas_consume"`
+	// enable_erc20_registration allows the denoms to be registered as ERC20s on
+	// their creation. The registration also requires the erc20 module to be
+	// enabled.
+	EnableErc20Registration bool `protobuf:"varint,3,opt,name=enable_erc20_registration,json=enableErc20Registration,proto3" json:"enable_erc20_registration,omitempty" yaml:"enable_erc20_registration"`
+	// erc20_registration_fee is charged to the creator of a denom registered as
+	// an ERC20, as each registration adds a dynamic precompile to the erc20
+	// module that is never removed.
+	Erc20RegistrationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=erc20_registration_fee,json=erc20RegistrationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"erc20_registration_fee" yaml:"erc20_registration_fee"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -82,6 +90,20 @@ func (m *Params) GetDenomCreationGasConsume() uint64 {
 	return 0
 }
 
+func (m *Params) GetEnableErc20Registration() bool {
+	if m != nil {
+		return m.EnableErc20Registration
+	}
+	return false
+}
+
+func (m *Params) GetErc20RegistrationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Erc20RegistrationFee
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kiichain.tokenfactory.v1beta1.Params")
 }
@@ -91,29 +113,34 @@ func init() {
 }
 
 var fileDescriptor_bd947e39c135a1a3 = []byte{
-	// 352 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x41, 0x4f, 0xea, 0x40,
-	0x10, 0xc7, 0xbb, 0xbc, 0x17, 0x0e, 0x7d, 0x97, 0x97, 0xe6, 0x25, 0x0f, 0x48, 0xdc, 0x62, 0x4f,
-	0x68, 0x62, 0x1b, 0x34, 0x5e, 0x3c, 0x42, 0xa2, 0x07, 0x43, 0x62, 0x38, 0x7a, 0x69, 0xa6, 0xcb,
-	0x52, 0x36, 0xd8, 0x1d, 0xd2, 0x5d, 0x8c, 0xfd, 0x08, 0xde, 0x3c, 0xf9, 0x21, 0xfc, 0x24, 0x1c,
-	0x39, 0x7a, 0xaa, 0x06, 0xbe, 0x01, 0x9f, 0xc0, 0xd0, 0x2e, 0x04, 0xd4, 0x78, 0xda, 0x99, 0xcc,
-	0x7f, 0x7e, 0xf3, 0x9f, 0x1d, 0xfb, 0x78, 0x2c, 0x04, 0x1b, 0x81, 0x90, 0x81, 0xc6, 0x31, 0x97,
-	0x43, 0x60, 0x1a, 0xd3, 0x2c, 0xb8, 0x6f, 0x47, 0x5c, 0x43, 0x3b, 0x98, 0x40, 0x0a, 0x89, 0xf2,
-	0x27, 0x29, 0x6a, 0x74, 0x0e, 0x36, 0x5a, 0x7f, 0x57, 0xeb, 0x1b, 0x6d, 0xe3, 0x5f, 0x8c, 0x31,
-	0x16, 0xca, 0x60, 0x1d, 0x95, 0x4d, 0x8d, 0xf3, 0x9f, 0x07, 0xc0, 0x54, 0x8f, 0x30, 0x15, 0x3a,
-	0xeb, 0x71, 0x0d, 0x03, 0xd0, 0x60, 0xda, 0xea, 0x0c, 0x55, 0x82, 0x2a, 0x2c, 0x79, 0x65, 0x62,
-	0x4a, 0xb4, 0xcc, 0x82, 0x08, 0x14, 0xdf, 0x72, 0x18, 0x0a, 0x59, 0xd6, 0xbd, 0xc7, 0x8a, 0x5d,
-	0xbd, 0x29, 0x7c, 0x3b, 0xcf, 0xc4, 0x76, 0x06, 0x5c, 0x62, 0x12, 0xb2, 0x94, 0x83, 0x16, 0x28,
-	0xc3, 0x21, 0xe7, 0x35, 0xd2, 0xfc, 0xd5, 0xfa, 0x73, 0x5a, 0xf7, 0x0d, 0x76, 0x0d, 0xda, 0x6c,
-	0xe1, 0x77, 0x51, 0xc8, 0x4e, 0x6f, 0x96, 0xbb, 0xd6, 0x2a, 0x77, 0xeb, 0x19, 0x24, 0x77, 0x17,
-	0xde, 0x57, 0x84, 0xf7, 0xf2, 0xe6, 0xb6, 0x62, 0xa1, 0x47, 0xd3, 0xc8, 0x67, 0x98, 0x18, 0x83,
-	0xe6, 0x39, 0x51, 0x83, 0x71, 0xa0, 0xb3, 0x09, 0x57, 0x05, 0x4d, 0xf5, 0xff, 0x16, 0x80, 0xae,
-	0xe9, 0xbf, 0xe4, 0xdc, 0x19, 0xda, 0x8d, 0x4f, 0xd0, 0x18, 0x54, 0xc8, 0x50, 0xaa, 0x69, 0xc2,
-	0x6b, 0x95, 0x26, 0x69, 0xfd, 0xee, 0x1c, 0xcd, 0x72, 0x97, 0xac, 0x72, 0xf7, 0xf0, 0x5b, 0x13,
-	0x3b, 0x7a, 0xaf, 0xff, 0x7f, 0x6f, 0xc0, 0x15, 0xa8, 0x6e, 0x59, 0xe9, 0x5c, 0xcf, 0x16, 0x94,
-	0xcc, 0x17, 0x94, 0xbc, 0x2f, 0x28, 0x79, 0x5a, 0x52, 0x6b, 0xbe, 0xa4, 0xd6, 0xeb, 0x92, 0x5a,
-	0xb7, 0xed, 0x1d, 0xf7, 0xdb, 0x13, 0x6d, 0x83, 0x87, 0xfd, 0x6b, 0x15, 0xcb, 0x44, 0xd5, 0xe2,
-	0x7f, 0xcf, 0x3e, 0x02, 0x00, 0x00, 0xff, 0xff, 0x85, 0x1d, 0x09, 0x9e, 0x34, 0x02, 0x00, 0x00,
+	// 429 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xb3, 0x34, 0xaa, 0x90, 0xb9, 0x20, 0xab, 0xa2, 0x49, 0xa4, 0xda, 0xc6, 0xe2, 0x60,
+	0x90, 0xb0, 0x49, 0x11, 0x17, 0x8e, 0x8e, 0x28, 0x07, 0x54, 0x09, 0x7c, 0xe4, 0x62, 0xd6, 0x9b,
+	0x89, 0xb3, 0x4a, 0xbd, 0x1b, 0xed, 0x6e, 0x10, 0x7e, 0x8b, 0x9e, 0x78, 0x00, 0x8e, 0x3c, 0x49,
+	0x8e, 0x3d, 0x72, 0x32, 0x28, 0x79, 0x83, 0x3c, 0x01, 0xca, 0xee, 0x36, 0x72, 0xdb, 0x00, 0xe2,
+	0xe4, 0x1d, 0xfd, 0xff, 0x7c, 0xff, 0x78, 0x34, 0xce, 0xb3, 0x19, 0xa5, 0x64, 0x8a, 0x29, 0x4b,
+	0x14, 0x9f, 0x01, 0x9b, 0x60, 0xa2, 0xb8, 0xa8, 0x93, 0xcf, 0xc3, 0x02, 0x14, 0x1e, 0x26, 0x73,
+	0x2c, 0x70, 0x25, 0xe3, 0xb9, 0xe0, 0x8a, 0xbb, 0x27, 0xd7, 0xde, 0xb8, 0xed, 0x8d, 0xad, 0x77,
+	0x70, 0x54, 0xf2, 0x92, 0x6b, 0x67, 0xb2, 0x7d, 0x99, 0xa6, 0xc1, 0xab, 0xbf, 0x07, 0xe0, 0x85,
+	0x9a, 0x72, 0x41, 0x55, 0x7d, 0x0e, 0x0a, 0x8f, 0xb1, 0xc2, 0xb6, 0xad, 0x4f, 0xb8, 0xac, 0xb8,
+	0xcc, 0x0d, 0xcf, 0x14, 0x56, 0xf2, 0x4c, 0x95, 0x14, 0x58, 0xc2, 0x8e, 0x43, 0x38, 0x65, 0x46,
+	0x0f, 0x2f, 0xbb, 0xce, 0xe1, 0x7b, 0x3d, 0xb7, 0xfb, 0x15, 0x39, 0xee, 0x18, 0x18, 0xaf, 0x72,
+	0x22, 0x00, 0x2b, 0xca, 0x59, 0x3e, 0x01, 0xe8, 0xa1, 0xe0, 0x20, 0x7a, 0x70, 0xda, 0x8f, 0x2d,
+	0x76, 0x0b, 0xba, 0xfe, 0x8b, 0x78, 0xc4, 0x29, 0x4b, 0xcf, 0x97, 0x8d, 0xdf, 0xd9, 0x34, 0x7e,
+	0xbf, 0xc6, 0xd5, 0xc5, 0xeb, 0xf0, 0x2e, 0x22, 0xfc, 0xfe, 0xd3, 0x8f, 0x4a, 0xaa, 0xa6, 0x8b,
+	0x22, 0x26, 0xbc, 0xb2, 0x03, 0xda, 0xcf, 0x73, 0x39, 0x9e, 0x25, 0xaa, 0x9e, 0x83, 0xd4, 0x34,
+	0x99, 0x3d, 0xd4, 0x80, 0x91, 0xed, 0x3f, 0x03, 0x70, 0x27, 0xce, 0xe0, 0x16, 0xb4, 0xc4, 0x32,
+	0x27, 0x9c, 0xc9, 0x45, 0x05, 0xbd, 0x7b, 0x01, 0x8a, 0xba, 0xe9, 0xd3, 0x65, 0xe3, 0xa3, 0x4d,
+	0xe3, 0x3f, 0xde, 0x3b, 0x44, 0xcb, 0x1f, 0x66, 0xc7, 0x37, 0x02, 0xde, 0x62, 0x39, 0x32, 0x8a,
+	0xfb, 0xc9, 0xe9, 0x03, 0xc3, 0xc5, 0x05, 0xe4, 0x20, 0xc8, 0xe9, 0x8b, 0x5c, 0x40, 0x49, 0xa5,
+	0x12, 0xda, 0xd6, 0x3b, 0x08, 0x50, 0x74, 0x3f, 0x7d, 0xb2, 0x69, 0xfc, 0xc0, 0x44, 0xfc, 0xd1,
+	0x1a, 0x66, 0xc7, 0x46, 0x7b, 0xb3, 0x95, 0xb2, 0x96, 0xe2, 0x7e, 0x43, 0xce, 0xa3, 0xbb, 0x0d,
+	0x7a, 0xcd, 0xdd, 0x7f, 0xad, 0xf9, 0x83, 0x5d, 0xf3, 0x89, 0x8d, 0xdf, 0x8b, 0xf9, 0xbf, 0x55,
+	0x1f, 0xc1, 0xed, 0x09, 0xcf, 0x00, 0xd2, 0x77, 0xcb, 0x95, 0x87, 0xae, 0x56, 0x1e, 0xfa, 0xb5,
+	0xf2, 0xd0, 0xe5, 0xda, 0xeb, 0x5c, 0xad, 0xbd, 0xce, 0x8f, 0xb5, 0xd7, 0xf9, 0x38, 0x6c, 0x91,
+	0x77, 0x97, 0xba, 0x7b, 0x7c, 0xb9, 0x79, 0xb4, 0x3a, 0xa8, 0x38, 0xd4, 0x67, 0xf6, 0xf2, 0x77,
+	0x00, 0x00, 0x00, 0xff, 0xff, 0x0e, 0xa4, 0x26, 0x2c, 0x3b, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -136,6 +163,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Erc20RegistrationFee) > 0 {
+		for iNdEx := len(m.Erc20RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Erc20RegistrationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EnableErc20Registration {
+		i--
+		if m.EnableErc20Registration {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.DenomCreationGasConsume != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DenomCreationGasConsume))
 		i--
@@ -184,6 +235,15 @@ func (m *Params) Size() (n int) {
 	if m.DenomCreationGasConsume != 0 {
 		n += 1 + sovParams(uint64(m.DenomCreationGasConsume))
 	}
+	if m.EnableErc20Registration {
+		n += 2
+	}
+	if len(m.Erc20RegistrationFee) > 0 {
+		for _, e := range m.Erc20RegistrationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -275,6 +335,60 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableErc20Registration", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableErc20Registration = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20RegistrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20RegistrationFee = append(m.Erc20RegistrationFee, types.Coin{})
+			if err := m.Erc20RegistrationFee[len(m.Erc20RegistrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -389,630 +389,2541 @@ func (m *QueryDenomsFromAdminResponse) GetDenoms() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "kiichain.tokenfactory.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "kiichain.tokenfactory.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryDenomAuthorityMetadataRequest)(nil), "kiichain.tokenfactory.v1beta1.QueryDenomAuthorityMetadataRequest")
-	proto.RegisterType((*QueryDenomAuthorityMetadataResponse)(nil), "kiichain.tokenfactory.v1beta1.QueryDenomAuthorityMetadataResponse")
-	proto.RegisterType((*QueryDenomsFromCreatorRequest)(nil), "kiichain.tokenfactory.v1beta1.QueryDenomsFromCreatorRequest")
-	proto.RegisterType((*QueryDenomsFromCreatorResponse)(nil), "kiichain.tokenfactory.v1beta1.QueryDenomsFromCreatorResponse")
-	proto.RegisterType((*QueryDenomsFromAdminRequest)(nil), "kiichain.tokenfactory.v1beta1.QueryDenomsFromAdminRequest")
-	proto.RegisterType((*QueryDenomsFromAdminResponse)(nil), "kiichain.tokenfactory.v1beta1.QueryDenomsFromAdminResponse")
+// QueryBeforeSendHookAddressRequest defines the request structure for the
+// BeforeSendHookAddress gRPC query.
+type QueryBeforeSendHookAddressRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 }
 
-func init() {
-	proto.RegisterFile("kiichain/tokenfactory/v1beta1/query.proto", fileDescriptor_589456711a18ee88)
+func (m *QueryBeforeSendHookAddressRequest) Reset()         { *m = QueryBeforeSendHookAddressRequest{} }
+func (m *QueryBeforeSendHookAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBeforeSendHookAddressRequest) ProtoMessage()    {}
+func (*QueryBeforeSendHookAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_589456711a18ee88, []int{8}
 }
-
-var fileDescriptor_589456711a18ee88 = []byte{
-	// 636 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x6b, 0x13, 0x41,
-	0x18, 0xce, 0xaa, 0x8d, 0x74, 0xfc, 0xec, 0x58, 0x44, 0xd7, 0x76, 0xa3, 0x23, 0xd5, 0x56, 0x74,
-	0x87, 0x54, 0x0a, 0x52, 0xab, 0xb4, 0xdb, 0x2a, 0x4a, 0x2d, 0xe8, 0x1e, 0x7b, 0x09, 0x93, 0x64,
-	0xba, 0x59, 0xda, 0xdd, 0xd9, 0xee, 0x4e, 0xc4, 0x10, 0x72, 0xf1, 0xe2, 0x55, 0xf0, 0xee, 0x5f,
-	0xf0, 0x0f, 0xf8, 0x03, 0x7a, 0xf0, 0x50, 0x10, 0xc4, 0x53, 0x90, 0xc4, 0x8b, 0xd7, 0xfc, 0x02,
-	0xd9, 0x99, 0x49, 0x9a, 0x66, 0x63, 0x3e, 0xea, 0x69, 0x27, 0xef, 0xc7, 0xf3, 0x3e, 0xcf, 0xfb,
-	0x41, 0xc0, 0xc2, 0xae, 0xeb, 0x16, 0x4a, 0xc4, 0xf5, 0x31, 0x67, 0xbb, 0xd4, 0xdf, 0x21, 0x05,
-	0xce, 0xc2, 0x0a, 0x7e, 0x9b, 0xcd, 0x53, 0x4e, 0xb2, 0x78, 0xbf, 0x4c, 0xc3, 0x8a, 0x19, 0x84,
-	0x8c, 0x33, 0x38, 0xdb, 0x0e, 0x35, 0xbb, 0x43, 0x4d, 0x15, 0xaa, 0x4f, 0x3b, 0xcc, 0x61, 0x22,
-	0x12, 0xc7, 0x2f, 0x99, 0xa4, 0xcf, 0x38, 0x8c, 0x39, 0x7b, 0x14, 0x93, 0xc0, 0xc5, 0xc4, 0xf7,
-	0x19, 0x27, 0xdc, 0x65, 0x7e, 0xa4, 0xbc, 0xf7, 0x0a, 0x2c, 0xf2, 0x58, 0x84, 0xf3, 0x24, 0xa2,
-	0xb2, 0x56, 0xa7, 0x72, 0x40, 0x1c, 0xd7, 0x17, 0xc1, 0x2a, 0x76, 0x69, 0x30, 0x53, 0x52, 0xe6,
-	0x25, 0x16, 0xba, 0xbc, 0xb2, 0x45, 0x39, 0x29, 0x12, 0x4e, 0xda, 0x25, 0x06, 0xa7, 0x05, 0x24,
-	0x24, 0x9e, 0xa2, 0x83, 0xa6, 0x01, 0x7c, 0x13, 0x93, 0x78, 0x2d, 0x8c, 0x36, 0xdd, 0x2f, 0xd3,
-	0x88, 0xa3, 0x6d, 0x70, 0xe5, 0x98, 0x35, 0x0a, 0x98, 0x1f, 0x51, 0xb8, 0x0e, 0xd2, 0x32, 0xf9,
-	0x9a, 0x76, 0x53, 0x9b, 0x3f, 0xb7, 0x38, 0x67, 0x0e, 0xec, 0x8f, 0x29, 0xd3, 0xad, 0x33, 0x07,
-	0xf5, 0x4c, 0xca, 0x56, 0xa9, 0xe8, 0x15, 0x40, 0x02, 0x7b, 0x83, 0xfa, 0xcc, 0x5b, 0xeb, 0x95,
-	0xa0, 0x18, 0xc0, 0x3b, 0x60, 0xa2, 0x18, 0x07, 0x88, 0x4a, 0x93, 0xd6, 0xe5, 0x56, 0x3d, 0x73,
-	0xbe, 0x42, 0xbc, 0xbd, 0x65, 0x24, 0xcc, 0xc8, 0x96, 0x6e, 0xf4, 0x45, 0x03, 0xb7, 0x07, 0xc2,
-	0x29, 0xea, 0x1f, 0x34, 0x00, 0x3b, 0xfd, 0xca, 0x79, 0xca, 0xad, 0x74, 0x2c, 0x0d, 0xd1, 0xd1,
-	0x1f, 0xdb, 0xba, 0x15, 0xeb, 0x6a, 0xd5, 0x33, 0xd7, 0x25, 0xb1, 0x24, 0x3c, 0xb2, 0xa7, 0x12,
-	0x33, 0x42, 0x5b, 0x60, 0xf6, 0x88, 0x70, 0xf4, 0x3c, 0x64, 0xde, 0x7a, 0x48, 0x09, 0x67, 0x61,
-	0x5b, 0xfa, 0x7d, 0x70, 0xb6, 0x20, 0x2d, 0x4a, 0x3c, 0x6c, 0xd5, 0x33, 0x17, 0x65, 0x0d, 0xe5,
-	0x40, 0x76, 0x3b, 0x04, 0x6d, 0x02, 0xe3, 0x5f, 0x70, 0x4a, 0xfa, 0x02, 0x48, 0x8b, 0x5e, 0xc5,
-	0x53, 0x3b, 0x3d, 0x3f, 0x69, 0x4d, 0xb5, 0xea, 0x99, 0x0b, 0x5d, 0xbd, 0x8c, 0x90, 0xad, 0x02,
-	0xd0, 0x33, 0x70, 0xa3, 0x07, 0x6c, 0xad, 0xe8, 0xb9, 0x7e, 0xd7, 0x50, 0x48, 0xfc, 0x3b, 0x39,
-	0x14, 0x61, 0x46, 0xb6, 0x74, 0xa3, 0x97, 0x60, 0xa6, 0x3f, 0xcc, 0xd8, 0x8c, 0x16, 0xbf, 0xa6,
-	0xc1, 0x84, 0xc0, 0x82, 0x9f, 0x35, 0x90, 0x96, 0x0b, 0x05, 0xb3, 0x43, 0xe6, 0x95, 0xdc, 0x68,
-	0x7d, 0x71, 0x9c, 0x14, 0x49, 0x13, 0x3d, 0x78, 0xff, 0xfd, 0xf7, 0xa7, 0x53, 0x77, 0xe1, 0x1c,
-	0x1e, 0xe5, 0xa0, 0xe0, 0x1f, 0x0d, 0x5c, 0xed, 0xbf, 0x29, 0x70, 0x6d, 0x94, 0xea, 0x03, 0x0f,
-	0x42, 0xb7, 0xfe, 0x07, 0x42, 0x09, 0x7a, 0x21, 0x04, 0x59, 0x70, 0x75, 0x88, 0x20, 0xd9, 0x7b,
-	0x5c, 0x15, 0xdf, 0x1a, 0x4e, 0x2e, 0x36, 0xfc, 0xa1, 0x81, 0xa9, 0xc4, 0xc6, 0xc1, 0x95, 0x91,
-	0x39, 0xf6, 0xd9, 0x7b, 0xfd, 0xc9, 0x09, 0xb3, 0x95, 0xb8, 0x0d, 0x21, 0xee, 0x29, 0x5c, 0x19,
-	0x49, 0x5c, 0x6e, 0x27, 0x64, 0x5e, 0x4e, 0x1d, 0x11, 0xae, 0xaa, 0x47, 0x0d, 0x7e, 0xd3, 0xc0,
-	0xa5, 0x9e, 0xb5, 0x85, 0xcb, 0xe3, 0x11, 0xeb, 0x3e, 0x19, 0xfd, 0xf1, 0x89, 0x72, 0x95, 0xa4,
-	0x55, 0x21, 0x69, 0x19, 0x3e, 0x1a, 0x43, 0x92, 0xb8, 0x40, 0x5c, 0x15, 0x9f, 0x9a, 0xb5, 0x79,
-	0xd0, 0x30, 0xb4, 0xc3, 0x86, 0xa1, 0xfd, 0x6a, 0x18, 0xda, 0xc7, 0xa6, 0x91, 0x3a, 0x6c, 0x1a,
-	0xa9, 0x9f, 0x4d, 0x23, 0xb5, 0x9d, 0x75, 0x5c, 0x5e, 0x2a, 0xe7, 0xcd, 0x02, 0xf3, 0x8e, 0xd0,
-	0x3b, 0x8f, 0x77, 0xc7, 0x0b, 0xf1, 0x4a, 0x40, 0xa3, 0x7c, 0x5a, 0xfc, 0x65, 0x3c, 0xfc, 0x1b,
-	0x00, 0x00, 0xff, 0xff, 0x57, 0xd1, 0xa0, 0xd6, 0x41, 0x07, 0x00, 0x00,
+func (m *QueryBeforeSendHookAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBeforeSendHookAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBeforeSendHookAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBeforeSendHookAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBeforeSendHookAddressRequest.Merge(m, src)
+}
+func (m *QueryBeforeSendHookAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBeforeSendHookAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBeforeSendHookAddressRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryBeforeSendHookAddressRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params defines a gRPC query method that returns the tokenfactory module's
-	// parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// DenomAuthorityMetadata defines a gRPC query method for fetching
-	// DenomAuthorityMetadata for a particular denom.
-	DenomAuthorityMetadata(ctx context.Context, in *QueryDenomAuthorityMetadataRequest, opts ...grpc.CallOption) (*QueryDenomAuthorityMetadataResponse, error)
-	// DenomsFromCreator defines a gRPC query method for fetching all
-	// denominations created by a specific admin/creator.
-	DenomsFromCreator(ctx context.Context, in *QueryDenomsFromCreatorRequest, opts ...grpc.CallOption) (*QueryDenomsFromCreatorResponse, error)
-	// DenomsFromAdmin defines a gRPC query method for fetching all
-	// denominations owned by a specific admin.
-	DenomsFromAdmin(ctx context.Context, in *QueryDenomsFromAdminRequest, opts ...grpc.CallOption) (*QueryDenomsFromAdminResponse, error)
+func (m *QueryBeforeSendHookAddressRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryBeforeSendHookAddressResponse defines the response structure for the
+// BeforeSendHookAddress gRPC query. The address is empty if no hook is set
+type QueryBeforeSendHookAddressResponse struct {
+	CosmwasmAddress string `protobuf:"bytes,1,opt,name=cosmwasm_address,json=cosmwasmAddress,proto3" json:"cosmwasm_address,omitempty" yaml:"cosmwasm_address"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryBeforeSendHookAddressResponse) Reset()         { *m = QueryBeforeSendHookAddressResponse{} }
+func (m *QueryBeforeSendHookAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBeforeSendHookAddressResponse) ProtoMessage()    {}
+func (*QueryBeforeSendHookAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_589456711a18ee88, []int{9}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.tokenfactory.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryBeforeSendHookAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBeforeSendHookAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBeforeSendHookAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryBeforeSendHookAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBeforeSendHookAddressResponse.Merge(m, src)
+}
+func (m *QueryBeforeSendHookAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBeforeSendHookAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBeforeSendHookAddressResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) DenomAuthorityMetadata(ctx context.Context, in *QueryDenomAuthorityMetadataRequest, opts ...grpc.CallOption) (*QueryDenomAuthorityMetadataResponse, error) {
-	out := new(QueryDenomAuthorityMetadataResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.tokenfactory.v1beta1.Query/DenomAuthorityMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBeforeSendHookAddressResponse proto.InternalMessageInfo
+
+func (m *QueryBeforeSendHookAddressResponse) GetCosmwasmAddress() string {
+	if m != nil {
+		return m.CosmwasmAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) DenomsFromCreator(ctx context.Context, in *QueryDenomsFromCreatorRequest, opts ...grpc.CallOption) (*QueryDenomsFromCreatorResponse, error) {
-	out := new(QueryDenomsFromCreatorResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.tokenfactory.v1beta1.Query/DenomsFromCreator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryDenomPausedRequest defines the request structure for the DenomPaused
+// gRPC query.
+type QueryDenomPausedRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 }
 
-func (c *queryClient) DenomsFromAdmin(ctx context.Context, in *QueryDenomsFromAdminRequest, opts ...grpc.CallOption) (*QueryDenomsFromAdminResponse, error) {
-	out := new(QueryDenomsFromAdminResponse)
-	err := c.cc.Invoke(ctx, "/kiichain.tokenfactory.v1beta1.Query/DenomsFromAdmin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryDenomPausedRequest) Reset()         { *m = QueryDenomPausedRequest{} }
+func (m *QueryDenomPausedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomPausedRequest) ProtoMessage()    {}
+func (*QueryDenomPausedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_589456711a18ee88, []int{10}
+}
+func (m *QueryDenomPausedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomPausedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomPausedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryDenomPausedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomPausedRequest.Merge(m, src)
+}
+func (m *QueryDenomPausedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomPausedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomPausedRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params defines a gRPC query method that returns the tokenfactory module's
-	// parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// DenomAuthorityMetadata defines a gRPC query method for fetching
-	// DenomAuthorityMetadata for a particular denom.
-	DenomAuthorityMetadata(context.Context, *QueryDenomAuthorityMetadataRequest) (*QueryDenomAuthorityMetadataResponse, error)
-	// DenomsFromCreator defines a gRPC query method for fetching all
-	// denominations created by a specific admin/creator.
-	DenomsFromCreator(context.Context, *QueryDenomsFromCreatorRequest) (*QueryDenomsFromCreatorResponse, error)
-	// DenomsFromAdmin defines a gRPC query method for fetching all
-	// denominations owned by a specific admin.
-	DenomsFromAdmin(context.Context, *QueryDenomsFromAdminRequest) (*QueryDenomsFromAdminResponse, error)
+var xxx_messageInfo_QueryDenomPausedRequest proto.InternalMessageInfo
+
+func (m *QueryDenomPausedRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryDenomPausedResponse defines the response structure for the DenomPaused
+// gRPC query.
+type QueryDenomPausedResponse struct {
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty" yaml:"paused"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryDenomPausedResponse) Reset()         { *m = QueryDenomPausedResponse{} }
+func (m *QueryDenomPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomPausedResponse) ProtoMessage()    {}
+func (*QueryDenomPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_589456711a18ee88, []int{11}
 }
-func (*UnimplementedQueryServer) DenomAuthorityMetadata(ctx context.Context, req *QueryDenomAuthorityMetadataRequest) (*QueryDenomAuthorityMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomAuthorityMetadata not implemented")
+func (m *QueryDenomPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) DenomsFromCreator(ctx context.Context, req *QueryDenomsFromCreatorRequest) (*QueryDenomsFromCreatorResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomsFromCreator not implemented")
+func (m *QueryDenomPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) DenomsFromAdmin(ctx context.Context, req *QueryDenomsFromAdminRequest) (*QueryDenomsFromAdminResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomsFromAdmin not implemented")
+func (m *QueryDenomPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomPausedResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryDenomPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomPausedResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.tokenfactory.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryDenomPausedResponse proto.InternalMessageInfo
+
+func (m *QueryDenomPausedResponse) GetPaused() bool {
+	if m != nil {
+		return m.Paused
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_DenomAuthorityMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDenomAuthorityMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomAuthorityMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.tokenfactory.v1beta1.Query/DenomAuthorityMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomAuthorityMetadata(ctx, req.(*QueryDenomAuthorityMetadataRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryAccountFrozenRequest defines the request structure for the
+// AccountFrozen gRPC query.
+type QueryAccountFrozenRequest struct {
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
 }
 
-func _Query_DenomsFromCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDenomsFromCreatorRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomsFromCreator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.tokenfactory.v1beta1.Query/DenomsFromCreator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomsFromCreator(ctx, req.(*QueryDenomsFromCreatorRequest))
+func (m *QueryAccountFrozenRequest) Reset()         { *m = QueryAccountFrozenRequest{} }
+func (m *QueryAccountFrozenRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountFrozenRequest) ProtoMessage()    {}
+func (*QueryAccountFrozenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_589456711a18ee88, []int{12}
+}
+func (m *QueryAccountFrozenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountFrozenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountFrozenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAccountFrozenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountFrozenRequest.Merge(m, src)
+}
+func (m *QueryAccountFrozenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountFrozenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountFrozenRequest.DiscardUnknown(m)
 }
 
-func _Query_DenomsFromAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDenomsFromAdminRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomsFromAdmin(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kiichain.tokenfactory.v1beta1.Query/DenomsFromAdmin",
+var xxx_messageInfo_QueryAccountFrozenRequest proto.InternalMessageInfo
+
+func (m *QueryAccountFrozenRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomsFromAdmin(ctx, req.(*QueryDenomsFromAdminRequest))
+	return ""
+}
+
+func (m *QueryAccountFrozenRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kiichain.tokenfactory.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "DenomAuthorityMetadata",
-			Handler:    _Query_DenomAuthorityMetadata_Handler,
-		},
-		{
-			MethodName: "DenomsFromCreator",
-			Handler:    _Query_DenomsFromCreator_Handler,
-		},
-		{
-			MethodName: "DenomsFromAdmin",
-			Handler:    _Query_DenomsFromAdmin_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kiichain/tokenfactory/v1beta1/query.proto",
+// QueryAccountFrozenResponse defines the response structure for the
+// AccountFrozen gRPC query.
+type QueryAccountFrozenResponse struct {
+	Frozen bool `protobuf:"varint,1,opt,name=frozen,proto3" json:"frozen,omitempty" yaml:"frozen"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAccountFrozenResponse) Reset()         { *m = QueryAccountFrozenResponse{} }
+func (m *QueryAccountFrozenResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountFrozenResponse) ProtoMessage()    {}
+func (*QueryAccountFrozenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_589456711a18ee88, []int{13}
+}
+func (m *QueryAccountFrozenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountFrozenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountFrozenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAccountFrozenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountFrozenResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAccountFrozenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountFrozenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountFrozenResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAccountFrozenResponse proto.InternalMessageInfo
+
+func (m *QueryAccountFrozenResponse) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFrozenAccountsRequest defines the request structure for the
+// FrozenAccounts gRPC query.
+type QueryFrozenAccountsRequest struct {
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryFrozenAccountsRequest) Reset()         { *m = QueryFrozenAccountsRequest{} }
+func (m *QueryFrozenAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenAccountsRequest) ProtoMessage()    {}
+func (*QueryFrozenAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_589456711a18ee88, []int{14}
+}
+func (m *QueryFrozenAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenAccountsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDenomAuthorityMetadataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFrozenAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenAccountsRequest.Merge(m, src)
 }
-
-func (m *QueryDenomAuthorityMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFrozenAccountsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenAccountsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDenomAuthorityMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryFrozenAccountsRequest proto.InternalMessageInfo
+
+func (m *QueryFrozenAccountsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryDenomAuthorityMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFrozenAccountsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDenomAuthorityMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFrozenAccountsResponse defines the response structure for the
+// FrozenAccounts gRPC query.
+type QueryFrozenAccountsResponse struct {
+	Addresses  []string            `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty" yaml:"addresses"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDenomAuthorityMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryFrozenAccountsResponse) Reset()         { *m = QueryFrozenAccountsResponse{} }
+func (m *QueryFrozenAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFrozenAccountsResponse) ProtoMessage()    {}
+func (*QueryFrozenAccountsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_589456711a18ee88, []int{15}
+}
+func (m *QueryFrozenAccountsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFrozenAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFrozenAccountsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDenomsFromCreatorRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFrozenAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFrozenAccountsResponse.Merge(m, src)
 }
-
-func (m *QueryDenomsFromCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFrozenAccountsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFrozenAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFrozenAccountsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDenomsFromCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryFrozenAccountsResponse proto.InternalMessageInfo
+
+func (m *QueryFrozenAccountsResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryDenomsFromCreatorResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFrozenAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDenomsFromCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDenomERC20AddressRequest defines the request structure for the
+// DenomERC20Address gRPC query.
+type QueryDenomERC20AddressRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
 }
 
-func (m *QueryDenomsFromCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denoms) > 0 {
-		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denoms[iNdEx])
-			copy(dAtA[i:], m.Denoms[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDenomERC20AddressRequest) Reset()         { *m = QueryDenomERC20AddressRequest{} }
+func (m *QueryDenomERC20AddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomERC20AddressRequest) ProtoMessage()    {}
+func (*QueryDenomERC20AddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_589456711a18ee88, []int{16}
+}
+func (m *QueryDenomERC20AddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomERC20AddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomERC20AddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryDenomsFromAdminRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryDenomERC20AddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomERC20AddressRequest.Merge(m, src)
 }
-
-func (m *QueryDenomsFromAdminRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryDenomERC20AddressRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryDenomsFromAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryDenomERC20AddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomERC20AddressRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDenomsFromAdminResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDenomERC20AddressRequest proto.InternalMessageInfo
+
+func (m *QueryDenomERC20AddressRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDenomsFromAdminResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDenomERC20AddressResponse defines the response structure for the
+// DenomERC20Address gRPC query. The address is empty if the denom is not
+// registered as an ERC20
+type QueryDenomERC20AddressResponse struct {
+	Erc20Address string `protobuf:"bytes,1,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty" yaml:"erc20_address"`
 }
 
-func (m *QueryDenomsFromAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denoms) > 0 {
-		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denoms[iNdEx])
-			copy(dAtA[i:], m.Denoms[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryDenomERC20AddressResponse) Reset()         { *m = QueryDenomERC20AddressResponse{} }
+func (m *QueryDenomERC20AddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomERC20AddressResponse) ProtoMessage()    {}
+func (*QueryDenomERC20AddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_589456711a18ee88, []int{17}
+}
+func (m *QueryDenomERC20AddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomERC20AddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomERC20AddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDenomERC20AddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomERC20AddressResponse.Merge(m, src)
+}
+func (m *QueryDenomERC20AddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomERC20AddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomERC20AddressResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryDenomERC20AddressResponse proto.InternalMessageInfo
+
+func (m *QueryDenomERC20AddressResponse) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "kiichain.tokenfactory.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "kiichain.tokenfactory.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryDenomAuthorityMetadataRequest)(nil), "kiichain.tokenfactory.v1beta1.QueryDenomAuthorityMetadataRequest")
+	proto.RegisterType((*QueryDenomAuthorityMetadataResponse)(nil), "kiichain.tokenfactory.v1beta1.QueryDenomAuthorityMetadataResponse")
+	proto.RegisterType((*QueryDenomsFromCreatorRequest)(nil), "kiichain.tokenfactory.v1beta1.QueryDenomsFromCreatorRequest")
+	proto.RegisterType((*QueryDenomsFromCreatorResponse)(nil), "kiichain.tokenfactory.v1beta1.QueryDenomsFromCreatorResponse")
+	proto.RegisterType((*QueryDenomsFromAdminRequest)(nil), "kiichain.tokenfactory.v1beta1.QueryDenomsFromAdminRequest")
+	proto.RegisterType((*QueryDenomsFromAdminResponse)(nil), "kiichain.tokenfactory.v1beta1.QueryDenomsFromAdminResponse")
+	proto.RegisterType((*QueryBeforeSendHookAddressRequest)(nil), "kiichain.tokenfactory.v1beta1.QueryBeforeSendHookAddressRequest")
+	proto.RegisterType((*QueryBeforeSendHookAddressResponse)(nil), "kiichain.tokenfactory.v1beta1.QueryBeforeSendHookAddressResponse")
+	proto.RegisterType((*QueryDenomPausedRequest)(nil), "kiichain.tokenfactory.v1beta1.QueryDenomPausedRequest")
+	proto.RegisterType((*QueryDenomPausedResponse)(nil), "kiichain.tokenfactory.v1beta1.QueryDenomPausedResponse")
+	proto.RegisterType((*QueryAccountFrozenRequest)(nil), "kiichain.tokenfactory.v1beta1.QueryAccountFrozenRequest")
+	proto.RegisterType((*QueryAccountFrozenResponse)(nil), "kiichain.tokenfactory.v1beta1.QueryAccountFrozenResponse")
+	proto.RegisterType((*QueryFrozenAccountsRequest)(nil), "kiichain.tokenfactory.v1beta1.QueryFrozenAccountsRequest")
+	proto.RegisterType((*QueryFrozenAccountsResponse)(nil), "kiichain.tokenfactory.v1beta1.QueryFrozenAccountsResponse")
+	proto.RegisterType((*QueryDenomERC20AddressRequest)(nil), "kiichain.tokenfactory.v1beta1.QueryDenomERC20AddressRequest")
+	proto.RegisterType((*QueryDenomERC20AddressResponse)(nil), "kiichain.tokenfactory.v1beta1.QueryDenomERC20AddressResponse")
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func init() {
+	proto.RegisterFile("kiichain/tokenfactory/v1beta1/query.proto", fileDescriptor_589456711a18ee88)
 }
 
-func (m *QueryDenomAuthorityMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+var fileDescriptor_589456711a18ee88 = []byte{
+	// 1060 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x16, 0x1a, 0xc8, 0xa4, 0x49, 0x93, 0x21, 0xd0, 0x74, 0xdb, 0xda, 0x74, 0x50, 0x7f,
+	0x21, 0xd8, 0x6d, 0x0c, 0x69, 0x4b, 0x48, 0xaa, 0xd8, 0x69, 0x9c, 0xa2, 0x50, 0xa9, 0x2c, 0xb7,
+	0x5e, 0xac, 0xb1, 0x3d, 0x71, 0x56, 0xc9, 0xee, 0x38, 0x3b, 0x6b, 0xc0, 0x44, 0xb9, 0x70, 0xe1,
+	0xc2, 0x01, 0x89, 0x1b, 0x07, 0xfe, 0x05, 0xfe, 0x01, 0xae, 0x48, 0x95, 0x40, 0xa2, 0x12, 0x12,
+	0xe2, 0x64, 0xa1, 0xa4, 0x17, 0xae, 0xfe, 0x0b, 0xaa, 0x9d, 0x79, 0x1b, 0xef, 0xda, 0x1b, 0xdb,
+	0x6b, 0x9f, 0xbc, 0x3b, 0xf3, 0xde, 0xf7, 0xbe, 0xef, 0xcd, 0xec, 0xfb, 0x64, 0x74, 0x67, 0xcf,
+	0xb6, 0x2b, 0xbb, 0xd4, 0x76, 0x4d, 0x9f, 0xef, 0x31, 0x77, 0x87, 0x56, 0x7c, 0xee, 0x35, 0xcd,
+	0xaf, 0x96, 0xca, 0xcc, 0xa7, 0x4b, 0xe6, 0x41, 0x83, 0x79, 0x4d, 0xa3, 0xee, 0x71, 0x9f, 0xe3,
+	0x6b, 0x61, 0xa8, 0x11, 0x0d, 0x35, 0x20, 0x54, 0x5f, 0xa8, 0xf1, 0x1a, 0x97, 0x91, 0x66, 0xf0,
+	0xa4, 0x92, 0xf4, 0xab, 0x35, 0xce, 0x6b, 0xfb, 0xcc, 0xa4, 0x75, 0xdb, 0xa4, 0xae, 0xcb, 0x7d,
+	0xea, 0xdb, 0xdc, 0x15, 0xb0, 0xfb, 0x7e, 0x85, 0x0b, 0x87, 0x0b, 0xb3, 0x4c, 0x05, 0x53, 0xb5,
+	0x4e, 0x2b, 0xd7, 0x69, 0xcd, 0x76, 0x65, 0x30, 0xc4, 0x2e, 0xf7, 0x67, 0x4a, 0x1b, 0xfe, 0x2e,
+	0xf7, 0x6c, 0xbf, 0xf9, 0x84, 0xf9, 0xb4, 0x4a, 0x7d, 0x1a, 0x96, 0xe8, 0x9f, 0x56, 0xa7, 0x1e,
+	0x75, 0x80, 0x0e, 0x59, 0x40, 0xf8, 0x8b, 0x80, 0xc4, 0x53, 0xb9, 0x68, 0xb1, 0x83, 0x06, 0x13,
+	0x3e, 0x79, 0x86, 0xde, 0x8a, 0xad, 0x8a, 0x3a, 0x77, 0x05, 0xc3, 0x1b, 0x68, 0x52, 0x25, 0x2f,
+	0x6a, 0xef, 0x6a, 0xb7, 0xa7, 0x73, 0x37, 0x8c, 0xbe, 0xfd, 0x31, 0x54, 0x7a, 0xe1, 0xf5, 0xe7,
+	0xad, 0xec, 0x84, 0x05, 0xa9, 0xe4, 0x73, 0x44, 0x24, 0xf6, 0x23, 0xe6, 0x72, 0x27, 0xdf, 0x2d,
+	0x01, 0x18, 0xe0, 0x9b, 0xe8, 0x7c, 0x35, 0x08, 0x90, 0x95, 0xa6, 0x0a, 0x73, 0xed, 0x56, 0xf6,
+	0x42, 0x93, 0x3a, 0xfb, 0x2b, 0x44, 0x2e, 0x13, 0x4b, 0x6d, 0x93, 0x5f, 0x35, 0xf4, 0x5e, 0x5f,
+	0x38, 0xa0, 0xfe, 0xbd, 0x86, 0xf0, 0x69, 0xbf, 0x4a, 0x0e, 0x6c, 0x83, 0x8e, 0xe5, 0x01, 0x3a,
+	0x92, 0xb1, 0x0b, 0xd7, 0x03, 0x5d, 0xed, 0x56, 0xf6, 0xb2, 0x22, 0xd6, 0x0b, 0x4f, 0xac, 0xf9,
+	0x9e, 0x33, 0x22, 0x4f, 0xd0, 0xb5, 0x0e, 0x61, 0x51, 0xf4, 0xb8, 0xb3, 0xe1, 0x31, 0xea, 0x73,
+	0x2f, 0x94, 0xfe, 0x01, 0x7a, 0xa3, 0xa2, 0x56, 0x40, 0x3c, 0x6e, 0xb7, 0xb2, 0xb3, 0xaa, 0x06,
+	0x6c, 0x10, 0x2b, 0x0c, 0x21, 0xdb, 0x28, 0x73, 0x16, 0x1c, 0x48, 0xbf, 0x83, 0x26, 0x65, 0xaf,
+	0x82, 0x53, 0x7b, 0xed, 0xf6, 0x54, 0x61, 0xbe, 0xdd, 0xca, 0xce, 0x44, 0x7a, 0x29, 0x88, 0x05,
+	0x01, 0x64, 0x13, 0x5d, 0xe9, 0x02, 0xcb, 0x57, 0x1d, 0xdb, 0x8d, 0x1c, 0x0a, 0x0d, 0xde, 0x7b,
+	0x0f, 0x45, 0x2e, 0x13, 0x4b, 0x6d, 0x93, 0xcf, 0xd0, 0xd5, 0x64, 0x98, 0xf4, 0x8c, 0xb6, 0xd1,
+	0x75, 0x09, 0x55, 0x60, 0x3b, 0xdc, 0x63, 0x5f, 0x32, 0xb7, 0xfa, 0x98, 0xf3, 0xbd, 0x7c, 0xb5,
+	0xea, 0x31, 0x21, 0xd2, 0x5e, 0x96, 0x7d, 0xb8, 0x7a, 0x67, 0x80, 0x01, 0xbb, 0x22, 0x9a, 0x0b,
+	0xbe, 0xd1, 0xaf, 0xa9, 0x70, 0x4a, 0x54, 0xed, 0x01, 0xf0, 0x95, 0x76, 0x2b, 0x7b, 0x09, 0x0e,
+	0xa2, 0x2b, 0x82, 0x58, 0x17, 0xc3, 0x25, 0xc0, 0x23, 0x79, 0x74, 0xa9, 0xd3, 0x85, 0xa7, 0xb4,
+	0x21, 0x58, 0x35, 0x2d, 0xe1, 0x4d, 0xb4, 0xd8, 0x0b, 0xd1, 0x69, 0x62, 0x5d, 0xae, 0x48, 0x90,
+	0x37, 0xa3, 0x4d, 0x54, 0xeb, 0xc4, 0x82, 0x00, 0x72, 0x80, 0x2e, 0x4b, 0x98, 0x7c, 0xa5, 0xc2,
+	0x1b, 0xae, 0x5f, 0xf4, 0xf8, 0xb7, 0xcc, 0x4d, 0xc9, 0x25, 0xb8, 0x96, 0x61, 0x37, 0xce, 0x75,
+	0x5f, 0xcb, 0xd3, 0x26, 0x84, 0x21, 0x64, 0x0b, 0xe9, 0x49, 0x25, 0x3b, 0xdc, 0x77, 0xe4, 0x4a,
+	0x2f, 0x77, 0xb5, 0x4e, 0x2c, 0x08, 0x20, 0x3f, 0x68, 0x80, 0xa4, 0x20, 0x00, 0x2f, 0xed, 0xd1,
+	0xe3, 0x22, 0x42, 0x9d, 0xf1, 0x2a, 0x05, 0x4c, 0xe7, 0x6e, 0x1a, 0x6a, 0x16, 0x1b, 0xc1, 0x2c,
+	0x36, 0xd4, 0xdc, 0xef, 0x8c, 0xae, 0x1a, 0x83, 0x1a, 0x56, 0x24, 0x93, 0xfc, 0xac, 0xc1, 0x27,
+	0xd2, 0x4d, 0x07, 0x94, 0xe5, 0xd0, 0x14, 0xb4, 0x80, 0x85, 0xb7, 0x7b, 0xa1, 0xdd, 0xca, 0xce,
+	0xc5, 0xfa, 0xc4, 0x04, 0xb1, 0x3a, 0x61, 0x78, 0x2b, 0x81, 0xdb, 0xad, 0x81, 0xdc, 0x54, 0xc1,
+	0x18, 0xb9, 0xad, 0xe8, 0x68, 0xd9, 0xb4, 0x36, 0x72, 0x77, 0x47, 0xfc, 0x50, 0x4a, 0xd1, 0xa1,
+	0x12, 0x07, 0x02, 0x9d, 0x6b, 0x68, 0x86, 0x79, 0x95, 0xdc, 0xdd, 0xae, 0x2f, 0x64, 0xb1, 0xdd,
+	0xca, 0x2e, 0x28, 0xc4, 0xd8, 0x36, 0xb1, 0x2e, 0xc8, 0x77, 0x80, 0xc9, 0xfd, 0x31, 0x8b, 0xce,
+	0xcb, 0x0a, 0xf8, 0x17, 0x0d, 0x4d, 0x2a, 0x9f, 0xc0, 0x4b, 0x03, 0xc6, 0x70, 0xaf, 0x51, 0xe9,
+	0xb9, 0x34, 0x29, 0x8a, 0x3a, 0xf9, 0xf0, 0xbb, 0xbf, 0x5f, 0xfe, 0x74, 0xee, 0x16, 0xbe, 0x61,
+	0x0e, 0xe3, 0x93, 0xf8, 0x7f, 0x0d, 0xbd, 0x93, 0x6c, 0x00, 0x38, 0x3f, 0x4c, 0xf5, 0xbe, 0x3e,
+	0xa7, 0x17, 0xc6, 0x81, 0x00, 0x41, 0x8f, 0xa5, 0xa0, 0x02, 0x5e, 0x1f, 0x20, 0x48, 0x8d, 0x54,
+	0xf3, 0x50, 0xfe, 0x1e, 0x99, 0xbd, 0x7e, 0x85, 0xff, 0xd1, 0xd0, 0x7c, 0x8f, 0x91, 0xe0, 0xd5,
+	0xa1, 0x39, 0x26, 0xd8, 0x99, 0xbe, 0x36, 0x62, 0x36, 0x88, 0x7b, 0x24, 0xc5, 0x3d, 0xc4, 0xab,
+	0x43, 0x89, 0x2b, 0xed, 0x78, 0xdc, 0x29, 0x81, 0x37, 0x9a, 0x87, 0xf0, 0x70, 0x84, 0xff, 0xd4,
+	0xd0, 0xc5, 0x2e, 0x37, 0xc2, 0x2b, 0xe9, 0x88, 0x45, 0x9d, 0x50, 0xff, 0x74, 0xa4, 0x5c, 0x90,
+	0xb4, 0x2e, 0x25, 0xad, 0xe0, 0x07, 0x29, 0x24, 0x49, 0x63, 0x35, 0x0f, 0xe5, 0xcf, 0x11, 0x7e,
+	0xa9, 0xa1, 0xb7, 0x13, 0x4d, 0x0c, 0xaf, 0x0f, 0x43, 0xac, 0x9f, 0x99, 0xea, 0xf9, 0x31, 0x10,
+	0x40, 0x60, 0x51, 0x0a, 0x5c, 0xc7, 0x0f, 0xd3, 0x5d, 0xc8, 0xb2, 0x04, 0x2d, 0x09, 0xe6, 0x56,
+	0x4b, 0xbb, 0x9c, 0xef, 0xe1, 0xdf, 0x34, 0x34, 0x1d, 0xb1, 0x3e, 0x7c, 0x6f, 0xe8, 0xae, 0xc7,
+	0xec, 0x56, 0xbf, 0x9f, 0x3a, 0x0f, 0x84, 0xac, 0x4a, 0x21, 0xf7, 0xf0, 0xc7, 0xe9, 0x84, 0x28,
+	0xdb, 0x0d, 0x2e, 0xdd, 0x4c, 0xcc, 0xff, 0xf0, 0x83, 0x61, 0x88, 0x24, 0xb9, 0xb4, 0xfe, 0xc9,
+	0x08, 0x99, 0xe3, 0x9d, 0x86, 0xf2, 0xdf, 0xe0, 0xca, 0xc9, 0xc3, 0x3d, 0xc2, 0xbf, 0x6b, 0x68,
+	0x36, 0xee, 0x7a, 0x78, 0x28, 0x56, 0x89, 0xc6, 0xad, 0xaf, 0x8c, 0x92, 0x3a, 0xde, 0xb1, 0x28,
+	0x45, 0xf8, 0xaf, 0x70, 0xc8, 0x45, 0x8d, 0x2d, 0xc5, 0x90, 0x4b, 0x30, 0xd6, 0x14, 0x43, 0x2e,
+	0xc9, 0x4d, 0xc9, 0x9a, 0x14, 0x74, 0x1f, 0x2f, 0x0f, 0x10, 0x14, 0xf3, 0x54, 0xb3, 0xdc, 0x2c,
+	0x49, 0x65, 0x85, 0xed, 0xe7, 0xc7, 0x19, 0xed, 0xc5, 0x71, 0x46, 0xfb, 0xef, 0x38, 0xa3, 0xfd,
+	0x78, 0x92, 0x99, 0x78, 0x71, 0x92, 0x99, 0xf8, 0xf7, 0x24, 0x33, 0xf1, 0x6c, 0xa9, 0x66, 0xfb,
+	0xbb, 0x8d, 0xb2, 0x51, 0xe1, 0x4e, 0x07, 0xfa, 0xf4, 0xe1, 0x9b, 0x78, 0x15, 0xbf, 0x59, 0x67,
+	0xa2, 0x3c, 0x29, 0xff, 0x18, 0x7e, 0xf4, 0x2a, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x7a, 0xb1, 0x55,
+	0x27, 0x0f, 0x00, 0x00,
 }
 
-func (m *QueryDenomAuthorityMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params defines a gRPC query method that returns the tokenfactory module's
+	// parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// DenomAuthorityMetadata defines a gRPC query method for fetching
+	// DenomAuthorityMetadata for a particular denom.
+	DenomAuthorityMetadata(ctx context.Context, in *QueryDenomAuthorityMetadataRequest, opts ...grpc.CallOption) (*QueryDenomAuthorityMetadataResponse, error)
+	// DenomsFromCreator defines a gRPC query method for fetching all
+	// denominations created by a specific admin/creator.
+	DenomsFromCreator(ctx context.Context, in *QueryDenomsFromCreatorRequest, opts ...grpc.CallOption) (*QueryDenomsFromCreatorResponse, error)
+	// DenomsFromAdmin defines a gRPC query method for fetching all
+	// denominations owned by a specific admin.
+	DenomsFromAdmin(ctx context.Context, in *QueryDenomsFromAdminRequest, opts ...grpc.CallOption) (*QueryDenomsFromAdminResponse, error)
+	// BeforeSendHookAddress defines a gRPC query method for
+	// getting the address registered for the before send hook.
+	BeforeSendHookAddress(ctx context.Context, in *QueryBeforeSendHookAddressRequest, opts ...grpc.CallOption) (*QueryBeforeSendHookAddressResponse, error)
+	// DenomPaused defines a gRPC query method for checking if the transfers of a
+	// denom are paused.
+	DenomPaused(ctx context.Context, in *QueryDenomPausedRequest, opts ...grpc.CallOption) (*QueryDenomPausedResponse, error)
+	// AccountFrozen defines a gRPC query method for checking if an address is
+	// frozen for a denom.
+	AccountFrozen(ctx context.Context, in *QueryAccountFrozenRequest, opts ...grpc.CallOption) (*QueryAccountFrozenResponse, error)
+	// FrozenAccounts defines a gRPC query method for fetching all the addresses
+	// frozen for a denom.
+	FrozenAccounts(ctx context.Context, in *QueryFrozenAccountsRequest, opts ...grpc.CallOption) (*QueryFrozenAccountsResponse, error)
+	// DenomERC20Address defines a gRPC query method for getting the ERC20
+	// address registered for a denom.
+	DenomERC20Address(ctx context.Context, in *QueryDenomERC20AddressRequest, opts ...grpc.CallOption) (*QueryDenomERC20AddressResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.tokenfactory.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.AuthorityMetadata.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryDenomsFromCreatorRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) DenomAuthorityMetadata(ctx context.Context, in *QueryDenomAuthorityMetadataRequest, opts ...grpc.CallOption) (*QueryDenomAuthorityMetadataResponse, error) {
+	out := new(QueryDenomAuthorityMetadataResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.tokenfactory.v1beta1.Query/DenomAuthorityMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) DenomsFromCreator(ctx context.Context, in *QueryDenomsFromCreatorRequest, opts ...grpc.CallOption) (*QueryDenomsFromCreatorResponse, error) {
+	out := new(QueryDenomsFromCreatorResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.tokenfactory.v1beta1.Query/DenomsFromCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryDenomsFromCreatorResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) DenomsFromAdmin(ctx context.Context, in *QueryDenomsFromAdminRequest, opts ...grpc.CallOption) (*QueryDenomsFromAdminResponse, error) {
+	out := new(QueryDenomsFromAdminResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.tokenfactory.v1beta1.Query/DenomsFromAdmin", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Denoms) > 0 {
-		for _, s := range m.Denoms {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) BeforeSendHookAddress(ctx context.Context, in *QueryBeforeSendHookAddressRequest, opts ...grpc.CallOption) (*QueryBeforeSendHookAddressResponse, error) {
+	out := new(QueryBeforeSendHookAddressResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.tokenfactory.v1beta1.Query/BeforeSendHookAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryDenomsFromAdminRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) DenomPaused(ctx context.Context, in *QueryDenomPausedRequest, opts ...grpc.CallOption) (*QueryDenomPausedResponse, error) {
+	out := new(QueryDenomPausedResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.tokenfactory.v1beta1.Query/DenomPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) AccountFrozen(ctx context.Context, in *QueryAccountFrozenRequest, opts ...grpc.CallOption) (*QueryAccountFrozenResponse, error) {
+	out := new(QueryAccountFrozenResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.tokenfactory.v1beta1.Query/AccountFrozen", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryDenomsFromAdminResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) FrozenAccounts(ctx context.Context, in *QueryFrozenAccountsRequest, opts ...grpc.CallOption) (*QueryFrozenAccountsResponse, error) {
+	out := new(QueryFrozenAccountsResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.tokenfactory.v1beta1.Query/FrozenAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Denoms) > 0 {
-		for _, s := range m.Denoms {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) DenomERC20Address(ctx context.Context, in *QueryDenomERC20AddressRequest, opts ...grpc.CallOption) (*QueryDenomERC20AddressResponse, error) {
+	out := new(QueryDenomERC20AddressResponse)
+	err := c.cc.Invoke(ctx, "/kiichain.tokenfactory.v1beta1.Query/DenomERC20Address", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params defines a gRPC query method that returns the tokenfactory module's
+	// parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// DenomAuthorityMetadata defines a gRPC query method for fetching
+	// DenomAuthorityMetadata for a particular denom.
+	DenomAuthorityMetadata(context.Context, *QueryDenomAuthorityMetadataRequest) (*QueryDenomAuthorityMetadataResponse, error)
+	// DenomsFromCreator defines a gRPC query method for fetching all
+	// denominations created by a specific admin/creator.
+	DenomsFromCreator(context.Context, *QueryDenomsFromCreatorRequest) (*QueryDenomsFromCreatorResponse, error)
+	// DenomsFromAdmin defines a gRPC query method for fetching all
+	// denominations owned by a specific admin.
+	DenomsFromAdmin(context.Context, *QueryDenomsFromAdminRequest) (*QueryDenomsFromAdminResponse, error)
+	// BeforeSendHookAddress defines a gRPC query method for
+	// getting the address registered for the before send hook.
+	BeforeSendHookAddress(context.Context, *QueryBeforeSendHookAddressRequest) (*QueryBeforeSendHookAddressResponse, error)
+	// DenomPaused defines a gRPC query method for checking if the transfers of a
+	// denom are paused.
+	DenomPaused(context.Context, *QueryDenomPausedRequest) (*QueryDenomPausedResponse, error)
+	// AccountFrozen defines a gRPC query method for checking if an address is
+	// frozen for a denom.
+	AccountFrozen(context.Context, *QueryAccountFrozenRequest) (*QueryAccountFrozenResponse, error)
+	// FrozenAccounts defines a gRPC query method for fetching all the addresses
+	// frozen for a denom.
+	FrozenAccounts(context.Context, *QueryFrozenAccountsRequest) (*QueryFrozenAccountsResponse, error)
+	// DenomERC20Address defines a gRPC query method for getting the ERC20
+	// address registered for a denom.
+	DenomERC20Address(context.Context, *QueryDenomERC20AddressRequest) (*QueryDenomERC20AddressResponse, error)
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) DenomAuthorityMetadata(ctx context.Context, req *QueryDenomAuthorityMetadataRequest) (*QueryDenomAuthorityMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomAuthorityMetadata not implemented")
+}
+func (*UnimplementedQueryServer) DenomsFromCreator(ctx context.Context, req *QueryDenomsFromCreatorRequest) (*QueryDenomsFromCreatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomsFromCreator not implemented")
+}
+func (*UnimplementedQueryServer) DenomsFromAdmin(ctx context.Context, req *QueryDenomsFromAdminRequest) (*QueryDenomsFromAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomsFromAdmin not implemented")
+}
+func (*UnimplementedQueryServer) BeforeSendHookAddress(ctx context.Context, req *QueryBeforeSendHookAddressRequest) (*QueryBeforeSendHookAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeforeSendHookAddress not implemented")
+}
+func (*UnimplementedQueryServer) DenomPaused(ctx context.Context, req *QueryDenomPausedRequest) (*QueryDenomPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomPaused not implemented")
+}
+func (*UnimplementedQueryServer) AccountFrozen(ctx context.Context, req *QueryAccountFrozenRequest) (*QueryAccountFrozenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountFrozen not implemented")
+}
+func (*UnimplementedQueryServer) FrozenAccounts(ctx context.Context, req *QueryFrozenAccountsRequest) (*QueryFrozenAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FrozenAccounts not implemented")
+}
+func (*UnimplementedQueryServer) DenomERC20Address(ctx context.Context, req *QueryDenomERC20AddressRequest) (*QueryDenomERC20AddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomERC20Address not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.tokenfactory.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomAuthorityMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomAuthorityMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomAuthorityMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.tokenfactory.v1beta1.Query/DenomAuthorityMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomAuthorityMetadata(ctx, req.(*QueryDenomAuthorityMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomsFromCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomsFromCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomsFromCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.tokenfactory.v1beta1.Query/DenomsFromCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomsFromCreator(ctx, req.(*QueryDenomsFromCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomsFromAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomsFromAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomsFromAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.tokenfactory.v1beta1.Query/DenomsFromAdmin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomsFromAdmin(ctx, req.(*QueryDenomsFromAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BeforeSendHookAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBeforeSendHookAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BeforeSendHookAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.tokenfactory.v1beta1.Query/BeforeSendHookAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BeforeSendHookAddress(ctx, req.(*QueryBeforeSendHookAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.tokenfactory.v1beta1.Query/DenomPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomPaused(ctx, req.(*QueryDenomPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountFrozen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountFrozenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountFrozen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.tokenfactory.v1beta1.Query/AccountFrozen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountFrozen(ctx, req.(*QueryAccountFrozenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FrozenAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFrozenAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FrozenAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.tokenfactory.v1beta1.Query/FrozenAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FrozenAccounts(ctx, req.(*QueryFrozenAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DenomERC20Address_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomERC20AddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomERC20Address(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kiichain.tokenfactory.v1beta1.Query/DenomERC20Address",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomERC20Address(ctx, req.(*QueryDenomERC20AddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kiichain.tokenfactory.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "DenomAuthorityMetadata",
+			Handler:    _Query_DenomAuthorityMetadata_Handler,
+		},
+		{
+			MethodName: "DenomsFromCreator",
+			Handler:    _Query_DenomsFromCreator_Handler,
+		},
+		{
+			MethodName: "DenomsFromAdmin",
+			Handler:    _Query_DenomsFromAdmin_Handler,
+		},
+		{
+			MethodName: "BeforeSendHookAddress",
+			Handler:    _Query_BeforeSendHookAddress_Handler,
+		},
+		{
+			MethodName: "DenomPaused",
+			Handler:    _Query_DenomPaused_Handler,
+		},
+		{
+			MethodName: "AccountFrozen",
+			Handler:    _Query_AccountFrozen_Handler,
+		},
+		{
+			MethodName: "FrozenAccounts",
+			Handler:    _Query_FrozenAccounts_Handler,
+		},
+		{
+			MethodName: "DenomERC20Address",
+			Handler:    _Query_DenomERC20Address_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kiichain/tokenfactory/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomAuthorityMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomAuthorityMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomAuthorityMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomAuthorityMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomAuthorityMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomAuthorityMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AuthorityMetadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomsFromCreatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomsFromCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomsFromCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomsFromCreatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomsFromCreatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomsFromCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomsFromAdminRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomsFromAdminRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomsFromAdminRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomsFromAdminResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomsFromAdminResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomsFromAdminResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBeforeSendHookAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBeforeSendHookAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBeforeSendHookAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBeforeSendHookAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBeforeSendHookAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBeforeSendHookAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CosmwasmAddress) > 0 {
+		i -= len(m.CosmwasmAddress)
+		copy(dAtA[i:], m.CosmwasmAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CosmwasmAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomPausedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomPausedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomPausedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountFrozenRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountFrozenRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountFrozenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountFrozenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountFrozenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountFrozenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFrozenAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFrozenAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFrozenAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomERC20AddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomERC20AddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomERC20AddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomERC20AddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomERC20AddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomERC20AddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomAuthorityMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomAuthorityMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AuthorityMetadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDenomsFromCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomsFromCreatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDenomsFromAdminRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomsFromAdminResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBeforeSendHookAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBeforeSendHookAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CosmwasmAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomPausedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAccountFrozenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountFrozenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryFrozenAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFrozenAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomERC20AddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomERC20AddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsFromAdminRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsFromAdminRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsFromAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsFromAdminResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsFromAdminResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsFromAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBeforeSendHookAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBeforeSendHookAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1035,12 +2946,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBeforeSendHookAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmwasmAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmwasmAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1062,7 +3005,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomPausedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1085,17 +3028,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomPausedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1105,25 +3048,94 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1145,7 +3157,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountFrozenRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1168,10 +3180,10 @@ func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountFrozenRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountFrozenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1206,6 +3218,38 @@ func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1227,7 +3271,7 @@ func (m *QueryDenomAuthorityMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountFrozenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1250,17 +3294,17 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountFrozenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomAuthorityMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountFrozenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorityMetadata", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1270,25 +3314,12 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.AuthorityMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Frozen = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1310,7 +3341,7 @@ func (m *QueryDenomAuthorityMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFrozenAccountsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1333,15 +3364,15 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFrozenAccountsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFrozenAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1369,7 +3400,43 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1392,7 +3459,7 @@ func (m *QueryDenomsFromCreatorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFrozenAccountsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1415,15 +3482,15 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFrozenAccountsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsFromCreatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFrozenAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1451,7 +3518,43 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1474,7 +3577,7 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsFromAdminRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomERC20AddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1497,15 +3600,15 @@ func (m *QueryDenomsFromAdminRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsFromAdminRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomERC20AddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsFromAdminRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomERC20AddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1533,7 +3636,7 @@ func (m *QueryDenomsFromAdminRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1556,7 +3659,7 @@ func (m *QueryDenomsFromAdminRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsFromAdminResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomERC20AddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1579,15 +3682,15 @@ func (m *QueryDenomsFromAdminResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsFromAdminResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomERC20AddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsFromAdminResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomERC20AddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1615,7 +3718,7 @@ func (m *QueryDenomsFromAdminResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
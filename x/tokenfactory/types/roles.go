@@ -0,0 +1,78 @@
+package types
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Roles that can be granted over a denom by its admin
+// The admin holds every role
+const (
+	RoleMinter           = "minter"
+	RoleBurner           = "burner"
+	RoleForceTransferrer = "force_transferrer"
+	RoleMetadata         = "metadata"
+	RolePauser           = "pauser"
+)
+
+// Roles is the list of the valid roles
+var Roles = []string{
+	RoleMinter,
+	RoleBurner,
+	RoleForceTransferrer,
+	RoleMetadata,
+	RolePauser,
+}
+
+// IsValidRole returns true if the role is a valid role
+func IsValidRole(role string) bool {
+	return slices.Contains(Roles, role)
+}
+
+// Validate validates a role granted over a denom
+func (r DenomRole) Validate() error {
+	if !IsValidRole(r.Role) {
+		return errorsmod.Wrapf(ErrInvalidRole, "unknown role %s", r.Role)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidRole, "invalid role address (%s)", err)
+	}
+
+	// Only the minters have an allowance
+	if r.MintAllowance != nil {
+		if r.Role != RoleMinter {
+			return errorsmod.Wrapf(ErrInvalidRole, "only the %s role has a mint allowance", RoleMinter)
+		}
+		if r.MintAllowance.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidRole, "mint allowance can't be negative, got %s", r.MintAllowance)
+		}
+	}
+
+	return nil
+}
+
+// HasRole returns true if the address is the admin or was granted the role
+func (metadata DenomAuthorityMetadata) HasRole(role, address string) bool {
+	if address == "" {
+		return false
+	}
+	if address == metadata.Admin {
+		return true
+	}
+	_, found := metadata.FindRole(role, address)
+	return found
+}
+
+// FindRole returns the index of a role granted to an address
+func (metadata DenomAuthorityMetadata) FindRole(role, address string) (int, bool) {
+	for i, r := range metadata.Roles {
+		if r.Role == role && r.Address == address {
+			return i, true
+		}
+	}
+	return -1, false
+}